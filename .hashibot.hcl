@@ -117,6 +117,9 @@ behavior "regexp_issue_labeler_v2" "service_labels" {
       "aws_api_gateway_v2_",
       "aws_apigatewayv2_",
     ],
+    "service/appconfig" = [
+      "aws_appconfig_",
+    ],
     "service/applicationautoscaling" = [
       "aws_appautoscaling_",
     ],
@@ -129,6 +132,9 @@ behavior "regexp_issue_labeler_v2" "service_labels" {
     "service/appmesh" = [
       "aws_appmesh_",
     ],
+    "service/apprunner" = [
+      "aws_apprunner_",
+    ],
     "service/appstream" = [
       "aws_appstream_",
     ],
@@ -232,6 +238,9 @@ behavior "regexp_issue_labeler_v2" "service_labels" {
     "service/dax" = [
       "aws_dax_",
     ],
+    "service/detective" = [
+      "aws_detective_",
+    ],
     "service/devicefarm" = [
       "aws_devicefarm_",
     ],
@@ -362,6 +371,9 @@ behavior "regexp_issue_labeler_v2" "service_labels" {
     "service/inspector" = [
       "aws_inspector_",
     ],
+    "service/inspector2" = [
+      "aws_inspector2_",
+    ],
     "service/iot" = [
       "aws_iot_",
     ],
@@ -408,6 +420,9 @@ behavior "regexp_issue_labeler_v2" "service_labels" {
     "service/macie2" = [
       "aws_macie2_",
     ],
+    "service/managedgrafana" = [
+      "aws_grafana_",
+    ],
     "service/marketplacecatalog" = [
       "aws_marketplace_catalog_",
     ],
@@ -429,6 +444,9 @@ behavior "regexp_issue_labeler_v2" "service_labels" {
     "service/mediatailor" = [
       "aws_media_tailor_",
     ],
+    "service/memorydb" = [
+      "aws_memorydb_",
+    ],
     "service/mobile" = [
       "aws_mobile_",
     ],
@@ -468,6 +486,9 @@ behavior "regexp_issue_labeler_v2" "service_labels" {
     "service/pricing" = [
       "aws_pricing_",
     ],
+    "service/prometheusservice" = [
+      "aws_prometheus_",
+    ],
     "service/qldb" = [
       "aws_qldb_",
     ],
@@ -538,6 +559,9 @@ behavior "regexp_issue_labeler_v2" "service_labels" {
     "service/ses" = [
       "aws_ses_",
     ],
+    "service/sesv2" = [
+      "aws_sesv2_",
+    ],
     "service/sfn" = [
       "aws_sfn_",
     ],
@@ -701,6 +725,11 @@ behavior "pull_request_path_labeler" "service_labels" {
       "**/api_gateway_v2_*",
       "**/apigatewayv2_*"
     ]
+    "service/appconfig" = [
+      "aws/internal/service/appconfig/**/*",
+      "**/*_appconfig_*",
+      "**/appconfig_*"
+    ]
     "service/applicationautoscaling" = [
       "aws/internal/service/applicationautoscaling/**/*",
       "**/*_appautoscaling_*",
@@ -716,6 +745,11 @@ behavior "pull_request_path_labeler" "service_labels" {
       "**/*_appmesh_*",
       "**/appmesh_*"
     ]
+    "service/apprunner" = [
+      "aws/internal/service/apprunner/**/*",
+      "**/*_apprunner_*",
+      "**/apprunner_*"
+    ]
     "service/appstream" = [
       "aws/internal/service/appstream/**/*",
       "**/*_appstream_*",
@@ -901,6 +935,11 @@ behavior "pull_request_path_labeler" "service_labels" {
       "**/*_dax_*",
       "**/dax_*"
     ]
+    "service/detective" = [
+      "aws/internal/service/detective/**/*",
+      "**/*_detective_*",
+      "**/detective_*"
+    ]
     "service/devicefarm" = [
       "aws/internal/service/devicefarm/**/*",
       "**/*_devicefarm_*",
@@ -1146,6 +1185,11 @@ behavior "pull_request_path_labeler" "service_labels" {
       "**/*_inspector_*",
       "**/inspector_*"
     ]
+    "service/inspector2" = [
+      "aws/internal/service/inspector2/**/*",
+      "**/*_inspector2_*",
+      "**/inspector2_*"
+    ]
     "service/iot" = [
       "aws/internal/service/iot/**/*",
       "**/*_iot_*",
@@ -1221,6 +1265,11 @@ behavior "pull_request_path_labeler" "service_labels" {
       "**/*_macie2_*",
       "**/macie2_*"
     ]
+    "service/managedgrafana" = [
+      "aws/internal/service/managedgrafana/**/*",
+      "**/*_grafana_*",
+      "**/grafana_*"
+    ]
     "service/marketplacecatalog" = [
       "aws/internal/service/marketplacecatalog/**/*",
       "**/*_marketplace_catalog_*",
@@ -1256,6 +1305,11 @@ behavior "pull_request_path_labeler" "service_labels" {
       "**/*_media_tailor_*",
       "**/media_tailor_*",
     ]
+    "service/memorydb" = [
+      "aws/internal/service/memorydb/**/*",
+      "**/*_memorydb_*",
+      "**/memorydb_*"
+    ]
     "service/mobile" = [
       "aws/internal/service/mobile/**/*",
       "**/*_mobile_*",
@@ -1316,6 +1370,11 @@ behavior "pull_request_path_labeler" "service_labels" {
       "**/*_pricing_*",
       "**/pricing_*"
     ]
+    "service/prometheusservice" = [
+      "aws/internal/service/prometheusservice/**/*",
+      "**/*_prometheus_*",
+      "**/prometheus_*"
+    ]
     "service/qldb" = [
       "aws/internal/service/qldb/**/*",
       "**/*_qldb_*",
@@ -1442,6 +1501,11 @@ behavior "pull_request_path_labeler" "service_labels" {
       "**/*_ses_*",
       "**/ses_*"
     ]
+    "service/sesv2" = [
+      "aws/internal/service/sesv2/**/*",
+      "**/*_sesv2_*",
+      "**/sesv2_*"
+    ]
     "service/sfn" = [
       "aws/internal/service/sfn/**/*",
       "**/*_sfn_*",
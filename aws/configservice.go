@@ -177,3 +177,112 @@ func configWaitForOrganizationRuleStatusUpdateSuccessful(conn *configservice.Con
 
 	return err
 }
+
+func configDescribeConformancePack(conn *configservice.ConfigService, name string) (*configservice.ConformancePackDetail, error) {
+	input := &configservice.DescribeConformancePacksInput{
+		ConformancePackNames: []*string{aws.String(name)},
+	}
+
+	for {
+		output, err := conn.DescribeConformancePacks(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pack := range output.ConformancePackDetails {
+			if aws.StringValue(pack.ConformancePackName) == name {
+				return pack, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func configDescribeConformancePackStatus(conn *configservice.ConfigService, name string) (*configservice.ConformancePackStatusDetail, error) {
+	input := &configservice.DescribeConformancePackStatusInput{
+		ConformancePackNames: []*string{aws.String(name)},
+	}
+
+	for {
+		output, err := conn.DescribeConformancePackStatus(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, status := range output.ConformancePackStatusDetails {
+			if aws.StringValue(status.ConformancePackName) == name {
+				return status, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func configRefreshConformancePackStatus(conn *configservice.ConfigService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		status, err := configDescribeConformancePackStatus(conn, name)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status == nil {
+			return nil, "", nil
+		}
+
+		switch aws.StringValue(status.ConformancePackState) {
+		case configservice.ConformancePackStateCreateFailed, configservice.ConformancePackStateDeleteFailed:
+			return status, aws.StringValue(status.ConformancePackState), fmt.Errorf("%s: %s", aws.StringValue(status.ConformancePackState), aws.StringValue(status.ConformancePackStatusReason))
+		}
+
+		return status, aws.StringValue(status.ConformancePackState), nil
+	}
+}
+
+func configWaitForConformancePackStateCreateComplete(conn *configservice.ConfigService, name string, timeout time.Duration) error {
+	stateChangeConf := &resource.StateChangeConf{
+		Pending: []string{configservice.ConformancePackStateCreateInProgress},
+		Target:  []string{configservice.ConformancePackStateCreateComplete},
+		Refresh: configRefreshConformancePackStatus(conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateChangeConf.WaitForState()
+
+	return err
+}
+
+func configWaitForConformancePackStateDeleteComplete(conn *configservice.ConfigService, name string, timeout time.Duration) error {
+	stateChangeConf := &resource.StateChangeConf{
+		Pending: []string{configservice.ConformancePackStateDeleteInProgress},
+		Target:  []string{},
+		Refresh: configRefreshConformancePackStatus(conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateChangeConf.WaitForState()
+
+	if isAWSErr(err, configservice.ErrCodeNoSuchConformancePackException, "") {
+		return nil
+	}
+
+	return err
+}
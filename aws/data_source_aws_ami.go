@@ -56,10 +56,18 @@ func dataSourceAwsAmi() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"boot_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"creation_date": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deprecation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -84,6 +92,10 @@ func dataSourceAwsAmi() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"imds_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"kernel_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -128,6 +140,10 @@ func dataSourceAwsAmi() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tpm_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -254,7 +270,9 @@ func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image, meta int
 	// Simple attributes first
 	d.SetId(aws.StringValue(image.ImageId))
 	d.Set("architecture", image.Architecture)
+	d.Set("boot_mode", image.BootMode)
 	d.Set("creation_date", image.CreationDate)
+	d.Set("deprecation_time", image.DeprecationTime)
 	if image.Description != nil {
 		d.Set("description", image.Description)
 	}
@@ -265,6 +283,7 @@ func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image, meta int
 		d.Set("image_owner_alias", image.ImageOwnerAlias)
 	}
 	d.Set("image_type", image.ImageType)
+	d.Set("imds_support", image.ImdsSupport)
 	if image.KernelId != nil {
 		d.Set("kernel_id", image.KernelId)
 	}
@@ -286,6 +305,7 @@ func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image, meta int
 		d.Set("sriov_net_support", image.SriovNetSupport)
 	}
 	d.Set("state", image.State)
+	d.Set("tpm_support", image.TpmSupport)
 	d.Set("virtualization_type", image.VirtualizationType)
 	// Complex types get their own functions
 	if err := d.Set("block_device_mappings", amiBlockDeviceMappings(image.BlockDeviceMappings)); err != nil {
@@ -44,6 +44,7 @@ func dataSourceAwsAvailabilityZones() *schema.Resource {
 			"state": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Default:  ec2.AvailabilityZoneStateAvailable,
 				ValidateFunc: validation.StringInSlice([]string{
 					ec2.AvailabilityZoneStateAvailable,
 					ec2.AvailabilityZoneStateInformation,
@@ -71,21 +72,20 @@ func dataSourceAwsAvailabilityZonesRead(d *schema.ResourceData, meta interface{}
 		request.AllAvailabilityZones = aws.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("state"); ok {
-		request.Filters = []*ec2.Filter{
-			{
-				Name:   aws.String("state"),
-				Values: []*string{aws.String(v.(string))},
-			},
-		}
-	}
-
 	if filters, filtersOk := d.GetOk("filter"); filtersOk {
 		request.Filters = append(request.Filters, buildEC2CustomFilterList(
 			filters.(*schema.Set),
 		)...)
 	}
 
+	// An explicit "state" filter takes precedence over the state argument default.
+	if v, ok := d.GetOk("state"); ok && !ec2FilterListContainsName(request.Filters, "state") {
+		request.Filters = append(request.Filters, &ec2.Filter{
+			Name:   aws.String("state"),
+			Values: []*string{aws.String(v.(string))},
+		})
+	}
+
 	if len(request.Filters) == 0 {
 		// Don't send an empty filters list; the EC2 API won't accept it.
 		request.Filters = nil
@@ -142,3 +142,13 @@ func dataSourceAwsAvailabilityZonesRead(d *schema.ResourceData, meta interface{}
 
 	return nil
 }
+
+func ec2FilterListContainsName(filters []*ec2.Filter, name string) bool {
+	for _, filter := range filters {
+		if aws.StringValue(filter.Name) == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -82,6 +82,7 @@ func TestAccAWSAvailabilityZones_basic(t *testing.T) {
 				Config: testAccCheckAwsAvailabilityZonesConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsAvailabilityZonesMeta("data.aws_availability_zones.availability_zones"),
+					resource.TestCheckResourceAttr("data.aws_availability_zones.availability_zones", "state", ec2.AvailabilityZoneStateAvailable),
 				),
 			},
 		},
@@ -280,10 +281,13 @@ func testAccCheckAwsAvailabilityZonesBuildAvailable(attrs map[string]string) ([]
 	if qty < 1 {
 		return nil, fmt.Errorf("No AZs found in region, this is probably a bug.")
 	}
-	_, ok = attrs["zone_ids.#"]
+	zoneIDs, ok := attrs["zone_ids.#"]
 	if !ok {
 		return nil, fmt.Errorf("Available AZ ID list is missing.")
 	}
+	if zoneIDs != v {
+		return nil, fmt.Errorf("AZ name and ID lists differ in length (%s != %s), this is definitely a bug.", v, zoneIDs)
+	}
 	zones := make([]string, qty)
 	for n := range zones {
 		zone, ok := attrs["names."+strconv.Itoa(n)]
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func dataSourceAwsCloudFrontOriginAccessControl() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFrontOriginAccessControlRead,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"origin_access_control_origin_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signing_behavior": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signing_protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudFrontOriginAccessControlRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	name := d.Get("name").(string)
+	summary, err := finder.OriginAccessControlSummaryByName(conn, name)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("no CloudFront Origin Access Control found with name (%s)", name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Origin Access Control (%s): %w", name, err)
+	}
+
+	id := aws.StringValue(summary.Id)
+
+	// The list summary does not include the ETag, so fetch the full configuration.
+	output, err := finder.OriginAccessControlByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Origin Access Control (%s): %w", id, err)
+	}
+
+	config := output.OriginAccessControl.OriginAccessControlConfig
+
+	d.SetId(id)
+	d.Set("description", config.Description)
+	d.Set("etag", output.ETag)
+	d.Set("name", config.Name)
+	d.Set("origin_access_control_origin_type", config.OriginAccessControlOriginType)
+	d.Set("signing_behavior", config.SigningBehavior)
+	d.Set("signing_protocol", config.SigningProtocol)
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsCloudFrontOriginAccessIdentities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFrontOriginAccessIdentitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"comments": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"iam_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"s3_canonical_user_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudFrontOriginAccessIdentitiesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	var comments []interface{}
+
+	if v, ok := d.GetOk("comments"); ok && v.(*schema.Set).Len() > 0 {
+		comments = v.(*schema.Set).List()
+	}
+
+	var identities []*cloudfront.OriginAccessIdentitySummary
+
+	err := conn.ListCloudFrontOriginAccessIdentitiesPages(&cloudfront.ListCloudFrontOriginAccessIdentitiesInput{}, func(page *cloudfront.ListCloudFrontOriginAccessIdentitiesOutput, lastPage bool) bool {
+		if page == nil || page.CloudFrontOriginAccessIdentityList == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.CloudFrontOriginAccessIdentityList.Items {
+			if item == nil {
+				continue
+			}
+
+			if len(comments) > 0 {
+				if _, ok := sliceContainsString(comments, aws.StringValue(item.Comment)); !ok {
+					continue
+				}
+			}
+
+			identities = append(identities, item)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing CloudFront Origin Access Identities: %w", err)
+	}
+
+	var iamARNs, ids, s3CanonicalUserIDs []string
+
+	for _, identity := range identities {
+		id := aws.StringValue(identity.Id)
+		iamARN := arn.ARN{
+			Partition: meta.(*AWSClient).partition,
+			Service:   "iam",
+			AccountID: "cloudfront",
+			Resource:  fmt.Sprintf("user/CloudFront Origin Access Identity %s", id),
+		}.String()
+
+		iamARNs = append(iamARNs, iamARN)
+		ids = append(ids, id)
+		s3CanonicalUserIDs = append(s3CanonicalUserIDs, aws.StringValue(identity.S3CanonicalUserId))
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	if err := d.Set("iam_arns", iamARNs); err != nil {
+		return fmt.Errorf("error setting iam_arns: %w", err)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	if err := d.Set("s3_canonical_user_ids", s3CanonicalUserIDs); err != nil {
+		return fmt.Errorf("error setting s3_canonical_user_ids: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSCloudFrontOriginAccessIdentities_comments(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_cloudfront_origin_access_identities.test"
+	resourceName := "aws_cloudfront_origin_access_identity.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontOriginAccessIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSCloudFrontOriginAccessIdentitiesConfigComments(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "iam_arns.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "s3_canonical_user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "iam_arns.*", resourceName, "iam_arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "s3_canonical_user_ids.*", resourceName, "s3_canonical_user_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSCloudFrontOriginAccessIdentitiesConfigComments(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_origin_access_identity" "test1" {
+  comment = "%[1]s-1"
+}
+
+resource "aws_cloudfront_origin_access_identity" "test2" {
+  comment = "%[1]s-2"
+}
+
+data "aws_cloudfront_origin_access_identities" "test" {
+  comments = ["%[1]s-1"]
+
+  depends_on = [aws_cloudfront_origin_access_identity.test1, aws_cloudfront_origin_access_identity.test2]
+}
+`, rName)
+}
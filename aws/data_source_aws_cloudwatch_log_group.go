@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
@@ -53,7 +54,7 @@ func dataSourceAwsCloudwatchLogGroupRead(d *schema.ResourceData, meta interface{
 	d.SetId(name)
 	d.Set("arn", logGroup.Arn)
 	d.Set("creation_time", logGroup.CreationTime)
-	d.Set("retention_in_days", logGroup.RetentionInDays)
+	d.Set("retention_in_days", aws.Int64Value(logGroup.RetentionInDays))
 	d.Set("kms_key_id", logGroup.KmsKeyId)
 
 	tags, err := keyvaluetags.CloudwatchlogsListTags(conn, name)
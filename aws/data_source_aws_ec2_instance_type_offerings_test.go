@@ -40,6 +40,8 @@ func TestAccAWSEc2InstanceTypeOfferingsDataSource_LocationType(t *testing.T) {
 				Config: testAccAWSEc2InstanceTypeOfferingsDataSourceConfigLocationType(),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEc2InstanceTypeOfferingsInstanceTypes(dataSourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "locations.0", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "location_types.0", ec2.LocationTypeAvailabilityZone),
 				),
 			},
 		},
@@ -57,6 +59,14 @@ func testAccCheckEc2InstanceTypeOfferingsInstanceTypes(dataSourceName string) re
 			return fmt.Errorf("expected at least one instance_types result, got none")
 		}
 
+		if v := rs.Primary.Attributes["locations.#"]; v == "0" {
+			return fmt.Errorf("expected at least one locations result, got none")
+		}
+
+		if v := rs.Primary.Attributes["location_types.#"]; v == "0" {
+			return fmt.Errorf("expected at least one location_types result, got none")
+		}
+
 		return nil
 	}
 }
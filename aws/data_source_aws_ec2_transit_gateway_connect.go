@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsEc2TransitGatewayConnect() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2TransitGatewayConnectRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"transit_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transport_attachment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2TransitGatewayConnectRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeTransitGatewayConnectsInput{}
+
+	if v, ok := d.GetOk("id"); ok {
+		input.TransitGatewayAttachmentIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	input.Filters = buildEC2CustomFilterList(d.Get("filter").(*schema.Set))
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Filters = append(input.Filters, ec2TagFiltersFromMap(v)...)
+	}
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Transit Gateway Connects: %s", input)
+	output, err := conn.DescribeTransitGatewayConnects(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connects: %w", err)
+	}
+
+	if output == nil || len(output.TransitGatewayConnects) == 0 {
+		return errors.New("error reading EC2 Transit Gateway Connect: no results found")
+	}
+
+	if len(output.TransitGatewayConnects) > 1 {
+		return errors.New("error reading EC2 Transit Gateway Connect: multiple results found, try adjusting search criteria")
+	}
+
+	transitGatewayConnect := output.TransitGatewayConnects[0]
+
+	if transitGatewayConnect == nil {
+		return errors.New("error reading EC2 Transit Gateway Connect: empty result")
+	}
+
+	if transitGatewayConnect.Options != nil {
+		d.Set("protocol", transitGatewayConnect.Options.Protocol)
+	} else {
+		d.Set("protocol", nil)
+	}
+	d.Set("transit_gateway_id", transitGatewayConnect.TransitGatewayId)
+	d.Set("transport_attachment_id", transitGatewayConnect.TransportTransitGatewayAttachmentId)
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(transitGatewayConnect.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	d.SetId(aws.StringValue(transitGatewayConnect.TransitGatewayAttachmentId))
+
+	return nil
+}
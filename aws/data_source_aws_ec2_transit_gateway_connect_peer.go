@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsEc2TransitGatewayConnectPeer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2TransitGatewayConnectPeerRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_asn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bgp_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_asn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_asn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"connect_attachment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"inside_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"peer_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"transit_gateway_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2TransitGatewayConnectPeerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{}
+
+	if v, ok := d.GetOk("id"); ok {
+		input.TransitGatewayConnectPeerIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	input.Filters = buildEC2CustomFilterList(d.Get("filter").(*schema.Set))
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Filters = append(input.Filters, ec2TagFiltersFromMap(v)...)
+	}
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Transit Gateway Connect Peers: %s", input)
+	output, err := conn.DescribeTransitGatewayConnectPeers(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connect Peers: %w", err)
+	}
+
+	if output == nil || len(output.TransitGatewayConnectPeers) == 0 {
+		return errors.New("error reading EC2 Transit Gateway Connect Peer: no results found")
+	}
+
+	if len(output.TransitGatewayConnectPeers) > 1 {
+		return errors.New("error reading EC2 Transit Gateway Connect Peer: multiple results found, try adjusting search criteria")
+	}
+
+	transitGatewayConnectPeer := output.TransitGatewayConnectPeers[0]
+
+	if transitGatewayConnectPeer == nil {
+		return errors.New("error reading EC2 Transit Gateway Connect Peer: empty result")
+	}
+
+	d.SetId(aws.StringValue(transitGatewayConnectPeer.TransitGatewayConnectPeerId))
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("transit-gateway-connect-peer/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("connect_attachment_id", transitGatewayConnectPeer.TransitGatewayAttachmentId)
+
+	if v := transitGatewayConnectPeer.ConnectPeerConfiguration; v != nil {
+		bgpConfigurations := v.BgpConfigurations
+
+		if len(bgpConfigurations) > 0 && bgpConfigurations[0] != nil {
+			d.Set("bgp_asn", strconv.FormatInt(aws.Int64Value(bgpConfigurations[0].PeerAsn), 10))
+		} else {
+			d.Set("bgp_asn", nil)
+		}
+
+		if err := d.Set("bgp_configuration", flattenEc2TransitGatewayAttachmentBgpConfigurations(bgpConfigurations)); err != nil {
+			return fmt.Errorf("error setting bgp_configuration: %w", err)
+		}
+
+		if err := d.Set("inside_cidr_blocks", aws.StringValueSlice(v.InsideCidrBlocks)); err != nil {
+			return fmt.Errorf("error setting inside_cidr_blocks: %w", err)
+		}
+
+		d.Set("peer_address", v.PeerAddress)
+		d.Set("transit_gateway_address", v.TransitGatewayAddress)
+	}
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(transitGatewayConnectPeer.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSEc2TransitGatewayConnectPeerDataSource_Filter(t *testing.T) {
+	dataSourceName := "data.aws_ec2_transit_gateway_connect_peer.test"
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerDataSourceConfigFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_asn", resourceName, "bgp_asn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_configuration.#", resourceName, "bgp_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connect_attachment_id", resourceName, "connect_attachment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "inside_cidr_blocks.#", resourceName, "inside_cidr_blocks.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "peer_address", resourceName, "peer_address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateway_address", resourceName, "transit_gateway_address"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayConnectPeerDataSource_ID(t *testing.T) {
+	dataSourceName := "data.aws_ec2_transit_gateway_connect_peer.test"
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerDataSourceConfigID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_asn", resourceName, "bgp_asn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_configuration.#", resourceName, "bgp_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connect_attachment_id", resourceName, "connect_attachment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "inside_cidr_blocks.#", resourceName, "inside_cidr_blocks.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "peer_address", resourceName, "peer_address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateway_address", resourceName, "transit_gateway_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSEc2TransitGatewayConnectPeerDataSourceConfigFilter(rName string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectPeerConfigTags1(rName, "Name", rName), `
+data "aws_ec2_transit_gateway_connect_peer" "test" {
+  filter {
+    name   = "transit-gateway-connect-peer-id"
+    values = [aws_ec2_transit_gateway_connect_peer.test.id]
+  }
+}
+`)
+}
+
+func testAccAWSEc2TransitGatewayConnectPeerDataSourceConfigID(rName string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectPeerConfigTags1(rName, "Name", rName), `
+data "aws_ec2_transit_gateway_connect_peer" "test" {
+  id = aws_ec2_transit_gateway_connect_peer.test.id
+}
+`)
+}
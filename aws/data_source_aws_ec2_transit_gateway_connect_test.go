@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSEc2TransitGatewayConnectDataSource_Filter(t *testing.T) {
+	dataSourceName := "data.aws_ec2_transit_gateway_connect.test"
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectDataSourceConfigFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "protocol", resourceName, "protocol"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateway_id", resourceName, "transit_gateway_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transport_attachment_id", resourceName, "transport_attachment_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayConnectDataSource_ID(t *testing.T) {
+	dataSourceName := "data.aws_ec2_transit_gateway_connect.test"
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectDataSourceConfigID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "protocol", resourceName, "protocol"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateway_id", resourceName, "transit_gateway_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transport_attachment_id", resourceName, "transport_attachment_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSEc2TransitGatewayConnectDataSourceConfigFilter(rName string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectConfigTags1(rName, "Name", rName), `
+data "aws_ec2_transit_gateway_connect" "test" {
+  filter {
+    name   = "transit-gateway-attachment-id"
+    values = [aws_ec2_transit_gateway_connect.test.id]
+  }
+}
+`)
+}
+
+func testAccAWSEc2TransitGatewayConnectDataSourceConfigID(rName string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectConfigTags1(rName, "Name", rName), `
+data "aws_ec2_transit_gateway_connect" "test" {
+  id = aws_ec2_transit_gateway_connect.test.id
+}
+`)
+}
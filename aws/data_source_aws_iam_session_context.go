@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func dataSourceAwsIAMSessionContext() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMSessionContextRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+			"issuer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuer_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"session_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMSessionContextRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	var sessionARN string
+
+	if v, ok := d.GetOk("arn"); ok {
+		sessionARN = v.(string)
+	} else {
+		output, err := meta.(*AWSClient).stsconn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+		if err != nil {
+			return fmt.Errorf("error getting Caller Identity: %w", err)
+		}
+
+		sessionARN = aws.StringValue(output.Arn)
+	}
+
+	d.SetId(sessionARN)
+	d.Set("arn", sessionARN)
+
+	roleName, sessionName := iamRoleNameSessionFromARN(sessionARN)
+
+	if roleName == "" {
+		d.Set("issuer_arn", sessionARN)
+		d.Set("issuer_id", "")
+		d.Set("issuer_name", "")
+		d.Set("session_name", "")
+
+		return nil
+	}
+
+	d.Set("issuer_name", roleName)
+	d.Set("session_name", sessionName)
+
+	role, err := finder.RoleByName(conn, roleName)
+
+	if tfresource.NotFound(err) {
+		// The role may have been deleted while sessions issued from it remain valid.
+		// Its path can no longer be determined, so assume the default path.
+		log.Printf("[WARN] IAM Role (%s) for session (%s) not found, constructing issuer ARN", roleName, sessionARN)
+
+		parsedARN, _ := arn.Parse(sessionARN)
+		issuerARN := arn.ARN{
+			Partition: parsedARN.Partition,
+			Service:   "iam",
+			AccountID: parsedARN.AccountID,
+			Resource:  fmt.Sprintf("role/%s", roleName),
+		}.String()
+
+		d.Set("issuer_arn", issuerARN)
+		d.Set("issuer_id", "")
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s): %w", roleName, err)
+	}
+
+	d.Set("issuer_arn", role.Arn)
+	d.Set("issuer_id", role.RoleId)
+
+	return nil
+}
+
+// iamRoleNameSessionFromARN returns the role and session names for an
+// STS assumed-role ARN, or empty strings for any other ARN.
+func iamRoleNameSessionFromARN(rawARN string) (string, string) {
+	parsedARN, err := arn.Parse(rawARN)
+
+	if err != nil {
+		return "", ""
+	}
+
+	if parsedARN.Service != "sts" || !strings.HasPrefix(parsedARN.Resource, "assumed-role/") {
+		return "", ""
+	}
+
+	parts := strings.Split(strings.TrimPrefix(parsedARN.Resource, "assumed-role/"), "/")
+
+	if len(parts) < 2 || parts[len(parts)-2] == "" || parts[len(parts)-1] == "" {
+		return "", ""
+	}
+
+	return parts[len(parts)-2], parts[len(parts)-1]
+}
@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestIamRoleNameSessionFromARN(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		ARN             string
+		ExpectedRole    string
+		ExpectedSession string
+	}{
+		{
+			Name: "not an ARN",
+			ARN:  "abc",
+		},
+		{
+			Name: "IAM role ARN",
+			ARN:  "arn:aws:iam::123456789012:role/example",
+		},
+		{
+			Name: "IAM user ARN",
+			ARN:  "arn:aws:iam::123456789012:user/example",
+		},
+		{
+			Name: "STS federated user ARN",
+			ARN:  "arn:aws:sts::123456789012:federated-user/example",
+		},
+		{
+			Name: "assumed role without session",
+			ARN:  "arn:aws:sts::123456789012:assumed-role/example",
+		},
+		{
+			Name:            "assumed role",
+			ARN:             "arn:aws:sts::123456789012:assumed-role/example/session",
+			ExpectedRole:    "example",
+			ExpectedSession: "session",
+		},
+		{
+			Name:            "assumed role with email session name",
+			ARN:             "arn:aws:sts::123456789012:assumed-role/example/user@example.com",
+			ExpectedRole:    "example",
+			ExpectedSession: "user@example.com",
+		},
+		{
+			Name:            "assumed role in another partition",
+			ARN:             "arn:aws-us-gov:sts::123456789012:assumed-role/example/session",
+			ExpectedRole:    "example",
+			ExpectedSession: "session",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			role, session := iamRoleNameSessionFromARN(testCase.ARN)
+
+			if role != testCase.ExpectedRole {
+				t.Errorf("got role %q, expected %q", role, testCase.ExpectedRole)
+			}
+
+			if session != testCase.ExpectedSession {
+				t.Errorf("got session %q, expected %q", session, testCase.ExpectedSession)
+			}
+		})
+	}
+}
+
+func TestAccAWSDataSourceIAMSessionContext_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_iam_session_context.test"
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMSessionContextConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "issuer_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "issuer_id", resourceName, "unique_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "issuer_name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "session_name", "session-id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMSessionContext_notAssumedRole(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_iam_session_context.test"
+	resourceName := "aws_iam_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMSessionContextNotAssumedRoleConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "issuer_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "issuer_id", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "issuer_name", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "session_name", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMSessionContext_callerIdentity(t *testing.T) {
+	dataSourceName := "data.aws_iam_session_context.test"
+	callerIdentityDataSourceName := "data.aws_caller_identity.current"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMSessionContextCallerIdentityConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", callerIdentityDataSourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "issuer_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsIAMSessionContextConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/test/"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+data "aws_iam_session_context" "test" {
+  arn = "arn:${data.aws_partition.current.partition}:sts::${data.aws_caller_identity.current.account_id}:assumed-role/${aws_iam_role.test.name}/session-id"
+}
+`, rName)
+}
+
+func testAccAwsIAMSessionContextNotAssumedRoleConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+data "aws_iam_session_context" "test" {
+  arn = aws_iam_user.test.arn
+}
+`, rName)
+}
+
+func testAccAwsIAMSessionContextCallerIdentityConfig() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "test" {}
+`
+}
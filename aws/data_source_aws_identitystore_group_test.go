@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSIdentityStoreGroupDataSource_DisplayName(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_group.test"
+	name := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreGroupName(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIdentityStoreGroupDataSourceConfigDisplayName(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "group_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIdentityStoreGroupDataSource_GroupID(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_group.test"
+	name := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+	groupID := os.Getenv("AWS_IDENTITY_STORE_GROUP_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreGroupName(t)
+			testAccPreCheckAWSIdentityStoreGroupID(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIdentityStoreGroupDataSourceConfigGroupID(name, groupID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "group_id", groupID),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIdentityStoreGroupDataSource_NonExistent(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIdentityStoreGroupDataSourceConfigDisplayName(acctest.RandomWithPrefix("tf-acc-test")),
+				ExpectError: regexp.MustCompile(`no Identity Store Group found matching criteria`),
+			},
+		},
+	})
+}
+
+func TestAccAWSIdentityStoreGroupDataSource_InvalidFilter(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIdentityStoreGroupDataSourceConfigFilter("UserName", "test"),
+				ExpectError: regexp.MustCompile(`expected .*attribute_path to be one of \[DisplayName\]`),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSIdentityStoreGroupName(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME") == "" {
+		t.Skip("AWS_IDENTITY_STORE_GROUP_NAME env var must be set for AWS Identity Store Group acceptance test")
+	}
+}
+
+func testAccAWSIdentityStoreGroupDataSourceConfigFilter(attributePath, attributeValue string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore_group" "test" {
+  filter {
+    attribute_path  = %[1]q
+    attribute_value = %[2]q
+  }
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+}
+`, attributePath, attributeValue)
+}
+
+func testAccAWSIdentityStoreGroupDataSourceConfigDisplayName(name string) string {
+	return testAccAWSIdentityStoreGroupDataSourceConfigFilter("DisplayName", name)
+}
+
+func testAccAWSIdentityStoreGroupDataSourceConfigGroupID(name, id string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore_group" "test" {
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = %[1]q
+  }
+
+  group_id          = %[2]q
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+}
+`, name, id)
+}
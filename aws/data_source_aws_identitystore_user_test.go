@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSIdentityStoreUserDataSource_UserName(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_user.test"
+	name := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreUserName(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIdentityStoreUserDataSourceConfigUserName(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "user_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "user_name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIdentityStoreUserDataSource_UserID(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_user.test"
+	name := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
+	userID := os.Getenv("AWS_IDENTITY_STORE_USER_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreUserName(t)
+			testAccPreCheckAWSIdentityStoreUserID(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIdentityStoreUserDataSourceConfigUserID(name, userID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "user_id", userID),
+					resource.TestCheckResourceAttr(dataSourceName, "user_name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIdentityStoreUserDataSource_NonExistent(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIdentityStoreUserDataSourceConfigUserName(acctest.RandomWithPrefix("tf-acc-test")),
+				ExpectError: regexp.MustCompile(`no Identity Store User found matching criteria`),
+			},
+		},
+	})
+}
+
+func TestAccAWSIdentityStoreUserDataSource_InvalidFilter(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIdentityStoreUserDataSourceConfigFilter("DisplayName", "test"),
+				ExpectError: regexp.MustCompile(`expected .*attribute_path to be one of \[UserName\]`),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSIdentityStoreUserName(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_USER_NAME") == "" {
+		t.Skip("AWS_IDENTITY_STORE_USER_NAME env var must be set for AWS Identity Store User acceptance test")
+	}
+}
+
+func testAccAWSIdentityStoreUserDataSourceConfigFilter(attributePath, attributeValue string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore_user" "test" {
+  filter {
+    attribute_path  = %[1]q
+    attribute_value = %[2]q
+  }
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+}
+`, attributePath, attributeValue)
+}
+
+func testAccAWSIdentityStoreUserDataSourceConfigUserName(name string) string {
+	return testAccAWSIdentityStoreUserDataSourceConfigFilter("UserName", name)
+}
+
+func testAccAWSIdentityStoreUserDataSourceConfigUserID(name, id string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore_user" "test" {
+  filter {
+    attribute_path  = "UserName"
+    attribute_value = %[1]q
+  }
+
+  user_id           = %[2]q
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+}
+`, name, id)
+}
+
+func testAccPreCheckAWSIdentityStoreUserID(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_USER_ID") == "" {
+		t.Skip("AWS_IDENTITY_STORE_USER_ID env var must be set for AWS Identity Store User acceptance test")
+	}
+}
@@ -0,0 +1,293 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsImageBuilderImage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsImageBuilderImageRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+				ExactlyOneOf: []string{"arn", "image_pipeline_arn"},
+			},
+			"build_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"distribution_configuration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enhanced_image_metadata_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"image_pipeline_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+				ExactlyOneOf: []string{"arn", "image_pipeline_arn"},
+			},
+			"image_recipe_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_tests_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image_tests_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"timeout_minutes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"infrastructure_configuration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"os_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amis": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"image": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsImageBuilderImageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	var buildVersionArn string
+
+	if v, ok := d.GetOk("arn"); ok {
+		buildVersionArn = v.(string)
+	} else {
+		imagePipelineArn := d.Get("image_pipeline_arn").(string)
+		summary, err := findImageBuilderLatestImagePipelineImage(conn, imagePipelineArn)
+
+		if err != nil {
+			return fmt.Errorf("error listing Image Builder Image Pipeline (%s) images: %w", imagePipelineArn, err)
+		}
+
+		if summary == nil {
+			return fmt.Errorf("no available Image Builder Image found for Image Pipeline (%s)", imagePipelineArn)
+		}
+
+		buildVersionArn = aws.StringValue(summary.Arn)
+	}
+
+	input := &imagebuilder.GetImageInput{
+		ImageBuildVersionArn: aws.String(buildVersionArn),
+	}
+
+	output, err := conn.GetImage(input)
+
+	if err != nil {
+		return fmt.Errorf("error getting Image Builder Image: %w", err)
+	}
+
+	if output == nil || output.Image == nil {
+		return fmt.Errorf("error getting Image Builder Image: empty response")
+	}
+
+	image := output.Image
+
+	d.SetId(aws.StringValue(image.Arn))
+
+	// To prevent Terraform errors, only reset arn if not configured.
+	// The configured ARN may contain x.x.x wildcards while the API returns
+	// the Amazon Resource Name (ARN) of the image build version.
+	if _, ok := d.GetOk("arn"); !ok {
+		d.Set("arn", image.Arn)
+	}
+
+	d.Set("build_version_arn", image.Arn)
+	d.Set("date_created", image.DateCreated)
+
+	if image.DistributionConfiguration != nil {
+		d.Set("distribution_configuration_arn", image.DistributionConfiguration.Arn)
+	} else {
+		d.Set("distribution_configuration_arn", nil)
+	}
+
+	d.Set("enhanced_image_metadata_enabled", image.EnhancedImageMetadataEnabled)
+	d.Set("image_pipeline_arn", image.SourcePipelineArn)
+
+	if image.ImageRecipe != nil {
+		d.Set("image_recipe_arn", image.ImageRecipe.Arn)
+	} else {
+		d.Set("image_recipe_arn", nil)
+	}
+
+	if image.ImageTestsConfiguration != nil {
+		d.Set("image_tests_configuration", []interface{}{flattenImageBuilderImageTestsConfiguration(image.ImageTestsConfiguration)})
+	} else {
+		d.Set("image_tests_configuration", nil)
+	}
+
+	if image.InfrastructureConfiguration != nil {
+		d.Set("infrastructure_configuration_arn", image.InfrastructureConfiguration.Arn)
+	} else {
+		d.Set("infrastructure_configuration_arn", nil)
+	}
+
+	d.Set("name", image.Name)
+	d.Set("os_version", image.OsVersion)
+
+	if image.OutputResources != nil {
+		d.Set("output_resources", []interface{}{flattenImageBuilderOutputResources(image.OutputResources)})
+	} else {
+		d.Set("output_resources", nil)
+	}
+
+	d.Set("platform", image.Platform)
+	d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(image.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).IgnoreTagsConfig).Map())
+	d.Set("version", image.Version)
+
+	return nil
+}
+
+// findImageBuilderLatestImagePipelineImage returns the most recently created
+// available image built by the specified image pipeline.
+// Returns nil if no available image is found.
+func findImageBuilderLatestImagePipelineImage(conn *imagebuilder.Imagebuilder, imagePipelineArn string) (*imagebuilder.ImageSummary, error) {
+	input := &imagebuilder.ListImagePipelineImagesInput{
+		ImagePipelineArn: aws.String(imagePipelineArn),
+	}
+
+	var result *imagebuilder.ImageSummary
+
+	err := conn.ListImagePipelineImagesPages(input, func(page *imagebuilder.ListImagePipelineImagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, summary := range page.ImageSummaryList {
+			if summary == nil || summary.State == nil {
+				continue
+			}
+
+			if aws.StringValue(summary.State.Status) != imagebuilder.ImageStatusAvailable {
+				continue
+			}
+
+			// Creation dates are ISO 8601 formatted and compare lexically.
+			if result == nil || aws.StringValue(summary.DateCreated) > aws.StringValue(result.DateCreated) {
+				result = summary
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func flattenImageBuilderOutputResources(apiObject *imagebuilder.OutputResources) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Amis; v != nil {
+		tfMap["amis"] = flattenImageBuilderAmis(v)
+	}
+
+	return tfMap
+}
+
+func flattenImageBuilderAmis(apiObjects []*imagebuilder.Ami) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"account_id":  aws.StringValue(apiObject.AccountId),
+			"description": aws.StringValue(apiObject.Description),
+			"image":       aws.StringValue(apiObject.Image),
+			"name":        aws.StringValue(apiObject.Name),
+			"region":      aws.StringValue(apiObject.Region),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAwsImageBuilderImageDataSource_Arn_Aws(t *testing.T) {
+	dataSourceName := "data.aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageDataSourceConfigArnAws(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccMatchResourceAttrRegionalARNAccountID(dataSourceName, "arn", "imagebuilder", "aws", regexp.MustCompile(`image/amazon-linux-2-x86/x.x.x`)),
+					testAccMatchResourceAttrRegionalARNAccountID(dataSourceName, "build_version_arn", "imagebuilder", "aws", regexp.MustCompile(`image/amazon-linux-2-x86/\d+\.\d+\.\d+/\d+`)),
+					testAccCheckResourceAttrRfc3339(dataSourceName, "date_created"),
+					resource.TestCheckResourceAttr(dataSourceName, "enhanced_image_metadata_enabled", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "os_version"),
+					resource.TestCheckResourceAttr(dataSourceName, "output_resources.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "platform", "Linux"),
+					resource.TestMatchResourceAttr(dataSourceName, "version", regexp.MustCompile(`\d+\.\d+\.\d+/\d+`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImageDataSource_ImagePipelineArn_NoImages(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderImagePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsImageBuilderImageDataSourceConfigImagePipelineArn(rName),
+				ExpectError: regexp.MustCompile(`no available Image Builder Image found`),
+			},
+		},
+	})
+}
+
+func testAccAwsImageBuilderImageDataSourceConfigArnAws() string {
+	return `
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_imagebuilder_image" "test" {
+  arn = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:image/amazon-linux-2-x86/x.x.x"
+}
+`
+}
+
+func testAccAwsImageBuilderImageDataSourceConfigImagePipelineArn(rName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderImagePipelineConfigName(rName),
+		`
+data "aws_imagebuilder_image" "test" {
+  image_pipeline_arn = aws_imagebuilder_image_pipeline.test.arn
+}
+`)
+}
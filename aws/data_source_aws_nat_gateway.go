@@ -39,6 +39,11 @@ func dataSourceAwsNatGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"connectivity_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"network_interface_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -83,6 +88,14 @@ func dataSourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error
 		)...)
 	}
 
+	if connectivityType, ok := d.GetOk("connectivity_type"); ok {
+		req.Filter = append(req.Filter, buildEC2AttributeFilterList(
+			map[string]string{
+				"connectivity-type": connectivityType.(string),
+			},
+		)...)
+	}
+
 	if subnet_id, ok := d.GetOk("subnet_id"); ok {
 		req.Filter = append(req.Filter, buildEC2AttributeFilterList(
 			map[string]string{
@@ -121,6 +134,7 @@ func dataSourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] NAT Gateway response: %s", ngw)
 
 	d.SetId(aws.StringValue(ngw.NatGatewayId))
+	d.Set("connectivity_type", ngw.ConnectivityType)
 	d.Set("state", ngw.State)
 	d.Set("subnet_id", ngw.SubnetId)
 	d.Set("vpc_id", ngw.VpcId)
@@ -130,7 +144,7 @@ func dataSourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	for _, address := range ngw.NatGatewayAddresses {
-		if *address.AllocationId != "" {
+		if aws.StringValue(address.AllocationId) != "" || aws.StringValue(ngw.ConnectivityType) == ec2.ConnectivityTypePrivate {
 			d.Set("allocation_id", address.AllocationId)
 			d.Set("network_interface_id", address.NetworkInterfaceId)
 			d.Set("private_ip", address.PrivateIp)
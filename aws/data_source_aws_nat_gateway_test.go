@@ -28,6 +28,10 @@ func TestAccDataSourceAwsNatGateway_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(
 						"data.aws_nat_gateway.test_by_tags", "tags.Name",
 						"aws_nat_gateway.test", "tags.Name"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_nat_gateway.test_by_connectivity_type", "id",
+						"aws_nat_gateway.test", "id"),
+					resource.TestCheckResourceAttr("data.aws_nat_gateway.test_by_id", "connectivity_type", "public"),
 					resource.TestCheckResourceAttrSet("data.aws_nat_gateway.test_by_id", "state"),
 					resource.TestCheckResourceAttrSet("data.aws_nat_gateway.test_by_id", "allocation_id"),
 					resource.TestCheckResourceAttrSet("data.aws_nat_gateway.test_by_id", "network_interface_id"),
@@ -95,6 +99,11 @@ data "aws_nat_gateway" "test_by_subnet_id" {
   subnet_id = aws_nat_gateway.test.subnet_id
 }
 
+data "aws_nat_gateway" "test_by_connectivity_type" {
+  connectivity_type = "public"
+  subnet_id         = aws_nat_gateway.test.subnet_id
+}
+
 data "aws_nat_gateway" "test_by_tags" {
   tags = {
     Name = aws_nat_gateway.test.tags["Name"]
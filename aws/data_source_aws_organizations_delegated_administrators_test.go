@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testAccDataSourceAwsOrganizationsDelegatedAdministrators_basic(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_organizations_delegated_administrators.test"
+	dataSourceIdentity := "data.aws_caller_identity.delegated"
+	servicePrincipal := "config-multiaccountsetup.amazonaws.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccOrganizationsEnabledPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsOrganizationsDelegatedAdministratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsDelegatedAdministratorsConfig(servicePrincipal),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "delegated_administrators.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "delegated_administrators.*.id", dataSourceIdentity, "account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsOrganizationsDelegatedAdministratorsConfig(servicePrincipal string) string {
+	return composeConfig(
+		testAccAwsOrganizationsDelegatedAdministratorConfig(servicePrincipal),
+		`
+data "aws_organizations_delegated_administrators" "test" {
+  service_principal = aws_organizations_delegated_administrator.test.service_principal
+}
+`)
+}
@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsPlacementGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsPlacementGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"filter": ec2CustomFiltersSchema(),
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"partition_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"placement_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"spread_level": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribePlacementGroupsInput{}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.GroupNames = aws.StringSlice([]string{v.(string)})
+	}
+
+	if v, ok := d.GetOk("placement_group_id"); ok {
+		input.GroupIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	if tags, tagsOk := d.GetOk("tags"); tagsOk {
+		input.Filters = append(input.Filters, buildEC2TagFilterList(
+			keyvaluetags.New(tags.(map[string]interface{})).Ec2Tags(),
+		)...)
+	}
+
+	input.Filters = append(input.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Placement Groups: %s", input)
+	output, err := conn.DescribePlacementGroups(input)
+
+	if err != nil {
+		return fmt.Errorf("error describing EC2 Placement Groups: %w", err)
+	}
+
+	if output == nil || len(output.PlacementGroups) == 0 {
+		return fmt.Errorf("no matching EC2 Placement Group found")
+	}
+
+	if len(output.PlacementGroups) > 1 {
+		return fmt.Errorf("multiple EC2 Placement Groups matched; use additional constraints to reduce matches to a single EC2 Placement Group")
+	}
+
+	pg := output.PlacementGroups[0]
+	name := aws.StringValue(pg.GroupName)
+
+	d.SetId(name)
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("placement-group/%s", name),
+	}.String()
+
+	d.Set("arn", arn)
+	d.Set("name", name)
+	d.Set("partition_count", pg.PartitionCount)
+	d.Set("placement_group_id", pg.GroupId)
+	d.Set("spread_level", pg.SpreadLevel)
+	d.Set("strategy", pg.Strategy)
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(pg.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSPlacementGroupDataSource_Name(t *testing.T) {
+	resourceName := "aws_placement_group.test"
+	dataSourceName := "data.aws_placement_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPlacementGroupDataSourceConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "partition_count", resourceName, "partition_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "placement_group_id", resourceName, "placement_group_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "spread_level", resourceName, "spread_level"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "strategy", resourceName, "strategy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSPlacementGroupDataSource_PlacementGroupId(t *testing.T) {
+	resourceName := "aws_placement_group.test"
+	dataSourceName := "data.aws_placement_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPlacementGroupDataSourceConfigPlacementGroupId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "partition_count", resourceName, "partition_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "placement_group_id", resourceName, "placement_group_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "spread_level", resourceName, "spread_level"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "strategy", resourceName, "strategy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSPlacementGroupDataSourceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_placement_group" "test" {
+  name            = %[1]q
+  partition_count = 3
+  strategy        = "partition"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSPlacementGroupDataSourceConfigName(rName string) string {
+	return composeConfig(testAccAWSPlacementGroupDataSourceConfigBase(rName), `
+data "aws_placement_group" "test" {
+  name = aws_placement_group.test.name
+}
+`)
+}
+
+func testAccAWSPlacementGroupDataSourceConfigPlacementGroupId(rName string) string {
+	return composeConfig(testAccAWSPlacementGroupDataSourceConfigBase(rName), `
+data "aws_placement_group" "test" {
+  placement_group_id = aws_placement_group.test.placement_group_id
+}
+`)
+}
@@ -85,41 +85,48 @@ func dataSourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{})
 		params.TagFilters = buildRAMTagFilters(filters.(*schema.Set))
 	}
 
-	for {
-		resp, err := conn.GetResourceShares(params)
+	var resourceShares []*ram.ResourceShare
 
-		if err != nil {
-			return fmt.Errorf("Error retrieving resource share: empty response for: %s", params)
+	err := conn.GetResourceSharesPages(params, func(page *ram.GetResourceSharesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		if len(resp.ResourceShares) > 1 {
-			return fmt.Errorf("Multiple resource shares found for: %s", name)
-		}
+		// Recently deleted resource shares are still returned
+		for _, r := range page.ResourceShares {
+			if aws.StringValue(r.Status) == ram.ResourceShareStatusDeleted {
+				continue
+			}
 
-		if resp == nil || len(resp.ResourceShares) == 0 {
-			return fmt.Errorf("No matching resource found: %s", err)
+			if aws.StringValue(r.Name) == name {
+				resourceShares = append(resourceShares, r)
+			}
 		}
 
-		for _, r := range resp.ResourceShares {
-			if aws.StringValue(r.Name) == name {
-				d.SetId(aws.StringValue(r.ResourceShareArn))
-				d.Set("arn", aws.StringValue(r.ResourceShareArn))
-				d.Set("owning_account_id", aws.StringValue(r.OwningAccountId))
-				d.Set("status", aws.StringValue(r.Status))
+		return !lastPage
+	})
 
-				if err := d.Set("tags", keyvaluetags.RamKeyValueTags(r.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-					return fmt.Errorf("error setting tags: %s", err)
-				}
+	if err != nil {
+		return fmt.Errorf("error reading RAM Resource Share (%s): %w", name, err)
+	}
 
-				break
-			}
-		}
+	if len(resourceShares) == 0 {
+		return fmt.Errorf("No matching resource found: %s", name)
+	}
 
-		if resp.NextToken == nil {
-			break
-		}
+	if len(resourceShares) > 1 {
+		return fmt.Errorf("Multiple resource shares found for: %s", name)
+	}
+
+	r := resourceShares[0]
+
+	d.SetId(aws.StringValue(r.ResourceShareArn))
+	d.Set("arn", r.ResourceShareArn)
+	d.Set("owning_account_id", r.OwningAccountId)
+	d.Set("status", r.Status)
 
-		params.NextToken = resp.NextToken
+	if err := d.Set("tags", keyvaluetags.RamKeyValueTags(r.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
 	}
 
 	return nil
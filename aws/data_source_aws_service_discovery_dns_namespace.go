@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicediscovery/finder"
+)
+
+func dataSourceAwsServiceDiscoveryDnsNamespace() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsServiceDiscoveryDnsNamespaceRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hosted_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicediscovery.NamespaceTypeDnsPublic,
+					servicediscovery.NamespaceTypeDnsPrivate,
+				}, false),
+			},
+		},
+	}
+}
+
+func dataSourceAwsServiceDiscoveryDnsNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	nsType := d.Get("type").(string)
+
+	namespaces, err := finder.NamespacesByNameAndType(conn, name, nsType)
+
+	if err != nil {
+		return fmt.Errorf("error listing Service Discovery DNS Namespaces: %w", err)
+	}
+
+	if len(namespaces) == 0 {
+		return fmt.Errorf("no Service Discovery DNS Namespace with name %q and type %q found", name, nsType)
+	}
+
+	if len(namespaces) > 1 {
+		return fmt.Errorf("multiple Service Discovery DNS Namespaces with name %q and type %q found", name, nsType)
+	}
+
+	namespace := namespaces[0]
+	arn := aws.StringValue(namespace.Arn)
+
+	d.SetId(aws.StringValue(namespace.Id))
+	d.Set("arn", arn)
+	d.Set("description", namespace.Description)
+	if namespace.Properties != nil && namespace.Properties.DnsProperties != nil {
+		d.Set("hosted_zone", namespace.Properties.DnsProperties.HostedZoneId)
+	} else {
+		d.Set("hosted_zone", nil)
+	}
+	d.Set("name", namespace.Name)
+	d.Set("type", namespace.Type)
+
+	tags, err := keyvaluetags.ServicediscoveryListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Service Discovery DNS Namespace (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
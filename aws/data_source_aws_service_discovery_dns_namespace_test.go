@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSServiceDiscoveryDnsNamespaceDataSource_private(t *testing.T) {
+	resourceName := "aws_service_discovery_private_dns_namespace.test"
+	dataSourceName := "data.aws_service_discovery_dns_namespace.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSServiceDiscovery(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceDiscoveryDnsNamespaceDataSourceConfigPrivate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "hosted_zone", resourceName, "hosted_zone"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "DNS_PRIVATE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceDiscoveryDnsNamespaceDataSource_public(t *testing.T) {
+	resourceName := "aws_service_discovery_public_dns_namespace.test"
+	dataSourceName := "data.aws_service_discovery_dns_namespace.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSServiceDiscovery(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceDiscoveryDnsNamespaceDataSourceConfigPublic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "hosted_zone", resourceName, "hosted_zone"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "DNS_PUBLIC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSServiceDiscoveryDnsNamespaceDataSourceConfigPrivate(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_service_discovery_private_dns_namespace" "test" {
+  name = "%[1]s.tf"
+  vpc  = aws_vpc.test.id
+}
+
+data "aws_service_discovery_dns_namespace" "test" {
+  name = aws_service_discovery_private_dns_namespace.test.name
+  type = "DNS_PRIVATE"
+}
+`, rName)
+}
+
+func testAccAWSServiceDiscoveryDnsNamespaceDataSourceConfigPublic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_service_discovery_public_dns_namespace" "test" {
+  name = "%[1]s.tf"
+}
+
+data "aws_service_discovery_dns_namespace" "test" {
+  name = aws_service_discovery_public_dns_namespace.test.name
+  type = "DNS_PUBLIC"
+}
+`, rName)
+}
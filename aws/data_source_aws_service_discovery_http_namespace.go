@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicediscovery/finder"
+)
+
+func dataSourceAwsServiceDiscoveryHttpNamespace() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsServiceDiscoveryHttpNamespaceRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateServiceDiscoveryNamespaceName,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsServiceDiscoveryHttpNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+
+	namespaces, err := finder.NamespacesByNameAndType(conn, name, servicediscovery.NamespaceTypeHttp)
+
+	if err != nil {
+		return fmt.Errorf("error listing Service Discovery HTTP Namespaces: %w", err)
+	}
+
+	if len(namespaces) == 0 {
+		return fmt.Errorf("no Service Discovery HTTP Namespace with name %q found", name)
+	}
+
+	if len(namespaces) > 1 {
+		return fmt.Errorf("multiple Service Discovery HTTP Namespaces with name %q found", name)
+	}
+
+	namespace := namespaces[0]
+	arn := aws.StringValue(namespace.Arn)
+
+	d.SetId(aws.StringValue(namespace.Id))
+	d.Set("arn", arn)
+	d.Set("description", namespace.Description)
+	if namespace.Properties != nil && namespace.Properties.HttpProperties != nil {
+		d.Set("http_name", namespace.Properties.HttpProperties.HttpName)
+	} else {
+		d.Set("http_name", nil)
+	}
+	d.Set("name", namespace.Name)
+
+	tags, err := keyvaluetags.ServicediscoveryListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Service Discovery HTTP Namespace (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
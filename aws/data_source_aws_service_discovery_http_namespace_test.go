@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSServiceDiscoveryHttpNamespaceDataSource_basic(t *testing.T) {
+	resourceName := "aws_service_discovery_http_namespace.test"
+	dataSourceName := "data.aws_service_discovery_http_namespace.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSServiceDiscovery(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceDiscoveryHttpNamespaceDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttr(dataSourceName, "http_name", rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.key1", resourceName, "tags.key1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSServiceDiscoveryHttpNamespaceDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_service_discovery_http_namespace" "test" {
+  name        = %[1]q
+  description = "test"
+
+  tags = {
+    key1 = "value1"
+  }
+}
+
+data "aws_service_discovery_http_namespace" "test" {
+  name = aws_service_discovery_http_namespace.test.name
+}
+`, rName)
+}
@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicediscovery/finder"
+)
+
+func dataSourceAwsServiceDiscoveryService() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsServiceDiscoveryServiceRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ttl": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"namespace_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"routing_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"health_check_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_threshold": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resource_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"health_check_custom_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_threshold": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"namespace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsServiceDiscoveryServiceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	namespaceID := d.Get("namespace_id").(string)
+
+	services, err := finder.ServicesByNamespaceIDAndName(conn, namespaceID, name)
+
+	if err != nil {
+		return fmt.Errorf("error listing Service Discovery Services: %w", err)
+	}
+
+	if len(services) == 0 {
+		return fmt.Errorf("no Service Discovery Service with name %q found in namespace (%s)", name, namespaceID)
+	}
+
+	if len(services) > 1 {
+		return fmt.Errorf("multiple Service Discovery Services with name %q found in namespace (%s)", name, namespaceID)
+	}
+
+	serviceID := aws.StringValue(services[0].Id)
+
+	output, err := conn.GetService(&servicediscovery.GetServiceInput{
+		Id: aws.String(serviceID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Discovery Service (%s): %w", serviceID, err)
+	}
+
+	if output == nil || output.Service == nil {
+		return fmt.Errorf("error reading Service Discovery Service (%s): empty response", serviceID)
+	}
+
+	service := output.Service
+	arn := aws.StringValue(service.Arn)
+
+	d.SetId(serviceID)
+	d.Set("arn", arn)
+	d.Set("description", service.Description)
+	if err := d.Set("dns_config", flattenServiceDiscoveryDnsConfig(service.DnsConfig)); err != nil {
+		return fmt.Errorf("error setting dns_config: %w", err)
+	}
+	if err := d.Set("health_check_config", flattenServiceDiscoveryHealthCheckConfig(service.HealthCheckConfig)); err != nil {
+		return fmt.Errorf("error setting health_check_config: %w", err)
+	}
+	if err := d.Set("health_check_custom_config", flattenServiceDiscoveryHealthCheckCustomConfig(service.HealthCheckCustomConfig)); err != nil {
+		return fmt.Errorf("error setting health_check_custom_config: %w", err)
+	}
+	d.Set("name", service.Name)
+	d.Set("namespace_id", service.NamespaceId)
+
+	tags, err := keyvaluetags.ServicediscoveryListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Service Discovery Service (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
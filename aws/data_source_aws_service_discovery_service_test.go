@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSServiceDiscoveryServiceDataSource_basic(t *testing.T) {
+	resourceName := "aws_service_discovery_service.test"
+	dataSourceName := "data.aws_service_discovery_service.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSServiceDiscovery(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceDiscoveryServiceDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dns_config.#", resourceName, "dns_config.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dns_config.0.namespace_id", resourceName, "dns_config.0.namespace_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dns_config.0.dns_records.#", resourceName, "dns_config.0.dns_records.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dns_config.0.dns_records.0.ttl", resourceName, "dns_config.0.dns_records.0.ttl"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dns_config.0.dns_records.0.type", resourceName, "dns_config.0.dns_records.0.type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dns_config.0.routing_policy", resourceName, "dns_config.0.routing_policy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "health_check_custom_config.#", resourceName, "health_check_custom_config.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "namespace_id", resourceName, "namespace_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSServiceDiscoveryServiceDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_service_discovery_private_dns_namespace" "test" {
+  name = "%[1]s.tf"
+  vpc  = aws_vpc.test.id
+}
+
+resource "aws_service_discovery_service" "test" {
+  name        = %[1]q
+  description = "test"
+
+  dns_config {
+    namespace_id = aws_service_discovery_private_dns_namespace.test.id
+
+    dns_records {
+      ttl  = 5
+      type = "A"
+    }
+  }
+
+  health_check_custom_config {
+    failure_threshold = 5
+  }
+}
+
+data "aws_service_discovery_service" "test" {
+  name         = aws_service_discovery_service.test.name
+  namespace_id = aws_service_discovery_private_dns_namespace.test.id
+}
+`, rName)
+}
@@ -2,9 +2,13 @@ package aws
 
 import (
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,6 +25,10 @@ func dataSourceAwsServiceQuotasServiceQuota() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"current_usage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
 			"default_value": {
 				Type:     schema.TypeFloat,
 				Computed: true,
@@ -49,6 +57,31 @@ func dataSourceAwsServiceQuotasServiceQuota() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"usage_metric": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_dimensions": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_statistic_recommendation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"value": {
 				Type:     schema.TypeFloat,
 				Computed: true,
@@ -138,7 +171,105 @@ func dataSourceAwsServiceQuotasServiceQuotaRead(d *schema.ResourceData, meta int
 	d.Set("service_code", serviceQuota.ServiceCode)
 	d.Set("service_name", serviceQuota.ServiceName)
 	d.Set("value", serviceQuota.Value)
+
+	if err := d.Set("usage_metric", flattenServiceQuotasMetricInfo(serviceQuota.UsageMetric)); err != nil {
+		return fmt.Errorf("error setting usage_metric: %s", err)
+	}
+
+	var currentUsage *float64
+
+	if serviceQuota.UsageMetric != nil && aws.StringValue(serviceQuota.UsageMetric.MetricName) != "" {
+		currentUsage, err = serviceQuotasCurrentUsage(meta.(*AWSClient).cloudwatchconn, serviceQuota.UsageMetric)
+
+		if tfawserr.ErrCodeEquals(err, "AccessDenied") {
+			log.Printf("[WARN] Unable to read Service (%s) Quota (%s) usage metric: %s", serviceCode, aws.StringValue(serviceQuota.QuotaCode), err)
+			err = nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error getting Service (%s) Quota (%s) usage: %s", serviceCode, aws.StringValue(serviceQuota.QuotaCode), err)
+		}
+	}
+
+	d.Set("current_usage", currentUsage)
 	d.SetId(aws.StringValue(serviceQuota.QuotaArn))
 
 	return nil
 }
+
+// serviceQuotasCurrentUsage returns the latest datapoint of the specified usage metric, or nil if the metric has no recent datapoints.
+func serviceQuotasCurrentUsage(conn *cloudwatch.CloudWatch, metric *servicequotas.MetricInfo) (*float64, error) {
+	statistic := aws.StringValue(metric.MetricStatisticRecommendation)
+	if statistic == "" {
+		statistic = cloudwatch.StatisticMaximum
+	}
+
+	var dimensions []*cloudwatch.Dimension
+	for k, v := range metric.MetricDimensions {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(k),
+			Value: v,
+		})
+	}
+
+	endTime := time.Now()
+	input := &cloudwatch.GetMetricStatisticsInput{
+		Dimensions: dimensions,
+		EndTime:    aws.Time(endTime),
+		MetricName: metric.MetricName,
+		Namespace:  metric.MetricNamespace,
+		Period:     aws.Int64(300),
+		StartTime:  aws.Time(endTime.Add(-1 * time.Hour)),
+		Statistics: aws.StringSlice([]string{statistic}),
+	}
+
+	output, err := conn.GetMetricStatistics(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *cloudwatch.Datapoint
+
+	for _, datapoint := range output.Datapoints {
+		if datapoint == nil {
+			continue
+		}
+
+		if latest == nil || aws.TimeValue(datapoint.Timestamp).After(aws.TimeValue(latest.Timestamp)) {
+			latest = datapoint
+		}
+	}
+
+	if latest == nil {
+		return nil, nil
+	}
+
+	switch statistic {
+	case cloudwatch.StatisticAverage:
+		return latest.Average, nil
+	case cloudwatch.StatisticMinimum:
+		return latest.Minimum, nil
+	case cloudwatch.StatisticSampleCount:
+		return latest.SampleCount, nil
+	case cloudwatch.StatisticSum:
+		return latest.Sum, nil
+	default:
+		return latest.Maximum, nil
+	}
+}
+
+func flattenServiceQuotasMetricInfo(apiObject *servicequotas.MetricInfo) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"metric_dimensions":               aws.StringValueMap(apiObject.MetricDimensions),
+		"metric_name":                     aws.StringValue(apiObject.MetricName),
+		"metric_namespace":                aws.StringValue(apiObject.MetricNamespace),
+		"metric_statistic_recommendation": aws.StringValue(apiObject.MetricStatisticRecommendation),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -28,6 +28,16 @@ func TestAccAwsServiceQuotasServiceQuotaDataSource_QuotaCode(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "service_code", "vpc"),
 					resource.TestCheckResourceAttr(dataSourceName, "service_name", "Amazon Virtual Private Cloud (Amazon VPC)"),
 					resource.TestMatchResourceAttr(dataSourceName, "value", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_dimensions.%", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_dimensions.Class", "None"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_dimensions.Resource", "vpc"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_dimensions.Service", "VPC"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_dimensions.Type", "Resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_name", "ResourceCount"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_namespace", "AWS/Usage"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_statistic_recommendation", "Maximum"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "current_usage"),
 				),
 			},
 		},
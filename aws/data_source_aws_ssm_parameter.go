@@ -19,6 +19,10 @@ func dataSourceAwsSsmParameter() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"data_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -73,6 +77,7 @@ func dataAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error {
 		Resource:  fmt.Sprintf("parameter/%s", strings.TrimPrefix(d.Id(), "/")),
 	}
 	d.Set("arn", arn.String())
+	d.Set("data_type", param.DataType)
 	d.Set("name", param.Name)
 	d.Set("type", param.Type)
 	d.Set("value", param.Value)
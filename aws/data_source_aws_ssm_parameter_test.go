@@ -22,6 +22,7 @@ func TestAccAWSSsmParameterDataSource_basic(t *testing.T) {
 				Config: testAccCheckAwsSsmParameterDataSourceConfig(name, "false"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair(resourceName, "arn", "aws_ssm_parameter.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "data_type", "text"),
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "type", "String"),
 					resource.TestCheckResourceAttr(resourceName, "value", "TestValue"),
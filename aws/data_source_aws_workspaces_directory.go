@@ -86,6 +86,46 @@ func dataSourceAwsWorkspacesDirectory() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"tags": tagsSchema(),
+			"workspace_access_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_type_android": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_type_chromeos": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_type_ios": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_type_linux": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_type_osx": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_type_web": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_type_windows": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_type_zeroclient": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"workspace_creation_properties": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -161,6 +201,10 @@ func dataSourceAwsWorkspacesDirectoryRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error setting self_service_permissions: %s", err)
 	}
 
+	if err := d.Set("workspace_access_properties", flattenWorkspaceAccessProperties(directory.WorkspaceAccessProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_access_properties: %s", err)
+	}
+
 	if err := d.Set("workspace_creation_properties", flattenWorkspaceCreationProperties(directory.WorkspaceCreationProperties)); err != nil {
 		return fmt.Errorf("error setting workspace_creation_properties: %s", err)
 	}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	awspolicy "github.com/jen20/awspolicyequivalence"
@@ -120,3 +121,20 @@ func suppressEquivalentJsonOrYamlDiffs(k, old, new string, d *schema.ResourceDat
 func suppressEqualCIDRBlockDiffs(k, old, new string, d *schema.ResourceData) bool {
 	return cidrBlocksEqual(old, new)
 }
+
+// suppressEquivalentTime provides custom difference suppression for RFC3339
+// timestamps that have different string values but represent the same instant,
+// e.g. when the API echoes back fractional seconds.
+func suppressEquivalentTime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
@@ -264,3 +264,44 @@ Outputs:
 		}
 	}
 }
+
+func TestSuppressEquivalentTime(t *testing.T) {
+	testCases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        "2021-06-01T00:00:00.000Z",
+			new:        "2021-06-01T00:00:00Z",
+			equivalent: true,
+		},
+		{
+			old:        "2021-06-01T02:00:00+02:00",
+			new:        "2021-06-01T00:00:00Z",
+			equivalent: true,
+		},
+		{
+			old:        "2021-06-01T00:00:00.000Z",
+			new:        "2021-06-02T00:00:00Z",
+			equivalent: false,
+		},
+		{
+			old:        "",
+			new:        "2021-06-01T00:00:00Z",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		value := suppressEquivalentTime("test_property", tc.old, tc.new, nil)
+
+		if tc.equivalent && !value {
+			t.Fatalf("expected test case %d to be equivalent", i)
+		}
+
+		if !tc.equivalent && value {
+			t.Fatalf("expected test case %d to not be equivalent", i)
+		}
+	}
+}
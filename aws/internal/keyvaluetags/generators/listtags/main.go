@@ -22,7 +22,9 @@ var serviceNames = []string{
 	"acmpca",
 	"amplify",
 	"apigatewayv2",
+	"appconfig",
 	"appmesh",
+	"apprunner",
 	"appstream",
 	"appsync",
 	"athena",
@@ -44,10 +46,12 @@ var serviceNames = []string{
 	"cognitoidentity",
 	"cognitoidentityprovider",
 	"configservice",
+	"connect",
 	"databasemigrationservice",
 	"dataexchange",
 	"datasync",
 	"dax",
+	"detective",
 	"devicefarm",
 	"directconnect",
 	"directoryservice",
@@ -85,18 +89,23 @@ var serviceNames = []string{
 	"kms",
 	"lambda",
 	"licensemanager",
+	"macie2",
+	"managedgrafana",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
 	"mediapackage",
 	"mediastore",
+	"memorydb",
 	"mq",
+	"mwaa",
 	"neptune",
 	"networkfirewall",
 	"networkmanager",
 	"opsworks",
 	"organizations",
 	"pinpoint",
+	"prometheusservice",
 	"qldb",
 	"quicksight",
 	"rds",
@@ -106,13 +115,16 @@ var serviceNames = []string{
 	"sagemaker",
 	"securityhub",
 	"servicediscovery",
+	"sesv2",
 	"sfn",
 	"signer",
 	"sns",
 	"sqs",
 	"ssm",
+	"ssoadmin",
 	"storagegateway",
 	"swf",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
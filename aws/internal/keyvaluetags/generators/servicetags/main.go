@@ -21,6 +21,7 @@ var sliceServiceNames = []string{
 	"acm",
 	"acmpca",
 	"appmesh",
+	"apprunner",
 	"athena",
 	"autoscaling",
 	"cloud9",
@@ -71,6 +72,7 @@ var sliceServiceNames = []string{
 	"licensemanager",
 	"lightsail",
 	"mediastore",
+	"memorydb",
 	"neptune",
 	"networkfirewall",
 	"networkmanager",
@@ -89,11 +91,14 @@ var sliceServiceNames = []string{
 	"serverlessapplicationrepository",
 	"servicecatalog",
 	"servicediscovery",
+	"sesv2",
 	"sfn",
 	"sns",
 	"ssm",
+	"ssoadmin",
 	"storagegateway",
 	"swf",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
@@ -107,6 +112,7 @@ var mapServiceNames = []string{
 	"amplify",
 	"apigateway",
 	"apigatewayv2",
+	"appconfig",
 	"appstream",
 	"appsync",
 	"backup",
@@ -116,7 +122,9 @@ var mapServiceNames = []string{
 	"codestarnotifications",
 	"cognitoidentity",
 	"cognitoidentityprovider",
+	"connect",
 	"dataexchange",
+	"detective",
 	"dlm",
 	"eks",
 	"glacier",
@@ -127,14 +135,18 @@ var mapServiceNames = []string{
 	"kinesisvideo",
 	"imagebuilder",
 	"lambda",
+	"macie2",
+	"managedgrafana",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
 	"mediapackage",
 	"mq",
+	"mwaa",
 	"opsworks",
 	"qldb",
 	"pinpoint",
+	"prometheusservice",
 	"resourcegroups",
 	"securityhub",
 	"signer",
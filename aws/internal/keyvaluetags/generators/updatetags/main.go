@@ -23,7 +23,9 @@ var serviceNames = []string{
 	"amplify",
 	"apigateway",
 	"apigatewayv2",
+	"appconfig",
 	"appmesh",
+	"apprunner",
 	"appstream",
 	"appsync",
 	"athena",
@@ -45,11 +47,13 @@ var serviceNames = []string{
 	"cognitoidentity",
 	"cognitoidentityprovider",
 	"configservice",
+	"connect",
 	"databasemigrationservice",
 	"dataexchange",
 	"datapipeline",
 	"datasync",
 	"dax",
+	"detective",
 	"devicefarm",
 	"directconnect",
 	"directoryservice",
@@ -88,18 +92,23 @@ var serviceNames = []string{
 	"lambda",
 	"licensemanager",
 	"lightsail",
+	"macie2",
+	"managedgrafana",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
 	"mediapackage",
 	"mediastore",
+	"memorydb",
 	"mq",
+	"mwaa",
 	"neptune",
 	"networkfirewall",
 	"networkmanager",
 	"opsworks",
 	"organizations",
 	"pinpoint",
+	"prometheusservice",
 	"qldb",
 	"quicksight",
 	"ram",
@@ -112,14 +121,17 @@ var serviceNames = []string{
 	"secretsmanager",
 	"securityhub",
 	"servicediscovery",
+	"sesv2",
 	"sfn",
 	"signer",
 	"sns",
 	"sqs",
 	"ssm",
+	"ssoadmin",
 	"storagegateway",
 	"swf",
 	"synthetics",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
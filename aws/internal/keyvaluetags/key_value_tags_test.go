@@ -4,6 +4,46 @@ import (
 	"testing"
 )
 
+func TestDefaultConfigGetTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			want:          map[string]string{},
+		},
+		{
+			name:          "no tags",
+			defaultConfig: &DefaultConfig{},
+			want:          map[string]string{},
+		},
+		{
+			name: "tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.GetTags()
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAws(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -12,7 +12,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/amplify"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/apprunner"
 	"github.com/aws/aws-sdk-go/service/appstream"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/aws/aws-sdk-go/service/athena"
@@ -34,11 +36,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/dataexchange"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
@@ -78,18 +82,23 @@ import (
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
 	"github.com/aws/aws-sdk-go/service/mediaconnect"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/aws/aws-sdk-go/service/medialive"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
 	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/memorydb"
 	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mwaa"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
 	"github.com/aws/aws-sdk-go/service/qldb"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/aws/aws-sdk-go/service/ram"
@@ -103,14 +112,17 @@ import (
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
@@ -139,8 +151,12 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(apigateway.New)
 	case "apigatewayv2":
 		funcType = reflect.TypeOf(apigatewayv2.New)
+	case "appconfig":
+		funcType = reflect.TypeOf(appconfig.New)
 	case "appmesh":
 		funcType = reflect.TypeOf(appmesh.New)
+	case "apprunner":
+		funcType = reflect.TypeOf(apprunner.New)
 	case "appstream":
 		funcType = reflect.TypeOf(appstream.New)
 	case "appsync":
@@ -183,6 +199,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(cognitoidentityprovider.New)
 	case "configservice":
 		funcType = reflect.TypeOf(configservice.New)
+	case "connect":
+		funcType = reflect.TypeOf(connect.New)
 	case "databasemigrationservice":
 		funcType = reflect.TypeOf(databasemigrationservice.New)
 	case "dataexchange":
@@ -193,6 +211,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(datasync.New)
 	case "dax":
 		funcType = reflect.TypeOf(dax.New)
+	case "detective":
+		funcType = reflect.TypeOf(detective.New)
 	case "devicefarm":
 		funcType = reflect.TypeOf(devicefarm.New)
 	case "directconnect":
@@ -271,6 +291,10 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(licensemanager.New)
 	case "lightsail":
 		funcType = reflect.TypeOf(lightsail.New)
+	case "macie2":
+		funcType = reflect.TypeOf(macie2.New)
+	case "managedgrafana":
+		funcType = reflect.TypeOf(managedgrafana.New)
 	case "mediaconnect":
 		funcType = reflect.TypeOf(mediaconnect.New)
 	case "mediaconvert":
@@ -281,8 +305,12 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(mediapackage.New)
 	case "mediastore":
 		funcType = reflect.TypeOf(mediastore.New)
+	case "memorydb":
+		funcType = reflect.TypeOf(memorydb.New)
 	case "mq":
 		funcType = reflect.TypeOf(mq.New)
+	case "mwaa":
+		funcType = reflect.TypeOf(mwaa.New)
 	case "neptune":
 		funcType = reflect.TypeOf(neptune.New)
 	case "networkfirewall":
@@ -295,6 +323,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(organizations.New)
 	case "pinpoint":
 		funcType = reflect.TypeOf(pinpoint.New)
+	case "prometheusservice":
+		funcType = reflect.TypeOf(prometheusservice.New)
 	case "qldb":
 		funcType = reflect.TypeOf(qldb.New)
 	case "quicksight":
@@ -321,6 +351,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(securityhub.New)
 	case "servicediscovery":
 		funcType = reflect.TypeOf(servicediscovery.New)
+	case "sesv2":
+		funcType = reflect.TypeOf(sesv2.New)
 	case "sfn":
 		funcType = reflect.TypeOf(sfn.New)
 	case "signer":
@@ -331,12 +363,16 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(sqs.New)
 	case "ssm":
 		funcType = reflect.TypeOf(ssm.New)
+	case "ssoadmin":
+		funcType = reflect.TypeOf(ssoadmin.New)
 	case "storagegateway":
 		funcType = reflect.TypeOf(storagegateway.New)
 	case "swf":
 		funcType = reflect.TypeOf(swf.New)
 	case "synthetics":
 		funcType = reflect.TypeOf(synthetics.New)
+	case "timestreamwrite":
+		funcType = reflect.TypeOf(timestreamwrite.New)
 	case "transfer":
 		funcType = reflect.TypeOf(transfer.New)
 	case "waf":
@@ -407,6 +443,8 @@ func ServiceListTagsFunction(serviceName string) string {
 		return "ListResourceTags"
 	case "lambda":
 		return "ListTags"
+	case "memorydb":
+		return "ListTags"
 	case "mq":
 		return "ListTags"
 	case "opsworks":
@@ -498,6 +536,8 @@ func ServiceListTagsOutputTagsField(serviceName string) string {
 		return "TagDescriptions[0].Tags"
 	case "mediaconvert":
 		return "ResourceTags.Tags"
+	case "memorydb":
+		return "TagList"
 	case "neptune":
 		return "TagList"
 	case "networkmanager":
@@ -732,8 +772,12 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "QueueUrl"
 	case "ssm":
 		return "ResourceId"
+	case "ssoadmin":
+		return "ResourceArn"
 	case "storagegateway":
 		return "ResourceARN"
+	case "timestreamwrite":
+		return "ResourceARN"
 	case "transfer":
 		return "Arn"
 	case "waf":
@@ -829,6 +873,8 @@ func ServiceTagResourceTypeField(serviceName string) string {
 		return "ResourceType"
 	case "ssm":
 		return "ResourceType"
+	case "ssoadmin":
+		return "InstanceArn"
 	default:
 		return ""
 	}
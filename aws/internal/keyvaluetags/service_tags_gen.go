@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/apprunner"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/cloud9"
@@ -59,6 +60,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/aws/aws-sdk-go/service/lightsail"
 	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/memorydb"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
@@ -77,11 +79,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafv2"
@@ -132,6 +137,16 @@ func Apigatewayv2KeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// AppconfigTags returns appconfig service tags.
+func (tags KeyValueTags) AppconfigTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// AppconfigKeyValueTags creates KeyValueTags from appconfig service tags.
+func AppconfigKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // AppstreamTags returns appstream service tags.
 func (tags KeyValueTags) AppstreamTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -222,6 +237,16 @@ func CognitoidentityproviderKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// ConnectTags returns connect service tags.
+func (tags KeyValueTags) ConnectTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// ConnectKeyValueTags creates KeyValueTags from connect service tags.
+func ConnectKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // DataexchangeTags returns dataexchange service tags.
 func (tags KeyValueTags) DataexchangeTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -232,6 +257,16 @@ func DataexchangeKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// DetectiveTags returns detective service tags.
+func (tags KeyValueTags) DetectiveTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// DetectiveKeyValueTags creates KeyValueTags from detective service tags.
+func DetectiveKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // DlmTags returns dlm service tags.
 func (tags KeyValueTags) DlmTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -332,6 +367,26 @@ func LambdaKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// Macie2Tags returns macie2 service tags.
+func (tags KeyValueTags) Macie2Tags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// Macie2KeyValueTags creates KeyValueTags from macie2 service tags.
+func Macie2KeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
+// ManagedgrafanaTags returns managedgrafana service tags.
+func (tags KeyValueTags) ManagedgrafanaTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// ManagedgrafanaKeyValueTags creates KeyValueTags from managedgrafana service tags.
+func ManagedgrafanaKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // MediaconnectTags returns mediaconnect service tags.
 func (tags KeyValueTags) MediaconnectTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -382,6 +437,16 @@ func MqKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// MwaaTags returns mwaa service tags.
+func (tags KeyValueTags) MwaaTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// MwaaKeyValueTags creates KeyValueTags from mwaa service tags.
+func MwaaKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // OpsworksTags returns opsworks service tags.
 func (tags KeyValueTags) OpsworksTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -402,6 +467,16 @@ func PinpointKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// PrometheusserviceTags returns prometheusservice service tags.
+func (tags KeyValueTags) PrometheusserviceTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// PrometheusserviceKeyValueTags creates KeyValueTags from prometheusservice service tags.
+func PrometheusserviceKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // QldbTags returns qldb service tags.
 func (tags KeyValueTags) QldbTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -555,6 +630,33 @@ func AppmeshKeyValueTags(tags []*appmesh.TagRef) KeyValueTags {
 	return New(m)
 }
 
+// ApprunnerTags returns apprunner service tags.
+func (tags KeyValueTags) ApprunnerTags() []*apprunner.Tag {
+	result := make([]*apprunner.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &apprunner.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// ApprunnerKeyValueTags creates KeyValueTags from apprunner service tags.
+func ApprunnerKeyValueTags(tags []*apprunner.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // AthenaTags returns athena service tags.
 func (tags KeyValueTags) AthenaTags() []*athena.Tag {
 	result := make([]*athena.Tag, 0, len(tags))
@@ -2065,6 +2167,33 @@ func MediastoreKeyValueTags(tags []*mediastore.Tag) KeyValueTags {
 	return New(m)
 }
 
+// MemorydbTags returns memorydb service tags.
+func (tags KeyValueTags) MemorydbTags() []*memorydb.Tag {
+	result := make([]*memorydb.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &memorydb.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// MemorydbKeyValueTags creates KeyValueTags from memorydb service tags.
+func MemorydbKeyValueTags(tags []*memorydb.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // NeptuneTags returns neptune service tags.
 func (tags KeyValueTags) NeptuneTags() []*neptune.Tag {
 	result := make([]*neptune.Tag, 0, len(tags))
@@ -2551,6 +2680,33 @@ func ServicediscoveryKeyValueTags(tags []*servicediscovery.Tag) KeyValueTags {
 	return New(m)
 }
 
+// Sesv2Tags returns sesv2 service tags.
+func (tags KeyValueTags) Sesv2Tags() []*sesv2.Tag {
+	result := make([]*sesv2.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &sesv2.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// Sesv2KeyValueTags creates KeyValueTags from sesv2 service tags.
+func Sesv2KeyValueTags(tags []*sesv2.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // SfnTags returns sfn service tags.
 func (tags KeyValueTags) SfnTags() []*sfn.Tag {
 	result := make([]*sfn.Tag, 0, len(tags))
@@ -2632,6 +2788,33 @@ func SsmKeyValueTags(tags []*ssm.Tag) KeyValueTags {
 	return New(m)
 }
 
+// SsoadminTags returns ssoadmin service tags.
+func (tags KeyValueTags) SsoadminTags() []*ssoadmin.Tag {
+	result := make([]*ssoadmin.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &ssoadmin.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// SsoadminKeyValueTags creates KeyValueTags from ssoadmin service tags.
+func SsoadminKeyValueTags(tags []*ssoadmin.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // StoragegatewayTags returns storagegateway service tags.
 func (tags KeyValueTags) StoragegatewayTags() []*storagegateway.Tag {
 	result := make([]*storagegateway.Tag, 0, len(tags))
@@ -2686,6 +2869,33 @@ func SwfKeyValueTags(tags []*swf.ResourceTag) KeyValueTags {
 	return New(m)
 }
 
+// TimestreamwriteTags returns timestreamwrite service tags.
+func (tags KeyValueTags) TimestreamwriteTags() []*timestreamwrite.Tag {
+	result := make([]*timestreamwrite.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &timestreamwrite.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// TimestreamwriteKeyValueTags creates KeyValueTags from timestreamwrite service tags.
+func TimestreamwriteKeyValueTags(tags []*timestreamwrite.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // TransferTags returns transfer service tags.
 func (tags KeyValueTags) TransferTags() []*transfer.Tag {
 	result := make([]*transfer.Tag, 0, len(tags))
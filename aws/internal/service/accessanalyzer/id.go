@@ -0,0 +1,25 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"strings"
+)
+
+const archiveRuleResourceIDSeparator = "/"
+
+func ArchiveRuleCreateResourceID(analyzerName, ruleName string) string {
+	parts := []string{analyzerName, ruleName}
+	id := strings.Join(parts, archiveRuleResourceIDSeparator)
+
+	return id
+}
+
+func ArchiveRuleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, archiveRuleResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AnalyzerName%[2]sRuleName", id, archiveRuleResourceIDSeparator)
+}
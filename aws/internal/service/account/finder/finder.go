@@ -0,0 +1,42 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// AlternateContactByAccountIDAndContactType returns the alternate contact corresponding to the specified account ID and type.
+// An empty account ID refers to the caller's account.
+func AlternateContactByAccountIDAndContactType(conn *account.Account, accountID, contactType string) (*account.AlternateContact, error) {
+	input := &account.GetAlternateContactInput{
+		AlternateContactType: aws.String(contactType),
+	}
+
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	output, err := conn.GetAlternateContact(input)
+
+	if tfawserr.ErrCodeEquals(err, account.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AlternateContact == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.AlternateContact, nil
+}
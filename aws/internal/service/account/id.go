@@ -0,0 +1,35 @@
+package account
+
+import (
+	"fmt"
+	"strings"
+)
+
+const alternateContactResourceIDSeparator = "/"
+
+// AlternateContactCreateResourceID returns the resource ID for an alternate contact.
+// The account ID is only part of the resource ID when it is set explicitly.
+func AlternateContactCreateResourceID(accountID, contactType string) string {
+	if accountID == "" {
+		return contactType
+	}
+
+	parts := []string{accountID, contactType}
+	id := strings.Join(parts, alternateContactResourceIDSeparator)
+
+	return id
+}
+
+// AlternateContactParseResourceID returns the account ID and contact type from an alternate contact resource ID.
+func AlternateContactParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, alternateContactResourceIDSeparator)
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return "", parts[0], nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ContactType or AccountID%[2]sContactType", id, alternateContactResourceIDSeparator)
+}
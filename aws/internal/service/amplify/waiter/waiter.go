@@ -0,0 +1,61 @@
+package waiter
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	DomainAssociationCreatedTimeout  = 5 * time.Minute
+	DomainAssociationVerifiedTimeout = 15 * time.Minute
+)
+
+// DomainAssociationCreated waits for a DomainAssociation to reach a state from
+// which DNS verification can proceed
+func DomainAssociationCreated(conn *amplify.Amplify, appID, domainName string) (*amplify.DomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{amplify.DomainStatusCreating, amplify.DomainStatusInProgress, amplify.DomainStatusRequestingCertificate},
+		Target:  []string{amplify.DomainStatusPendingVerification, amplify.DomainStatusPendingDeployment, amplify.DomainStatusAvailable},
+		Refresh: DomainAssociationStatus(conn, appID, domainName),
+		Timeout: DomainAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*amplify.DomainAssociation); ok {
+		if status := aws.StringValue(output.DomainStatus); status == amplify.DomainStatusFailed {
+			err = errors.New(aws.StringValue(output.StatusReason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DomainAssociationVerified waits for the DNS records of a DomainAssociation
+// to be verified and the domain to become AVAILABLE
+func DomainAssociationVerified(conn *amplify.Amplify, appID, domainName string) (*amplify.DomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{amplify.DomainStatusUpdating, amplify.DomainStatusInProgress, amplify.DomainStatusPendingVerification},
+		Target:  []string{amplify.DomainStatusPendingDeployment, amplify.DomainStatusAvailable},
+		Refresh: DomainAssociationStatus(conn, appID, domainName),
+		Timeout: DomainAssociationVerifiedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*amplify.DomainAssociation); ok {
+		if status := aws.StringValue(output.DomainStatus); status == amplify.DomainStatusFailed {
+			err = errors.New(aws.StringValue(output.StatusReason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,41 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// DeploymentByApplicationEnvironmentAndNumber returns the AppConfig Deployment corresponding to the specified
+// application ID, environment ID and deployment number.
+// Returns NotFoundError if no Deployment is found.
+func DeploymentByApplicationEnvironmentAndNumber(conn *appconfig.AppConfig, applicationID, environmentID string, deploymentNumber int) (*appconfig.GetDeploymentOutput, error) {
+	input := &appconfig.GetDeploymentInput{
+		ApplicationId:    aws.String(applicationID),
+		DeploymentNumber: aws.Int64(int64(deploymentNumber)),
+		EnvironmentId:    aws.String(environmentID),
+	}
+
+	output, err := conn.GetDeployment(input)
+
+	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,95 @@
+package appconfig
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const environmentResourceIDSeparator = ":"
+
+func EnvironmentCreateResourceID(environmentID, applicationID string) string {
+	parts := []string{environmentID, applicationID}
+	id := strings.Join(parts, environmentResourceIDSeparator)
+
+	return id
+}
+
+func EnvironmentParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, environmentResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EnvironmentID%[2]sApplicationID", id, environmentResourceIDSeparator)
+}
+
+const configurationProfileResourceIDSeparator = ":"
+
+func ConfigurationProfileCreateResourceID(configurationProfileID, applicationID string) string {
+	parts := []string{configurationProfileID, applicationID}
+	id := strings.Join(parts, configurationProfileResourceIDSeparator)
+
+	return id
+}
+
+func ConfigurationProfileParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, configurationProfileResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ConfigurationProfileID%[2]sApplicationID", id, configurationProfileResourceIDSeparator)
+}
+
+const hostedConfigurationVersionResourceIDSeparator = "/"
+
+func HostedConfigurationVersionCreateResourceID(applicationID, configurationProfileID string, versionNumber int) string {
+	parts := []string{applicationID, configurationProfileID, strconv.Itoa(versionNumber)}
+	id := strings.Join(parts, hostedConfigurationVersionResourceIDSeparator)
+
+	return id
+}
+
+func HostedConfigurationVersionParseResourceID(id string) (string, string, int, error) {
+	parts := strings.Split(id, hostedConfigurationVersionResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		versionNumber, err := strconv.Atoi(parts[2])
+
+		if err != nil {
+			return "", "", 0, fmt.Errorf("error parsing version number in ID (%s): %w", id, err)
+		}
+
+		return parts[0], parts[1], versionNumber, nil
+	}
+
+	return "", "", 0, fmt.Errorf("unexpected format for ID (%[1]s), expected ApplicationID%[2]sConfigurationProfileID%[2]sVersionNumber", id, hostedConfigurationVersionResourceIDSeparator)
+}
+
+const deploymentResourceIDSeparator = "/"
+
+func DeploymentCreateResourceID(applicationID, environmentID string, deploymentNumber int) string {
+	parts := []string{applicationID, environmentID, strconv.Itoa(deploymentNumber)}
+	id := strings.Join(parts, deploymentResourceIDSeparator)
+
+	return id
+}
+
+func DeploymentParseResourceID(id string) (string, string, int, error) {
+	parts := strings.Split(id, deploymentResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		deploymentNumber, err := strconv.Atoi(parts[2])
+
+		if err != nil {
+			return "", "", 0, fmt.Errorf("error parsing deployment number in ID (%s): %w", id, err)
+		}
+
+		return parts[0], parts[1], deploymentNumber, nil
+	}
+
+	return "", "", 0, fmt.Errorf("unexpected format for ID (%[1]s), expected ApplicationID%[2]sEnvironmentID%[2]sDeploymentNumber", id, deploymentResourceIDSeparator)
+}
@@ -0,0 +1,26 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// DeploymentState fetches the Deployment and its State
+func DeploymentState(conn *appconfig.AppConfig, applicationID, environmentID string, deploymentNumber int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.DeploymentByApplicationEnvironmentAndNumber(conn, applicationID, environmentID, deploymentNumber)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
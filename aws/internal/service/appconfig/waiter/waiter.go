@@ -0,0 +1,36 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	DeploymentCompletedTimeout = 60 * time.Minute
+
+	deploymentCompletedDelay      = 10 * time.Second
+	deploymentCompletedMinTimeout = 5 * time.Second
+)
+
+// DeploymentCompleted waits for a Deployment to return COMPLETE.
+// A deployment that is rolled back returns ROLLING_BACK or ROLLED_BACK, which is surfaced as an unexpected state error.
+func DeploymentCompleted(conn *appconfig.AppConfig, applicationID, environmentID string, deploymentNumber int, timeout time.Duration) (*appconfig.GetDeploymentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{appconfig.DeploymentStateBaking, appconfig.DeploymentStateDeploying, appconfig.DeploymentStateValidating},
+		Target:     []string{appconfig.DeploymentStateComplete},
+		Refresh:    DeploymentState(conn, applicationID, environmentID, deploymentNumber),
+		Timeout:    timeout,
+		Delay:      deploymentCompletedDelay,
+		MinTimeout: deploymentCompletedMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appconfig.GetDeploymentOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
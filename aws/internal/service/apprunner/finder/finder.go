@@ -0,0 +1,160 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// AutoScalingConfigurationByARN returns the App Runner AutoScalingConfiguration corresponding to the specified ARN.
+// Returns NotFoundError if no AutoScalingConfiguration is found.
+func AutoScalingConfigurationByARN(conn *apprunner.AppRunner, arn string) (*apprunner.AutoScalingConfiguration, error) {
+	input := &apprunner.DescribeAutoScalingConfigurationInput{
+		AutoScalingConfigurationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAutoScalingConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutoScalingConfiguration == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.AutoScalingConfiguration, nil
+}
+
+// ConnectionSummaryByName returns the App Runner ConnectionSummary corresponding to the specified name.
+// Returns NotFoundError if no Connection is found.
+func ConnectionSummaryByName(conn *apprunner.AppRunner, name string) (*apprunner.ConnectionSummary, error) {
+	input := &apprunner.ListConnectionsInput{
+		ConnectionName: aws.String(name),
+	}
+
+	var result *apprunner.ConnectionSummary
+
+	err := conn.ListConnectionsPages(input, func(page *apprunner.ListConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, summary := range page.ConnectionSummaryList {
+			if summary == nil {
+				continue
+			}
+
+			if aws.StringValue(summary.ConnectionName) == name {
+				result = summary
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// CustomDomainByDomainNameAndServiceARN returns the App Runner CustomDomain corresponding to the specified
+// domain name and service ARN.
+// Returns NotFoundError if no CustomDomain is found.
+func CustomDomainByDomainNameAndServiceARN(conn *apprunner.AppRunner, domainName, serviceArn string) (*apprunner.CustomDomain, error) {
+	input := &apprunner.DescribeCustomDomainsInput{
+		ServiceArn: aws.String(serviceArn),
+	}
+
+	var result *apprunner.CustomDomain
+
+	err := conn.DescribeCustomDomainsPages(input, func(page *apprunner.DescribeCustomDomainsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, customDomain := range page.CustomDomains {
+			if customDomain == nil {
+				continue
+			}
+
+			if aws.StringValue(customDomain.DomainName) == domainName {
+				result = customDomain
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// ServiceByARN returns the App Runner Service corresponding to the specified ARN.
+// Returns NotFoundError if no Service is found.
+func ServiceByARN(conn *apprunner.AppRunner, arn string) (*apprunner.Service, error) {
+	input := &apprunner.DescribeServiceInput{
+		ServiceArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeService(input)
+
+	if tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Service == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Service, nil
+}
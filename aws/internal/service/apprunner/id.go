@@ -0,0 +1,25 @@
+package apprunner
+
+import (
+	"fmt"
+	"strings"
+)
+
+const customDomainAssociationResourceIDSeparator = ","
+
+func CustomDomainAssociationCreateResourceID(domainName, serviceArn string) string {
+	parts := []string{domainName, serviceArn}
+	id := strings.Join(parts, customDomainAssociationResourceIDSeparator)
+
+	return id
+}
+
+func CustomDomainAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, customDomainAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DomainName%[2]sServiceArn", id, customDomainAssociationResourceIDSeparator)
+}
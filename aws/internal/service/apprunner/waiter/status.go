@@ -0,0 +1,84 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// AutoScalingConfigurationStatus fetches the AutoScalingConfiguration and its Status
+func AutoScalingConfigurationStatus(conn *apprunner.AppRunner, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.AutoScalingConfigurationByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// ConnectionStatus fetches the Connection and its Status.
+// A Connection in the DELETED state is treated as not found.
+func ConnectionStatus(conn *apprunner.AppRunner, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ConnectionSummaryByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Deleted connections may continue to be listed for a short time.
+		if aws.StringValue(output.Status) == apprunner.ConnectionStatusDeleted {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// CustomDomainStatus fetches the CustomDomain and its Status
+func CustomDomainStatus(conn *apprunner.AppRunner, domainName, serviceArn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.CustomDomainByDomainNameAndServiceARN(conn, domainName, serviceArn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// ServiceStatus fetches the Service and its Status.
+// A Service reports OPERATION_IN_PROGRESS while a create, update, pause, resume or delete operation is running.
+func ServiceStatus(conn *apprunner.AppRunner, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ServiceByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
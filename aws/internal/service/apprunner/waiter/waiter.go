@@ -0,0 +1,172 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	AutoScalingConfigurationCreatedTimeout = 2 * time.Minute
+	AutoScalingConfigurationDeletedTimeout = 2 * time.Minute
+
+	ConnectionDeletedTimeout = 5 * time.Minute
+
+	CustomDomainAssociationCreatedTimeout = 5 * time.Minute
+	CustomDomainAssociationDeletedTimeout = 5 * time.Minute
+
+	ServiceCreatedTimeout = 20 * time.Minute
+	ServiceUpdatedTimeout = 20 * time.Minute
+	ServiceDeletedTimeout = 20 * time.Minute
+)
+
+// AutoScalingConfigurationActive waits for an AutoScalingConfiguration to return ACTIVE
+func AutoScalingConfigurationActive(conn *apprunner.AppRunner, arn string) (*apprunner.AutoScalingConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{apprunner.AutoScalingConfigurationStatusActive},
+		Refresh: AutoScalingConfigurationStatus(conn, arn),
+		Timeout: AutoScalingConfigurationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*apprunner.AutoScalingConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// AutoScalingConfigurationInactive waits for an AutoScalingConfiguration to return INACTIVE
+func AutoScalingConfigurationInactive(conn *apprunner.AppRunner, arn string) (*apprunner.AutoScalingConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{apprunner.AutoScalingConfigurationStatusActive},
+		Target:  []string{apprunner.AutoScalingConfigurationStatusInactive},
+		Refresh: AutoScalingConfigurationStatus(conn, arn),
+		Timeout: AutoScalingConfigurationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*apprunner.AutoScalingConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ConnectionDeleted waits for a Connection to be deleted
+func ConnectionDeleted(conn *apprunner.AppRunner, name string) (*apprunner.ConnectionSummary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{apprunner.ConnectionStatusPendingHandshake, apprunner.ConnectionStatusAvailable, apprunner.ConnectionStatusError},
+		Target:  []string{},
+		Refresh: ConnectionStatus(conn, name),
+		Timeout: ConnectionDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*apprunner.ConnectionSummary); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// CustomDomainAssociationCreated waits for a CustomDomain to reach a state in which
+// its certificate validation records are available
+func CustomDomainAssociationCreated(conn *apprunner.AppRunner, domainName, serviceArn string) (*apprunner.CustomDomain, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{apprunner.CustomDomainAssociationStatusCreating},
+		Target:  []string{apprunner.CustomDomainAssociationStatusPendingCertificateDnsValidation, apprunner.CustomDomainAssociationStatusBindingCertificate, apprunner.CustomDomainAssociationStatusActive},
+		Refresh: CustomDomainStatus(conn, domainName, serviceArn),
+		Timeout: CustomDomainAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*apprunner.CustomDomain); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// CustomDomainAssociationDeleted waits for a CustomDomain to be disassociated
+func CustomDomainAssociationDeleted(conn *apprunner.AppRunner, domainName, serviceArn string) (*apprunner.CustomDomain, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			apprunner.CustomDomainAssociationStatusActive,
+			apprunner.CustomDomainAssociationStatusBindingCertificate,
+			apprunner.CustomDomainAssociationStatusDeleting,
+			apprunner.CustomDomainAssociationStatusPendingCertificateDnsValidation,
+		},
+		Target:  []string{},
+		Refresh: CustomDomainStatus(conn, domainName, serviceArn),
+		Timeout: CustomDomainAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*apprunner.CustomDomain); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ServiceCreated waits for the create operation of a Service to finish and the Service to return RUNNING
+func ServiceCreated(conn *apprunner.AppRunner, arn string, timeout time.Duration) (*apprunner.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{apprunner.ServiceStatusOperationInProgress},
+		Target:  []string{apprunner.ServiceStatusRunning},
+		Refresh: ServiceStatus(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*apprunner.Service); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ServiceUpdated waits for the update operation of a Service to finish and the Service to return RUNNING
+func ServiceUpdated(conn *apprunner.AppRunner, arn string, timeout time.Duration) (*apprunner.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{apprunner.ServiceStatusOperationInProgress},
+		Target:  []string{apprunner.ServiceStatusRunning},
+		Refresh: ServiceStatus(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*apprunner.Service); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ServiceDeleted waits for the delete operation of a Service to finish and the Service to return DELETED
+func ServiceDeleted(conn *apprunner.AppRunner, arn string, timeout time.Duration) (*apprunner.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{apprunner.ServiceStatusRunning, apprunner.ServiceStatusOperationInProgress},
+		Target:  []string{apprunner.ServiceStatusDeleted},
+		Refresh: ServiceStatus(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*apprunner.Service); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -19,6 +19,11 @@ func (cp *containerProperties) Reduce() error {
 		return aws.StringValue(cp.Environment[i].Name) < aws.StringValue(cp.Environment[j].Name)
 	})
 
+	// Deal with ResourceRequirements objects which may be re-ordered in the API
+	sort.Slice(cp.ResourceRequirements, func(i, j int) bool {
+		return aws.StringValue(cp.ResourceRequirements[i].Type) < aws.StringValue(cp.ResourceRequirements[j].Type)
+	})
+
 	// Prevent difference of API response that adds an empty array when not configured during the request
 	if len(cp.Command) == 0 {
 		cp.Command = nil
@@ -54,6 +59,16 @@ func (cp *containerProperties) Reduce() error {
 		cp.Volumes = nil
 	}
 
+	// Prevent difference of API response that adds the default Fargate platform version when not configured during the request
+	if cp.FargatePlatformConfiguration != nil && (cp.FargatePlatformConfiguration.PlatformVersion == nil || aws.StringValue(cp.FargatePlatformConfiguration.PlatformVersion) == "LATEST") {
+		cp.FargatePlatformConfiguration = nil
+	}
+
+	// Prevent difference of API response that adds the default network configuration when not configured during the request
+	if cp.NetworkConfiguration != nil && (cp.NetworkConfiguration.AssignPublicIp == nil || aws.StringValue(cp.NetworkConfiguration.AssignPublicIp) == batch.AssignPublicIpDisabled) {
+		cp.NetworkConfiguration = nil
+	}
+
 	return nil
 }
 
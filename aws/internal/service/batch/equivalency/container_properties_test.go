@@ -241,6 +241,66 @@ func TestEquivalentBatchContainerPropertiesJSON(t *testing.T) {
 `,
 			ExpectEquivalent: true,
 		},
+		{
+			Name: "Fargate with default platform version and network configuration",
+			ApiJson: `
+{
+	"image": "busybox",
+	"command": ["echo", "test"],
+	"executionRoleArn": "arn:aws:iam::123456789012:role/ecs_task_execution_role",
+	"fargatePlatformConfiguration": {"platformVersion": "LATEST"},
+	"networkConfiguration": {"assignPublicIp": "DISABLED"},
+	"resourceRequirements": [
+		{"type": "VCPU", "value": "0.25"},
+		{"type": "MEMORY", "value": "512"}
+	],
+	"volumes": [],
+	"environment": [],
+	"mountPoints": [],
+	"ulimits": [],
+	"secrets": []
+}
+`,
+			ConfigurationJson: `
+{
+	"image": "busybox",
+	"command": ["echo", "test"],
+	"executionRoleArn": "arn:aws:iam::123456789012:role/ecs_task_execution_role",
+	"resourceRequirements": [
+		{"type": "MEMORY", "value": "512"},
+		{"type": "VCPU", "value": "0.25"}
+	]
+}
+`,
+			ExpectEquivalent: true,
+		},
+		{
+			Name: "Fargate with public IP assignment",
+			ApiJson: `
+{
+	"image": "busybox",
+	"executionRoleArn": "arn:aws:iam::123456789012:role/ecs_task_execution_role",
+	"fargatePlatformConfiguration": {"platformVersion": "1.4.0"},
+	"networkConfiguration": {"assignPublicIp": "ENABLED"},
+	"resourceRequirements": [
+		{"type": "VCPU", "value": "0.25"},
+		{"type": "MEMORY", "value": "512"}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"image": "busybox",
+	"executionRoleArn": "arn:aws:iam::123456789012:role/ecs_task_execution_role",
+	"fargatePlatformConfiguration": {"platformVersion": "1.4.0"},
+	"resourceRequirements": [
+		{"type": "VCPU", "value": "0.25"},
+		{"type": "MEMORY", "value": "512"}
+	]
+}
+`,
+			ExpectEquivalent: false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,35 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/budgets/finder"
+)
+
+const (
+	actionStatusNotFound = "NotFound"
+	actionStatusUnknown  = "Unknown"
+)
+
+// ActionStatus fetches the budget action and its Status
+func ActionStatus(conn *budgets.Budgets, accountID, actionID, budgetName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		action, err := finder.ActionByAccountIDActionIDAndBudgetName(conn, accountID, actionID, budgetName)
+
+		if tfawserr.ErrCodeEquals(err, budgets.ErrCodeNotFoundException) {
+			return nil, actionStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, actionStatusUnknown, err
+		}
+
+		if action == nil {
+			return nil, actionStatusNotFound, nil
+		}
+
+		return action, aws.StringValue(action.Status), nil
+	}
+}
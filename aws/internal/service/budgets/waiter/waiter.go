@@ -0,0 +1,43 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a budget action to become available
+	ActionAvailableTimeout = 5 * time.Minute
+)
+
+// ActionAvailable waits for a budget action to become available
+func ActionAvailable(conn *budgets.Budgets, accountID, actionID, budgetName string) (*budgets.Action, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			budgets.ActionStatusExecutionInProgress,
+			budgets.ActionStatusResetInProgress,
+			budgets.ActionStatusReverseInProgress,
+		},
+		Target: []string{
+			budgets.ActionStatusExecutionFailure,
+			budgets.ActionStatusExecutionSuccess,
+			budgets.ActionStatusPending,
+			budgets.ActionStatusResetFailure,
+			budgets.ActionStatusReverseFailure,
+			budgets.ActionStatusReverseSuccess,
+			budgets.ActionStatusStandby,
+		},
+		Refresh: ActionStatus(conn, accountID, actionID, budgetName),
+		Timeout: ActionAvailableTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*budgets.Action); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -54,3 +54,67 @@ func Stack(conn *cloudformation.CloudFormation, stackID string) (*cloudformation
 
 	return stack, nil
 }
+
+func TypeByARN(conn *cloudformation.CloudFormation, arn string) (*cloudformation.DescribeTypeOutput, error) {
+	input := &cloudformation.DescribeTypeInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeType(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeTypeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+			Message:     "returned empty response",
+		}
+	}
+
+	if status := aws.StringValue(output.DeprecatedStatus); status == cloudformation.DeprecatedStatusDeprecated {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "CloudFormation Type deprecated",
+		}
+	}
+
+	return output, nil
+}
+
+func TypeRegistrationByToken(conn *cloudformation.CloudFormation, registrationToken string) (*cloudformation.DescribeTypeRegistrationOutput, error) {
+	input := &cloudformation.DescribeTypeRegistrationInput{
+		RegistrationToken: aws.String(registrationToken),
+	}
+
+	output, err := conn.DescribeTypeRegistration(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeCFNRegistryException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+			Message:     "returned empty response",
+		}
+	}
+
+	return output, nil
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func ChangeSetStatus(conn *cloudformation.CloudFormation, stackID, changeSetName string) resource.StateRefreshFunc {
@@ -33,13 +35,17 @@ func ChangeSetStatus(conn *cloudformation.CloudFormation, stackID, changeSetName
 	}
 }
 
-func StackSetOperationStatus(conn *cloudformation.CloudFormation, stackSetName, operationID string) resource.StateRefreshFunc {
+func StackSetOperationStatus(conn *cloudformation.CloudFormation, stackSetName, operationID, callAs string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &cloudformation.DescribeStackSetOperationInput{
 			OperationId:  aws.String(operationID),
 			StackSetName: aws.String(stackSetName),
 		}
 
+		if callAs != "" {
+			input.CallAs = aws.String(callAs)
+		}
+
 		output, err := conn.DescribeStackSetOperation(input)
 
 		if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeOperationNotFoundException) {
@@ -61,6 +67,10 @@ func StackSetOperationStatus(conn *cloudformation.CloudFormation, stackSetName,
 				StackSetName: aws.String(stackSetName),
 			}
 
+			if callAs != "" {
+				listOperationResultsInput.CallAs = aws.String(callAs)
+			}
+
 			// TODO: PAGES
 			for {
 				listOperationResultsOutput, err := conn.ListStackSetOperationResults(listOperationResultsInput)
@@ -91,6 +101,22 @@ func StackSetOperationStatus(conn *cloudformation.CloudFormation, stackSetName,
 	}
 }
 
+func TypeRegistrationProgressStatus(conn *cloudformation.CloudFormation, registrationToken string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.TypeRegistrationByToken(conn, registrationToken)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ProgressStatus), nil
+	}
+}
+
 const (
 	stackStatusError    = "Error"
 	stackStatusNotFound = "NotFound"
@@ -59,11 +59,11 @@ const (
 	StackSetUpdatedDefaultTimeout = 30 * time.Minute
 )
 
-func StackSetOperationSucceeded(conn *cloudformation.CloudFormation, stackSetName, operationID string, timeout time.Duration) error {
+func StackSetOperationSucceeded(conn *cloudformation.CloudFormation, stackSetName, operationID, callAs string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{cloudformation.StackSetOperationStatusRunning},
 		Target:  []string{cloudformation.StackSetOperationStatusSucceeded},
-		Refresh: StackSetOperationStatus(conn, stackSetName, operationID),
+		Refresh: StackSetOperationStatus(conn, stackSetName, operationID, callAs),
 		Timeout: timeout,
 		Delay:   stackSetOperationDelay,
 	}
@@ -74,6 +74,35 @@ func StackSetOperationSucceeded(conn *cloudformation.CloudFormation, stackSetNam
 	return err
 }
 
+const (
+	// Maximum amount of time to wait for a Type registration to complete
+	TypeRegistrationTimeout = 5 * time.Minute
+
+	// Default maximum amount of time to wait for a Type to be Deregistered
+	TypeDeregisteredDefaultTimeout = 5 * time.Minute
+)
+
+func TypeRegistrationProgressStatusComplete(conn *cloudformation.CloudFormation, registrationToken string) (*cloudformation.DescribeTypeRegistrationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudformation.RegistrationStatusInProgress},
+		Target:  []string{cloudformation.RegistrationStatusComplete},
+		Refresh: TypeRegistrationProgressStatus(conn, registrationToken),
+		Timeout: TypeRegistrationTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*cloudformation.DescribeTypeRegistrationOutput); ok {
+		if status := aws.StringValue(output.ProgressStatus); status == cloudformation.RegistrationStatusFailed {
+			return output, fmt.Errorf("registration failed: %s", aws.StringValue(output.Description))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	// Default maximum amount of time to wait for a Stack to be Created
 	StackCreatedDefaultTimeout = 30 * time.Minute
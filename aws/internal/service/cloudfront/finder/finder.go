@@ -0,0 +1,75 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// OriginAccessControlByID returns the origin access control corresponding to the specified ID.
+func OriginAccessControlByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetOriginAccessControlOutput, error) {
+	input := &cloudfront.GetOriginAccessControlInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetOriginAccessControl(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginAccessControl) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OriginAccessControl == nil || output.OriginAccessControl.OriginAccessControlConfig == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// OriginAccessControlSummaryByName returns the origin access control summary corresponding to the specified name.
+func OriginAccessControlSummaryByName(conn *cloudfront.CloudFront, name string) (*cloudfront.OriginAccessControlSummary, error) {
+	input := &cloudfront.ListOriginAccessControlsInput{}
+
+	for {
+		output, err := conn.ListOriginAccessControls(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.OriginAccessControlList == nil {
+			break
+		}
+
+		for _, item := range output.OriginAccessControlList.Items {
+			if item == nil {
+				continue
+			}
+
+			if aws.StringValue(item.Name) == name {
+				return item, nil
+			}
+		}
+
+		if !aws.BoolValue(output.OriginAccessControlList.IsTruncated) {
+			break
+		}
+
+		input.Marker = output.OriginAccessControlList.NextMarker
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "Empty result",
+		LastRequest: input,
+	}
+}
@@ -0,0 +1,38 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ConnectionStateNotFound = "NotFound"
+	ConnectionStateUnknown  = "Unknown"
+)
+
+// ConnectionState fetches the Connection and its State
+func ConnectionState(conn *events.CloudWatchEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &events.DescribeConnectionInput{
+			Name: aws.String(name),
+		}
+
+		output, err := conn.DescribeConnection(input)
+
+		if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+			return output, ConnectionStateNotFound, nil
+		}
+
+		if err != nil {
+			return output, ConnectionStateUnknown, err
+		}
+
+		if output == nil {
+			return output, ConnectionStateUnknown, nil
+		}
+
+		return output, aws.StringValue(output.ConnectionState), nil
+	}
+}
@@ -0,0 +1,71 @@
+package waiter
+
+import (
+	"time"
+
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Connection to finish creating or updating
+	ConnectionCreatedTimeout = 2 * time.Minute
+	ConnectionUpdatedTimeout = 2 * time.Minute
+
+	// Maximum amount of time to wait for a Connection to be deleted
+	ConnectionDeletedTimeout = 2 * time.Minute
+)
+
+// ConnectionCreated waits for a Connection to leave the CREATING and AUTHORIZING states
+func ConnectionCreated(conn *events.CloudWatchEvents, name string) (*events.DescribeConnectionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{events.ConnectionStateCreating, events.ConnectionStateAuthorizing},
+		Target:  []string{events.ConnectionStateAuthorized, events.ConnectionStateDeauthorized},
+		Refresh: ConnectionState(conn, name),
+		Timeout: ConnectionCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*events.DescribeConnectionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ConnectionUpdated waits for a Connection to leave the UPDATING and AUTHORIZING states
+func ConnectionUpdated(conn *events.CloudWatchEvents, name string) (*events.DescribeConnectionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{events.ConnectionStateUpdating, events.ConnectionStateAuthorizing, events.ConnectionStateDeauthorizing},
+		Target:  []string{events.ConnectionStateAuthorized, events.ConnectionStateDeauthorized},
+		Refresh: ConnectionState(conn, name),
+		Timeout: ConnectionUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*events.DescribeConnectionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ConnectionDeleted waits for a Connection to be deleted
+func ConnectionDeleted(conn *events.CloudWatchEvents, name string) (*events.DescribeConnectionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{events.ConnectionStateDeleting},
+		Target:  []string{ConnectionStateNotFound},
+		Refresh: ConnectionState(conn, name),
+		Timeout: ConnectionDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*events.DescribeConnectionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,38 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// DataProtectionPolicyByLogGroupName returns the data protection policy attached to the specified log group.
+func DataProtectionPolicyByLogGroupName(conn *cloudwatchlogs.CloudWatchLogs, name string) (*cloudwatchlogs.GetDataProtectionPolicyOutput, error) {
+	input := &cloudwatchlogs.GetDataProtectionPolicyInput{
+		LogGroupIdentifier: aws.String(name),
+	}
+
+	output, err := conn.GetDataProtectionPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A log group without a data protection policy returns an empty document.
+	if output == nil || aws.StringValue(output.PolicyDocument) == "" {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,7 @@
+package connect
+
+const (
+	// ErrMessageContactFlowCannotBeDeleted is returned with an InvalidRequestException
+	// for contact flow types that the API does not allow to be deleted.
+	ErrMessageContactFlowCannotBeDeleted = "cannot be deleted"
+)
@@ -0,0 +1,68 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+)
+
+// InstanceByID returns the Connect instance corresponding to the specified ID.
+// Returns nil if no instance is found.
+func InstanceByID(conn *connect.Connect, id string) (*connect.Instance, error) {
+	input := &connect.DescribeInstanceInput{
+		InstanceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeInstance(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Instance, nil
+}
+
+// InstanceAttributeByTwoPartKey returns the value of the specified Connect instance attribute.
+// Returns nil if no attribute is found.
+func InstanceAttributeByTwoPartKey(conn *connect.Connect, instanceID, attributeType string) (*connect.Attribute, error) {
+	input := &connect.DescribeInstanceAttributeInput{
+		AttributeType: aws.String(attributeType),
+		InstanceId:    aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeInstanceAttribute(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Attribute, nil
+}
+
+// ContactFlowByTwoPartKey returns the Connect contact flow corresponding to the specified instance and contact flow IDs.
+// Returns nil if no contact flow is found.
+func ContactFlowByTwoPartKey(conn *connect.Connect, instanceID, contactFlowID string) (*connect.ContactFlow, error) {
+	input := &connect.DescribeContactFlowInput{
+		ContactFlowId: aws.String(contactFlowID),
+		InstanceId:    aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeContactFlow(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ContactFlow, nil
+}
@@ -0,0 +1,25 @@
+package connect
+
+import (
+	"fmt"
+	"strings"
+)
+
+const contactFlowIDSeparator = ":"
+
+func ContactFlowCreateID(instanceID, contactFlowID string) string {
+	parts := []string{instanceID, contactFlowID}
+	id := strings.Join(parts, contactFlowIDSeparator)
+
+	return id
+}
+
+func ContactFlowParseID(id string) (string, string, error) {
+	parts := strings.Split(id, contactFlowIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected INSTANCE-ID%[2]sCONTACT-FLOW-ID", id, contactFlowIDSeparator)
+}
@@ -0,0 +1,43 @@
+package waiter
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+const (
+	instanceStatusNotFound = "NotFound"
+	instanceStatusUnknown  = "Unknown"
+)
+
+// InstanceStatus fetches the Instance and its Status
+func InstanceStatus(conn *connect.Connect, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.InstanceByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			return nil, instanceStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, instanceStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, instanceStatusNotFound, nil
+		}
+
+		status := aws.StringValue(output.InstanceStatus)
+
+		if status == connect.InstanceStatusCreationFailed && output.StatusReason != nil {
+			return output, status, errors.New(aws.StringValue(output.StatusReason.Message))
+		}
+
+		return output, status, nil
+	}
+}
@@ -0,0 +1,31 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an Instance to be created
+	InstanceCreatedTimeout = 5 * time.Minute
+)
+
+// InstanceCreated waits for an Instance to return "ACTIVE"
+func InstanceCreated(conn *connect.Connect, id string) (*connect.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connect.InstanceStatusCreationInProgress},
+		Target:  []string{connect.InstanceStatusActive},
+		Refresh: InstanceStatus(conn, id),
+		Timeout: InstanceCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*connect.Instance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
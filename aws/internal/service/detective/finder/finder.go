@@ -0,0 +1,101 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+)
+
+// GraphByARN returns the Detective behavior graph corresponding to the specified ARN.
+// Returns nil if no graph is found.
+func GraphByARN(conn *detective.Detective, arn string) (*detective.Graph, error) {
+	input := &detective.ListGraphsInput{}
+	var result *detective.Graph
+
+	err := conn.ListGraphsPages(input, func(page *detective.ListGraphsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, graph := range page.GraphList {
+			if graph == nil {
+				continue
+			}
+
+			if aws.StringValue(graph.Arn) == arn {
+				result = graph
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// MemberByGraphARNAndAccountID returns the Detective member corresponding to the specified graph ARN and account ID.
+// Returns nil if no member is found.
+func MemberByGraphARNAndAccountID(conn *detective.Detective, graphARN, accountID string) (*detective.MemberDetail, error) {
+	input := &detective.GetMembersInput{
+		AccountIds: aws.StringSlice([]string{accountID}),
+		GraphArn:   aws.String(graphARN),
+	}
+
+	output, err := conn.GetMembers(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, member := range output.MemberDetails {
+		if member == nil {
+			continue
+		}
+
+		if aws.StringValue(member.AccountId) == accountID {
+			return member, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// InvitationByGraphARN returns the Detective invitation (membership) of the current account corresponding to the specified graph ARN.
+// Returns nil if no invitation is found.
+func InvitationByGraphARN(conn *detective.Detective, graphARN string) (*detective.MemberDetail, error) {
+	input := &detective.ListInvitationsInput{}
+	var result *detective.MemberDetail
+
+	err := conn.ListInvitationsPages(input, func(page *detective.ListInvitationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, invitation := range page.Invitations {
+			if invitation == nil {
+				continue
+			}
+
+			if aws.StringValue(invitation.GraphArn) == graphARN {
+				result = invitation
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
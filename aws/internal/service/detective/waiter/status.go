@@ -0,0 +1,35 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+const (
+	memberStatusNotFound = "NotFound"
+	memberStatusUnknown  = "Unknown"
+)
+
+// MemberStatus fetches the Member and its Status
+func MemberStatus(conn *detective.Detective, graphARN, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.MemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+		if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+			return nil, memberStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, memberStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, memberStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
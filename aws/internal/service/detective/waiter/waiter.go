@@ -0,0 +1,32 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Member to be invited or enabled
+	MemberCreatedTimeout = 4 * time.Minute
+)
+
+// MemberCreated waits for a Member to return "INVITED" or, for accounts that
+// are automatically enabled, "ENABLED"
+func MemberCreated(conn *detective.Detective, graphARN, accountID string) (*detective.MemberDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{detective.MemberStatusVerificationInProgress},
+		Target:  []string{detective.MemberStatusInvited, detective.MemberStatusEnabled},
+		Refresh: MemberStatus(conn, graphARN, accountID),
+		Timeout: MemberCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*detective.MemberDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -166,3 +166,248 @@ func ManagedPrefixListByID(conn *ec2.EC2, id string) (*ec2.ManagedPrefixList, er
 
 	return output.PrefixLists[0], nil
 }
+
+// TransitGatewayConnectByID returns the Transit Gateway Connect attachment corresponding to the specified identifier.
+// Returns nil and potentially an error if no Connect attachment is found.
+func TransitGatewayConnectByID(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnect, error) {
+	input := &ec2.DescribeTransitGatewayConnectsInput{
+		TransitGatewayAttachmentIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeTransitGatewayConnects(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayConnects) == 0 {
+		return nil, nil
+	}
+
+	return output.TransitGatewayConnects[0], nil
+}
+
+// TransitGatewayConnectPeerByID returns the Transit Gateway Connect peer corresponding to the specified identifier.
+// Returns nil and potentially an error if no Connect peer is found.
+func TransitGatewayConnectPeerByID(conn *ec2.EC2, id string) (*ec2.TransitGatewayConnectPeer, error) {
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{
+		TransitGatewayConnectPeerIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeTransitGatewayConnectPeers(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayConnectPeers) == 0 {
+		return nil, nil
+	}
+
+	return output.TransitGatewayConnectPeers[0], nil
+}
+
+// TransitGatewayMulticastDomainByID returns the Transit Gateway multicast domain corresponding to the specified identifier.
+// Returns nil and potentially an error if no multicast domain is found.
+func TransitGatewayMulticastDomainByID(conn *ec2.EC2, id string) (*ec2.TransitGatewayMulticastDomain, error) {
+	input := &ec2.DescribeTransitGatewayMulticastDomainsInput{
+		TransitGatewayMulticastDomainIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeTransitGatewayMulticastDomains(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayMulticastDomains) == 0 {
+		return nil, nil
+	}
+
+	return output.TransitGatewayMulticastDomains[0], nil
+}
+
+// TransitGatewayMulticastDomainAssociations returns all of the multicast domain associations matching the input.
+func TransitGatewayMulticastDomainAssociations(conn *ec2.EC2, input *ec2.GetTransitGatewayMulticastDomainAssociationsInput) ([]*ec2.TransitGatewayMulticastDomainAssociation, error) {
+	var associations []*ec2.TransitGatewayMulticastDomainAssociation
+
+	err := conn.GetTransitGatewayMulticastDomainAssociationsPages(input, func(page *ec2.GetTransitGatewayMulticastDomainAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, association := range page.MulticastDomainAssociations {
+			if association == nil {
+				continue
+			}
+
+			associations = append(associations, association)
+		}
+
+		return !lastPage
+	})
+
+	return associations, err
+}
+
+// TransitGatewayMulticastDomainAssociation returns the association of the specified subnet with the multicast domain.
+// Returns nil and potentially an error if no association is found.
+func TransitGatewayMulticastDomainAssociation(conn *ec2.EC2, multicastDomainID, attachmentID, subnetID string) (*ec2.TransitGatewayMulticastDomainAssociation, error) {
+	input := &ec2.GetTransitGatewayMulticastDomainAssociationsInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"subnet-id":                     subnetID,
+			"transit-gateway-attachment-id": attachmentID,
+		}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	associations, err := TransitGatewayMulticastDomainAssociations(conn, input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, association := range associations {
+		if association.Subnet == nil {
+			continue
+		}
+
+		if aws.StringValue(association.TransitGatewayAttachmentId) == attachmentID && aws.StringValue(association.Subnet.SubnetId) == subnetID {
+			return association, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// TransitGatewayMulticastGroups returns all of the multicast group registrations matching the input.
+func TransitGatewayMulticastGroups(conn *ec2.EC2, input *ec2.SearchTransitGatewayMulticastGroupsInput) ([]*ec2.TransitGatewayMulticastGroup, error) {
+	var groups []*ec2.TransitGatewayMulticastGroup
+
+	err := conn.SearchTransitGatewayMulticastGroupsPages(input, func(page *ec2.SearchTransitGatewayMulticastGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, group := range page.MulticastGroups {
+			if group == nil {
+				continue
+			}
+
+			groups = append(groups, group)
+		}
+
+		return !lastPage
+	})
+
+	return groups, err
+}
+
+// TransitGatewayMulticastGroupMember returns the registration of the specified network interface as a member of the multicast group.
+// Returns nil and potentially an error if no registration is found.
+func TransitGatewayMulticastGroupMember(conn *ec2.EC2, multicastDomainID, groupIPAddress, networkInterfaceID string) (*ec2.TransitGatewayMulticastGroup, error) {
+	return transitGatewayMulticastGroupRegistration(conn, multicastDomainID, groupIPAddress, networkInterfaceID, "is-group-member")
+}
+
+// TransitGatewayMulticastGroupSource returns the registration of the specified network interface as a source for the multicast group.
+// Returns nil and potentially an error if no registration is found.
+func TransitGatewayMulticastGroupSource(conn *ec2.EC2, multicastDomainID, groupIPAddress, networkInterfaceID string) (*ec2.TransitGatewayMulticastGroup, error) {
+	return transitGatewayMulticastGroupRegistration(conn, multicastDomainID, groupIPAddress, networkInterfaceID, "is-group-source")
+}
+
+func transitGatewayMulticastGroupRegistration(conn *ec2.EC2, multicastDomainID, groupIPAddress, networkInterfaceID, registrationFilterName string) (*ec2.TransitGatewayMulticastGroup, error) {
+	input := &ec2.SearchTransitGatewayMulticastGroupsInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"group-ip-address":     groupIPAddress,
+			registrationFilterName: "true",
+		}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	groups, err := TransitGatewayMulticastGroups(conn, input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		if aws.StringValue(group.NetworkInterfaceId) == networkInterfaceID {
+			return group, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// EbsFastSnapshotRestore returns the fast snapshot restore state of the specified snapshot in the specified Availability Zone.
+// Returns nil and potentially an error if no fast snapshot restore is found.
+func EbsFastSnapshotRestore(conn *ec2.EC2, availabilityZone, snapshotID string) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	input := &ec2.DescribeFastSnapshotRestoresInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"availability-zone": availabilityZone,
+			"snapshot-id":       snapshotID,
+		}),
+	}
+
+	var result *ec2.DescribeFastSnapshotRestoreSuccessItem
+
+	err := conn.DescribeFastSnapshotRestoresPages(input, func(page *ec2.DescribeFastSnapshotRestoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, fastSnapshotRestore := range page.FastSnapshotRestores {
+			if fastSnapshotRestore == nil {
+				continue
+			}
+
+			if aws.StringValue(fastSnapshotRestore.AvailabilityZone) == availabilityZone && aws.StringValue(fastSnapshotRestore.SnapshotId) == snapshotID {
+				result = fastSnapshotRestore
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// DefaultNetworkAclByVpcID returns the default network ACL of the specified VPC.
+// Returns nil and potentially an error if no default network ACL is found.
+func DefaultNetworkAclByVpcID(conn *ec2.EC2, vpcID string) (*ec2.NetworkAcl, error) {
+	input := &ec2.DescribeNetworkAclsInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"default": "true",
+			"vpc-id":  vpcID,
+		}),
+	}
+
+	output, err := conn.DescribeNetworkAcls(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.NetworkAcls) == 0 || output.NetworkAcls[0] == nil {
+		return nil, nil
+	}
+
+	return output.NetworkAcls[0], nil
+}
+
+// MainRouteTableByVpcID returns the main route table of the specified VPC.
+// Returns nil and potentially an error if no main route table is found.
+func MainRouteTableByVpcID(conn *ec2.EC2, vpcID string) (*ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"association.main": "true",
+			"vpc-id":           vpcID,
+		}),
+	}
+
+	output, err := conn.DescribeRouteTables(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.RouteTables) == 0 || output.RouteTables[0] == nil {
+		return nil, nil
+	}
+
+	return output.RouteTables[0], nil
+}
@@ -74,3 +74,80 @@ func ClientVpnRouteParseID(id string) (string, string, string, error) {
 func VpnGatewayVpcAttachmentCreateID(vpnGatewayID, vpcID string) string {
 	return fmt.Sprintf("vpn-attachment-%x", hashcode.String(fmt.Sprintf("%s-%s", vpcID, vpnGatewayID)))
 }
+
+const transitGatewayMulticastDomainAssociationIDSeparator = "/"
+
+func TransitGatewayMulticastDomainAssociationCreateID(multicastDomainID, attachmentID, subnetID string) string {
+	parts := []string{multicastDomainID, attachmentID, subnetID}
+	id := strings.Join(parts, transitGatewayMulticastDomainAssociationIDSeparator)
+	return id
+}
+
+func TransitGatewayMulticastDomainAssociationParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastDomainAssociationIDSeparator)
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected multicast-domain-id"+transitGatewayMulticastDomainAssociationIDSeparator+
+			"transit-gateway-attachment-id"+transitGatewayMulticastDomainAssociationIDSeparator+"subnet-id", id)
+}
+
+const transitGatewayMulticastGroupRegistrationIDSeparator = "/"
+
+// TransitGatewayMulticastGroupRegistrationCreateID returns the ID of a network interface's
+// membership of, or registration as a source for, a multicast group.
+func TransitGatewayMulticastGroupRegistrationCreateID(multicastDomainID, groupIPAddress, networkInterfaceID string) string {
+	parts := []string{multicastDomainID, groupIPAddress, networkInterfaceID}
+	id := strings.Join(parts, transitGatewayMulticastGroupRegistrationIDSeparator)
+	return id
+}
+
+func TransitGatewayMulticastGroupRegistrationParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastGroupRegistrationIDSeparator)
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected multicast-domain-id"+transitGatewayMulticastGroupRegistrationIDSeparator+
+			"group-ip-address"+transitGatewayMulticastGroupRegistrationIDSeparator+"network-interface-id", id)
+}
+
+const ebsFastSnapshotRestoreIDSeparator = ","
+
+func EbsFastSnapshotRestoreCreateID(availabilityZone, snapshotID string) string {
+	parts := []string{availabilityZone, snapshotID}
+	id := strings.Join(parts, ebsFastSnapshotRestoreIDSeparator)
+	return id
+}
+
+func EbsFastSnapshotRestoreParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ebsFastSnapshotRestoreIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected availability-zone"+ebsFastSnapshotRestoreIDSeparator+"snapshot-id", id)
+}
+
+const tagIDSeparator = ","
+
+func TagCreateID(resourceID, key string) string {
+	parts := []string{resourceID, key}
+	id := strings.Join(parts, tagIDSeparator)
+	return id
+}
+
+func TagParseID(id string) (string, string, error) {
+	// Tag keys may themselves contain the separator.
+	parts := strings.SplitN(id, tagIDSeparator, 2)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected resource-id"+tagIDSeparator+"key", id)
+}
@@ -316,3 +316,150 @@ func ManagedPrefixListState(conn *ec2.EC2, prefixListId string) resource.StateRe
 		return managedPrefixList, aws.StringValue(managedPrefixList.State), nil
 	}
 }
+
+const (
+	transitGatewayConnectStateNotFound = "NotFound"
+	transitGatewayConnectStateUnknown  = "Unknown"
+)
+
+// TransitGatewayConnectState fetches the TransitGatewayConnect and its State
+func TransitGatewayConnectState(conn *ec2.EC2, transitGatewayAttachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayConnect, err := finder.TransitGatewayConnectByID(conn, transitGatewayAttachmentID)
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayAttachmentIDNotFound) {
+			return nil, transitGatewayConnectStateNotFound, nil
+		}
+		if err != nil {
+			return nil, transitGatewayConnectStateUnknown, err
+		}
+
+		if transitGatewayConnect == nil {
+			return nil, transitGatewayConnectStateNotFound, nil
+		}
+
+		state := aws.StringValue(transitGatewayConnect.State)
+
+		if state == ec2.TransitGatewayAttachmentStateDeleted {
+			return nil, transitGatewayConnectStateNotFound, nil
+		}
+
+		return transitGatewayConnect, state, nil
+	}
+}
+
+const (
+	transitGatewayConnectPeerStateNotFound = "NotFound"
+	transitGatewayConnectPeerStateUnknown  = "Unknown"
+)
+
+// TransitGatewayConnectPeerState fetches the TransitGatewayConnectPeer and its State
+func TransitGatewayConnectPeerState(conn *ec2.EC2, transitGatewayConnectPeerID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayConnectPeer, err := finder.TransitGatewayConnectPeerByID(conn, transitGatewayConnectPeerID)
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayConnectPeerIDNotFound) {
+			return nil, transitGatewayConnectPeerStateNotFound, nil
+		}
+		if err != nil {
+			return nil, transitGatewayConnectPeerStateUnknown, err
+		}
+
+		if transitGatewayConnectPeer == nil {
+			return nil, transitGatewayConnectPeerStateNotFound, nil
+		}
+
+		state := aws.StringValue(transitGatewayConnectPeer.State)
+
+		if state == ec2.TransitGatewayConnectPeerStateDeleted {
+			return nil, transitGatewayConnectPeerStateNotFound, nil
+		}
+
+		return transitGatewayConnectPeer, state, nil
+	}
+}
+
+const (
+	transitGatewayMulticastDomainStateNotFound = "NotFound"
+	transitGatewayMulticastDomainStateUnknown  = "Unknown"
+)
+
+// TransitGatewayMulticastDomainState fetches the TransitGatewayMulticastDomain and its State
+func TransitGatewayMulticastDomainState(conn *ec2.EC2, multicastDomainID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		multicastDomain, err := finder.TransitGatewayMulticastDomainByID(conn, multicastDomainID)
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+			return nil, transitGatewayMulticastDomainStateNotFound, nil
+		}
+		if err != nil {
+			return nil, transitGatewayMulticastDomainStateUnknown, err
+		}
+
+		if multicastDomain == nil {
+			return nil, transitGatewayMulticastDomainStateNotFound, nil
+		}
+
+		state := aws.StringValue(multicastDomain.State)
+
+		if state == ec2.TransitGatewayMulticastDomainStateDeleted {
+			return nil, transitGatewayMulticastDomainStateNotFound, nil
+		}
+
+		return multicastDomain, state, nil
+	}
+}
+
+const (
+	transitGatewayMulticastDomainAssociationStateNotFound = "NotFound"
+	transitGatewayMulticastDomainAssociationStateUnknown  = "Unknown"
+)
+
+// TransitGatewayMulticastDomainAssociationState fetches the TransitGatewayMulticastDomainAssociation and its subnet's State
+func TransitGatewayMulticastDomainAssociationState(conn *ec2.EC2, multicastDomainID, attachmentID, subnetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		association, err := finder.TransitGatewayMulticastDomainAssociation(conn, multicastDomainID, attachmentID, subnetID)
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+			return nil, transitGatewayMulticastDomainAssociationStateNotFound, nil
+		}
+		if err != nil {
+			return nil, transitGatewayMulticastDomainAssociationStateUnknown, err
+		}
+
+		if association == nil || association.Subnet == nil {
+			return nil, transitGatewayMulticastDomainAssociationStateNotFound, nil
+		}
+
+		state := aws.StringValue(association.Subnet.State)
+
+		if state == ec2.TransitGatewayMulitcastDomainAssociationStateDisassociated {
+			return nil, transitGatewayMulticastDomainAssociationStateNotFound, nil
+		}
+
+		return association, state, nil
+	}
+}
+
+const (
+	ebsFastSnapshotRestoreStateNotFound = "NotFound"
+	ebsFastSnapshotRestoreStateUnknown  = "Unknown"
+)
+
+// EbsFastSnapshotRestoreState fetches the fast snapshot restore and its State
+func EbsFastSnapshotRestoreState(conn *ec2.EC2, availabilityZone, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fastSnapshotRestore, err := finder.EbsFastSnapshotRestore(conn, availabilityZone, snapshotID)
+		if err != nil {
+			return nil, ebsFastSnapshotRestoreStateUnknown, err
+		}
+
+		if fastSnapshotRestore == nil {
+			return nil, ebsFastSnapshotRestoreStateNotFound, nil
+		}
+
+		state := aws.StringValue(fastSnapshotRestore.State)
+
+		if state == ec2.FastSnapshotRestoreStateCodeDisabled {
+			return nil, ebsFastSnapshotRestoreStateNotFound, nil
+		}
+
+		return fastSnapshotRestore, state, nil
+	}
+}
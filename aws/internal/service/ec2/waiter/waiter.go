@@ -347,3 +347,224 @@ func ManagedPrefixListDeleted(conn *ec2.EC2, prefixListId string) error {
 
 	return nil
 }
+
+const (
+	TransitGatewayConnectCreatedTimeout = 10 * time.Minute
+
+	TransitGatewayConnectDeletedTimeout = 10 * time.Minute
+)
+
+func TransitGatewayConnectCreated(conn *ec2.EC2, transitGatewayAttachmentID string) (*ec2.TransitGatewayConnect, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAttachmentStatePending},
+		Target:  []string{ec2.TransitGatewayAttachmentStateAvailable},
+		Refresh: TransitGatewayConnectState(conn, transitGatewayAttachmentID),
+		Timeout: TransitGatewayConnectCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func TransitGatewayConnectDeleted(conn *ec2.EC2, transitGatewayAttachmentID string) (*ec2.TransitGatewayConnect, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayAttachmentStateAvailable,
+			ec2.TransitGatewayAttachmentStateDeleting,
+		},
+		Target:  []string{},
+		Refresh: TransitGatewayConnectState(conn, transitGatewayAttachmentID),
+		Timeout: TransitGatewayConnectDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnect); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	TransitGatewayConnectPeerCreatedTimeout = 10 * time.Minute
+
+	TransitGatewayConnectPeerDeletedTimeout = 10 * time.Minute
+)
+
+func TransitGatewayConnectPeerCreated(conn *ec2.EC2, transitGatewayConnectPeerID string) (*ec2.TransitGatewayConnectPeer, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayConnectPeerStatePending},
+		Target:  []string{ec2.TransitGatewayConnectPeerStateAvailable},
+		Refresh: TransitGatewayConnectPeerState(conn, transitGatewayConnectPeerID),
+		Timeout: TransitGatewayConnectPeerCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func TransitGatewayConnectPeerDeleted(conn *ec2.EC2, transitGatewayConnectPeerID string) (*ec2.TransitGatewayConnectPeer, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayConnectPeerStateAvailable,
+			ec2.TransitGatewayConnectPeerStateDeleting,
+		},
+		Target:  []string{},
+		Refresh: TransitGatewayConnectPeerState(conn, transitGatewayConnectPeerID),
+		Timeout: TransitGatewayConnectPeerDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayConnectPeer); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	TransitGatewayMulticastDomainCreatedTimeout = 10 * time.Minute
+
+	TransitGatewayMulticastDomainDeletedTimeout = 10 * time.Minute
+)
+
+func TransitGatewayMulticastDomainCreated(conn *ec2.EC2, multicastDomainID string) (*ec2.TransitGatewayMulticastDomain, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayMulticastDomainStatePending},
+		Target:  []string{ec2.TransitGatewayMulticastDomainStateAvailable},
+		Refresh: TransitGatewayMulticastDomainState(conn, multicastDomainID),
+		Timeout: TransitGatewayMulticastDomainCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomain); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func TransitGatewayMulticastDomainDeleted(conn *ec2.EC2, multicastDomainID string) (*ec2.TransitGatewayMulticastDomain, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayMulticastDomainStateAvailable,
+			ec2.TransitGatewayMulticastDomainStateDeleting,
+		},
+		Target:  []string{},
+		Refresh: TransitGatewayMulticastDomainState(conn, multicastDomainID),
+		Timeout: TransitGatewayMulticastDomainDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomain); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	TransitGatewayMulticastDomainAssociationCreatedTimeout = 10 * time.Minute
+
+	TransitGatewayMulticastDomainAssociationDeletedTimeout = 10 * time.Minute
+)
+
+func TransitGatewayMulticastDomainAssociationCreated(conn *ec2.EC2, multicastDomainID, attachmentID, subnetID string) (*ec2.TransitGatewayMulticastDomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayMulitcastDomainAssociationStateAssociating},
+		Target:  []string{ec2.TransitGatewayMulitcastDomainAssociationStateAssociated},
+		Refresh: TransitGatewayMulticastDomainAssociationState(conn, multicastDomainID, attachmentID, subnetID),
+		Timeout: TransitGatewayMulticastDomainAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomainAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func TransitGatewayMulticastDomainAssociationDeleted(conn *ec2.EC2, multicastDomainID, attachmentID, subnetID string) (*ec2.TransitGatewayMulticastDomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayMulitcastDomainAssociationStateAssociated,
+			ec2.TransitGatewayMulitcastDomainAssociationStateDisassociating,
+		},
+		Target:  []string{},
+		Refresh: TransitGatewayMulticastDomainAssociationState(conn, multicastDomainID, attachmentID, subnetID),
+		Timeout: TransitGatewayMulticastDomainAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayMulticastDomainAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	EbsFastSnapshotRestoreEnabledTimeout  = 60 * time.Minute
+	EbsFastSnapshotRestoreDisabledTimeout = 60 * time.Minute
+)
+
+func EbsFastSnapshotRestoreEnabled(conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.FastSnapshotRestoreStateCodeEnabling,
+			ec2.FastSnapshotRestoreStateCodeOptimizing,
+		},
+		Target:  []string{ec2.FastSnapshotRestoreStateCodeEnabled},
+		Refresh: EbsFastSnapshotRestoreState(conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func EbsFastSnapshotRestoreDisabled(conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.FastSnapshotRestoreStateCodeDisabling,
+			ec2.FastSnapshotRestoreStateCodeEnabled,
+			ec2.FastSnapshotRestoreStateCodeEnabling,
+			ec2.FastSnapshotRestoreStateCodeOptimizing,
+		},
+		Target:  []string{},
+		Refresh: EbsFastSnapshotRestoreState(conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -34,3 +34,24 @@ func CapacityProviderStatus(conn *ecs.ECS, capacityProvider string) resource.Sta
 		return output.CapacityProviders[0], aws.StringValue(output.CapacityProviders[0].Status), nil
 	}
 }
+
+// CapacityProviderUpdateStatus fetches the Capacity Provider and its UpdateStatus
+func CapacityProviderUpdateStatus(conn *ecs.ECS, capacityProvider string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ecs.DescribeCapacityProvidersInput{
+			CapacityProviders: aws.StringSlice([]string{capacityProvider}),
+		}
+
+		output, err := conn.DescribeCapacityProviders(input)
+
+		if err != nil {
+			return nil, CapacityProviderStatusUnknown, err
+		}
+
+		if len(output.CapacityProviders) == 0 {
+			return nil, CapacityProviderStatusNotFound, nil
+		}
+
+		return output.CapacityProviders[0], aws.StringValue(output.CapacityProviders[0].UpdateStatus), nil
+	}
+}
@@ -1,8 +1,10 @@
 package waiter
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -10,6 +12,9 @@ import (
 const (
 	// Maximum amount of time to wait for a Capacity Provider to return INACTIVE
 	CapacityProviderInactiveTimeout = 20 * time.Minute
+
+	// Maximum amount of time to wait for a Capacity Provider update to complete
+	CapacityProviderUpdateTimeout = 10 * time.Minute
 )
 
 // CapacityProviderInactive waits for a Capacity Provider to return INACTIVE
@@ -29,3 +34,25 @@ func CapacityProviderInactive(conn *ecs.ECS, capacityProvider string) (*ecs.Capa
 
 	return nil, err
 }
+
+// CapacityProviderUpdated waits for a Capacity Provider to return UPDATE_COMPLETE
+func CapacityProviderUpdated(conn *ecs.ECS, capacityProvider string) (*ecs.CapacityProvider, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ecs.CapacityProviderUpdateStatusUpdateInProgress},
+		Target:  []string{ecs.CapacityProviderUpdateStatusUpdateComplete},
+		Refresh: CapacityProviderUpdateStatus(conn, capacityProvider),
+		Timeout: CapacityProviderUpdateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.CapacityProvider); ok {
+		if status := aws.StringValue(v.UpdateStatus); status == ecs.CapacityProviderUpdateStatusUpdateFailed {
+			err = errors.New(aws.StringValue(v.UpdateStatusReason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,56 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emr"
+)
+
+// StudioByID returns the EMR Studio corresponding to the specified identifier.
+// Returns nil if no studio is found.
+func StudioByID(conn *emr.EMR, id string) (*emr.Studio, error) {
+	input := &emr.DescribeStudioInput{
+		StudioId: aws.String(id),
+	}
+
+	output, err := conn.DescribeStudio(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Studio, nil
+}
+
+// StudioSessionMapping returns the EMR Studio session mapping corresponding to the specified
+// studio identifier, identity type and identity identifier or name.
+// Returns nil if no session mapping is found.
+func StudioSessionMapping(conn *emr.EMR, studioID, identityType, identityID, identityName string) (*emr.SessionMappingDetail, error) {
+	input := &emr.GetStudioSessionMappingInput{
+		IdentityType: aws.String(identityType),
+		StudioId:     aws.String(studioID),
+	}
+
+	if identityID != "" {
+		input.IdentityId = aws.String(identityID)
+	}
+
+	if identityName != "" {
+		input.IdentityName = aws.String(identityName)
+	}
+
+	output, err := conn.GetStudioSessionMapping(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.SessionMapping, nil
+}
@@ -0,0 +1,25 @@
+package emr
+
+import (
+	"fmt"
+	"strings"
+)
+
+const studioSessionMappingIDSeparator = ":"
+
+func StudioSessionMappingCreateID(studioID, identityType, identityID string) string {
+	parts := []string{studioID, identityType, identityID}
+	id := strings.Join(parts, studioSessionMappingIDSeparator)
+	return id
+}
+
+func StudioSessionMappingParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, studioSessionMappingIDSeparator)
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected studio-id"+studioSessionMappingIDSeparator+
+			"identity-type"+studioSessionMappingIDSeparator+"identity-id", id)
+}
@@ -0,0 +1,129 @@
+package equivalency
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+)
+
+// managedServiceDataUnorderedLists maps JSON keys whose array values the API
+// may return re-ordered to the object key used to sort their elements.
+var managedServiceDataUnorderedLists = map[string]string{
+	"excludeRules":   "name",
+	"securityGroups": "id",
+}
+
+// managedServiceDataDefaults maps JSON keys to default values the API adds
+// to the response when not configured during the request.
+var managedServiceDataDefaults = map[string]interface{}{
+	"overrideCustomerWebACLAssociation": false,
+}
+
+type managedServiceData map[string]interface{}
+
+func (msd managedServiceData) Reduce() error {
+	reduceManagedServiceDataObject(msd)
+
+	return nil
+}
+
+func reduceManagedServiceDataObject(m map[string]interface{}) {
+	for k, v := range m {
+		// Prevent difference of API response that adds null values when not configured during the request
+		if v == nil {
+			delete(m, k)
+			continue
+		}
+
+		// Prevent difference of API response that adds default values when not configured during the request
+		if d, ok := managedServiceDataDefaults[k]; ok && v == d {
+			delete(m, k)
+			continue
+		}
+
+		switch v := v.(type) {
+		case map[string]interface{}:
+			reduceManagedServiceDataObject(v)
+		case []interface{}:
+			// Prevent difference of API response that adds an empty array when not configured during the request
+			if len(v) == 0 {
+				delete(m, k)
+				continue
+			}
+
+			for _, e := range v {
+				if e, ok := e.(map[string]interface{}); ok {
+					reduceManagedServiceDataObject(e)
+				}
+			}
+
+			// Deal with objects which may be re-ordered in the API
+			if sortKey, ok := managedServiceDataUnorderedLists[k]; ok {
+				sort.SliceStable(v, func(i, j int) bool {
+					return managedServiceDataSortValue(v[i], sortKey) < managedServiceDataSortValue(v[j], sortKey)
+				})
+			}
+		}
+	}
+}
+
+func managedServiceDataSortValue(v interface{}, key string) string {
+	if m, ok := v.(map[string]interface{}); ok {
+		return fmt.Sprintf("%v", m[key])
+	}
+
+	return fmt.Sprintf("%v", v)
+}
+
+// NormalizeFmsManagedServiceDataJSON returns the canonical form of a Firewall Manager
+// security service policy ManagedServiceData JSON string
+func NormalizeFmsManagedServiceDataJSON(str string) (string, error) {
+	if str == "" {
+		return "", nil
+	}
+
+	var msd managedServiceData
+
+	if err := json.Unmarshal([]byte(str), &msd); err != nil {
+		return "", err
+	}
+
+	if err := msd.Reduce(); err != nil {
+		return "", err
+	}
+
+	// Object keys are sorted during marshalling.
+	canonicalJson, err := json.Marshal(msd)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(canonicalJson), nil
+}
+
+// EquivalentFmsManagedServiceDataJSON determines equality between two Firewall Manager
+// security service policy ManagedServiceData JSON strings
+func EquivalentFmsManagedServiceDataJSON(str1, str2 string) (bool, error) {
+	canonicalJson1, err := NormalizeFmsManagedServiceDataJSON(str1)
+
+	if err != nil {
+		return false, err
+	}
+
+	canonicalJson2, err := NormalizeFmsManagedServiceDataJSON(str2)
+
+	if err != nil {
+		return false, err
+	}
+
+	equal := bytes.Equal([]byte(canonicalJson1), []byte(canonicalJson2))
+
+	if !equal {
+		log.Printf("[DEBUG] Canonical FMS Managed Service Data JSON are not equal.\nFirst: %s\nSecond: %s\n", canonicalJson1, canonicalJson2)
+	}
+
+	return equal, nil
+}
@@ -0,0 +1,156 @@
+package equivalency
+
+import (
+	"testing"
+)
+
+func TestEquivalentFmsManagedServiceDataJSON(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		ApiJson           string
+		ConfigurationJson string
+		ExpectEquivalent  bool
+		ExpectError       bool
+	}{
+		{
+			Name:              "empty",
+			ApiJson:           ``,
+			ConfigurationJson: ``,
+			ExpectEquivalent:  true,
+		},
+		{
+			Name:              "invalid JSON",
+			ApiJson:           `{"type": "WAFV2"}`,
+			ConfigurationJson: `{"type": "WAFV2"`,
+			ExpectEquivalent:  false,
+			ExpectError:       true,
+		},
+		{
+			Name: "reordered keys",
+			ApiJson: `
+{
+	"type": "WAF",
+	"defaultAction": {"type": "BLOCK"},
+	"ruleGroups": [{"id": "12345678-1234-1234-1234-123456789012", "overrideAction": {"type": "COUNT"}}]
+}
+`,
+			ConfigurationJson: `
+{
+	"ruleGroups": [{"overrideAction": {"type": "COUNT"}, "id": "12345678-1234-1234-1234-123456789012"}],
+	"defaultAction": {"type": "BLOCK"},
+	"type": "WAF"
+}
+`,
+			ExpectEquivalent: true,
+		},
+		{
+			Name: "API added nulls, empty arrays and defaults",
+			ApiJson: `
+{
+	"type": "WAFV2",
+	"preProcessRuleGroups": [
+		{
+			"ruleGroupArn": null,
+			"overrideAction": {"type": "NONE"},
+			"managedRuleGroupIdentifier": {"version": null, "vendorName": "AWS", "managedRuleGroupName": "AWSManagedRulesAmazonIpReputationList"},
+			"ruleGroupType": "ManagedRuleGroup",
+			"excludeRules": []
+		}
+	],
+	"postProcessRuleGroups": [],
+	"defaultAction": {"type": "ALLOW"},
+	"overrideCustomerWebACLAssociation": false,
+	"loggingConfiguration": null
+}
+`,
+			ConfigurationJson: `
+{
+	"type": "WAFV2",
+	"preProcessRuleGroups": [
+		{
+			"managedRuleGroupIdentifier": {"vendorName": "AWS", "managedRuleGroupName": "AWSManagedRulesAmazonIpReputationList"},
+			"overrideAction": {"type": "NONE"},
+			"ruleGroupType": "ManagedRuleGroup"
+		}
+	],
+	"defaultAction": {"type": "ALLOW"}
+}
+`,
+			ExpectEquivalent: true,
+		},
+		{
+			Name: "reordered security groups",
+			ApiJson: `
+{
+	"type": "SECURITY_GROUPS_COMMON",
+	"revertManualSecurityGroupChanges": false,
+	"exclusiveResourceSecurityGroupManagement": false,
+	"securityGroups": [{"id": "sg-22222222"}, {"id": "sg-11111111"}]
+}
+`,
+			ConfigurationJson: `
+{
+	"type": "SECURITY_GROUPS_COMMON",
+	"revertManualSecurityGroupChanges": false,
+	"exclusiveResourceSecurityGroupManagement": false,
+	"securityGroups": [{"id": "sg-11111111"}, {"id": "sg-22222222"}]
+}
+`,
+			ExpectEquivalent: true,
+		},
+		{
+			Name: "changed value",
+			ApiJson: `
+{
+	"type": "WAFV2",
+	"defaultAction": {"type": "ALLOW"},
+	"overrideCustomerWebACLAssociation": false
+}
+`,
+			ConfigurationJson: `
+{
+	"type": "WAFV2",
+	"defaultAction": {"type": "ALLOW"},
+	"overrideCustomerWebACLAssociation": true
+}
+`,
+			ExpectEquivalent: false,
+		},
+		{
+			Name: "reordered rule groups",
+			ApiJson: `
+{
+	"type": "WAF",
+	"defaultAction": {"type": "BLOCK"},
+	"ruleGroups": [{"id": "22222222-1234-1234-1234-123456789012"}, {"id": "11111111-1234-1234-1234-123456789012"}]
+}
+`,
+			ConfigurationJson: `
+{
+	"type": "WAF",
+	"defaultAction": {"type": "BLOCK"},
+	"ruleGroups": [{"id": "11111111-1234-1234-1234-123456789012"}, {"id": "22222222-1234-1234-1234-123456789012"}]
+}
+`,
+			ExpectEquivalent: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := EquivalentFmsManagedServiceDataJSON(testCase.ConfigurationJson, testCase.ApiJson)
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, but received none")
+			}
+
+			if got != testCase.ExpectEquivalent {
+				t.Errorf("got %t, expected %t", got, testCase.ExpectEquivalent)
+			}
+		})
+	}
+}
@@ -18,3 +18,45 @@ func EndpointGroupByARN(conn *globalaccelerator.GlobalAccelerator, arn string) (
 
 	return output.EndpointGroup, nil
 }
+
+// CustomRoutingAcceleratorByARN returns the custom routing accelerator corresponding to the specified ARN.
+func CustomRoutingAcceleratorByARN(conn *globalaccelerator.GlobalAccelerator, arn string) (*globalaccelerator.CustomRoutingAccelerator, error) {
+	input := &globalaccelerator.DescribeCustomRoutingAcceleratorInput{
+		AcceleratorArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeCustomRoutingAccelerator(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Accelerator, nil
+}
+
+// CustomRoutingListenerByARN returns the custom routing listener corresponding to the specified ARN.
+func CustomRoutingListenerByARN(conn *globalaccelerator.GlobalAccelerator, arn string) (*globalaccelerator.CustomRoutingListener, error) {
+	input := &globalaccelerator.DescribeCustomRoutingListenerInput{
+		ListenerArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeCustomRoutingListener(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Listener, nil
+}
+
+// CustomRoutingEndpointGroupByARN returns the custom routing endpoint group corresponding to the specified ARN.
+func CustomRoutingEndpointGroupByARN(conn *globalaccelerator.GlobalAccelerator, arn string) (*globalaccelerator.CustomRoutingEndpointGroup, error) {
+	input := &globalaccelerator.DescribeCustomRoutingEndpointGroupInput{
+		EndpointGroupArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeCustomRoutingEndpointGroup(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.EndpointGroup, nil
+}
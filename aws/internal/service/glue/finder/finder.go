@@ -3,6 +3,8 @@ package finder
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tfglue "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue"
 )
 
@@ -50,3 +52,49 @@ func SchemaVersionByID(conn *glue.Glue, id string) (*glue.GetSchemaVersionOutput
 
 	return output, nil
 }
+
+// PartitionIndexByName returns the partition index corresponding to the specified table and index name.
+func PartitionIndexByName(conn *glue.Glue, catalogID, dbName, tableName, indexName string) (*glue.PartitionIndexDescriptor, error) {
+	input := &glue.GetPartitionIndexesInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+	}
+
+	var result *glue.PartitionIndexDescriptor
+
+	err := conn.GetPartitionIndexesPages(input, func(page *glue.GetPartitionIndexesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, partitionIndex := range page.PartitionIndexDescriptorList {
+			if aws.StringValue(partitionIndex.IndexName) == indexName {
+				result = partitionIndex
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
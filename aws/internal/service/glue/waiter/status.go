@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 const (
@@ -18,6 +19,23 @@ const (
 	TriggerStatusUnknown       = "Unknown"
 )
 
+// PartitionIndexStatus fetches the partition index and its Status
+func PartitionIndexStatus(conn *glue.Glue, catalogID, dbName, tableName, indexName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.PartitionIndexByName(conn, catalogID, dbName, tableName, indexName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.IndexStatus), nil
+	}
+}
+
 // MLTransformStatus fetches the MLTransform and its Status
 func MLTransformStatus(conn *glue.Glue, transformId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -10,6 +10,8 @@ import (
 const (
 	// Maximum amount of time to wait for an Operation to return Deleted
 	MLTransformDeleteTimeout      = 2 * time.Minute
+	PartitionIndexCreateTimeout   = 10 * time.Minute
+	PartitionIndexDeleteTimeout   = 10 * time.Minute
 	RegistryDeleteTimeout         = 2 * time.Minute
 	SchemaAvailableTimeout        = 2 * time.Minute
 	SchemaDeleteTimeout           = 2 * time.Minute
@@ -36,6 +38,42 @@ func MLTransformDeleted(conn *glue.Glue, transformId string) (*glue.GetMLTransfo
 	return nil, err
 }
 
+// PartitionIndexCreated waits for a partition index to return Active
+func PartitionIndexCreated(conn *glue.Glue, catalogID, dbName, tableName, indexName string) (*glue.PartitionIndexDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{glue.PartitionIndexStatusCreating},
+		Target:  []string{glue.PartitionIndexStatusActive},
+		Refresh: PartitionIndexStatus(conn, catalogID, dbName, tableName, indexName),
+		Timeout: PartitionIndexCreateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*glue.PartitionIndexDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// PartitionIndexDeleted waits for a partition index to be deleted
+func PartitionIndexDeleted(conn *glue.Glue, catalogID, dbName, tableName, indexName string) (*glue.PartitionIndexDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{glue.PartitionIndexStatusDeleting},
+		Target:  []string{},
+		Refresh: PartitionIndexStatus(conn, catalogID, dbName, tableName, indexName),
+		Timeout: PartitionIndexDeleteTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*glue.PartitionIndexDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 // RegistryDeleted waits for a Registry to return Deleted
 func RegistryDeleted(conn *glue.Glue, registryID string) (*glue.GetRegistryOutput, error) {
 	stateConf := &resource.StateChangeConf{
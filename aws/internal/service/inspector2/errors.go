@@ -0,0 +1,24 @@
+package inspector2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// FailedAccountsError returns an error combining the per-account failures reported by the API, or nil if there are none.
+func FailedAccountsError(apiObjects []*inspector2.FailedAccount) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("account (%s): %s: %s", aws.StringValue(apiObject.AccountId), aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)))
+	}
+
+	return errs.ErrorOrNil()
+}
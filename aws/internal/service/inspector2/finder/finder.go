@@ -0,0 +1,108 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfinspector2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2"
+)
+
+// AccountStatesByIDs returns the Inspector V2 status of each of the specified accounts.
+// Any per-account failures reported by the API are returned as a combined error.
+func AccountStatesByIDs(conn *inspector2.Inspector2, accountIDs []string) ([]*inspector2.AccountState, error) {
+	input := &inspector2.BatchGetAccountStatusInput{
+		AccountIds: aws.StringSlice(accountIDs),
+	}
+
+	output, err := conn.BatchGetAccountStatus(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	if err := tfinspector2.FailedAccountsError(output.FailedAccounts); err != nil {
+		return nil, err
+	}
+
+	if len(output.Accounts) == 0 {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Accounts, nil
+}
+
+// DelegatedAdminAccountByID returns the Inspector V2 delegated administrator account corresponding to the specified ID.
+func DelegatedAdminAccountByID(conn *inspector2.Inspector2, id string) (*inspector2.DelegatedAdminAccount, error) {
+	input := &inspector2.ListDelegatedAdminAccountsInput{}
+	var result *inspector2.DelegatedAdminAccount
+
+	err := conn.ListDelegatedAdminAccountsPages(input, func(page *inspector2.ListDelegatedAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, account := range page.DelegatedAdminAccounts {
+			if account == nil {
+				continue
+			}
+
+			if aws.StringValue(account.AccountId) == id {
+				result = account
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// OrganizationConfiguration returns the Inspector V2 organization configuration.
+func OrganizationConfiguration(conn *inspector2.Inspector2) (*inspector2.DescribeOrganizationConfigurationOutput, error) {
+	input := &inspector2.DescribeOrganizationConfigurationInput{}
+
+	output, err := conn.DescribeOrganizationConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutoEnable == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,35 @@
+package inspector2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	enablerIDSeparator      = ":"
+	enablerIDValueSeparator = ","
+)
+
+func EnablerCreateID(accountIDs, resourceTypes []string) string {
+	accountIDs = append([]string(nil), accountIDs...)
+	resourceTypes = append([]string(nil), resourceTypes...)
+
+	sort.Strings(accountIDs)
+	sort.Strings(resourceTypes)
+
+	parts := []string{strings.Join(accountIDs, enablerIDValueSeparator), strings.Join(resourceTypes, enablerIDValueSeparator)}
+	id := strings.Join(parts, enablerIDSeparator)
+
+	return id
+}
+
+func EnablerParseID(id string) ([]string, []string, error) {
+	parts := strings.Split(id, enablerIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return strings.Split(parts[0], enablerIDValueSeparator), strings.Split(parts[1], enablerIDValueSeparator), nil
+	}
+
+	return nil, nil, fmt.Errorf("unexpected format for ID (%[1]s), expected ACCOUNT-ID[%[3]sACCOUNT-ID]...%[2]sRESOURCE-TYPE[%[3]sRESOURCE-TYPE]...", id, enablerIDSeparator, enablerIDValueSeparator)
+}
@@ -0,0 +1,25 @@
+package inspector2
+
+import (
+	"github.com/aws/aws-sdk-go/service/inspector2"
+)
+
+// ResourceTypeState returns the scan state for the specified resource type, or nil if the API did not report one.
+func ResourceTypeState(apiObject *inspector2.ResourceState, resourceType string) *inspector2.State {
+	if apiObject == nil {
+		return nil
+	}
+
+	switch resourceType {
+	case inspector2.ResourceScanTypeEc2:
+		return apiObject.Ec2
+	case inspector2.ResourceScanTypeEcr:
+		return apiObject.Ecr
+	case inspector2.ResourceScanTypeLambda:
+		return apiObject.Lambda
+	case inspector2.ResourceScanTypeLambdaCode:
+		return apiObject.LambdaCode
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfinspector2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	// Constants not currently provided by the AWS Go SDK
+	resourceTypesStatusMixed = "MIXED"
+)
+
+// ResourceTypesStatus fetches the Inspector V2 status of the specified accounts and
+// returns the status shared by all of the specified resource types in those accounts.
+// While any account is transitioning its transitional status is returned, otherwise
+// differing statuses are reported as MIXED.
+func ResourceTypesStatus(conn *inspector2.Inspector2, accountIDs, resourceTypes []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.AccountStatesByIDs(conn, accountIDs)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		statuses := make(map[string]bool)
+
+		for _, account := range output {
+			if account == nil {
+				continue
+			}
+
+			for _, resourceType := range resourceTypes {
+				if state := tfinspector2.ResourceTypeState(account.ResourceState, resourceType); state != nil {
+					statuses[aws.StringValue(state.Status)] = true
+				}
+			}
+		}
+
+		if len(statuses) == 0 {
+			return nil, "", nil
+		}
+
+		if len(statuses) == 1 {
+			for status := range statuses {
+				return output, status, nil
+			}
+		}
+
+		for _, status := range []string{inspector2.StatusEnabling, inspector2.StatusDisabling, inspector2.StatusSuspending} {
+			if statuses[status] {
+				return output, status, nil
+			}
+		}
+
+		return output, resourceTypesStatusMixed, nil
+	}
+}
+
+// DelegatedAdminAccountStatus fetches the Inspector V2 delegated administrator account and its status.
+func DelegatedAdminAccountStatus(conn *inspector2.Inspector2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.DelegatedAdminAccountByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,134 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfinspector2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2"
+)
+
+const (
+	DelegatedAdminAccountEnabledTimeout  = 5 * time.Minute
+	DelegatedAdminAccountDisabledTimeout = 5 * time.Minute
+)
+
+// ResourceTypesEnabled waits for the specified resource types to report ENABLED in every specified account.
+func ResourceTypesEnabled(conn *inspector2.Inspector2, accountIDs, resourceTypes []string, timeout time.Duration) ([]*inspector2.AccountState, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.StatusEnabling},
+		Target:  []string{inspector2.StatusEnabled},
+		Refresh: ResourceTypesStatus(conn, accountIDs, resourceTypes),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.([]*inspector2.AccountState); ok {
+		setLastError(err, accountStatesError(output, resourceTypes, inspector2.StatusEnabled))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ResourceTypesDisabled waits for the specified resource types to report DISABLED in every specified account.
+func ResourceTypesDisabled(conn *inspector2.Inspector2, accountIDs, resourceTypes []string, timeout time.Duration) ([]*inspector2.AccountState, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.StatusDisabling},
+		Target:  []string{inspector2.StatusDisabled},
+		Refresh: ResourceTypesStatus(conn, accountIDs, resourceTypes),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.([]*inspector2.AccountState); ok {
+		setLastError(err, accountStatesError(output, resourceTypes, inspector2.StatusDisabled))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DelegatedAdminAccountEnabled waits for a delegated administrator account to report ENABLED.
+func DelegatedAdminAccountEnabled(conn *inspector2.Inspector2, id string) (*inspector2.DelegatedAdminAccount, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{inspector2.DelegatedAdminStatusEnabled},
+		Refresh: DelegatedAdminAccountStatus(conn, id),
+		Timeout: DelegatedAdminAccountEnabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*inspector2.DelegatedAdminAccount); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DelegatedAdminAccountDisabled waits for a delegated administrator account to be removed.
+func DelegatedAdminAccountDisabled(conn *inspector2.Inspector2, id string) (*inspector2.DelegatedAdminAccount, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.DelegatedAdminStatusEnabled, inspector2.DelegatedAdminStatusDisableInProgress},
+		Target:  []string{},
+		Refresh: DelegatedAdminAccountStatus(conn, id),
+		Timeout: DelegatedAdminAccountDisabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*inspector2.DelegatedAdminAccount); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// accountStatesError returns an error combining the failure messages of every
+// resource type that has not reached the target status.
+func accountStatesError(apiObjects []*inspector2.AccountState, resourceTypes []string, target string) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, resourceType := range resourceTypes {
+			state := tfinspector2.ResourceTypeState(apiObject.ResourceState, resourceType)
+
+			if state == nil || aws.StringValue(state.Status) == target || aws.StringValue(state.ErrorMessage) == "" {
+				continue
+			}
+
+			errs = multierror.Append(errs, fmt.Errorf("account (%s) %s: %s: %s", aws.StringValue(apiObject.AccountId), resourceType, aws.StringValue(state.ErrorCode), aws.StringValue(state.ErrorMessage)))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func setLastError(err, lastErr error) {
+	if err == nil || lastErr == nil {
+		return
+	}
+
+	switch e := err.(type) {
+	case *resource.TimeoutError:
+		if e.LastError == nil {
+			e.LastError = lastErr
+		}
+	case *resource.UnexpectedStateError:
+		if e.LastError == nil {
+			e.LastError = lastErr
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package iot
+
+import (
+	"fmt"
+	"strings"
+)
+
+const thingGroupMembershipResourceIDSeparator = "/"
+
+func ThingGroupMembershipCreateResourceID(thingGroupName, thingName string) string {
+	parts := []string{thingGroupName, thingName}
+	id := strings.Join(parts, thingGroupMembershipResourceIDSeparator)
+
+	return id
+}
+
+func ThingGroupMembershipParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, thingGroupMembershipResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected thing-group-name%[2]sthing-name", id, thingGroupMembershipResourceIDSeparator)
+}
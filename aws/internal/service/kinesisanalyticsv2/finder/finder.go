@@ -18,3 +18,18 @@ func ApplicationByName(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string)
 
 	return output.ApplicationDetail, nil
 }
+
+// SnapshotDetailsByApplicationAndSnapshotNames returns the application snapshot details corresponding to the specified application and snapshot names.
+func SnapshotDetailsByApplicationAndSnapshotNames(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName, snapshotName string) (*kinesisanalyticsv2.SnapshotDetails, error) {
+	input := &kinesisanalyticsv2.DescribeApplicationSnapshotInput{
+		ApplicationName: aws.String(applicationName),
+		SnapshotName:    aws.String(snapshotName),
+	}
+
+	output, err := conn.DescribeApplicationSnapshot(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.SnapshotDetails, nil
+}
@@ -11,6 +11,9 @@ import (
 const (
 	applicationStatusNotFound = "NotFound"
 	applicationStatusUnknown  = "Unknown"
+
+	snapshotStatusNotFound = "NotFound"
+	snapshotStatusUnknown  = "Unknown"
 )
 
 // ApplicationStatus fetches the Application and its Status
@@ -29,3 +32,20 @@ func ApplicationStatus(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string)
 		return application, aws.StringValue(application.ApplicationStatus), nil
 	}
 }
+
+// SnapshotDetailsStatus fetches the application snapshot's details and its status
+func SnapshotDetailsStatus(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName, snapshotName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshotDetails, err := finder.SnapshotDetailsByApplicationAndSnapshotNames(conn, applicationName, snapshotName)
+
+		if tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+			return nil, snapshotStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, snapshotStatusUnknown, err
+		}
+
+		return snapshotDetails, aws.StringValue(snapshotDetails.SnapshotStatus), nil
+	}
+}
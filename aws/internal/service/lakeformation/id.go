@@ -0,0 +1,26 @@
+package lakeformation
+
+import (
+	"fmt"
+	"strings"
+)
+
+const lfTagResourceIDSeparator = ":"
+
+func LFTagCreateResourceID(catalogID, tagKey string) string {
+	parts := []string{catalogID, tagKey}
+	id := strings.Join(parts, lfTagResourceIDSeparator)
+
+	return id
+}
+
+func LFTagParseResourceID(id string) (string, string, error) {
+	// LF-Tag keys may themselves contain the separator, catalog IDs may not.
+	parts := strings.SplitN(id, lfTagResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CatalogID%[2]sTagKey", id, lfTagResourceIDSeparator)
+}
@@ -0,0 +1,56 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+)
+
+// ContainerServiceByName returns the container service corresponding to the specified name.
+// Returns nil if no container service is found.
+func ContainerServiceByName(conn *lightsail.Lightsail, serviceName string) (*lightsail.ContainerService, error) {
+	input := &lightsail.GetContainerServicesInput{
+		ServiceName: aws.String(serviceName),
+	}
+
+	output, err := conn.GetContainerServices(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ContainerServices) == 0 || output.ContainerServices[0] == nil {
+		return nil, nil
+	}
+
+	return output.ContainerServices[0], nil
+}
+
+// ContainerServiceDeploymentByVersion returns the container service deployment corresponding to the specified version.
+// Returns nil if no deployment is found.
+func ContainerServiceDeploymentByVersion(conn *lightsail.Lightsail, serviceName string, version int) (*lightsail.ContainerServiceDeployment, error) {
+	input := &lightsail.GetContainerServiceDeploymentsInput{
+		ServiceName: aws.String(serviceName),
+	}
+
+	output, err := conn.GetContainerServiceDeployments(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, deployment := range output.Deployments {
+		if deployment == nil {
+			continue
+		}
+
+		if int(aws.Int64Value(deployment.Version)) == version {
+			return deployment, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,52 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lightsail/finder"
+)
+
+const (
+	containerServiceStatusNotFound           = "NotFound"
+	containerServiceDeploymentStatusNotFound = "NotFound"
+)
+
+// ContainerServiceState fetches the ContainerService and its State
+func ContainerServiceState(conn *lightsail.Lightsail, serviceName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		containerService, err := finder.ContainerServiceByName(conn, serviceName)
+
+		if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+			return nil, containerServiceStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if containerService == nil {
+			return nil, containerServiceStatusNotFound, nil
+		}
+
+		return containerService, aws.StringValue(containerService.State), nil
+	}
+}
+
+// ContainerServiceDeploymentVersionState fetches the ContainerServiceDeployment and its State
+func ContainerServiceDeploymentVersionState(conn *lightsail.Lightsail, serviceName string, version int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		deployment, err := finder.ContainerServiceDeploymentByVersion(conn, serviceName, version)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if deployment == nil {
+			return nil, containerServiceDeploymentStatusNotFound, nil
+		}
+
+		return deployment, aws.StringValue(deployment.State), nil
+	}
+}
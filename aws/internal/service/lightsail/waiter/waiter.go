@@ -0,0 +1,121 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ContainerServiceCreateTimeout = 30 * time.Minute
+	ContainerServiceUpdateTimeout = 30 * time.Minute
+	ContainerServiceDeleteTimeout = 30 * time.Minute
+
+	ContainerServiceDeploymentVersionCreateTimeout = 30 * time.Minute
+
+	containerServiceDelay      = 5 * time.Second
+	containerServiceMinTimeout = 3 * time.Second
+)
+
+// ContainerServiceCreated waits for a ContainerService to return READY
+func ContainerServiceCreated(conn *lightsail.Lightsail, serviceName string, timeout time.Duration) (*lightsail.ContainerService, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.ContainerServiceStatePending},
+		Target:     []string{lightsail.ContainerServiceStateReady},
+		Refresh:    ContainerServiceState(conn, serviceName),
+		Timeout:    timeout,
+		Delay:      containerServiceDelay,
+		MinTimeout: containerServiceMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ContainerServiceDisabledModified waits for a ContainerService to finish
+// being disabled or re-enabled
+func ContainerServiceDisabledModified(conn *lightsail.Lightsail, serviceName string, timeout time.Duration) (*lightsail.ContainerService, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.ContainerServiceStateUpdating},
+		Target:     []string{lightsail.ContainerServiceStateDisabled, lightsail.ContainerServiceStateReady, lightsail.ContainerServiceStateRunning},
+		Refresh:    ContainerServiceState(conn, serviceName),
+		Timeout:    timeout,
+		Delay:      containerServiceDelay,
+		MinTimeout: containerServiceMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ContainerServiceUpdated waits for a ContainerService to return READY or RUNNING
+func ContainerServiceUpdated(conn *lightsail.Lightsail, serviceName string, timeout time.Duration) (*lightsail.ContainerService, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.ContainerServiceStateUpdating, lightsail.ContainerServiceStateDeploying},
+		Target:     []string{lightsail.ContainerServiceStateReady, lightsail.ContainerServiceStateRunning},
+		Refresh:    ContainerServiceState(conn, serviceName),
+		Timeout:    timeout,
+		Delay:      containerServiceDelay,
+		MinTimeout: containerServiceMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ContainerServiceDeleted waits for a ContainerService to be deleted
+func ContainerServiceDeleted(conn *lightsail.Lightsail, serviceName string, timeout time.Duration) (*lightsail.ContainerService, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.ContainerServiceStateDeleting},
+		Target:     []string{containerServiceStatusNotFound},
+		Refresh:    ContainerServiceState(conn, serviceName),
+		Timeout:    timeout,
+		Delay:      containerServiceDelay,
+		MinTimeout: containerServiceMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerService); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ContainerServiceDeploymentVersionActive waits for a ContainerServiceDeployment to return ACTIVE.
+// A deployment that fails to activate returns FAILED, which is surfaced as an unexpected state error.
+func ContainerServiceDeploymentVersionActive(conn *lightsail.Lightsail, serviceName string, version int, timeout time.Duration) (*lightsail.ContainerServiceDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.ContainerServiceDeploymentStateActivating},
+		Target:     []string{lightsail.ContainerServiceDeploymentStateActive},
+		Refresh:    ContainerServiceDeploymentVersionState(conn, serviceName, version),
+		Timeout:    timeout,
+		Delay:      containerServiceDelay,
+		MinTimeout: containerServiceMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lightsail.ContainerServiceDeployment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
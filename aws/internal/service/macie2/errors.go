@@ -0,0 +1,5 @@
+package macie2
+
+const (
+	ErrMessageMacieNotEnabled = "Macie is not enabled"
+)
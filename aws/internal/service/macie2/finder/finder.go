@@ -0,0 +1,54 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+)
+
+// MacieSession returns the Macie status and configuration for the current account.
+func MacieSession(conn *macie2.Macie2) (*macie2.GetMacieSessionOutput, error) {
+	input := &macie2.GetMacieSessionInput{}
+
+	output, err := conn.GetMacieSession(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// ClassificationJobByID returns the classification job corresponding to the specified ID.
+func ClassificationJobByID(conn *macie2.Macie2, id string) (*macie2.DescribeClassificationJobOutput, error) {
+	input := &macie2.DescribeClassificationJobInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeClassificationJob(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// CustomDataIdentifierByID returns the custom data identifier corresponding to the specified ID.
+// Returns nil if the custom data identifier has been deleted.
+func CustomDataIdentifierByID(conn *macie2.Macie2, id string) (*macie2.GetCustomDataIdentifierOutput, error) {
+	input := &macie2.GetCustomDataIdentifierInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetCustomDataIdentifier(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.BoolValue(output.Deleted) {
+		return nil, nil
+	}
+
+	return output, nil
+}
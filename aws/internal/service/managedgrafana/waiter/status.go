@@ -0,0 +1,35 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/managedgrafana/finder"
+)
+
+const (
+	workspaceStatusNotFound = "NotFound"
+	workspaceStatusUnknown  = "Unknown"
+)
+
+// WorkspaceStatus fetches the Workspace and its Status
+func WorkspaceStatus(conn *managedgrafana.ManagedGrafana, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.WorkspaceByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+			return nil, workspaceStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, workspaceStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, workspaceStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,69 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	WorkspaceStatusPollInterval = 30 * time.Second
+)
+
+// WorkspaceCreated waits for a Workspace to return "Active"
+func WorkspaceCreated(conn *managedgrafana.ManagedGrafana, id string, timeout time.Duration) (*managedgrafana.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{managedgrafana.WorkspaceStatusCreating},
+		Target:       []string{managedgrafana.WorkspaceStatusActive},
+		Refresh:      WorkspaceStatus(conn, id),
+		Timeout:      timeout,
+		PollInterval: WorkspaceStatusPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WorkspaceUpdated waits for a Workspace to return "Active"
+func WorkspaceUpdated(conn *managedgrafana.ManagedGrafana, id string, timeout time.Duration) (*managedgrafana.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{managedgrafana.WorkspaceStatusUpdating, managedgrafana.WorkspaceStatusVersionUpdating},
+		Target:       []string{managedgrafana.WorkspaceStatusActive},
+		Refresh:      WorkspaceStatus(conn, id),
+		Timeout:      timeout,
+		PollInterval: WorkspaceStatusPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WorkspaceDeleted waits for a Workspace to be deleted
+func WorkspaceDeleted(conn *managedgrafana.ManagedGrafana, id string, timeout time.Duration) (*managedgrafana.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{managedgrafana.WorkspaceStatusDeleting},
+		Target:       []string{},
+		Refresh:      WorkspaceStatus(conn, id),
+		Timeout:      timeout,
+		PollInterval: WorkspaceStatusPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
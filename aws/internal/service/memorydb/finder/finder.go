@@ -0,0 +1,189 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// ACLByName returns the MemoryDB ACL corresponding to the specified name.
+func ACLByName(conn *memorydb.MemoryDB, name string) (*memorydb.ACL, error) {
+	input := &memorydb.DescribeACLsInput{
+		ACLName: aws.String(name),
+	}
+
+	output, err := conn.DescribeACLs(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeACLNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ACLs) == 0 || output.ACLs[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.ACLs[0], nil
+}
+
+// ClusterByName returns the MemoryDB cluster corresponding to the specified name.
+func ClusterByName(conn *memorydb.MemoryDB, name string) (*memorydb.Cluster, error) {
+	input := &memorydb.DescribeClustersInput{
+		ClusterName:      aws.String(name),
+		ShowShardDetails: aws.Bool(true),
+	}
+
+	output, err := conn.DescribeClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Clusters) == 0 || output.Clusters[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Clusters[0], nil
+}
+
+// ParameterGroupByName returns the MemoryDB parameter group corresponding to the specified name.
+func ParameterGroupByName(conn *memorydb.MemoryDB, name string) (*memorydb.ParameterGroup, error) {
+	input := &memorydb.DescribeParameterGroupsInput{
+		ParameterGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeParameterGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ParameterGroups) == 0 || output.ParameterGroups[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.ParameterGroups[0], nil
+}
+
+// ParametersByParameterGroupName returns all of the parameters in the specified MemoryDB parameter group.
+func ParametersByParameterGroupName(conn *memorydb.MemoryDB, name string) ([]*memorydb.Parameter, error) {
+	input := &memorydb.DescribeParametersInput{
+		ParameterGroupName: aws.String(name),
+	}
+	var parameters []*memorydb.Parameter
+
+	err := conn.DescribeParametersPages(input, func(page *memorydb.DescribeParametersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, parameter := range page.Parameters {
+			if parameter != nil {
+				parameters = append(parameters, parameter)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parameters, nil
+}
+
+// SubnetGroupByName returns the MemoryDB subnet group corresponding to the specified name.
+func SubnetGroupByName(conn *memorydb.MemoryDB, name string) (*memorydb.SubnetGroup, error) {
+	input := &memorydb.DescribeSubnetGroupsInput{
+		SubnetGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeSubnetGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeSubnetGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.SubnetGroups) == 0 || output.SubnetGroups[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.SubnetGroups[0], nil
+}
+
+// UserByName returns the MemoryDB user corresponding to the specified name.
+func UserByName(conn *memorydb.MemoryDB, name string) (*memorydb.User, error) {
+	input := &memorydb.DescribeUsersInput{
+		UserName: aws.String(name),
+	}
+
+	output, err := conn.DescribeUsers(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeUserNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Users) == 0 || output.Users[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Users[0], nil
+}
@@ -0,0 +1,60 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ACLStatus fetches the MemoryDB ACL and its status.
+func ACLStatus(conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		acl, err := finder.ACLByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return acl, aws.StringValue(acl.Status), nil
+	}
+}
+
+// ClusterStatus fetches the MemoryDB cluster and its status.
+func ClusterStatus(conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := finder.ClusterByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cluster, aws.StringValue(cluster.Status), nil
+	}
+}
+
+// UserStatus fetches the MemoryDB user and its status.
+func UserStatus(conn *memorydb.MemoryDB, userName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		user, err := finder.UserByName(conn, userName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return user, aws.StringValue(user.Status), nil
+	}
+}
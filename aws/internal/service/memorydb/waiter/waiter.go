@@ -0,0 +1,140 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ACLActiveTimeout  = 5 * time.Minute
+	ACLDeletedTimeout = 5 * time.Minute
+
+	UserActiveTimeout  = 5 * time.Minute
+	UserDeletedTimeout = 5 * time.Minute
+
+	// MemoryDB does not publish these status values as enums.
+	ACLStatusActive    = "active"
+	ACLStatusCreating  = "creating"
+	ACLStatusDeleting  = "deleting"
+	ACLStatusModifying = "modifying"
+
+	ClusterStatusAvailable    = "available"
+	ClusterStatusCreating     = "creating"
+	ClusterStatusDeleting     = "deleting"
+	ClusterStatusSnapshotting = "snapshotting"
+	ClusterStatusUpdating     = "updating"
+
+	UserStatusActive    = "active"
+	UserStatusDeleting  = "deleting"
+	UserStatusModifying = "modifying"
+)
+
+// ACLActive waits for a MemoryDB ACL to reach an active state after modifications.
+func ACLActive(conn *memorydb.MemoryDB, name string) (*memorydb.ACL, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ACLStatusCreating, ACLStatusModifying},
+		Target:  []string{ACLStatusActive},
+		Refresh: ACLStatus(conn, name),
+		Timeout: ACLActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.ACL); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ACLDeleted waits for a MemoryDB ACL to be deleted.
+func ACLDeleted(conn *memorydb.MemoryDB, name string) (*memorydb.ACL, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ACLStatusDeleting},
+		Target:  []string{},
+		Refresh: ACLStatus(conn, name),
+		Timeout: ACLDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.ACL); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ClusterAvailable waits for a MemoryDB cluster to reach an available state after modifications.
+func ClusterAvailable(conn *memorydb.MemoryDB, name string, timeout time.Duration) (*memorydb.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ClusterStatusCreating, ClusterStatusUpdating, ClusterStatusSnapshotting},
+		Target:  []string{ClusterStatusAvailable},
+		Refresh: ClusterStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ClusterDeleted waits for a MemoryDB cluster to be deleted.
+func ClusterDeleted(conn *memorydb.MemoryDB, name string, timeout time.Duration) (*memorydb.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: ClusterStatus(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// UserActive waits for a MemoryDB user to reach an active state after modifications.
+func UserActive(conn *memorydb.MemoryDB, userName string) (*memorydb.User, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusModifying},
+		Target:  []string{UserStatusActive},
+		Refresh: UserStatus(conn, userName),
+		Timeout: UserActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.User); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// UserDeleted waits for a MemoryDB user to be deleted.
+func UserDeleted(conn *memorydb.MemoryDB, userName string) (*memorydb.User, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusDeleting},
+		Target:  []string{},
+		Refresh: UserStatus(conn, userName),
+		Timeout: UserDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*memorydb.User); ok {
+		return output, err
+	}
+
+	return nil, err
+}
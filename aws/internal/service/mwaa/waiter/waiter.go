@@ -0,0 +1,90 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Environment creation commonly takes 20-30 minutes and updates can take longer
+	EnvironmentCreatedTimeout = 120 * time.Minute
+	EnvironmentUpdatedTimeout = 120 * time.Minute
+	EnvironmentDeletedTimeout = 90 * time.Minute
+
+	environmentStatusDelay      = 1 * time.Minute
+	environmentStatusMinTimeout = 10 * time.Second
+)
+
+// EnvironmentCreated waits for an Environment to return AVAILABLE
+func EnvironmentCreated(conn *mwaa.MWAA, name string, timeout time.Duration) (*mwaa.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{mwaa.EnvironmentStatusCreating},
+		Target:     []string{mwaa.EnvironmentStatusAvailable},
+		Refresh:    EnvironmentStatus(conn, name),
+		Timeout:    timeout,
+		Delay:      environmentStatusDelay,
+		MinTimeout: environmentStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*mwaa.Environment); ok {
+		return output, environmentLastUpdateError(output, err)
+	}
+
+	return nil, err
+}
+
+// EnvironmentUpdated waits for an Environment to return AVAILABLE
+func EnvironmentUpdated(conn *mwaa.MWAA, name string, timeout time.Duration) (*mwaa.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{mwaa.EnvironmentStatusUpdating},
+		Target:     []string{mwaa.EnvironmentStatusAvailable},
+		Refresh:    EnvironmentStatus(conn, name),
+		Timeout:    timeout,
+		Delay:      environmentStatusDelay,
+		MinTimeout: environmentStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*mwaa.Environment); ok {
+		return output, environmentLastUpdateError(output, err)
+	}
+
+	return nil, err
+}
+
+// EnvironmentDeleted waits for an Environment to be deleted
+func EnvironmentDeleted(conn *mwaa.MWAA, name string, timeout time.Duration) (*mwaa.Environment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{mwaa.EnvironmentStatusDeleting},
+		Target:     []string{},
+		Refresh:    EnvironmentStatus(conn, name),
+		Timeout:    timeout,
+		Delay:      environmentStatusDelay,
+		MinTimeout: environmentStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*mwaa.Environment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// environmentLastUpdateError enriches a waiter error with the failure details
+// MWAA reports in the Environment's last update, if any.
+func environmentLastUpdateError(environment *mwaa.Environment, err error) error {
+	if err == nil || environment.LastUpdate == nil || environment.LastUpdate.Error == nil {
+		return err
+	}
+
+	return fmt.Errorf("%s: %s: %w", aws.StringValue(environment.LastUpdate.Error.ErrorCode), aws.StringValue(environment.LastUpdate.Error.ErrorMessage), err)
+}
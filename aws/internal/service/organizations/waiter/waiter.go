@@ -0,0 +1,32 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a member account to be closed
+	AccountClosedTimeout = 10 * time.Minute
+)
+
+// AccountClosed waits for a member account to reach the SUSPENDED status
+func AccountClosed(conn *organizations.Organizations, id string) (*organizations.Account, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{organizations.AccountStatusActive, organizations.AccountStatusPendingClosure},
+		Target:       []string{organizations.AccountStatusSuspended},
+		Refresh:      AccountStatus(conn, id),
+		Timeout:      AccountClosedTimeout,
+		PollInterval: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*organizations.Account); ok {
+		return output, err
+	}
+
+	return nil, err
+}
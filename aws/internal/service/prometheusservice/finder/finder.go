@@ -0,0 +1,67 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+)
+
+// WorkspaceByID returns the Prometheus workspace corresponding to the specified ID.
+// Returns nil if no workspace is found.
+func WorkspaceByID(conn *prometheusservice.PrometheusService, id string) (*prometheusservice.WorkspaceDescription, error) {
+	input := &prometheusservice.DescribeWorkspaceInput{
+		WorkspaceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeWorkspace(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Workspace, nil
+}
+
+// AlertManagerDefinitionByID returns the Prometheus alert manager definition of the specified workspace.
+// Returns nil if no alert manager definition is found.
+func AlertManagerDefinitionByID(conn *prometheusservice.PrometheusService, workspaceID string) (*prometheusservice.AlertManagerDefinitionDescription, error) {
+	input := &prometheusservice.DescribeAlertManagerDefinitionInput{
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	output, err := conn.DescribeAlertManagerDefinition(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.AlertManagerDefinition, nil
+}
+
+// RuleGroupNamespaceByName returns the Prometheus rule group namespace corresponding to the specified workspace ID and name.
+// Returns nil if no rule group namespace is found.
+func RuleGroupNamespaceByName(conn *prometheusservice.PrometheusService, workspaceID, name string) (*prometheusservice.RuleGroupsNamespaceDescription, error) {
+	input := &prometheusservice.DescribeRuleGroupsNamespaceInput{
+		Name:        aws.String(name),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	output, err := conn.DescribeRuleGroupsNamespace(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.RuleGroupsNamespace, nil
+}
@@ -0,0 +1,25 @@
+package prometheusservice
+
+import (
+	"fmt"
+	"strings"
+)
+
+const ruleGroupNamespaceIDSeparator = "/"
+
+func RuleGroupNamespaceCreateID(workspaceID, name string) string {
+	parts := []string{workspaceID, name}
+	id := strings.Join(parts, ruleGroupNamespaceIDSeparator)
+
+	return id
+}
+
+func RuleGroupNamespaceParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ruleGroupNamespaceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WORKSPACE-ID%[2]sNAME", id, ruleGroupNamespaceIDSeparator)
+}
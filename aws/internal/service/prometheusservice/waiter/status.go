@@ -0,0 +1,104 @@
+package waiter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice/finder"
+)
+
+const (
+	statusNotFound = "NotFound"
+	statusUnknown  = "Unknown"
+)
+
+// WorkspaceStatus fetches the Workspace and its Status
+func WorkspaceStatus(conn *prometheusservice.PrometheusService, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.WorkspaceByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+			return nil, statusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, statusUnknown, err
+		}
+
+		if output == nil || output.Status == nil {
+			return nil, statusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status.StatusCode), nil
+	}
+}
+
+// AlertManagerDefinitionStatus fetches the AlertManagerDefinition and its Status
+func AlertManagerDefinitionStatus(conn *prometheusservice.PrometheusService, workspaceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.AlertManagerDefinitionByID(conn, workspaceID)
+
+		if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+			return nil, statusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, statusUnknown, err
+		}
+
+		if output == nil || output.Status == nil {
+			return nil, statusNotFound, nil
+		}
+
+		statusCode := aws.StringValue(output.Status.StatusCode)
+
+		// Validation failures of the definition are only reported in the status reason.
+		switch statusCode {
+		case prometheusservice.AlertManagerDefinitionStatusCodeCreationFailed, prometheusservice.AlertManagerDefinitionStatusCodeUpdateFailed:
+			return output, statusCode, statusReasonError(output.Status.StatusReason)
+		}
+
+		return output, statusCode, nil
+	}
+}
+
+// RuleGroupNamespaceStatus fetches the RuleGroupsNamespace and its Status
+func RuleGroupNamespaceStatus(conn *prometheusservice.PrometheusService, workspaceID, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.RuleGroupNamespaceByName(conn, workspaceID, name)
+
+		if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+			return nil, statusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, statusUnknown, err
+		}
+
+		if output == nil || output.Status == nil {
+			return nil, statusNotFound, nil
+		}
+
+		statusCode := aws.StringValue(output.Status.StatusCode)
+
+		// Validation failures of the rules are only reported in the status reason.
+		switch statusCode {
+		case prometheusservice.RuleGroupsNamespaceStatusCodeCreationFailed, prometheusservice.RuleGroupsNamespaceStatusCodeUpdateFailed:
+			return output, statusCode, statusReasonError(output.Status.StatusReason)
+		}
+
+		return output, statusCode, nil
+	}
+}
+
+func statusReasonError(reason *string) error {
+	if v := aws.StringValue(reason); v != "" {
+		return errors.New(v)
+	}
+
+	return fmt.Errorf("no status reason returned")
+}
@@ -0,0 +1,184 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	WorkspaceCreatedTimeout = 5 * time.Minute
+	WorkspaceUpdatedTimeout = 5 * time.Minute
+	WorkspaceDeletedTimeout = 5 * time.Minute
+
+	AlertManagerDefinitionCreatedTimeout = 5 * time.Minute
+	AlertManagerDefinitionUpdatedTimeout = 5 * time.Minute
+	AlertManagerDefinitionDeletedTimeout = 5 * time.Minute
+
+	RuleGroupNamespaceCreatedTimeout = 5 * time.Minute
+	RuleGroupNamespaceUpdatedTimeout = 5 * time.Minute
+	RuleGroupNamespaceDeletedTimeout = 5 * time.Minute
+)
+
+// WorkspaceCreated waits for a Workspace to return "Active"
+func WorkspaceCreated(conn *prometheusservice.PrometheusService, id string) (*prometheusservice.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.WorkspaceStatusCodeCreating},
+		Target:  []string{prometheusservice.WorkspaceStatusCodeActive},
+		Refresh: WorkspaceStatus(conn, id),
+		Timeout: WorkspaceCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*prometheusservice.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WorkspaceUpdated waits for a Workspace to return "Active"
+func WorkspaceUpdated(conn *prometheusservice.PrometheusService, id string) (*prometheusservice.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.WorkspaceStatusCodeUpdating},
+		Target:  []string{prometheusservice.WorkspaceStatusCodeActive},
+		Refresh: WorkspaceStatus(conn, id),
+		Timeout: WorkspaceUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*prometheusservice.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WorkspaceDeleted waits for a Workspace to be deleted
+func WorkspaceDeleted(conn *prometheusservice.PrometheusService, id string) (*prometheusservice.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.WorkspaceStatusCodeDeleting},
+		Target:  []string{},
+		Refresh: WorkspaceStatus(conn, id),
+		Timeout: WorkspaceDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*prometheusservice.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// AlertManagerDefinitionCreated waits for an AlertManagerDefinition to return "Active"
+func AlertManagerDefinitionCreated(conn *prometheusservice.PrometheusService, workspaceID string) (*prometheusservice.AlertManagerDefinitionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.AlertManagerDefinitionStatusCodeCreating},
+		Target:  []string{prometheusservice.AlertManagerDefinitionStatusCodeActive},
+		Refresh: AlertManagerDefinitionStatus(conn, workspaceID),
+		Timeout: AlertManagerDefinitionCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// AlertManagerDefinitionUpdated waits for an AlertManagerDefinition to return "Active"
+func AlertManagerDefinitionUpdated(conn *prometheusservice.PrometheusService, workspaceID string) (*prometheusservice.AlertManagerDefinitionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.AlertManagerDefinitionStatusCodeUpdating},
+		Target:  []string{prometheusservice.AlertManagerDefinitionStatusCodeActive},
+		Refresh: AlertManagerDefinitionStatus(conn, workspaceID),
+		Timeout: AlertManagerDefinitionUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// AlertManagerDefinitionDeleted waits for an AlertManagerDefinition to be deleted
+func AlertManagerDefinitionDeleted(conn *prometheusservice.PrometheusService, workspaceID string) (*prometheusservice.AlertManagerDefinitionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.AlertManagerDefinitionStatusCodeDeleting},
+		Target:  []string{},
+		Refresh: AlertManagerDefinitionStatus(conn, workspaceID),
+		Timeout: AlertManagerDefinitionDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// RuleGroupNamespaceCreated waits for a RuleGroupsNamespace to return "Active"
+func RuleGroupNamespaceCreated(conn *prometheusservice.PrometheusService, workspaceID, name string) (*prometheusservice.RuleGroupsNamespaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.RuleGroupsNamespaceStatusCodeCreating},
+		Target:  []string{prometheusservice.RuleGroupsNamespaceStatusCodeActive},
+		Refresh: RuleGroupNamespaceStatus(conn, workspaceID, name),
+		Timeout: RuleGroupNamespaceCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*prometheusservice.RuleGroupsNamespaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// RuleGroupNamespaceUpdated waits for a RuleGroupsNamespace to return "Active"
+func RuleGroupNamespaceUpdated(conn *prometheusservice.PrometheusService, workspaceID, name string) (*prometheusservice.RuleGroupsNamespaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.RuleGroupsNamespaceStatusCodeUpdating},
+		Target:  []string{prometheusservice.RuleGroupsNamespaceStatusCodeActive},
+		Refresh: RuleGroupNamespaceStatus(conn, workspaceID, name),
+		Timeout: RuleGroupNamespaceUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*prometheusservice.RuleGroupsNamespaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// RuleGroupNamespaceDeleted waits for a RuleGroupsNamespace to be deleted
+func RuleGroupNamespaceDeleted(conn *prometheusservice.PrometheusService, workspaceID, name string) (*prometheusservice.RuleGroupsNamespaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.RuleGroupsNamespaceStatusCodeDeleting},
+		Target:  []string{},
+		Refresh: RuleGroupNamespaceStatus(conn, workspaceID, name),
+		Timeout: RuleGroupNamespaceDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*prometheusservice.RuleGroupsNamespaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,29 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// DataSourceStatus fetches the DataSource and its Status
+func DataSourceStatus(conn *quicksight.QuickSight, accountID, dataSourceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &quicksight.DescribeDataSourceInput{
+			AwsAccountId: aws.String(accountID),
+			DataSourceId: aws.String(dataSourceID),
+		}
+
+		output, err := conn.DescribeDataSource(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.DataSource == nil {
+			return nil, "", nil
+		}
+
+		return output.DataSource, aws.StringValue(output.DataSource.Status), nil
+	}
+}
@@ -0,0 +1,61 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	DataSourceCreateTimeout = 5 * time.Minute
+	DataSourceUpdateTimeout = 5 * time.Minute
+)
+
+// DataSourceCreated waits for a DataSource to return CREATION_SUCCESSFUL.
+// A CREATION_FAILED status returns an error containing the error info.
+func DataSourceCreated(conn *quicksight.QuickSight, accountID, dataSourceID string) (*quicksight.DataSource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful},
+		Refresh: DataSourceStatus(conn, accountID, dataSourceID),
+		Timeout: DataSourceCreateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*quicksight.DataSource); ok {
+		if status, errorInfo := aws.StringValue(output.Status), output.ErrorInfo; status == quicksight.ResourceStatusCreationFailed && errorInfo != nil {
+			return output, fmt.Errorf("%s: %s", aws.StringValue(errorInfo.Type), aws.StringValue(errorInfo.Message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DataSourceUpdated waits for a DataSource to return UPDATE_SUCCESSFUL.
+// An UPDATE_FAILED status returns an error containing the error info.
+func DataSourceUpdated(conn *quicksight.QuickSight, accountID, dataSourceID string) (*quicksight.DataSource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusUpdateInProgress},
+		Target:  []string{quicksight.ResourceStatusUpdateSuccessful},
+		Refresh: DataSourceStatus(conn, accountID, dataSourceID),
+		Timeout: DataSourceUpdateTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*quicksight.DataSource); ok {
+		if status, errorInfo := aws.StringValue(output.Status), output.ErrorInfo; status == quicksight.ResourceStatusUpdateFailed && errorInfo != nil {
+			return output, fmt.Errorf("%s: %s", aws.StringValue(errorInfo.Type), aws.StringValue(errorInfo.Message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,80 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+)
+
+// ResourceShareOwnerOtherAccountsByArn returns the resource share corresponding to the
+// specified ARN, owned by another account. Returns nil if no resource share is found.
+func ResourceShareOwnerOtherAccountsByArn(conn *ram.RAM, arn string) (*ram.ResourceShare, error) {
+	input := &ram.GetResourceSharesInput{
+		ResourceOwner:     aws.String(ram.ResourceOwnerOtherAccounts),
+		ResourceShareArns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.GetResourceShares(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ResourceShares) == 0 {
+		return nil, nil
+	}
+
+	return output.ResourceShares[0], nil
+}
+
+// PendingInvitationResources returns the resources that will be shared once the
+// specified resource share invitation is accepted.
+func PendingInvitationResources(conn *ram.RAM, invitationArn string) ([]*ram.Resource, error) {
+	input := &ram.ListPendingInvitationResourcesInput{
+		ResourceShareInvitationArn: aws.String(invitationArn),
+	}
+
+	var result []*ram.Resource
+
+	err := conn.ListPendingInvitationResourcesPages(input, func(page *ram.ListPendingInvitationResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, resource := range page.Resources {
+			if resource != nil {
+				result = append(result, resource)
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// ResourcesByShareArn returns the resources associated with the specified resource share.
+func ResourcesByShareArn(conn *ram.RAM, resourceOwner, arn string) ([]*ram.Resource, error) {
+	input := &ram.ListResourcesInput{
+		MaxResults:        aws.Int64(500),
+		ResourceOwner:     aws.String(resourceOwner),
+		ResourceShareArns: aws.StringSlice([]string{arn}),
+	}
+
+	var result []*ram.Resource
+
+	err := conn.ListResourcesPages(input, func(page *ram.ListResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, resource := range page.Resources {
+			if resource != nil {
+				result = append(result, resource)
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
@@ -0,0 +1,40 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ram/finder"
+)
+
+const (
+	resourceShareResourcesStatusUnknown = "Unknown"
+)
+
+// ResourceShareResourcesStatus fetches the resources visible to the receiving account
+// and returns "AVAILABLE" once all of the expected resources are associated
+func ResourceShareResourcesStatus(conn *ram.RAM, arn string, resourceArns []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resources, err := finder.ResourcesByShareArn(conn, ram.ResourceOwnerOtherAccounts, arn)
+
+		if err != nil {
+			return nil, resourceShareResourcesStatusUnknown, err
+		}
+
+		visible := make(map[string]*ram.Resource, len(resources))
+
+		for _, resource := range resources {
+			visible[aws.StringValue(resource.Arn)] = resource
+		}
+
+		for _, resourceArn := range resourceArns {
+			resource, ok := visible[resourceArn]
+
+			if !ok || aws.StringValue(resource.Status) == ram.ResourceStatusPending {
+				return resources, ram.ResourceStatusPending, nil
+			}
+		}
+
+		return resources, ram.ResourceStatusAvailable, nil
+	}
+}
@@ -0,0 +1,27 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// ResourceShareResourcesAvailable waits for the specified resources of an accepted
+// resource share to be visible to the receiving account
+func ResourceShareResourcesAvailable(conn *ram.RAM, arn string, resourceArns []string, timeout time.Duration) ([]*ram.Resource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceStatusPending},
+		Target:  []string{ram.ResourceStatusAvailable},
+		Refresh: ResourceShareResourcesStatus(conn, arn, resourceArns),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.([]*ram.Resource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
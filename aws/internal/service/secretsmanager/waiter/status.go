@@ -0,0 +1,47 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	secretReplicationStatusUnknown = "Unknown"
+)
+
+// SecretReplicationStatus fetches the Secret and its aggregate replication status
+func SecretReplicationStatus(conn *secretsmanager.SecretsManager, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(id),
+		}
+
+		output, err := conn.DescribeSecret(input)
+
+		if err != nil {
+			return nil, secretReplicationStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, secretReplicationStatusUnknown, nil
+		}
+
+		status := secretsmanager.StatusTypeInSync
+
+		for _, replicationStatus := range output.ReplicationStatus {
+			if replicationStatus == nil {
+				continue
+			}
+
+			switch aws.StringValue(replicationStatus.Status) {
+			case secretsmanager.StatusTypeFailed:
+				return output, secretsmanager.StatusTypeFailed, nil
+			case secretsmanager.StatusTypeInProgress:
+				status = secretsmanager.StatusTypeInProgress
+			}
+		}
+
+		return output, status, nil
+	}
+}
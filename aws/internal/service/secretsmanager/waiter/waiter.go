@@ -1,10 +1,53 @@
 package waiter
 
 import (
+	"fmt"
+	"strings"
 	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 const (
 	// Maximum amount of time to wait for Secrets Manager deletions to propagate
 	DeletionPropagationTimeout = 2 * time.Minute
+
+	// Maximum amount of time to wait for Secrets Manager replicas to become InSync
+	SecretReplicationInSyncTimeout = 10 * time.Minute
 )
+
+// SecretReplicationInSync waits for all replicas of a Secret to return InSync
+func SecretReplicationInSync(conn *secretsmanager.SecretsManager, id string) (*secretsmanager.DescribeSecretOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{secretsmanager.StatusTypeInProgress},
+		Target:  []string{secretsmanager.StatusTypeInSync},
+		Refresh: SecretReplicationStatus(conn, id),
+		Timeout: SecretReplicationInSyncTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*secretsmanager.DescribeSecretOutput); ok {
+		if err != nil {
+			var messages []string
+
+			for _, replicationStatus := range output.ReplicationStatus {
+				if replicationStatus == nil || aws.StringValue(replicationStatus.Status) != secretsmanager.StatusTypeFailed {
+					continue
+				}
+
+				messages = append(messages, fmt.Sprintf("%s: %s", aws.StringValue(replicationStatus.Region), aws.StringValue(replicationStatus.StatusMessage)))
+			}
+
+			if len(messages) > 0 {
+				err = fmt.Errorf("%w (%s)", err, strings.Join(messages, ", "))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
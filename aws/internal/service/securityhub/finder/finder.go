@@ -0,0 +1,37 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+)
+
+// StandardsControlByStandardsSubscriptionARNAndStandardsControlARN returns the standards control corresponding to the specified standards subscription and standards control ARNs.
+// Returns nil if no standards control is found.
+func StandardsControlByStandardsSubscriptionARNAndStandardsControlARN(conn *securityhub.SecurityHub, standardsSubscriptionARN, standardsControlARN string) (*securityhub.StandardsControl, error) {
+	input := &securityhub.DescribeStandardsControlsInput{
+		StandardsSubscriptionArn: aws.String(standardsSubscriptionARN),
+	}
+	var output *securityhub.StandardsControl
+
+	err := conn.DescribeStandardsControlsPages(input, func(page *securityhub.DescribeStandardsControlsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, control := range page.Controls {
+			if aws.StringValue(control.StandardsControlArn) == standardsControlARN {
+				output = control
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,85 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+)
+
+// ProvisionedProductByID returns the Provisioned Product corresponding to the specified identifier.
+func ProvisionedProductByID(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) (*servicecatalog.DescribeProvisionedProductOutput, error) {
+	input := &servicecatalog.DescribeProvisionedProductInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(id),
+	}
+
+	return conn.DescribeProvisionedProduct(input)
+}
+
+// RecordByID returns the Record corresponding to the specified identifier,
+// including the outputs from all pages.
+func RecordByID(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) (*servicecatalog.DescribeRecordOutput, error) {
+	input := &servicecatalog.DescribeRecordInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(id),
+	}
+
+	var result *servicecatalog.DescribeRecordOutput
+
+	for {
+		output, err := conn.DescribeRecord(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		if result == nil {
+			result = output
+		} else {
+			result.RecordOutputs = append(result.RecordOutputs, output.RecordOutputs...)
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = output.NextPageToken
+	}
+
+	return result, nil
+}
+
+// PortfolioShare returns the Portfolio Share of the specified type for the specified principal.
+// Returns nil if no matching share is found.
+func PortfolioShare(conn *servicecatalog.ServiceCatalog, portfolioID, shareType, principalID string) (*servicecatalog.PortfolioShareDetail, error) {
+	input := &servicecatalog.DescribePortfolioSharesInput{
+		PortfolioId: aws.String(portfolioID),
+		Type:        aws.String(shareType),
+	}
+
+	var result *servicecatalog.PortfolioShareDetail
+
+	err := conn.DescribePortfolioSharesPages(input, func(page *servicecatalog.DescribePortfolioSharesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, detail := range page.PortfolioShareDetails {
+			if detail == nil {
+				continue
+			}
+
+			if aws.StringValue(detail.PrincipalId) == principalID {
+				result = detail
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
@@ -0,0 +1,25 @@
+package servicecatalog
+
+import (
+	"fmt"
+	"strings"
+)
+
+const portfolioShareResourceIDSeparator = ":"
+
+func PortfolioShareCreateResourceID(portfolioID, shareType, principalID string) string {
+	parts := []string{portfolioID, shareType, principalID}
+	id := strings.Join(parts, portfolioShareResourceIDSeparator)
+
+	return id
+}
+
+func PortfolioShareParseResourceID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, portfolioShareResourceIDSeparator, 3)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PortfolioID%[2]sType%[2]sPrincipalID", id, portfolioShareResourceIDSeparator)
+}
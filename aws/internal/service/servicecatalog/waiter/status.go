@@ -0,0 +1,87 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+)
+
+const (
+	provisionedProductStatusNotFound = "NotFound"
+	provisionedProductStatusUnknown  = "Unknown"
+
+	recordStatusNotFound = "NotFound"
+	recordStatusUnknown  = "Unknown"
+
+	portfolioShareStatusNotFound = "NotFound"
+	portfolioShareStatusUnknown  = "Unknown"
+)
+
+// RecordStatus fetches the Record and its Status
+func RecordStatus(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.RecordByID(conn, acceptLanguage, id)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return nil, recordStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, recordStatusUnknown, err
+		}
+
+		if output == nil || output.RecordDetail == nil {
+			return nil, recordStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.RecordDetail.Status), nil
+	}
+}
+
+// ProvisionedProductStatus fetches the Provisioned Product and its Status
+func ProvisionedProductStatus(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ProvisionedProductByID(conn, acceptLanguage, id)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return nil, provisionedProductStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, provisionedProductStatusUnknown, err
+		}
+
+		if output == nil || output.ProvisionedProductDetail == nil {
+			return nil, provisionedProductStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.ProvisionedProductDetail.Status), nil
+	}
+}
+
+// PortfolioShareStatus fetches the Portfolio Share operation and its Status
+func PortfolioShareStatus(conn *servicecatalog.ServiceCatalog, token string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &servicecatalog.DescribePortfolioShareStatusInput{
+			PortfolioShareToken: aws.String(token),
+		}
+
+		output, err := conn.DescribePortfolioShareStatus(input)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return nil, portfolioShareStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, portfolioShareStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, portfolioShareStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,137 @@
+package waiter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Default maximum amount of time to wait for a Provisioned Product to be Created
+	ProvisionedProductCreatedDefaultTimeout = 30 * time.Minute
+
+	// Default maximum amount of time to wait for a Provisioned Product to be Updated
+	ProvisionedProductUpdatedDefaultTimeout = 30 * time.Minute
+
+	// Default maximum amount of time to wait for a Provisioned Product to be Deleted
+	ProvisionedProductDeletedDefaultTimeout = 30 * time.Minute
+
+	// Maximum amount of time to wait for a Portfolio Share operation to complete
+	PortfolioShareTimeout = 3 * time.Minute
+
+	// Maximum amount of time to wait for launch paths and principal associations to propagate
+	PropagationTimeout = 2 * time.Minute
+)
+
+// RecordReady waits for a Record to return "SUCCEEDED". A "FAILED" Record
+// returns an error containing the Record's errors.
+func RecordReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string, timeout time.Duration) (*servicecatalog.DescribeRecordOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			servicecatalog.RecordStatusCreated,
+			servicecatalog.RecordStatusInProgress,
+			servicecatalog.RecordStatusInProgressInError,
+		},
+		Target:  []string{servicecatalog.RecordStatusSucceeded},
+		Refresh: RecordStatus(conn, acceptLanguage, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*servicecatalog.DescribeRecordOutput); ok {
+		if output.RecordDetail != nil && aws.StringValue(output.RecordDetail.Status) == servicecatalog.RecordStatusFailed {
+			return output, recordErrors(output.RecordDetail.RecordErrors)
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ProvisionedProductTerminated waits for a Provisioned Product to be removed
+func ProvisionedProductTerminated(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			servicecatalog.ProvisionedProductStatusAvailable,
+			servicecatalog.ProvisionedProductStatusUnderChange,
+		},
+		Target:  []string{},
+		Refresh: ProvisionedProductStatus(conn, acceptLanguage, id),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// PortfolioShareReady waits for a Portfolio Share operation to return "COMPLETED".
+// Operations completing with errors return an error containing the share errors.
+func PortfolioShareReady(conn *servicecatalog.ServiceCatalog, token string) (*servicecatalog.DescribePortfolioShareStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			servicecatalog.ShareStatusNotStarted,
+			servicecatalog.ShareStatusInProgress,
+		},
+		Target:  []string{servicecatalog.ShareStatusCompleted},
+		Refresh: PortfolioShareStatus(conn, token),
+		Timeout: PortfolioShareTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
+		if status := aws.StringValue(output.Status); status == servicecatalog.ShareStatusCompletedWithErrors || status == servicecatalog.ShareStatusError {
+			return output, shareErrors(output.ShareDetails)
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func recordErrors(apiObjects []*servicecatalog.RecordError) error {
+	var errs []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(apiObject.Code), aws.StringValue(apiObject.Description)))
+	}
+
+	if len(errs) == 0 {
+		return errors.New("record failed with no errors reported")
+	}
+
+	return errors.New(strings.Join(errs, "\n"))
+}
+
+func shareErrors(apiObject *servicecatalog.ShareDetails) error {
+	var errs []string
+
+	if apiObject != nil {
+		for _, shareError := range apiObject.ShareErrors {
+			if shareError == nil {
+				continue
+			}
+
+			errs = append(errs, fmt.Sprintf("%s: %s (%s)", aws.StringValue(shareError.Error), aws.StringValue(shareError.Message), strings.Join(aws.StringValueSlice(shareError.Accounts), ", ")))
+		}
+	}
+
+	if len(errs) == 0 {
+		return errors.New("share failed with no errors reported")
+	}
+
+	return errors.New(strings.Join(errs, "\n"))
+}
@@ -0,0 +1,76 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+)
+
+// NamespacesByNameAndType returns the summaries of all namespaces of the specified type with the specified name.
+func NamespacesByNameAndType(conn *servicediscovery.ServiceDiscovery, name, nsType string) ([]*servicediscovery.NamespaceSummary, error) {
+	input := &servicediscovery.ListNamespacesInput{
+		Filters: []*servicediscovery.NamespaceFilter{
+			{
+				Condition: aws.String(servicediscovery.FilterConditionEq),
+				Name:      aws.String(servicediscovery.NamespaceFilterNameType),
+				Values:    aws.StringSlice([]string{nsType}),
+			},
+		},
+	}
+
+	var results []*servicediscovery.NamespaceSummary
+
+	err := conn.ListNamespacesPages(input, func(page *servicediscovery.ListNamespacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, namespace := range page.Namespaces {
+			if namespace == nil {
+				continue
+			}
+
+			if aws.StringValue(namespace.Name) == name {
+				results = append(results, namespace)
+			}
+		}
+
+		return !lastPage
+	})
+
+	return results, err
+}
+
+// ServicesByNamespaceIDAndName returns the summaries of all services in the specified namespace with the specified name.
+func ServicesByNamespaceIDAndName(conn *servicediscovery.ServiceDiscovery, namespaceID, name string) ([]*servicediscovery.ServiceSummary, error) {
+	input := &servicediscovery.ListServicesInput{
+		Filters: []*servicediscovery.ServiceFilter{
+			{
+				Condition: aws.String(servicediscovery.FilterConditionEq),
+				Name:      aws.String(servicediscovery.ServiceFilterNameNamespaceId),
+				Values:    aws.StringSlice([]string{namespaceID}),
+			},
+		},
+	}
+
+	var results []*servicediscovery.ServiceSummary
+
+	err := conn.ListServicesPages(input, func(page *servicediscovery.ListServicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, service := range page.Services {
+			if service == nil {
+				continue
+			}
+
+			if aws.StringValue(service.Name) == name {
+				results = append(results, service)
+			}
+		}
+
+		return !lastPage
+	})
+
+	return results, err
+}
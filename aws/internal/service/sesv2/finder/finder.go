@@ -0,0 +1,67 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+)
+
+// ConfigurationSetByName returns the SESv2 configuration set corresponding to the specified name.
+func ConfigurationSetByName(conn *sesv2.SESV2, name string) (*sesv2.GetConfigurationSetOutput, error) {
+	input := &sesv2.GetConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	output, err := conn.GetConfigurationSet(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// ConfigurationSetEventDestinationByTwoPartKey returns the event destination of the specified configuration set.
+// Returns nil if no event destination is found.
+func ConfigurationSetEventDestinationByTwoPartKey(conn *sesv2.SESV2, configurationSetName, eventDestinationName string) (*sesv2.EventDestination, error) {
+	input := &sesv2.GetConfigurationSetEventDestinationsInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+	}
+
+	output, err := conn.GetConfigurationSetEventDestinations(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, eventDestination := range output.EventDestinations {
+		if aws.StringValue(eventDestination.Name) == eventDestinationName {
+			return eventDestination, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// DedicatedIPPoolByName returns the SESv2 dedicated IP pool corresponding to the specified name.
+// Returns nil if no dedicated IP pool is found.
+func DedicatedIPPoolByName(conn *sesv2.SESV2, name string) (*sesv2.DedicatedIpPool, error) {
+	input := &sesv2.GetDedicatedIpPoolInput{
+		PoolName: aws.String(name),
+	}
+
+	output, err := conn.GetDedicatedIpPool(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.DedicatedIpPool, nil
+}
@@ -0,0 +1,43 @@
+package waiter
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// AssociationSuccess waits for an SSM association to report a successful application.
+func AssociationSuccess(conn *ssm.SSM, id string, timeout time.Duration) (*ssm.AssociationDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssm.AssociationStatusNamePending},
+		Target:  []string{ssm.AssociationStatusNameSuccess},
+		Refresh: AssociationStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ssm.AssociationDescription); ok {
+		if err != nil && output.Overview != nil && output.Overview.DetailedStatus != nil {
+			newErr := errors.New(aws.StringValue(output.Overview.DetailedStatus))
+
+			switch e := err.(type) {
+			case *resource.TimeoutError:
+				if e.LastError == nil {
+					e.LastError = newErr
+				}
+			case *resource.UnexpectedStateError:
+				if e.LastError == nil {
+					e.LastError = newErr
+				}
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
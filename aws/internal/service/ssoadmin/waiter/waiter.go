@@ -0,0 +1,93 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	AccountAssignmentCreateTimeout      = 5 * time.Minute
+	AccountAssignmentDeleteTimeout      = 5 * time.Minute
+	AccountAssignmentDelay              = 5 * time.Second
+	AccountAssignmentMinTimeout         = 5 * time.Second
+	PermissionSetProvisioningRetryDelay = 5 * time.Second
+	PermissionSetProvisionTimeout       = 10 * time.Minute
+)
+
+// AccountAssignmentCreated waits for an account assignment creation request to return "SUCCEEDED".
+// A "FAILED" request returns an error containing the failure reason.
+func AccountAssignmentCreated(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+	stateConf := resource.StateChangeConf{
+		Delay:      AccountAssignmentDelay,
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    AccountAssignmentCreationStatus(conn, instanceArn, requestID),
+		Timeout:    AccountAssignmentCreateTimeout,
+		MinTimeout: AccountAssignmentMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		if aws.StringValue(v.Status) == ssoadmin.StatusValuesFailed {
+			return v, fmt.Errorf("%s", aws.StringValue(v.FailureReason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// AccountAssignmentDeleted waits for an account assignment deletion request to return "SUCCEEDED".
+// A "FAILED" request returns an error containing the failure reason.
+func AccountAssignmentDeleted(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+	stateConf := resource.StateChangeConf{
+		Delay:      AccountAssignmentDelay,
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    AccountAssignmentDeletionStatus(conn, instanceArn, requestID),
+		Timeout:    AccountAssignmentDeleteTimeout,
+		MinTimeout: AccountAssignmentMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		if aws.StringValue(v.Status) == ssoadmin.StatusValuesFailed {
+			return v, fmt.Errorf("%s", aws.StringValue(v.FailureReason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// PermissionSetProvisioned waits for a permission set provisioning request to return "SUCCEEDED".
+// A "FAILED" request returns an error containing the failure reason.
+func PermissionSetProvisioned(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.PermissionSetProvisioningStatus, error) {
+	stateConf := resource.StateChangeConf{
+		Delay:   PermissionSetProvisioningRetryDelay,
+		Pending: []string{ssoadmin.StatusValuesInProgress},
+		Target:  []string{ssoadmin.StatusValuesSucceeded},
+		Refresh: PermissionSetProvisioningStatus(conn, instanceArn, requestID),
+		Timeout: PermissionSetProvisionTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.PermissionSetProvisioningStatus); ok {
+		if aws.StringValue(v.Status) == ssoadmin.StatusValuesFailed {
+			return v, fmt.Errorf("%s", aws.StringValue(v.FailureReason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,36 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+)
+
+// FileSystemAssociationByARN returns the Storage Gateway file system association corresponding to the specified ARN.
+// Returns nil if no association is found.
+func FileSystemAssociationByARN(conn *storagegateway.StorageGateway, fileSystemAssociationARN string) (*storagegateway.FileSystemAssociationInfo, error) {
+	input := &storagegateway.DescribeFileSystemAssociationsInput{
+		FileSystemAssociationARNList: []*string{aws.String(fileSystemAssociationARN)},
+	}
+
+	output, err := conn.DescribeFileSystemAssociations(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, fileSystemAssociation := range output.FileSystemAssociationInfoList {
+		if fileSystemAssociation == nil {
+			continue
+		}
+
+		if aws.StringValue(fileSystemAssociation.FileSystemAssociationARN) == fileSystemAssociationARN {
+			return fileSystemAssociation, nil
+		}
+	}
+
+	return nil, nil
+}
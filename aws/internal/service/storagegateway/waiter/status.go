@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/storagegateway/finder"
 )
 
 const (
@@ -18,6 +19,8 @@ const (
 	NfsFileShareStatusUnknown            = "Unknown"
 	SmbFileShareStatusNotFound           = "NotFound"
 	SmbFileShareStatusUnknown            = "Unknown"
+	FileSystemAssociationStatusNotFound  = "NotFound"
+	FileSystemAssociationStatusUnknown   = "Unknown"
 )
 
 func StorageGatewayGatewayStatus(conn *storagegateway.StorageGateway, gatewayARN string) resource.StateRefreshFunc {
@@ -135,3 +138,23 @@ func SmbFileShareStatus(conn *storagegateway.StorageGateway, fileShareArn string
 		return fileshare, aws.StringValue(fileshare.FileShareStatus), nil
 	}
 }
+
+func FileSystemAssociationStatus(conn *storagegateway.StorageGateway, fileSystemAssociationARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.FileSystemAssociationByARN(conn, fileSystemAssociationARN)
+
+		if tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified file system association was not found.") {
+			return nil, FileSystemAssociationStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, FileSystemAssociationStatusUnknown, fmt.Errorf("error reading Storage Gateway File System Association: %w", err)
+		}
+
+		if output == nil {
+			return nil, FileSystemAssociationStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.FileSystemAssociationStatus), nil
+	}
+}
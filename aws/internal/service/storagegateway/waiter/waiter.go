@@ -16,6 +16,8 @@ const (
 	NfsFileShareDeletedDelay                                = 5 * time.Second
 	SmbFileShareAvailableDelay                              = 5 * time.Second
 	SmbFileShareDeletedDelay                                = 5 * time.Second
+	FileSystemAssociationAvailableDelay                     = 5 * time.Second
+	FileSystemAssociationDeletedDelay                       = 5 * time.Second
 )
 
 func StorageGatewayGatewayConnected(conn *storagegateway.StorageGateway, gatewayARN string, timeout time.Duration) (*storagegateway.DescribeGatewayInformationOutput, error) {
@@ -148,3 +150,41 @@ func SmbFileShareDeleted(conn *storagegateway.StorageGateway, fileShareArn strin
 
 	return nil, err
 }
+
+// FileSystemAssociationAvailable waits for a File System Association to return Available
+func FileSystemAssociationAvailable(conn *storagegateway.StorageGateway, fileSystemAssociationARN string, timeout time.Duration) (*storagegateway.FileSystemAssociationInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"CREATING", "UPDATING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: FileSystemAssociationStatus(conn, fileSystemAssociationARN),
+		Timeout: timeout,
+		Delay:   FileSystemAssociationAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*storagegateway.FileSystemAssociationInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func FileSystemAssociationDeleted(conn *storagegateway.StorageGateway, fileSystemAssociationARN string, timeout time.Duration) (*storagegateway.FileSystemAssociationInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:        []string{"AVAILABLE", "DELETING", "FORCE_DELETING"},
+		Target:         []string{},
+		Refresh:        FileSystemAssociationStatus(conn, fileSystemAssociationARN),
+		Timeout:        timeout,
+		Delay:          FileSystemAssociationDeletedDelay,
+		NotFoundChecks: 1,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*storagegateway.FileSystemAssociationInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,44 @@
+package waiter
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+)
+
+const (
+	canaryStateNotFound = "NotFound"
+	canaryStateUnknown  = "Unknown"
+)
+
+// CanaryState fetches the Canary and its State
+func CanaryState(conn *synthetics.Synthetics, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.CanaryByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+			return nil, canaryStateNotFound, nil
+		}
+
+		if err != nil {
+			return nil, canaryStateUnknown, err
+		}
+
+		if output == nil || output.Status == nil {
+			return nil, canaryStateNotFound, nil
+		}
+
+		state := aws.StringValue(output.Status.State)
+
+		// Failures to create, update or start the canary are only reported in the state reason.
+		if state == synthetics.CanaryStateError {
+			return output, state, errors.New(aws.StringValue(output.Status.StateReason))
+		}
+
+		return output, state, nil
+	}
+}
@@ -0,0 +1,112 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	CanaryCreatedTimeout = 5 * time.Minute
+	CanaryUpdatedTimeout = 5 * time.Minute
+	CanaryRunningTimeout = 5 * time.Minute
+	CanaryStoppedTimeout = 5 * time.Minute
+	CanaryDeletedTimeout = 5 * time.Minute
+)
+
+// CanaryReady waits for a Canary to return "READY"
+func CanaryReady(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateCreating, synthetics.CanaryStateUpdating},
+		Target:  []string{synthetics.CanaryStateReady},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*synthetics.Canary); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// CanaryUpdated waits for a Canary to return "READY" or "STOPPED"
+func CanaryUpdated(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateUpdating},
+		Target:  []string{synthetics.CanaryStateReady, synthetics.CanaryStateStopped},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*synthetics.Canary); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// CanaryRunning waits for a Canary to return "RUNNING"
+func CanaryRunning(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateStarting, synthetics.CanaryStateUpdating},
+		Target:  []string{synthetics.CanaryStateRunning},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryRunningTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*synthetics.Canary); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// CanaryStopped waits for a Canary to return "STOPPED"
+func CanaryStopped(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			synthetics.CanaryStateStopping,
+			synthetics.CanaryStateUpdating,
+			synthetics.CanaryStateRunning,
+			synthetics.CanaryStateReady,
+			synthetics.CanaryStateStarting,
+		},
+		Target:  []string{synthetics.CanaryStateStopped},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*synthetics.Canary); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// CanaryDeleted waits for a Canary to be deleted
+func CanaryDeleted(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateDeleting},
+		Target:  []string{},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*synthetics.Canary); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,47 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+)
+
+// DatabaseByName returns the Timestream database corresponding to the specified name.
+// Returns nil if no database is found.
+func DatabaseByName(conn *timestreamwrite.TimestreamWrite, databaseName string) (*timestreamwrite.Database, error) {
+	input := &timestreamwrite.DescribeDatabaseInput{
+		DatabaseName: aws.String(databaseName),
+	}
+
+	output, err := conn.DescribeDatabase(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Database, nil
+}
+
+// TableByName returns the Timestream table corresponding to the specified database and table names.
+// Returns nil if no table is found.
+func TableByName(conn *timestreamwrite.TimestreamWrite, databaseName, tableName string) (*timestreamwrite.Table, error) {
+	input := &timestreamwrite.DescribeTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	}
+
+	output, err := conn.DescribeTable(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Table, nil
+}
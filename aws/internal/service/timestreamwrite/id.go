@@ -0,0 +1,24 @@
+package timestreamwrite
+
+import (
+	"fmt"
+	"strings"
+)
+
+const tableIDSeparator = ":"
+
+func TableCreateID(databaseName, tableName string) string {
+	parts := []string{databaseName, tableName}
+	id := strings.Join(parts, tableIDSeparator)
+	return id
+}
+
+func TableParseID(id string) (string, string, error) {
+	parts := strings.Split(id, tableIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected database-name"+tableIDSeparator+"table-name", id)
+}
@@ -87,6 +87,23 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with tags exposed by the aws_default_tags data source. Resources do not apply these tags automatically.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tags exposed by the aws_default_tags data source.",
+						},
+					},
+				},
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -189,6 +206,8 @@ func Provider() *schema.Provider {
 			"aws_cloudformation_export":                      dataSourceAwsCloudFormationExport(),
 			"aws_cloudformation_stack":                       dataSourceAwsCloudFormationStack(),
 			"aws_cloudfront_distribution":                    dataSourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_origin_access_control":           dataSourceAwsCloudFrontOriginAccessControl(),
+			"aws_cloudfront_origin_access_identities":        dataSourceAwsCloudFrontOriginAccessIdentities(),
 			"aws_cloudhsm_v2_cluster":                        dataSourceCloudHsmV2Cluster(),
 			"aws_cloudtrail_service_account":                 dataSourceAwsCloudTrailServiceAccount(),
 			"aws_cloudwatch_log_group":                       dataSourceAwsCloudwatchLogGroup(),
@@ -202,6 +221,7 @@ func Provider() *schema.Provider {
 			"aws_db_instance":                                dataSourceAwsDbInstance(),
 			"aws_db_snapshot":                                dataSourceAwsDbSnapshot(),
 			"aws_db_subnet_group":                            dataSourceAwsDbSubnetGroup(),
+			"aws_default_tags":                               dataSourceAwsDefaultTags(),
 			"aws_directory_service_directory":                dataSourceAwsDirectoryServiceDirectory(),
 			"aws_docdb_engine_version":                       dataSourceAwsDocdbEngineVersion(),
 			"aws_docdb_orderable_db_instance":                dataSourceAwsDocdbOrderableDbInstance(),
@@ -228,6 +248,8 @@ func Provider() *schema.Provider {
 			"aws_ec2_managed_prefix_list":                    dataSourceAwsEc2ManagedPrefixList(),
 			"aws_ec2_spot_price":                             dataSourceAwsEc2SpotPrice(),
 			"aws_ec2_transit_gateway":                        dataSourceAwsEc2TransitGateway(),
+			"aws_ec2_transit_gateway_connect":                dataSourceAwsEc2TransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":           dataSourceAwsEc2TransitGatewayConnectPeer(),
 			"aws_ec2_transit_gateway_dx_gateway_attachment":  dataSourceAwsEc2TransitGatewayDxGatewayAttachment(),
 			"aws_ec2_transit_gateway_peering_attachment":     dataSourceAwsEc2TransitGatewayPeeringAttachment(),
 			"aws_ec2_transit_gateway_route_table":            dataSourceAwsEc2TransitGatewayRouteTable(),
@@ -266,9 +288,13 @@ func Provider() *schema.Provider {
 			"aws_iam_policy_document":                        dataSourceAwsIamPolicyDocument(),
 			"aws_iam_role":                                   dataSourceAwsIAMRole(),
 			"aws_iam_server_certificate":                     dataSourceAwsIAMServerCertificate(),
+			"aws_iam_session_context":                        dataSourceAwsIAMSessionContext(),
 			"aws_iam_user":                                   dataSourceAwsIAMUser(),
+			"aws_identitystore_group":                        dataSourceAwsIdentityStoreGroup(),
+			"aws_identitystore_user":                         dataSourceAwsIdentityStoreUser(),
 			"aws_imagebuilder_component":                     dataSourceAwsImageBuilderComponent(),
 			"aws_imagebuilder_distribution_configuration":    datasourceAwsImageBuilderDistributionConfiguration(),
+			"aws_imagebuilder_image":                         dataSourceAwsImageBuilderImage(),
 			"aws_imagebuilder_image_pipeline":                dataSourceAwsImageBuilderImagePipeline(),
 			"aws_imagebuilder_image_recipe":                  dataSourceAwsImageBuilderImageRecipe(),
 			"aws_imagebuilder_infrastructure_configuration":  datasourceAwsImageBuilderInfrastructureConfiguration(),
@@ -307,6 +333,7 @@ func Provider() *schema.Provider {
 			"aws_network_acls":                               dataSourceAwsNetworkAcls(),
 			"aws_network_interface":                          dataSourceAwsNetworkInterface(),
 			"aws_network_interfaces":                         dataSourceAwsNetworkInterfaces(),
+			"aws_organizations_delegated_administrators":     dataSourceAwsOrganizationsDelegatedAdministrators(),
 			"aws_organizations_organization":                 dataSourceAwsOrganizationsOrganization(),
 			"aws_organizations_organizational_units":         dataSourceAwsOrganizationsOrganizationalUnits(),
 			"aws_outposts_outpost":                           dataSourceAwsOutpostsOutpost(),
@@ -316,6 +343,7 @@ func Provider() *schema.Provider {
 			"aws_outposts_site":                              dataSourceAwsOutpostsSite(),
 			"aws_outposts_sites":                             dataSourceAwsOutpostsSites(),
 			"aws_partition":                                  dataSourceAwsPartition(),
+			"aws_placement_group":                            dataSourceAwsPlacementGroup(),
 			"aws_prefix_list":                                dataSourceAwsPrefixList(),
 			"aws_pricing_product":                            dataSourceAwsPricingProduct(),
 			"aws_qldb_ledger":                                dataSourceAwsQLDBLedger(),
@@ -329,6 +357,7 @@ func Provider() *schema.Provider {
 			"aws_redshift_service_account":                   dataSourceAwsRedshiftServiceAccount(),
 			"aws_region":                                     dataSourceAwsRegion(),
 			"aws_regions":                                    dataSourceAwsRegions(),
+			"aws_resourcegroupstaggingapi_resources":         dataSourceAwsResourceGroupsTaggingAPIResources(),
 			"aws_route":                                      dataSourceAwsRoute(),
 			"aws_route_table":                                dataSourceAwsRouteTable(),
 			"aws_route_tables":                               dataSourceAwsRouteTables(),
@@ -344,6 +373,9 @@ func Provider() *schema.Provider {
 			"aws_secretsmanager_secret":                      dataSourceAwsSecretsManagerSecret(),
 			"aws_secretsmanager_secret_rotation":             dataSourceAwsSecretsManagerSecretRotation(),
 			"aws_secretsmanager_secret_version":              dataSourceAwsSecretsManagerSecretVersion(),
+			"aws_service_discovery_dns_namespace":            dataSourceAwsServiceDiscoveryDnsNamespace(),
+			"aws_service_discovery_http_namespace":           dataSourceAwsServiceDiscoveryHttpNamespace(),
+			"aws_service_discovery_service":                  dataSourceAwsServiceDiscoveryService(),
 			"aws_servicequotas_service":                      dataSourceAwsServiceQuotasService(),
 			"aws_servicequotas_service_quota":                dataSourceAwsServiceQuotasServiceQuota(),
 			"aws_sfn_activity":                               dataSourceAwsSfnActivity(),
@@ -355,6 +387,7 @@ func Provider() *schema.Provider {
 			"aws_ssm_document":                               dataSourceAwsSsmDocument(),
 			"aws_ssm_parameter":                              dataSourceAwsSsmParameter(),
 			"aws_ssm_patch_baseline":                         dataSourceAwsSsmPatchBaseline(),
+			"aws_ssoadmin_instances":                         dataSourceAwsSsoAdminInstances(),
 			"aws_storagegateway_local_disk":                  dataSourceAwsStorageGatewayLocalDisk(),
 			"aws_subnet":                                     dataSourceAwsSubnet(),
 			"aws_subnet_ids":                                 dataSourceAwsSubnetIDs(),
@@ -397,6 +430,8 @@ func Provider() *schema.Provider {
 
 		ResourcesMap: map[string]*schema.Resource{
 			"aws_accessanalyzer_analyzer":                             resourceAwsAccessAnalyzerAnalyzer(),
+			"aws_accessanalyzer_archive_rule":                         resourceAwsAccessAnalyzerArchiveRule(),
+			"aws_account_alternate_contact":                           resourceAwsAccountAlternateContact(),
 			"aws_acm_certificate":                                     resourceAwsAcmCertificate(),
 			"aws_acm_certificate_validation":                          resourceAwsAcmCertificateValidation(),
 			"aws_acmpca_certificate_authority":                        resourceAwsAcmpcaCertificateAuthority(),
@@ -404,6 +439,10 @@ func Provider() *schema.Provider {
 			"aws_ami_copy":                                            resourceAwsAmiCopy(),
 			"aws_ami_from_instance":                                   resourceAwsAmiFromInstance(),
 			"aws_ami_launch_permission":                               resourceAwsAmiLaunchPermission(),
+			"aws_amplify_app":                                         resourceAwsAmplifyApp(),
+			"aws_amplify_branch":                                      resourceAwsAmplifyBranch(),
+			"aws_amplify_domain_association":                          resourceAwsAmplifyDomainAssociation(),
+			"aws_amplify_webhook":                                     resourceAwsAmplifyWebhook(),
 			"aws_api_gateway_account":                                 resourceAwsApiGatewayAccount(),
 			"aws_api_gateway_api_key":                                 resourceAwsApiGatewayApiKey(),
 			"aws_api_gateway_authorizer":                              resourceAwsApiGatewayAuthorizer(),
@@ -444,6 +483,12 @@ func Provider() *schema.Provider {
 			"aws_appautoscaling_target":                               resourceAwsAppautoscalingTarget(),
 			"aws_appautoscaling_policy":                               resourceAwsAppautoscalingPolicy(),
 			"aws_appautoscaling_scheduled_action":                     resourceAwsAppautoscalingScheduledAction(),
+			"aws_appconfig_application":                               resourceAwsAppconfigApplication(),
+			"aws_appconfig_configuration_profile":                     resourceAwsAppconfigConfigurationProfile(),
+			"aws_appconfig_deployment":                                resourceAwsAppconfigDeployment(),
+			"aws_appconfig_deployment_strategy":                       resourceAwsAppconfigDeploymentStrategy(),
+			"aws_appconfig_environment":                               resourceAwsAppconfigEnvironment(),
+			"aws_appconfig_hosted_configuration_version":              resourceAwsAppconfigHostedConfigurationVersion(),
 			"aws_appmesh_gateway_route":                               resourceAwsAppmeshGatewayRoute(),
 			"aws_appmesh_mesh":                                        resourceAwsAppmeshMesh(),
 			"aws_appmesh_route":                                       resourceAwsAppmeshRoute(),
@@ -451,6 +496,10 @@ func Provider() *schema.Provider {
 			"aws_appmesh_virtual_node":                                resourceAwsAppmeshVirtualNode(),
 			"aws_appmesh_virtual_router":                              resourceAwsAppmeshVirtualRouter(),
 			"aws_appmesh_virtual_service":                             resourceAwsAppmeshVirtualService(),
+			"aws_apprunner_auto_scaling_configuration_version":        resourceAwsAppRunnerAutoScalingConfigurationVersion(),
+			"aws_apprunner_connection":                                resourceAwsAppRunnerConnection(),
+			"aws_apprunner_custom_domain_association":                 resourceAwsAppRunnerCustomDomainAssociation(),
+			"aws_apprunner_service":                                   resourceAwsAppRunnerService(),
 			"aws_appsync_api_key":                                     resourceAwsAppsyncApiKey(),
 			"aws_appsync_datasource":                                  resourceAwsAppsyncDatasource(),
 			"aws_appsync_function":                                    resourceAwsAppsyncFunction(),
@@ -458,6 +507,7 @@ func Provider() *schema.Provider {
 			"aws_appsync_resolver":                                    resourceAwsAppsyncResolver(),
 			"aws_athena_database":                                     resourceAwsAthenaDatabase(),
 			"aws_athena_named_query":                                  resourceAwsAthenaNamedQuery(),
+			"aws_athena_prepared_statement":                           resourceAwsAthenaPreparedStatement(),
 			"aws_athena_workgroup":                                    resourceAwsAthenaWorkgroup(),
 			"aws_autoscaling_attachment":                              resourceAwsAutoscalingAttachment(),
 			"aws_autoscaling_group":                                   resourceAwsAutoscalingGroup(),
@@ -473,18 +523,26 @@ func Provider() *schema.Provider {
 			"aws_backup_vault_notifications":                          resourceAwsBackupVaultNotifications(),
 			"aws_backup_vault_policy":                                 resourceAwsBackupVaultPolicy(),
 			"aws_budgets_budget":                                      resourceAwsBudgetsBudget(),
+			"aws_budgets_budget_action":                               resourceAwsBudgetsBudgetAction(),
+			"aws_ce_cost_category":                                    resourceAwsCECostCategory(),
 			"aws_cloud9_environment_ec2":                              resourceAwsCloud9EnvironmentEc2(),
 			"aws_cloudformation_stack":                                resourceAwsCloudFormationStack(),
 			"aws_cloudformation_stack_set":                            resourceAwsCloudFormationStackSet(),
 			"aws_cloudformation_stack_set_instance":                   resourceAwsCloudFormationStackSetInstance(),
+			"aws_cloudformation_type":                                 resourceAwsCloudFormationType(),
+			"aws_cloudformation_type_default_version":                 resourceAwsCloudFormationTypeDefaultVersion(),
 			"aws_cloudfront_distribution":                             resourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_origin_access_control":                    resourceAwsCloudFrontOriginAccessControl(),
 			"aws_cloudfront_origin_access_identity":                   resourceAwsCloudFrontOriginAccessIdentity(),
 			"aws_cloudfront_public_key":                               resourceAwsCloudFrontPublicKey(),
 			"aws_cloudtrail":                                          resourceAwsCloudTrail(),
+			"aws_cloudwatch_event_api_destination":                    resourceAwsCloudWatchEventApiDestination(),
 			"aws_cloudwatch_event_bus":                                resourceAwsCloudWatchEventBus(),
+			"aws_cloudwatch_event_connection":                         resourceAwsCloudWatchEventConnection(),
 			"aws_cloudwatch_event_permission":                         resourceAwsCloudWatchEventPermission(),
 			"aws_cloudwatch_event_rule":                               resourceAwsCloudWatchEventRule(),
 			"aws_cloudwatch_event_target":                             resourceAwsCloudWatchEventTarget(),
+			"aws_cloudwatch_log_data_protection_policy":               resourceAwsCloudWatchLogDataProtectionPolicy(),
 			"aws_cloudwatch_log_destination":                          resourceAwsCloudWatchLogDestination(),
 			"aws_cloudwatch_log_destination_policy":                   resourceAwsCloudWatchLogDestinationPolicy(),
 			"aws_cloudwatch_log_group":                                resourceAwsCloudWatchLogGroup(),
@@ -497,10 +555,13 @@ func Provider() *schema.Provider {
 			"aws_config_configuration_aggregator":                     resourceAwsConfigConfigurationAggregator(),
 			"aws_config_configuration_recorder":                       resourceAwsConfigConfigurationRecorder(),
 			"aws_config_configuration_recorder_status":                resourceAwsConfigConfigurationRecorderStatus(),
+			"aws_config_conformance_pack":                             resourceAwsConfigConformancePack(),
 			"aws_config_delivery_channel":                             resourceAwsConfigDeliveryChannel(),
 			"aws_config_organization_custom_rule":                     resourceAwsConfigOrganizationCustomRule(),
 			"aws_config_organization_managed_rule":                    resourceAwsConfigOrganizationManagedRule(),
 			"aws_config_remediation_configuration":                    resourceAwsConfigRemediationConfiguration(),
+			"aws_connect_contact_flow":                                resourceAwsConnectContactFlow(),
+			"aws_connect_instance":                                    resourceAwsConnectInstance(),
 			"aws_cognito_identity_pool":                               resourceAwsCognitoIdentityPool(),
 			"aws_cognito_identity_pool_roles_attachment":              resourceAwsCognitoIdentityPoolRolesAttachment(),
 			"aws_cognito_identity_provider":                           resourceAwsCognitoIdentityProvider(),
@@ -535,6 +596,7 @@ func Provider() *schema.Provider {
 			"aws_datapipeline_pipeline":                               resourceAwsDataPipelinePipeline(),
 			"aws_datasync_agent":                                      resourceAwsDataSyncAgent(),
 			"aws_datasync_location_efs":                               resourceAwsDataSyncLocationEfs(),
+			"aws_datasync_location_fsx_lustre_file_system":            resourceAwsDataSyncLocationFsxLustreFileSystem(),
 			"aws_datasync_location_fsx_windows_file_system":           resourceAwsDataSyncLocationFsxWindowsFileSystem(),
 			"aws_datasync_location_nfs":                               resourceAwsDataSyncLocationNfs(),
 			"aws_datasync_location_s3":                                resourceAwsDataSyncLocationS3(),
@@ -555,6 +617,9 @@ func Provider() *schema.Provider {
 			"aws_db_security_group":                                   resourceAwsDbSecurityGroup(),
 			"aws_db_snapshot":                                         resourceAwsDbSnapshot(),
 			"aws_db_subnet_group":                                     resourceAwsDbSubnetGroup(),
+			"aws_detective_graph":                                     resourceAwsDetectiveGraph(),
+			"aws_detective_invitation_accepter":                       resourceAwsDetectiveInvitationAccepter(),
+			"aws_detective_member":                                    resourceAwsDetectiveMember(),
 			"aws_devicefarm_project":                                  resourceAwsDevicefarmProject(),
 			"aws_directory_service_directory":                         resourceAwsDirectoryServiceDirectory(),
 			"aws_directory_service_conditional_forwarder":             resourceAwsDirectoryServiceConditionalForwarder(),
@@ -570,6 +635,7 @@ func Provider() *schema.Provider {
 			"aws_docdb_cluster_instance":                              resourceAwsDocDBClusterInstance(),
 			"aws_docdb_cluster_parameter_group":                       resourceAwsDocDBClusterParameterGroup(),
 			"aws_docdb_cluster_snapshot":                              resourceAwsDocDBClusterSnapshot(),
+			"aws_docdb_global_cluster":                                resourceAwsDocDBGlobalCluster(),
 			"aws_docdb_subnet_group":                                  resourceAwsDocDBSubnetGroup(),
 			"aws_dx_bgp_peer":                                         resourceAwsDxBgpPeer(),
 			"aws_dx_connection":                                       resourceAwsDxConnection(),
@@ -584,6 +650,7 @@ func Provider() *schema.Provider {
 			"aws_dx_hosted_transit_virtual_interface":                 resourceAwsDxHostedTransitVirtualInterface(),
 			"aws_dx_hosted_transit_virtual_interface_accepter":        resourceAwsDxHostedTransitVirtualInterfaceAccepter(),
 			"aws_dx_lag":                                              resourceAwsDxLag(),
+			"aws_dx_macsec_key_association":                           resourceAwsDxMacSecKeyAssociation(),
 			"aws_dx_private_virtual_interface":                        resourceAwsDxPrivateVirtualInterface(),
 			"aws_dx_public_virtual_interface":                         resourceAwsDxPublicVirtualInterface(),
 			"aws_dx_transit_virtual_interface":                        resourceAwsDxTransitVirtualInterface(),
@@ -592,6 +659,7 @@ func Provider() *schema.Provider {
 			"aws_dynamodb_global_table":                               resourceAwsDynamoDbGlobalTable(),
 			"aws_ebs_default_kms_key":                                 resourceAwsEbsDefaultKmsKey(),
 			"aws_ebs_encryption_by_default":                           resourceAwsEbsEncryptionByDefault(),
+			"aws_ebs_fast_snapshot_restore":                           resourceAwsEbsFastSnapshotRestore(),
 			"aws_ebs_snapshot":                                        resourceAwsEbsSnapshot(),
 			"aws_ebs_snapshot_copy":                                   resourceAwsEbsSnapshotCopy(),
 			"aws_ebs_volume":                                          resourceAwsEbsVolume(),
@@ -606,12 +674,19 @@ func Provider() *schema.Provider {
 			"aws_ec2_local_gateway_route":                             resourceAwsEc2LocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":       resourceAwsEc2LocalGatewayRouteTableVpcAssociation(),
 			"aws_ec2_managed_prefix_list":                             resourceAwsEc2ManagedPrefixList(),
+			"aws_ec2_serial_console_access":                           resourceAwsEc2SerialConsoleAccess(),
 			"aws_ec2_tag":                                             resourceAwsEc2Tag(),
 			"aws_ec2_traffic_mirror_filter":                           resourceAwsEc2TrafficMirrorFilter(),
 			"aws_ec2_traffic_mirror_filter_rule":                      resourceAwsEc2TrafficMirrorFilterRule(),
 			"aws_ec2_traffic_mirror_target":                           resourceAwsEc2TrafficMirrorTarget(),
 			"aws_ec2_traffic_mirror_session":                          resourceAwsEc2TrafficMirrorSession(),
 			"aws_ec2_transit_gateway":                                 resourceAwsEc2TransitGateway(),
+			"aws_ec2_transit_gateway_connect":                         resourceAwsEc2TransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                    resourceAwsEc2TransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_multicast_domain":                resourceAwsEc2TransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_multicast_domain_association":    resourceAwsEc2TransitGatewayMulticastDomainAssociation(),
+			"aws_ec2_transit_gateway_multicast_group_member":          resourceAwsEc2TransitGatewayMulticastGroupMember(),
+			"aws_ec2_transit_gateway_multicast_group_source":          resourceAwsEc2TransitGatewayMulticastGroupSource(),
 			"aws_ec2_transit_gateway_peering_attachment":              resourceAwsEc2TransitGatewayPeeringAttachment(),
 			"aws_ec2_transit_gateway_peering_attachment_accepter":     resourceAwsEc2TransitGatewayPeeringAttachmentAccepter(),
 			"aws_ec2_transit_gateway_route":                           resourceAwsEc2TransitGatewayRoute(),
@@ -657,10 +732,13 @@ func Provider() *schema.Provider {
 			"aws_emr_instance_fleet":                                  resourceAwsEMRInstanceFleet(),
 			"aws_emr_managed_scaling_policy":                          resourceAwsEMRManagedScalingPolicy(),
 			"aws_emr_security_configuration":                          resourceAwsEMRSecurityConfiguration(),
+			"aws_emr_studio":                                          resourceAwsEMRStudio(),
+			"aws_emr_studio_session_mapping":                          resourceAwsEMRStudioSessionMapping(),
 			"aws_flow_log":                                            resourceAwsFlowLog(),
 			"aws_fsx_lustre_file_system":                              resourceAwsFsxLustreFileSystem(),
 			"aws_fsx_windows_file_system":                             resourceAwsFsxWindowsFileSystem(),
 			"aws_fms_admin_account":                                   resourceAwsFmsAdminAccount(),
+			"aws_fms_policy":                                          resourceAwsFmsPolicy(),
 			"aws_gamelift_alias":                                      resourceAwsGameliftAlias(),
 			"aws_gamelift_build":                                      resourceAwsGameliftBuild(),
 			"aws_gamelift_fleet":                                      resourceAwsGameliftFleet(),
@@ -668,6 +746,10 @@ func Provider() *schema.Provider {
 			"aws_glacier_vault":                                       resourceAwsGlacierVault(),
 			"aws_glacier_vault_lock":                                  resourceAwsGlacierVaultLock(),
 			"aws_globalaccelerator_accelerator":                       resourceAwsGlobalAcceleratorAccelerator(),
+			"aws_globalaccelerator_custom_routing_accelerator":        resourceAwsGlobalAcceleratorCustomRoutingAccelerator(),
+			"aws_globalaccelerator_custom_routing_endpoint_group":     resourceAwsGlobalAcceleratorCustomRoutingEndpointGroup(),
+			"aws_globalaccelerator_custom_routing_endpoint_traffic":   resourceAwsGlobalAcceleratorCustomRoutingEndpointTraffic(),
+			"aws_globalaccelerator_custom_routing_listener":           resourceAwsGlobalAcceleratorCustomRoutingListener(),
 			"aws_globalaccelerator_endpoint_group":                    resourceAwsGlobalAcceleratorEndpointGroup(),
 			"aws_globalaccelerator_listener":                          resourceAwsGlobalAcceleratorListener(),
 			"aws_glue_catalog_database":                               resourceAwsGlueCatalogDatabase(),
@@ -687,6 +769,7 @@ func Provider() *schema.Provider {
 			"aws_glue_trigger":                                        resourceAwsGlueTrigger(),
 			"aws_glue_user_defined_function":                          resourceAwsGlueUserDefinedFunction(),
 			"aws_glue_workflow":                                       resourceAwsGlueWorkflow(),
+			"aws_grafana_workspace":                                   resourceAwsGrafanaWorkspace(),
 			"aws_guardduty_detector":                                  resourceAwsGuardDutyDetector(),
 			"aws_guardduty_filter":                                    resourceAwsGuardDutyFilter(),
 			"aws_guardduty_invite_accepter":                           resourceAwsGuardDutyInviteAccepter(),
@@ -727,12 +810,18 @@ func Provider() *schema.Provider {
 			"aws_inspector_assessment_target":                         resourceAWSInspectorAssessmentTarget(),
 			"aws_inspector_assessment_template":                       resourceAWSInspectorAssessmentTemplate(),
 			"aws_inspector_resource_group":                            resourceAWSInspectorResourceGroup(),
+			"aws_inspector2_delegated_admin_account":                  resourceAwsInspector2DelegatedAdminAccount(),
+			"aws_inspector2_enabler":                                  resourceAwsInspector2Enabler(),
+			"aws_inspector2_organization_configuration":               resourceAwsInspector2OrganizationConfiguration(),
 			"aws_instance":                                            resourceAwsInstance(),
 			"aws_internet_gateway":                                    resourceAwsInternetGateway(),
 			"aws_iot_certificate":                                     resourceAwsIotCertificate(),
+			"aws_iot_indexing_configuration":                          resourceAwsIotIndexingConfiguration(),
 			"aws_iot_policy":                                          resourceAwsIotPolicy(),
 			"aws_iot_policy_attachment":                               resourceAwsIotPolicyAttachment(),
 			"aws_iot_thing":                                           resourceAwsIotThing(),
+			"aws_iot_thing_group":                                     resourceAwsIotThingGroup(),
+			"aws_iot_thing_group_membership":                          resourceAwsIotThingGroupMembership(),
 			"aws_iot_thing_principal_attachment":                      resourceAwsIotThingPrincipalAttachment(),
 			"aws_iot_thing_type":                                      resourceAwsIotThingType(),
 			"aws_iot_topic_rule":                                      resourceAwsIotTopicRule(),
@@ -740,8 +829,10 @@ func Provider() *schema.Provider {
 			"aws_key_pair":                                            resourceAwsKeyPair(),
 			"aws_kinesis_analytics_application":                       resourceAwsKinesisAnalyticsApplication(),
 			"aws_kinesisanalyticsv2_application":                      resourceAwsKinesisAnalyticsV2Application(),
+			"aws_kinesisanalyticsv2_application_snapshot":             resourceAwsKinesisAnalyticsV2ApplicationSnapshot(),
 			"aws_kinesis_firehose_delivery_stream":                    resourceAwsKinesisFirehoseDeliveryStream(),
 			"aws_kinesis_stream":                                      resourceAwsKinesisStream(),
+			"aws_kinesis_stream_consumer":                             resourceAwsKinesisStreamConsumer(),
 			"aws_kinesis_video_stream":                                resourceAwsKinesisVideoStream(),
 			"aws_kms_alias":                                           resourceAwsKmsAlias(),
 			"aws_kms_external_key":                                    resourceAwsKmsExternalKey(),
@@ -749,8 +840,10 @@ func Provider() *schema.Provider {
 			"aws_kms_key":                                             resourceAwsKmsKey(),
 			"aws_kms_ciphertext":                                      resourceAwsKmsCiphertext(),
 			"aws_lakeformation_data_lake_settings":                    resourceAwsLakeFormationDataLakeSettings(),
+			"aws_lakeformation_lf_tag":                                resourceAwsLakeFormationLFTag(),
 			"aws_lakeformation_permissions":                           resourceAwsLakeFormationPermissions(),
 			"aws_lakeformation_resource":                              resourceAwsLakeFormationResource(),
+			"aws_lakeformation_resource_lf_tags":                      resourceAwsLakeFormationResourceLFTags(),
 			"aws_lambda_alias":                                        resourceAwsLambdaAlias(),
 			"aws_lambda_code_signing_config":                          resourceAwsLambdaCodeSigningConfig(),
 			"aws_lambda_event_source_mapping":                         resourceAwsLambdaEventSourceMapping(),
@@ -767,6 +860,8 @@ func Provider() *schema.Provider {
 			"aws_lex_slot_type":                                       resourceAwsLexSlotType(),
 			"aws_licensemanager_association":                          resourceAwsLicenseManagerAssociation(),
 			"aws_licensemanager_license_configuration":                resourceAwsLicenseManagerLicenseConfiguration(),
+			"aws_lightsail_container_service":                         resourceAwsLightsailContainerService(),
+			"aws_lightsail_container_service_deployment_version":      resourceAwsLightsailContainerServiceDeploymentVersion(),
 			"aws_lightsail_domain":                                    resourceAwsLightsailDomain(),
 			"aws_lightsail_instance":                                  resourceAwsLightsailInstance(),
 			"aws_lightsail_key_pair":                                  resourceAwsLightsailKeyPair(),
@@ -779,6 +874,9 @@ func Provider() *schema.Provider {
 			"aws_lb_ssl_negotiation_policy":                           resourceAwsLBSSLNegotiationPolicy(),
 			"aws_macie_member_account_association":                    resourceAwsMacieMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":                         resourceAwsMacieS3BucketAssociation(),
+			"aws_macie2_account":                                      resourceAwsMacie2Account(),
+			"aws_macie2_classification_job":                           resourceAwsMacie2ClassificationJob(),
+			"aws_macie2_custom_data_identifier":                       resourceAwsMacie2CustomDataIdentifier(),
 			"aws_main_route_table_association":                        resourceAwsMainRouteTableAssociation(),
 			"aws_mq_broker":                                           resourceAwsMqBroker(),
 			"aws_mq_configuration":                                    resourceAwsMqConfiguration(),
@@ -786,9 +884,15 @@ func Provider() *schema.Provider {
 			"aws_media_package_channel":                               resourceAwsMediaPackageChannel(),
 			"aws_media_store_container":                               resourceAwsMediaStoreContainer(),
 			"aws_media_store_container_policy":                        resourceAwsMediaStoreContainerPolicy(),
+			"aws_memorydb_acl":                                        resourceAwsMemoryDbACL(),
+			"aws_memorydb_cluster":                                    resourceAwsMemoryDbCluster(),
+			"aws_memorydb_parameter_group":                            resourceAwsMemoryDbParameterGroup(),
+			"aws_memorydb_subnet_group":                               resourceAwsMemoryDbSubnetGroup(),
+			"aws_memorydb_user":                                       resourceAwsMemoryDbUser(),
 			"aws_msk_cluster":                                         resourceAwsMskCluster(),
 			"aws_msk_configuration":                                   resourceAwsMskConfiguration(),
 			"aws_msk_scram_secret_association":                        resourceAwsMskScramSecretAssociation(),
+			"aws_mwaa_environment":                                    resourceAwsMwaaEnvironment(),
 			"aws_nat_gateway":                                         resourceAwsNatGateway(),
 			"aws_network_acl":                                         resourceAwsNetworkAcl(),
 			"aws_default_network_acl":                                 resourceAwsDefaultNetworkAcl(),
@@ -825,13 +929,19 @@ func Provider() *schema.Provider {
 			"aws_opsworks_rds_db_instance":                            resourceAwsOpsworksRdsDbInstance(),
 			"aws_organizations_organization":                          resourceAwsOrganizationsOrganization(),
 			"aws_organizations_account":                               resourceAwsOrganizationsAccount(),
+			"aws_organizations_delegated_administrator":               resourceAwsOrganizationsDelegatedAdministrator(),
 			"aws_organizations_policy":                                resourceAwsOrganizationsPolicy(),
 			"aws_organizations_policy_attachment":                     resourceAwsOrganizationsPolicyAttachment(),
 			"aws_organizations_organizational_unit":                   resourceAwsOrganizationsOrganizationalUnit(),
 			"aws_placement_group":                                     resourceAwsPlacementGroup(),
+			"aws_prometheus_alert_manager_definition":                 resourceAwsPrometheusAlertManagerDefinition(),
+			"aws_prometheus_rule_group_namespace":                     resourceAwsPrometheusRuleGroupNamespace(),
+			"aws_prometheus_workspace":                                resourceAwsPrometheusWorkspace(),
 			"aws_proxy_protocol_policy":                               resourceAwsProxyProtocolPolicy(),
 			"aws_qldb_ledger":                                         resourceAwsQLDBLedger(),
+			"aws_quicksight_data_source":                              resourceAwsQuickSightDataSource(),
 			"aws_quicksight_group":                                    resourceAwsQuickSightGroup(),
+			"aws_quicksight_group_membership":                         resourceAwsQuickSightGroupMembership(),
 			"aws_quicksight_user":                                     resourceAwsQuickSightUser(),
 			"aws_ram_principal_association":                           resourceAwsRamPrincipalAssociation(),
 			"aws_ram_resource_association":                            resourceAwsRamResourceAssociation(),
@@ -891,6 +1001,9 @@ func Provider() *schema.Provider {
 			"aws_ses_event_destination":                               resourceAwsSesEventDestination(),
 			"aws_ses_identity_notification_topic":                     resourceAwsSesNotificationTopic(),
 			"aws_ses_template":                                        resourceAwsSesTemplate(),
+			"aws_sesv2_configuration_set":                             resourceAwsSesV2ConfigurationSet(),
+			"aws_sesv2_configuration_set_event_destination":           resourceAwsSesV2ConfigurationSetEventDestination(),
+			"aws_sesv2_dedicated_ip_pool":                             resourceAwsSesV2DedicatedIpPool(),
 			"aws_s3_access_point":                                     resourceAwsS3AccessPoint(),
 			"aws_s3_account_public_access_block":                      resourceAwsS3AccountPublicAccessBlock(),
 			"aws_s3_bucket":                                           resourceAwsS3Bucket(),
@@ -914,14 +1027,19 @@ func Provider() *schema.Provider {
 			"aws_securityhub_action_target":                           resourceAwsSecurityHubActionTarget(),
 			"aws_securityhub_member":                                  resourceAwsSecurityHubMember(),
 			"aws_securityhub_product_subscription":                    resourceAwsSecurityHubProductSubscription(),
+			"aws_securityhub_standards_control":                       resourceAwsSecurityHubStandardsControl(),
 			"aws_securityhub_standards_subscription":                  resourceAwsSecurityHubStandardsSubscription(),
 			"aws_servicecatalog_portfolio":                            resourceAwsServiceCatalogPortfolio(),
+			"aws_servicecatalog_portfolio_share":                      resourceAwsServiceCatalogPortfolioShare(),
+			"aws_servicecatalog_provisioned_product":                  resourceAwsServiceCatalogProvisionedProduct(),
 			"aws_service_discovery_http_namespace":                    resourceAwsServiceDiscoveryHttpNamespace(),
 			"aws_service_discovery_private_dns_namespace":             resourceAwsServiceDiscoveryPrivateDnsNamespace(),
 			"aws_service_discovery_public_dns_namespace":              resourceAwsServiceDiscoveryPublicDnsNamespace(),
 			"aws_service_discovery_service":                           resourceAwsServiceDiscoveryService(),
 			"aws_servicequotas_service_quota":                         resourceAwsServiceQuotasServiceQuota(),
+			"aws_shield_proactive_engagement":                         resourceAwsShieldProactiveEngagement(),
 			"aws_shield_protection":                                   resourceAwsShieldProtection(),
+			"aws_shield_protection_group":                             resourceAwsShieldProtectionGroup(),
 			"aws_signer_signing_job":                                  resourceAwsSignerSigningJob(),
 			"aws_signer_signing_profile":                              resourceAwsSignerSigningProfile(),
 			"aws_signer_signing_profile_permission":                   resourceAwsSignerSigningProfilePermission(),
@@ -936,8 +1054,13 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_group":                                     resourceAwsSsmPatchGroup(),
 			"aws_ssm_parameter":                                       resourceAwsSsmParameter(),
 			"aws_ssm_resource_data_sync":                              resourceAwsSsmResourceDataSync(),
+			"aws_ssoadmin_account_assignment":                         resourceAwsSsoAdminAccountAssignment(),
+			"aws_ssoadmin_managed_policy_attachment":                  resourceAwsSsoAdminManagedPolicyAttachment(),
+			"aws_ssoadmin_permission_set":                             resourceAwsSsoAdminPermissionSet(),
+			"aws_ssoadmin_permission_set_inline_policy":               resourceAwsSsoAdminPermissionSetInlinePolicy(),
 			"aws_storagegateway_cache":                                resourceAwsStorageGatewayCache(),
 			"aws_storagegateway_cached_iscsi_volume":                  resourceAwsStorageGatewayCachedIscsiVolume(),
+			"aws_storagegateway_file_system_association":              resourceAwsStorageGatewayFileSystemAssociation(),
 			"aws_storagegateway_gateway":                              resourceAwsStorageGatewayGateway(),
 			"aws_storagegateway_nfs_file_share":                       resourceAwsStorageGatewayNfsFileShare(),
 			"aws_storagegateway_smb_file_share":                       resourceAwsStorageGatewaySmbFileShare(),
@@ -961,6 +1084,9 @@ func Provider() *schema.Provider {
 			"aws_default_subnet":                                      resourceAwsDefaultSubnet(),
 			"aws_subnet":                                              resourceAwsSubnet(),
 			"aws_swf_domain":                                          resourceAwsSwfDomain(),
+			"aws_synthetics_canary":                                   resourceAwsSyntheticsCanary(),
+			"aws_timestreamwrite_database":                            resourceAwsTimestreamWriteDatabase(),
+			"aws_timestreamwrite_table":                               resourceAwsTimestreamWriteTable(),
 			"aws_transfer_server":                                     resourceAwsTransferServer(),
 			"aws_transfer_ssh_key":                                    resourceAwsTransferSshKey(),
 			"aws_transfer_user":                                       resourceAwsTransferUser(),
@@ -1131,13 +1257,16 @@ func init() {
 
 	endpointServiceNames = []string{
 		"accessanalyzer",
+		"account",
 		"acm",
 		"acmpca",
 		"amplify",
 		"apigateway",
+		"appconfig",
 		"applicationautoscaling",
 		"applicationinsights",
 		"appmesh",
+		"apprunner",
 		"appstream",
 		"appsync",
 		"athena",
@@ -1146,6 +1275,7 @@ func init() {
 		"backup",
 		"batch",
 		"budgets",
+		"ce",
 		"cloud9",
 		"cloudformation",
 		"cloudfront",
@@ -1170,6 +1300,7 @@ func init() {
 		"datapipeline",
 		"datasync",
 		"dax",
+		"detective",
 		"devicefarm",
 		"directconnect",
 		"dlm",
@@ -1203,6 +1334,7 @@ func init() {
 		"identitystore",
 		"imagebuilder",
 		"inspector",
+		"inspector2",
 		"iot",
 		"iotanalytics",
 		"iotevents",
@@ -1220,6 +1352,7 @@ func init() {
 		"macie",
 		"macie2",
 		"managedblockchain",
+		"managedgrafana",
 		"marketplacecatalog",
 		"mediaconnect",
 		"mediaconvert",
@@ -1227,6 +1360,7 @@ func init() {
 		"mediapackage",
 		"mediastore",
 		"mediastoredata",
+		"memorydb",
 		"mq",
 		"mwaa",
 		"neptune",
@@ -1238,6 +1372,7 @@ func init() {
 		"personalize",
 		"pinpoint",
 		"pricing",
+		"prometheusservice",
 		"qldb",
 		"quicksight",
 		"ram",
@@ -1260,6 +1395,7 @@ func init() {
 		"servicediscovery",
 		"servicequotas",
 		"ses",
+		"sesv2",
 		"shield",
 		"signer",
 		"sns",
@@ -1293,6 +1429,7 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		CredsFilename:           d.Get("shared_credentials_file").(string),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
+		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
@@ -1471,6 +1608,21 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandProviderDefaultTags(l []interface{}) *keyvaluetags.DefaultConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	defaultConfig := &keyvaluetags.DefaultConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		defaultConfig.Tags = keyvaluetags.New(v)
+	}
+
+	return defaultConfig
+}
+
 func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
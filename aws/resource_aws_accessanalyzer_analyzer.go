@@ -1,12 +1,15 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -91,6 +94,11 @@ func resourceAwsAccessAnalyzerAnalyzerCreate(d *schema.ResourceData, meta interf
 		_, err = conn.CreateAnalyzer(input)
 	}
 
+	// Organization analyzers require trusted access for Access Analyzer in AWS Organizations.
+	if d.Get("type").(string) == accessanalyzer.TypeOrganization && isAccessAnalyzerOrganizationAccessError(err) {
+		return fmt.Errorf("error creating Access Analyzer Analyzer (%s): ORGANIZATION analyzers must be created from the organization management account or a delegated administrator, with access-analyzer.amazonaws.com enabled in the organization's aws_service_access_principals: %s", analyzerName, err)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error creating Access Analyzer Analyzer (%s): %s", analyzerName, err)
 	}
@@ -169,3 +177,24 @@ func resourceAwsAccessAnalyzerAnalyzerDelete(d *schema.ResourceData, meta interf
 
 	return nil
 }
+
+// isAccessAnalyzerOrganizationAccessError returns true if the error reports that the
+// caller is not trusted to create an organization analyzer, i.e. it is neither the
+// management account nor a delegated administrator, or trusted access is not enabled.
+func isAccessAnalyzerOrganizationAccessError(err error) bool {
+	var awsErr awserr.Error
+
+	if !errors.As(err, &awsErr) || awsErr.Code() != accessanalyzer.ErrCodeValidationException {
+		return false
+	}
+
+	message := strings.ToLower(awsErr.Message())
+
+	for _, s := range []string{"delegated administrator", "management account", "master account", "trusted access"} {
+		if strings.Contains(message, s) {
+			return true
+		}
+	}
+
+	return false
+}
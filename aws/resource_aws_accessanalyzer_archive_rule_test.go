@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfaccessanalyzer "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/accessanalyzer"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/accessanalyzer/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAWSAccessAnalyzerArchiveRule_basic(t *testing.T) {
+	var archiveRule accessanalyzer.ArchiveRuleSummary
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAccessAnalyzer(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAccessAnalyzerArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAccessAnalyzerArchiveRuleConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAccessAnalyzerArchiveRuleExists(resourceName, &archiveRule),
+					resource.TestCheckResourceAttr(resourceName, "analyzer_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria": "isPublic",
+						"eq.#":     "1",
+						"eq.0":     "false",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSAccessAnalyzerArchiveRule_disappears(t *testing.T) {
+	var archiveRule accessanalyzer.ArchiveRuleSummary
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAccessAnalyzer(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAccessAnalyzerArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAccessAnalyzerArchiveRuleConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAccessAnalyzerArchiveRuleExists(resourceName, &archiveRule),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAccessAnalyzerArchiveRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSAccessAnalyzerArchiveRule_updateFilters(t *testing.T) {
+	var archiveRule accessanalyzer.ArchiveRuleSummary
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAccessAnalyzer(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAccessAnalyzerArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAccessAnalyzerArchiveRuleConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAccessAnalyzerArchiveRuleExists(resourceName, &archiveRule),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSAccessAnalyzerArchiveRuleConfigUpdateFilters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAccessAnalyzerArchiveRuleExists(resourceName, &archiveRule),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria": "error",
+						"exists":   "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria": "isPublic",
+						"eq.#":     "1",
+						"eq.0":     "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria":   "resourceType",
+						"neq.#":      "1",
+						"neq.0":      "AWS::S3::Bucket",
+						"contains.#": "0",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessAnalyzerArchiveRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_accessanalyzer_archive_rule" {
+			continue
+		}
+
+		analyzerName, ruleName, err := tfaccessanalyzer.ArchiveRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ArchiveRuleByAnalyzerNameAndRuleName(conn, analyzerName, ruleName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Access Analyzer Archive Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsAccessAnalyzerArchiveRuleExists(n string, v *accessanalyzer.ArchiveRuleSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Access Analyzer Archive Rule ID is set")
+		}
+
+		analyzerName, ruleName, err := tfaccessanalyzer.ArchiveRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn
+
+		output, err := finder.ArchiveRuleByAnalyzerNameAndRuleName(conn, analyzerName, ruleName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSAccessAnalyzerArchiveRuleConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAccessAnalyzerArchiveRuleConfigBasic(rName string) string {
+	return composeConfig(
+		testAccAWSAccessAnalyzerArchiveRuleConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_accessanalyzer_archive_rule" "test" {
+  analyzer_name = aws_accessanalyzer_analyzer.test.analyzer_name
+  rule_name     = %[1]q
+
+  filter {
+    criteria = "isPublic"
+    eq       = ["false"]
+  }
+}
+`, rName))
+}
+
+func testAccAWSAccessAnalyzerArchiveRuleConfigUpdateFilters(rName string) string {
+	return composeConfig(
+		testAccAWSAccessAnalyzerArchiveRuleConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_accessanalyzer_archive_rule" "test" {
+  analyzer_name = aws_accessanalyzer_analyzer.test.analyzer_name
+  rule_name     = %[1]q
+
+  filter {
+    criteria = "error"
+    exists   = true
+  }
+
+  filter {
+    criteria = "isPublic"
+    eq       = ["false"]
+  }
+
+  filter {
+    criteria = "resourceType"
+    neq      = ["AWS::S3::Bucket"]
+  }
+}
+`, rName))
+}
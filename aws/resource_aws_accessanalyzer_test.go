@@ -16,6 +16,11 @@ func TestAccAWSAccessAnalyzer_serial(t *testing.T) {
 			"Tags":              testAccAWSAccessAnalyzerAnalyzer_Tags,
 			"Type_Organization": testAccAWSAccessAnalyzerAnalyzer_Type_Organization,
 		},
+		"ArchiveRule": {
+			"basic":          testAccAWSAccessAnalyzerArchiveRule_basic,
+			"disappears":     testAccAWSAccessAnalyzerArchiveRule_disappears,
+			"update_filters": testAccAWSAccessAnalyzerArchiveRule_updateFilters,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfaccount "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/account"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/account/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAccountAlternateContact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAccountAlternateContactCreate,
+		Read:   resourceAwsAccountAlternateContactRead,
+		Update: resourceAwsAccountAlternateContactUpdate,
+		Delete: resourceAwsAccountAlternateContactDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"alternate_contact_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(account.AlternateContactType_Values(), false),
+			},
+			"email_address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`), "must be a valid email address"),
+				),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\s0-9()+-]+$`), "must be a valid phone number"),
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+		},
+	}
+}
+
+func resourceAwsAccountAlternateContactCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accountconn
+
+	accountID := d.Get("account_id").(string)
+	contactType := d.Get("alternate_contact_type").(string)
+	id := tfaccount.AlternateContactCreateResourceID(accountID, contactType)
+
+	if err := resourceAwsAccountAlternateContactPut(conn, d, accountID, contactType); err != nil {
+		return fmt.Errorf("error creating Account Alternate Contact (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsAccountAlternateContactRead(d, meta)
+}
+
+func resourceAwsAccountAlternateContactRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accountconn
+
+	accountID, contactType, err := tfaccount.AlternateContactParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.AlternateContactByAccountIDAndContactType(conn, accountID, contactType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Account Alternate Contact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Account Alternate Contact (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("alternate_contact_type", output.AlternateContactType)
+	d.Set("email_address", output.EmailAddress)
+	d.Set("name", output.Name)
+	d.Set("phone_number", output.PhoneNumber)
+	d.Set("title", output.Title)
+
+	return nil
+}
+
+func resourceAwsAccountAlternateContactUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accountconn
+
+	accountID, contactType, err := tfaccount.AlternateContactParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if err := resourceAwsAccountAlternateContactPut(conn, d, accountID, contactType); err != nil {
+		return fmt.Errorf("error updating Account Alternate Contact (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsAccountAlternateContactRead(d, meta)
+}
+
+func resourceAwsAccountAlternateContactDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).accountconn
+
+	accountID, contactType, err := tfaccount.AlternateContactParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &account.DeleteAlternateContactInput{
+		AlternateContactType: aws.String(contactType),
+	}
+
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	log.Printf("[DEBUG] Deleting Account Alternate Contact: %s", d.Id())
+	_, err = conn.DeleteAlternateContact(input)
+
+	if tfawserr.ErrCodeEquals(err, account.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Account Alternate Contact (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// PutAlternateContact both creates and replaces the contact of the given type.
+func resourceAwsAccountAlternateContactPut(conn *account.Account, d *schema.ResourceData, accountID, contactType string) error {
+	input := &account.PutAlternateContactInput{
+		AlternateContactType: aws.String(contactType),
+		EmailAddress:         aws.String(d.Get("email_address").(string)),
+		Name:                 aws.String(d.Get("name").(string)),
+		PhoneNumber:          aws.String(d.Get("phone_number").(string)),
+		Title:                aws.String(d.Get("title").(string)),
+	}
+
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	_, err := conn.PutAlternateContact(input)
+
+	return err
+}
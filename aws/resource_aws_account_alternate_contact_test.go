@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfaccount "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/account"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/account/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// Alternate contacts are account-wide singletons per type, so tests must not run in parallel.
+func TestAccAWSAccount_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"AlternateContact": {
+			"basic":      testAccAWSAccountAlternateContact_basic,
+			"disappears": testAccAWSAccountAlternateContact_disappears,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccAWSAccountAlternateContact_basic(t *testing.T) {
+	resourceName := "aws_account_alternate_contact.test"
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	emailAddress1 := fmt.Sprintf("%s@example.com", rName1)
+	emailAddress2 := fmt.Sprintf("%s@example.com", rName2)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAccountAlternateContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAccountAlternateContactConfig(rName1, emailAddress1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAccountAlternateContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "alternate_contact_type", "OPERATIONS"),
+					resource.TestCheckResourceAttr(resourceName, "email_address", emailAddress1),
+					resource.TestCheckResourceAttr(resourceName, "name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "phone_number", "+17031235555"),
+					resource.TestCheckResourceAttr(resourceName, "title", rName1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsAccountAlternateContactConfig(rName2, emailAddress2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAccountAlternateContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "email_address", emailAddress2),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "title", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSAccountAlternateContact_disappears(t *testing.T) {
+	resourceName := "aws_account_alternate_contact.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	emailAddress := fmt.Sprintf("%s@example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAccountAlternateContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAccountAlternateContactConfig(rName, emailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAccountAlternateContactExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAccountAlternateContact(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAccountAlternateContactDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).accountconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_account_alternate_contact" {
+			continue
+		}
+
+		accountID, contactType, err := tfaccount.AlternateContactParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.AlternateContactByAccountIDAndContactType(conn, accountID, contactType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Account Alternate Contact %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsAccountAlternateContactExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Account Alternate Contact ID is set")
+		}
+
+		accountID, contactType, err := tfaccount.AlternateContactParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).accountconn
+
+		_, err = finder.AlternateContactByAccountIDAndContactType(conn, accountID, contactType)
+
+		return err
+	}
+}
+
+func testAccAwsAccountAlternateContactConfig(rName, emailAddress string) string {
+	return fmt.Sprintf(`
+resource "aws_account_alternate_contact" "test" {
+  alternate_contact_type = %[3]q
+
+  email_address = %[2]q
+  name          = %[1]q
+  phone_number  = "+17031235555"
+  title         = %[1]q
+}
+`, rName, emailAddress, account.AlternateContactTypeOperations)
+}
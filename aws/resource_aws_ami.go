@@ -63,6 +63,19 @@ func resourceAwsAmi() *schema.Resource {
 					ec2.ArchitectureValuesArm64,
 				}, false),
 			},
+			"boot_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.BootModeValues_Values(), false),
+			},
+			"deprecation_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateUTCTimestamp,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -165,6 +178,12 @@ func resourceAwsAmi() *schema.Resource {
 					return hashcode.String(buf.String())
 				},
 			},
+			"imds_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.ImdsSupportValues_Values(), false),
+			},
 			"kernel_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -204,6 +223,12 @@ func resourceAwsAmi() *schema.Resource {
 				Default:  "simple",
 			},
 			"tags": tagsSchema(),
+			"tpm_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.TpmSupportValues_Values(), false),
+			},
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -236,12 +261,21 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 		EnaSupport:         aws.Bool(d.Get("ena_support").(bool)),
 	}
 
+	if v, ok := d.GetOk("boot_mode"); ok {
+		req.BootMode = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("imds_support"); ok {
+		req.ImdsSupport = aws.String(v.(string))
+	}
 	if kernelId := d.Get("kernel_id").(string); kernelId != "" {
 		req.KernelId = aws.String(kernelId)
 	}
 	if ramdiskId := d.Get("ramdisk_id").(string); ramdiskId != "" {
 		req.RamdiskId = aws.String(ramdiskId)
 	}
+	if v, ok := d.GetOk("tpm_support"); ok {
+		req.TpmSupport = aws.String(v.(string))
+	}
 
 	ebsBlockDevsSet := d.Get("ebs_block_device").(*schema.Set)
 	ephemeralBlockDevsSet := d.Get("ephemeral_block_device").(*schema.Set)
@@ -303,6 +337,12 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if v, ok := d.GetOk("deprecation_time"); ok {
+		if err := resourceAwsAmiEnableDeprecation(client, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsAmiRead(d, meta)
 }
 
@@ -384,6 +424,10 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("sriov_net_support", image.SriovNetSupport)
 	d.Set("virtualization_type", image.VirtualizationType)
 	d.Set("ena_support", image.EnaSupport)
+	d.Set("boot_mode", image.BootMode)
+	d.Set("deprecation_time", image.DeprecationTime)
+	d.Set("imds_support", image.ImdsSupport)
+	d.Set("tpm_support", image.TpmSupport)
 
 	imageArn := arn.ARN{
 		Partition: meta.(*AWSClient).partition,
@@ -456,9 +500,54 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("deprecation_time") {
+		if v := d.Get("deprecation_time").(string); v != "" {
+			if err := resourceAwsAmiEnableDeprecation(client, d.Id(), v); err != nil {
+				return err
+			}
+		} else {
+			if err := resourceAwsAmiDisableDeprecation(client, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceAwsAmiRead(d, meta)
 }
 
+func resourceAwsAmiEnableDeprecation(conn *ec2.EC2, id string, deprecateAt string) error {
+	v, _ := time.Parse(time.RFC3339, deprecateAt)
+
+	input := &ec2.EnableImageDeprecationInput{
+		DeprecateAt: aws.Time(v),
+		ImageId:     aws.String(id),
+	}
+
+	log.Printf("[DEBUG] Enabling AMI deprecation: %s", input)
+	_, err := conn.EnableImageDeprecation(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling AMI (%s) deprecation: %w", id, err)
+	}
+
+	return nil
+}
+
+func resourceAwsAmiDisableDeprecation(conn *ec2.EC2, id string) error {
+	input := &ec2.DisableImageDeprecationInput{
+		ImageId: aws.String(id),
+	}
+
+	log.Printf("[DEBUG] Disabling AMI deprecation: %s", input)
+	_, err := conn.DisableImageDeprecation(input)
+
+	if err != nil {
+		return fmt.Errorf("error disabling AMI (%s) deprecation: %w", id, err)
+	}
+
+	return nil
+}
+
 func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).ec2conn
 
@@ -26,6 +26,16 @@ func resourceAwsAmiCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"boot_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deprecation_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateUTCTimestamp,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -126,6 +136,10 @@ func resourceAwsAmiCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"imds_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"kernel_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -177,6 +191,10 @@ func resourceAwsAmiCopy() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tagsSchema(),
+			"tpm_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -230,5 +248,11 @@ func resourceAwsAmiCopyCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if v, ok := d.GetOk("deprecation_time"); ok {
+		if err := resourceAwsAmiEnableDeprecation(client, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsAmiRead(d, meta)
 }
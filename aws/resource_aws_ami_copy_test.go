@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -62,6 +63,35 @@ func TestAccAWSAMICopy_Description(t *testing.T) {
 	})
 }
 
+func TestAccAWSAMICopy_DeprecationTime(t *testing.T) {
+	var image ec2.Image
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ami_copy.test"
+	deprecateAt := time.Now().UTC().AddDate(0, 0, 1).Truncate(time.Minute).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAMICopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAMICopyConfigDeprecationTime(rName, deprecateAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAMICopyExists(resourceName, &image),
+					resource.TestCheckResourceAttrSet(resourceName, "deprecation_time"),
+				),
+			},
+			{
+				Config: testAccAWSAMICopyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAMICopyExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAMICopy_EnaSupport(t *testing.T) {
 	var image ec2.Image
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -337,6 +367,28 @@ resource "aws_ami_copy" "test" {
 `, rName, description, rName)
 }
 
+func testAccAWSAMICopyConfigDeprecationTime(rName, deprecationTime string) string {
+	return testAccAWSAMICopyConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ami" "test" {
+  name                = "%[1]s-source"
+  virtualization_type = "hvm"
+  root_device_name    = "/dev/sda1"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = aws_ebs_snapshot.test.id
+  }
+}
+
+resource "aws_ami_copy" "test" {
+  deprecation_time  = %[2]q
+  name              = %[1]q
+  source_ami_id     = aws_ami.test.id
+  source_ami_region = data.aws_region.current.name
+}
+`, rName, deprecationTime)
+}
+
 func testAccAWSAMICopyConfigENASupport(rName string) string {
 	return testAccAWSAMICopyConfigBase(rName) + fmt.Sprintf(`
 resource "aws_ami" "test" {
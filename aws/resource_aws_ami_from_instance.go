@@ -26,6 +26,16 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"boot_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deprecation_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateUTCTimestamp,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -120,6 +130,10 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"imds_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"kernel_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -164,6 +178,10 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tagsSchema(),
+			"tpm_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -212,5 +230,11 @@ func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	if v, ok := d.GetOk("deprecation_time"); ok {
+		if err := resourceAwsAmiEnableDeprecation(client, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsAmiRead(d, meta)
 }
@@ -87,6 +87,83 @@ func TestAccAWSAMI_description(t *testing.T) {
 	})
 }
 
+func TestAccAWSAMI_BootMode(t *testing.T) {
+	var ami ec2.Image
+	resourceName := "aws_ami.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAmiConfigBootMode(rName, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "boot_mode", ec2.BootModeValuesUefi),
+					resource.TestCheckResourceAttr(resourceName, "imds_support", ec2.ImdsSupportValuesV20),
+					resource.TestCheckResourceAttr(resourceName, "tpm_support", ec2.TpmSupportValuesV20),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"manage_ebs_snapshots",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSAMI_DeprecationTime(t *testing.T) {
+	var ami ec2.Image
+	resourceName := "aws_ami.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	deprecateAt := time.Now().UTC().AddDate(0, 0, 1).Truncate(time.Minute).Format(time.RFC3339)
+	deprecateAtUpdated := time.Now().UTC().AddDate(0, 0, 2).Truncate(time.Minute).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAmiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAmiConfigDeprecationTime(rName, deprecateAt, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttrSet(resourceName, "deprecation_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"deprecation_time",
+					"manage_ebs_snapshots",
+				},
+			},
+			{
+				Config: testAccAmiConfigDeprecationTime(rName, deprecateAtUpdated, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttrSet(resourceName, "deprecation_time"),
+				),
+			},
+			{
+				Config: testAccAmiConfigBasic(rName, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAmiExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAMI_disappears(t *testing.T) {
 	var ami ec2.Image
 	resourceName := "aws_ami.test"
@@ -431,3 +508,39 @@ resource "aws_ami" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccAmiConfigBootMode(rName string, size int) string {
+	return testAccAmiConfigBase(rName, size) + fmt.Sprintf(`
+resource "aws_ami" "test" {
+  boot_mode           = "uefi"
+  ena_support         = true
+  imds_support        = "v2.0"
+  name                = %[1]q
+  root_device_name    = "/dev/sda1"
+  tpm_support         = "v2.0"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = aws_ebs_snapshot.test.id
+  }
+}
+`, rName)
+}
+
+func testAccAmiConfigDeprecationTime(rName, deprecationTime string, size int) string {
+	return testAccAmiConfigBase(rName, size) + fmt.Sprintf(`
+resource "aws_ami" "test" {
+  deprecation_time    = %[2]q
+  ena_support         = true
+  name                = %[1]q
+  root_device_name    = "/dev/sda1"
+  virtualization_type = "hvm"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = aws_ebs_snapshot.test.id
+  }
+}
+`, rName, deprecationTime)
+}
@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/amplify"
+)
+
+// Amplify resources are serialized to stay below the per-region app quota.
+func TestAccAWSAmplify_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"App": {
+			"basic":                    testAccAWSAmplifyApp_basic,
+			"disappears":               testAccAWSAmplifyApp_disappears,
+			"tags":                     testAccAWSAmplifyApp_Tags,
+			"AutoBranchCreationConfig": testAccAWSAmplifyApp_AutoBranchCreationConfig,
+			"BasicAuthCredentials":     testAccAWSAmplifyApp_BasicAuthCredentials,
+			"BuildSpec":                testAccAWSAmplifyApp_BuildSpec,
+			"CustomRules":              testAccAWSAmplifyApp_CustomRules,
+			"Description":              testAccAWSAmplifyApp_Description,
+			"EnvironmentVariables":     testAccAWSAmplifyApp_EnvironmentVariables,
+			"IamServiceRole":           testAccAWSAmplifyApp_IamServiceRole,
+			"Name":                     testAccAWSAmplifyApp_Name,
+			"Repository":               testAccAWSAmplifyApp_Repository,
+		},
+		"Branch": {
+			"basic":                testAccAWSAmplifyBranch_basic,
+			"disappears":           testAccAWSAmplifyBranch_disappears,
+			"tags":                 testAccAWSAmplifyBranch_Tags,
+			"BasicAuthCredentials": testAccAWSAmplifyBranch_BasicAuthCredentials,
+			"EnvironmentVariables": testAccAWSAmplifyBranch_EnvironmentVariables,
+			"OptionalArguments":    testAccAWSAmplifyBranch_OptionalArguments,
+		},
+		"DomainAssociation": {
+			"basic":      testAccAWSAmplifyDomainAssociation_basic,
+			"disappears": testAccAWSAmplifyDomainAssociation_disappears,
+			"update":     testAccAWSAmplifyDomainAssociation_update,
+		},
+		"Webhook": {
+			"basic":      testAccAWSAmplifyWebhook_basic,
+			"disappears": testAccAWSAmplifyWebhook_disappears,
+			"update":     testAccAWSAmplifyWebhook_update,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccPreCheckAWSAmplify(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+	input := &amplify.ListAppsInput{}
+
+	_, err := conn.ListApps(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
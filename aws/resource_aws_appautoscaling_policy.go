@@ -115,8 +115,9 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"dimensions": {
-										Type:     schema.TypeSet,
-										Optional: true,
+										Type:          schema.TypeSet,
+										Optional:      true,
+										ConflictsWith: []string{"target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics"},
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"name": {
@@ -131,16 +132,98 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 										},
 									},
 									"metric_name": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										ConflictsWith: []string{"target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics"},
+									},
+									"metrics": {
+										Type:          schema.TypeSet,
+										Optional:      true,
+										ConflictsWith: []string{"target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.dimensions", "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metric_name", "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.namespace", "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.statistic", "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.unit"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"expression": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 2048),
+												},
+												"id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"label": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 2048),
+												},
+												"metric_stat": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"metric": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"dimensions": {
+																			Type:     schema.TypeSet,
+																			Optional: true,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"name": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																					"value": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																				},
+																			},
+																		},
+																		"metric_name": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																		"namespace": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+															"stat": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"unit": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"return_data": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+											},
+										},
 									},
 									"namespace": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										ConflictsWith: []string{"target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics"},
 									},
 									"statistic": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										ConflictsWith: []string{"target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics"},
 										ValidateFunc: validation.StringInSlice([]string{
 											applicationautoscaling.MetricStatisticAverage,
 											applicationautoscaling.MetricStatisticMinimum,
@@ -150,8 +233,9 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 										}, false),
 									},
 									"unit": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										ConflictsWith: []string{"target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics"},
 									},
 								},
 							},
@@ -164,8 +248,9 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"predefined_metric_type": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(applicationautoscaling.MetricType_Values(), false),
 									},
 									"resource_label": {
 										Type:         schema.TypeString,
@@ -481,20 +566,24 @@ func expandAppautoscalingCustomizedMetricSpecification(configured []interface{})
 
 	for _, raw := range configured {
 		data := raw.(map[string]interface{})
-		if v, ok := data["metric_name"]; ok {
-			spec.MetricName = aws.String(v.(string))
+		if v, ok := data["metrics"].(*schema.Set); ok && v.Len() > 0 {
+			spec.Metrics = expandAppautoscalingTargetTrackingMetricDataQueries(v.List())
 		}
 
-		if v, ok := data["namespace"]; ok {
-			spec.Namespace = aws.String(v.(string))
+		if v, ok := data["metric_name"].(string); ok && v != "" {
+			spec.MetricName = aws.String(v)
+		}
+
+		if v, ok := data["namespace"].(string); ok && v != "" {
+			spec.Namespace = aws.String(v)
 		}
 
 		if v, ok := data["unit"].(string); ok && v != "" {
 			spec.Unit = aws.String(v)
 		}
 
-		if v, ok := data["statistic"]; ok {
-			spec.Statistic = aws.String(v.(string))
+		if v, ok := data["statistic"].(string); ok && v != "" {
+			spec.Statistic = aws.String(v)
 		}
 
 		if s, ok := data["dimensions"].(*schema.Set); ok && s.Len() > 0 {
@@ -512,6 +601,77 @@ func expandAppautoscalingCustomizedMetricSpecification(configured []interface{})
 	return spec
 }
 
+func expandAppautoscalingTargetTrackingMetricDataQueries(tfList []interface{}) []*applicationautoscaling.TargetTrackingMetricDataQuery {
+	var apiObjects []*applicationautoscaling.TargetTrackingMetricDataQuery
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &applicationautoscaling.TargetTrackingMetricDataQuery{
+			Id: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["expression"].(string); ok && v != "" {
+			apiObject.Expression = aws.String(v)
+		}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			apiObject.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["metric_stat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.MetricStat = expandAppautoscalingTargetTrackingMetricStat(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["return_data"].(bool); ok {
+			apiObject.ReturnData = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAppautoscalingTargetTrackingMetricStat(tfMap map[string]interface{}) *applicationautoscaling.TargetTrackingMetricStat {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &applicationautoscaling.TargetTrackingMetricStat{
+		Stat: aws.String(tfMap["stat"].(string)),
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		metric := v[0].(map[string]interface{})
+
+		apiObject.Metric = &applicationautoscaling.TargetTrackingMetric{
+			MetricName: aws.String(metric["metric_name"].(string)),
+			Namespace:  aws.String(metric["namespace"].(string)),
+		}
+
+		if s, ok := metric["dimensions"].(*schema.Set); ok && s.Len() > 0 {
+			for _, d := range s.List() {
+				dimension := d.(map[string]interface{})
+				apiObject.Metric.Dimensions = append(apiObject.Metric.Dimensions, &applicationautoscaling.TargetTrackingMetricDimension{
+					Name:  aws.String(dimension["name"].(string)),
+					Value: aws.String(dimension["value"].(string)),
+				})
+			}
+		}
+	}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandAppautoscalingPredefinedMetricSpecification(configured []interface{}) *applicationautoscaling.PredefinedMetricSpecification {
 	spec := &applicationautoscaling.PredefinedMetricSpecification{}
 
@@ -731,22 +891,89 @@ func flattenCustomizedMetricSpecification(cfg *applicationautoscaling.Customized
 		return []interface{}{}
 	}
 
-	m := map[string]interface{}{
-		"metric_name": *cfg.MetricName,
-		"namespace":   *cfg.Namespace,
-		"statistic":   *cfg.Statistic,
+	m := map[string]interface{}{}
+
+	if cfg.MetricName != nil {
+		m["metric_name"] = aws.StringValue(cfg.MetricName)
+	}
+
+	if cfg.Namespace != nil {
+		m["namespace"] = aws.StringValue(cfg.Namespace)
+	}
+
+	if cfg.Statistic != nil {
+		m["statistic"] = aws.StringValue(cfg.Statistic)
 	}
 
 	if len(cfg.Dimensions) > 0 {
 		m["dimensions"] = flattenMetricDimensions(cfg.Dimensions)
 	}
 
+	if len(cfg.Metrics) > 0 {
+		m["metrics"] = flattenAppautoscalingTargetTrackingMetricDataQueries(cfg.Metrics)
+	}
+
 	if cfg.Unit != nil {
 		m["unit"] = *cfg.Unit
 	}
 	return []interface{}{m}
 }
 
+func flattenAppautoscalingTargetTrackingMetricDataQueries(apiObjects []*applicationautoscaling.TargetTrackingMetricDataQuery) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"expression":  aws.StringValue(apiObject.Expression),
+			"id":          aws.StringValue(apiObject.Id),
+			"label":       aws.StringValue(apiObject.Label),
+			"return_data": aws.BoolValue(apiObject.ReturnData),
+		}
+
+		if apiObject.ReturnData == nil {
+			tfMap["return_data"] = true
+		}
+
+		if v := apiObject.MetricStat; v != nil {
+			metricStat := map[string]interface{}{
+				"stat": aws.StringValue(v.Stat),
+				"unit": aws.StringValue(v.Unit),
+			}
+
+			if metric := v.Metric; metric != nil {
+				var dimensions []interface{}
+
+				for _, dimension := range metric.Dimensions {
+					if dimension == nil {
+						continue
+					}
+
+					dimensions = append(dimensions, map[string]interface{}{
+						"name":  aws.StringValue(dimension.Name),
+						"value": aws.StringValue(dimension.Value),
+					})
+				}
+
+				metricStat["metric"] = []interface{}{map[string]interface{}{
+					"dimensions":  dimensions,
+					"metric_name": aws.StringValue(metric.MetricName),
+					"namespace":   aws.StringValue(metric.Namespace),
+				}}
+			}
+
+			tfMap["metric_stat"] = []interface{}{metricStat}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenMetricDimensions(ds []*applicationautoscaling.MetricDimension) []interface{} {
 	l := make([]interface{}, len(ds))
 	for i, d := range ds {
@@ -50,6 +50,11 @@ func TestValidateAppautoscalingPolicyImportInput(t *testing.T) {
 			expected:      []string{"elasticmapreduce", "instancegroup/j-2EEZNYKUA1NTV/ig-1791Y4E1L8YI0", "elasticmapreduce:instancegroup:InstanceCount", "test-appautoscaling-policy-ruuhd"},
 			errorExpected: false,
 		},
+		{
+			input:         "lambda/function:my-function:prod/lambda:function:ProvisionedConcurrency/test-policy-name",
+			expected:      []string{"lambda", "function:my-function:prod", "lambda:function:ProvisionedConcurrency", "test-policy-name"},
+			errorExpected: false,
+		},
 		{
 			input:         "rds/cluster:id/rds:cluster:ReadReplicaCount/cpu-auto-scaling",
 			expected:      []string{"rds", "cluster:id", "rds:cluster:ReadReplicaCount", "cpu-auto-scaling"},
@@ -117,6 +122,85 @@ func TestAccAWSAppautoScalingPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSAppautoScalingPolicy_LambdaProvisionedConcurrency(t *testing.T) {
+	var policy applicationautoscaling.ScalingPolicy
+	appAutoscalingTargetResourceName := "aws_appautoscaling_target.test"
+	resourceName := "aws_appautoscaling_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppautoscalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppautoscalingPolicyConfigLambdaProvisionedConcurrency(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppautoscalingPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "TargetTrackingScaling"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_id", appAutoscalingTargetResourceName, "resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "scalable_dimension", "lambda:function:ProvisionedConcurrency"),
+					resource.TestCheckResourceAttr(resourceName, "service_namespace", "lambda"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.predefined_metric_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.predefined_metric_specification.0.predefined_metric_type", "LambdaProvisionedConcurrencyUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.target_value", "0.75"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAppautoscalingPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppautoScalingPolicy_TargetTrackingMetricMath(t *testing.T) {
+	var policy applicationautoscaling.ScalingPolicy
+	resourceName := "aws_appautoscaling_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppautoscalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppautoscalingPolicyConfigTargetTrackingMetricMath(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppautoscalingPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "TargetTrackingScaling"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.customized_metric_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metric_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics.*", map[string]string{
+						"id":                               "m1",
+						"return_data":                      "false",
+						"metric_stat.#":                    "1",
+						"metric_stat.0.stat":               "Average",
+						"metric_stat.0.metric.#":           "1",
+						"metric_stat.0.metric.0.namespace": "AWS/ECS",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_tracking_scaling_policy_configuration.0.customized_metric_specification.0.metrics.*", map[string]string{
+						"id":          "e1",
+						"expression":  "m1 + m2",
+						"return_data": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAppautoscalingPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSAppautoScalingPolicy_disappears(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 	resourceName := "aws_appautoscaling_policy.test"
@@ -549,6 +633,182 @@ resource "aws_appautoscaling_policy" "test" {
 `, rName)
 }
 
+func testAccAWSAppautoscalingPolicyConfigLambdaProvisionedConcurrency(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  handler       = "exports.example"
+  publish       = true
+  role          = aws_iam_role.test.arn
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_lambda_alias" "test" {
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+  name             = "test"
+}
+
+resource "aws_appautoscaling_target" "test" {
+  max_capacity       = 2
+  min_capacity       = 1
+  resource_id        = "function:${aws_lambda_function.test.function_name}:${aws_lambda_alias.test.name}"
+  scalable_dimension = "lambda:function:ProvisionedConcurrency"
+  service_namespace  = "lambda"
+}
+
+resource "aws_appautoscaling_policy" "test" {
+  name               = %[1]q
+  policy_type        = "TargetTrackingScaling"
+  resource_id        = aws_appautoscaling_target.test.resource_id
+  scalable_dimension = aws_appautoscaling_target.test.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.test.service_namespace
+
+  target_tracking_scaling_policy_configuration {
+    predefined_metric_specification {
+      predefined_metric_type = "LambdaProvisionedConcurrencyUtilization"
+    }
+
+    target_value = 0.75
+  }
+}
+`, rName)
+}
+
+func testAccAWSAppautoscalingPolicyConfigTargetTrackingMetricMath(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<EOF
+[
+  {
+    "name": "busybox",
+    "image": "busybox:latest",
+    "cpu": 10,
+    "memory": 128,
+    "essential": true
+  }
+]
+EOF
+}
+
+resource "aws_ecs_service" "test" {
+  cluster                            = aws_ecs_cluster.test.id
+  deployment_maximum_percent         = 200
+  deployment_minimum_healthy_percent = 50
+  desired_count                      = 0
+  name                               = %[1]q
+  task_definition                    = aws_ecs_task_definition.test.arn
+}
+
+resource "aws_appautoscaling_target" "test" {
+  max_capacity       = 4
+  min_capacity       = 0
+  resource_id        = "service/${aws_ecs_cluster.test.name}/${aws_ecs_service.test.name}"
+  scalable_dimension = "ecs:service:DesiredCount"
+  service_namespace  = "ecs"
+}
+
+resource "aws_appautoscaling_policy" "test" {
+  name               = %[1]q
+  policy_type        = "TargetTrackingScaling"
+  resource_id        = aws_appautoscaling_target.test.resource_id
+  scalable_dimension = aws_appautoscaling_target.test.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.test.service_namespace
+
+  target_tracking_scaling_policy_configuration {
+    target_value = 100
+
+    customized_metric_specification {
+      metrics {
+        id          = "m1"
+        label       = "CPU utilization"
+        return_data = false
+
+        metric_stat {
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/ECS"
+
+            dimensions {
+              name  = "ClusterName"
+              value = aws_ecs_cluster.test.name
+            }
+
+            dimensions {
+              name  = "ServiceName"
+              value = aws_ecs_service.test.name
+            }
+          }
+
+          stat = "Average"
+        }
+      }
+
+      metrics {
+        id          = "m2"
+        label       = "Memory utilization"
+        return_data = false
+
+        metric_stat {
+          metric {
+            metric_name = "MemoryUtilization"
+            namespace   = "AWS/ECS"
+
+            dimensions {
+              name  = "ClusterName"
+              value = aws_ecs_cluster.test.name
+            }
+
+            dimensions {
+              name  = "ServiceName"
+              value = aws_ecs_service.test.name
+            }
+          }
+
+          stat = "Average"
+        }
+      }
+
+      metrics {
+        id          = "e1"
+        label       = "CPU plus memory utilization"
+        expression  = "m1 + m2"
+        return_data = true
+      }
+    }
+  }
+}
+`, rName)
+}
+
 func testAccAWSAppautoscalingPolicySpotFleetRequestConfig(randPolicyName, validUntil string) string {
 	return fmt.Sprintf(`
 data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
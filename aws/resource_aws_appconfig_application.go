@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsAppconfigApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppconfigApplicationCreate,
+		Read:   resourceAwsAppconfigApplicationRead,
+		Update: resourceAwsAppconfigApplicationUpdate,
+		Delete: resourceAwsAppconfigApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsAppconfigApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	name := d.Get("name").(string)
+	input := &appconfig.CreateApplicationInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().AppconfigTags()
+	}
+
+	output, err := conn.CreateApplication(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppConfig Application (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceAwsAppconfigApplicationRead(d, meta)
+}
+
+func resourceAwsAppconfigApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &appconfig.GetApplicationInput{
+		ApplicationId: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetApplication(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] AppConfig Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting AppConfig Application (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error getting AppConfig Application (%s): empty response", d.Id())
+	}
+
+	arn := arn.ARN{
+		AccountID: meta.(*AWSClient).accountid,
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("application/%s", d.Id()),
+		Service:   "appconfig",
+	}.String()
+
+	d.Set("arn", arn)
+	d.Set("name", output.Name)
+	d.Set("description", output.Description)
+
+	tags, err := keyvaluetags.AppconfigListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for AppConfig Application (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppconfigApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	if d.HasChangesExcept("tags") {
+		input := &appconfig.UpdateApplicationInput{
+			ApplicationId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdateApplication(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating AppConfig Application (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.AppconfigUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppConfig Application (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppconfigApplicationRead(d, meta)
+}
+
+func resourceAwsAppconfigApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	input := &appconfig.DeleteApplicationInput{
+		ApplicationId: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteApplication(input)
+
+	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppConfig Application (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,423 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappconfig "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig"
+)
+
+func TestAccAWSAppConfigConfigurationProfile_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_configuration_profile.test"
+	appResourceName := "aws_appconfig_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigConfigurationProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigConfigurationProfileConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigConfigurationProfileExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", appResourceName, "id"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "appconfig", regexp.MustCompile(`application/[a-z0-9]{4,7}/configurationprofile/[a-z0-9]{4,7}`)),
+					resource.TestMatchResourceAttr(resourceName, "configuration_profile_id", regexp.MustCompile(`[a-z0-9]{4,7}`)),
+					resource.TestCheckResourceAttr(resourceName, "location_uri", "hosted"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "AWS.Freeform"),
+					resource.TestCheckResourceAttr(resourceName, "validator.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigConfigurationProfile_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_configuration_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigConfigurationProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigConfigurationProfileConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigConfigurationProfileExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppconfigConfigurationProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigConfigurationProfile_Validators_JSON(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_configuration_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigConfigurationProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigConfigurationProfileConfigValidator_JSON(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigConfigurationProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "validator.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "validator.*", map[string]string{
+						"type": appconfig.ValidatorTypeJsonSchema,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppConfigConfigurationProfileConfigValidator_NoJSONContent(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigConfigurationProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "validator.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "validator.*", map[string]string{
+						"content": "",
+						"type":    appconfig.ValidatorTypeJsonSchema,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Test Validator Removal
+				Config: testAccAWSAppConfigConfigurationProfileConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigConfigurationProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "validator.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigConfigurationProfile_Validators_Lambda(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_configuration_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigConfigurationProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigConfigurationProfileConfigValidator_Lambda(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigConfigurationProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "validator.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "validator.*.content", "aws_lambda_function.test", "arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "validator.*", map[string]string{
+						"type": appconfig.ValidatorTypeLambda,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigConfigurationProfile_updateNameAndDescription(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test-update")
+	resourceName := "aws_appconfig_configuration_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigConfigurationProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigConfigurationProfileConfigNameDescription(rName, rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigConfigurationProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+				),
+			},
+			{
+				Config: testAccAWSAppConfigConfigurationProfileConfigNameDescription(rName, rNameUpdated, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigConfigurationProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "description", rNameUpdated),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigConfigurationProfile_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_configuration_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigConfigurationProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigConfigurationProfileConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigConfigurationProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppConfigConfigurationProfileConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigConfigurationProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppConfigConfigurationProfileConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigConfigurationProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppConfigConfigurationProfileDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appconfig_configuration_profile" {
+			continue
+		}
+
+		confProfID, appID, err := tfappconfig.ConfigurationProfileParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		input := &appconfig.GetConfigurationProfileInput{
+			ApplicationId:          aws.String(appID),
+			ConfigurationProfileId: aws.String(confProfID),
+		}
+
+		output, err := conn.GetConfigurationProfile(input)
+
+		if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading AppConfig Configuration Profile (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil {
+			return fmt.Errorf("AppConfig Configuration Profile (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppConfigConfigurationProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		confProfID, appID, err := tfappconfig.ConfigurationProfileParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+
+		output, err := conn.GetConfigurationProfile(&appconfig.GetConfigurationProfileInput{
+			ApplicationId:          aws.String(appID),
+			ConfigurationProfileId: aws.String(confProfID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading AppConfig Configuration Profile (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output == nil {
+			return fmt.Errorf("AppConfig Configuration Profile (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSAppConfigConfigurationProfileConfigName(rName string) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %q
+  location_uri   = "hosted"
+}
+`, rName))
+}
+
+func testAccAWSAppConfigConfigurationProfileConfigNameDescription(rName, name, description string) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  description    = %q
+  name           = %q
+  location_uri   = "hosted"
+}
+`, description, name))
+}
+
+func testAccAWSAppConfigConfigurationProfileConfigValidator_JSON(rName string) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %q
+  location_uri   = "hosted"
+
+  validator {
+    content = jsonencode({
+      "$schema"            = "http://json-schema.org/draft-04/schema#"
+      title                = "$id$"
+      description          = "BasicFeatureToggle-1"
+      type                 = "object"
+      additionalProperties = false
+      patternProperties = {
+        "[^\\s]+$" = {
+          type = "boolean"
+        }
+      }
+      minProperties = 1
+    })
+
+    type = "JSON_SCHEMA"
+  }
+}
+`, rName))
+}
+
+func testAccAWSAppConfigConfigurationProfileConfigValidator_NoJSONContent(rName string) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %q
+  location_uri   = "hosted"
+
+  validator {
+    type = "JSON_SCHEMA"
+  }
+}
+`, rName))
+}
+
+func testAccAWSAppConfigConfigurationProfileConfigValidator_Lambda(rName string) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		testAccAWSLambdaConfigBasic(rName, rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %q
+  location_uri   = "hosted"
+
+  validator {
+    content = aws_lambda_function.test.arn
+    type    = "LAMBDA"
+  }
+}
+`, rName))
+}
+
+func testAccAWSAppConfigConfigurationProfileConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %[1]q
+  location_uri   = "hosted"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSAppConfigConfigurationProfileConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %[1]q
+  location_uri   = "hosted"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfappconfig "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppconfigDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppconfigDeploymentCreate,
+		Read:   resourceAwsAppconfigDeploymentRead,
+		Update: resourceAwsAppconfigDeploymentUpdate,
+		Delete: resourceAwsAppconfigDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.DeploymentCompletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_profile_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"configuration_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"deployment_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"deployment_strategy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`(^[a-z0-9]{4,7}$|^AppConfig\.[A-Za-z0-9]{9,40}$)`), ""),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsAppconfigDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	appID := d.Get("application_id").(string)
+	envID := d.Get("environment_id").(string)
+
+	input := &appconfig.StartDeploymentInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(d.Get("configuration_profile_id").(string)),
+		ConfigurationVersion:   aws.String(d.Get("configuration_version").(string)),
+		DeploymentStrategyId:   aws.String(d.Get("deployment_strategy_id").(string)),
+		EnvironmentId:          aws.String(envID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().AppconfigTags()
+	}
+
+	output, err := conn.StartDeployment(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting AppConfig Deployment for Application (%s) and Environment (%s): %w", appID, envID, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error starting AppConfig Deployment for Application (%s) and Environment (%s): empty response", appID, envID)
+	}
+
+	deploymentNumber := int(aws.Int64Value(output.DeploymentNumber))
+
+	d.SetId(tfappconfig.DeploymentCreateResourceID(appID, envID, deploymentNumber))
+
+	if d.Get("wait_for_deployment").(bool) {
+		if _, err := waiter.DeploymentCompleted(conn, appID, envID, deploymentNumber, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for AppConfig Deployment (%s) to complete: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppconfigDeploymentRead(d, meta)
+}
+
+func resourceAwsAppconfigDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	appID, envID, deploymentNumber, err := tfappconfig.DeploymentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.DeploymentByApplicationEnvironmentAndNumber(conn, appID, envID, deploymentNumber)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppConfig Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting AppConfig Deployment (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		AccountID: meta.(*AWSClient).accountid,
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("application/%s/environment/%s/deployment/%d", appID, envID, deploymentNumber),
+		Service:   "appconfig",
+	}.String()
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set("arn", arn)
+	d.Set("configuration_profile_id", output.ConfigurationProfileId)
+	d.Set("configuration_version", output.ConfigurationVersion)
+	d.Set("deployment_number", output.DeploymentNumber)
+	d.Set("deployment_strategy_id", output.DeploymentStrategyId)
+	d.Set("description", output.Description)
+	d.Set("environment_id", output.EnvironmentId)
+	d.Set("state", output.State)
+
+	tags, err := keyvaluetags.AppconfigListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for AppConfig Deployment (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppconfigDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.AppconfigUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppConfig Deployment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppconfigDeploymentRead(d, meta)
+}
+
+func resourceAwsAppconfigDeploymentDelete(_ *schema.ResourceData, _ interface{}) error {
+	// AppConfig Deployments cannot be stopped once complete; removing the resource
+	// from state allows a new configuration version to trigger a new deployment.
+	log.Printf("[WARN] Cannot destroy AppConfig Deployment. Terraform will remove this resource from the state file, however this resource remains.")
+	return nil
+}
@@ -0,0 +1,373 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappconfig "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig"
+)
+
+func TestAccAWSAppConfigEnvironment_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_environment.test"
+	appResourceName := "aws_appconfig_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigEnvironmentConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigEnvironmentExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "appconfig", regexp.MustCompile(`application/[a-z0-9]{4,7}/environment/[a-z0-9]{4,7}`)),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", appResourceName, "id"),
+					resource.TestMatchResourceAttr(resourceName, "environment_id", regexp.MustCompile(`[a-z0-9]{4,7}`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "monitor.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "state", appconfig.EnvironmentStateReadyForDeployment),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigEnvironment_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigEnvironmentConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigEnvironmentExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppconfigEnvironment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigEnvironment_updateNameAndDescription(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test-update")
+	resourceName := "aws_appconfig_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigEnvironmentConfigNameDescription(rName, rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+				),
+			},
+			{
+				Config: testAccAWSAppConfigEnvironmentConfigNameDescription(rName, rNameUpdated, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "description", rNameUpdated),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigEnvironment_Monitors(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigEnvironmentWithMonitors(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "monitor.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "monitor.*.alarm_arn", "aws_cloudwatch_metric_alarm.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "monitor.*.alarm_role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppConfigEnvironmentWithMonitors(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "monitor.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "monitor.*.alarm_arn", "aws_cloudwatch_metric_alarm.test.1", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSAppConfigEnvironmentWithMonitors(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "monitor.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigEnvironment_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigEnvironmentConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppConfigEnvironmentConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppConfigEnvironmentConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppConfigEnvironmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appconfig_environment" {
+			continue
+		}
+
+		envID, appID, err := tfappconfig.EnvironmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		input := &appconfig.GetEnvironmentInput{
+			ApplicationId: aws.String(appID),
+			EnvironmentId: aws.String(envID),
+		}
+
+		output, err := conn.GetEnvironment(input)
+
+		if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading AppConfig Environment (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil {
+			return fmt.Errorf("AppConfig Environment (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppConfigEnvironmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		envID, appID, err := tfappconfig.EnvironmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+
+		input := &appconfig.GetEnvironmentInput{
+			ApplicationId: aws.String(appID),
+			EnvironmentId: aws.String(envID),
+		}
+
+		output, err := conn.GetEnvironment(input)
+
+		if err != nil {
+			return fmt.Errorf("error reading AppConfig Environment (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output == nil {
+			return fmt.Errorf("AppConfig Environment (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSAppConfigEnvironmentConfigBasic(rName string) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_environment" "test" {
+  name           = %[1]q
+  application_id = aws_appconfig_application.test.id
+}
+`, rName))
+}
+
+func testAccAWSAppConfigEnvironmentConfigNameDescription(rName, name, description string) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_environment" "test" {
+  name           = %[1]q
+  description    = %[2]q
+  application_id = aws_appconfig_application.test.id
+}
+`, name, description))
+}
+
+func testAccAWSAppConfigEnvironmentWithMonitors(rName string, count int) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "appconfig.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  count = %[2]d
+
+  alarm_name                = "%[1]s-${count.index}"
+  comparison_operator       = "GreaterThanOrEqualToThreshold"
+  evaluation_periods        = "2"
+  metric_name               = "CPUUtilization"
+  namespace                 = "AWS/EC2"
+  period                    = "120"
+  statistic                 = "Average"
+  threshold                 = "80"
+  alarm_description         = "This metric monitors ec2 cpu utilization"
+  insufficient_data_actions = []
+
+  dimensions = {
+    InstanceId = "i-abc123"
+  }
+}
+
+resource "aws_appconfig_environment" "test" {
+  name           = %[1]q
+  application_id = aws_appconfig_application.test.id
+
+  dynamic "monitor" {
+    for_each = aws_cloudwatch_metric_alarm.test.*.arn
+    content {
+      alarm_arn      = monitor.value
+      alarm_role_arn = aws_iam_role.test.arn
+    }
+  }
+}
+`, rName, count))
+}
+
+func testAccAWSAppConfigEnvironmentConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_environment" "test" {
+  name           = %[1]q
+  application_id = aws_appconfig_application.test.id
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSAppConfigEnvironmentConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSAppConfigApplicationConfigName(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_environment" "test" {
+  name           = %[1]q
+  application_id = aws_appconfig_application.test.id
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
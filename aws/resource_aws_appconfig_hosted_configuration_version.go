@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfappconfig "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig"
+)
+
+func resourceAwsAppconfigHostedConfigurationVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppconfigHostedConfigurationVersionCreate,
+		Read:   resourceAwsAppconfigHostedConfigurationVersionRead,
+		Delete: resourceAwsAppconfigHostedConfigurationVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_profile_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{4,7}$`), ""),
+			},
+			"content": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"content_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppconfigHostedConfigurationVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	appID := d.Get("application_id").(string)
+	profileID := d.Get("configuration_profile_id").(string)
+
+	input := &appconfig.CreateHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(profileID),
+		Content:                []byte(d.Get("content").(string)),
+		ContentType:            aws.String(d.Get("content_type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateHostedConfigurationVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppConfig Hosted Configuration Version for Application (%s) and Configuration Profile (%s): %w", appID, profileID, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error creating AppConfig Hosted Configuration Version for Application (%s) and Configuration Profile (%s): empty response", appID, profileID)
+	}
+
+	d.SetId(tfappconfig.HostedConfigurationVersionCreateResourceID(aws.StringValue(output.ApplicationId), aws.StringValue(output.ConfigurationProfileId), int(aws.Int64Value(output.VersionNumber))))
+
+	return resourceAwsAppconfigHostedConfigurationVersionRead(d, meta)
+}
+
+func resourceAwsAppconfigHostedConfigurationVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	appID, confProfID, versionNumber, err := tfappconfig.HostedConfigurationVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &appconfig.GetHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(confProfID),
+		VersionNumber:          aws.Int64(int64(versionNumber)),
+	}
+
+	output, err := conn.GetHostedConfigurationVersion(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] AppConfig Hosted Configuration Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting AppConfig Hosted Configuration Version (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error getting AppConfig Hosted Configuration Version (%s): empty response", d.Id())
+	}
+
+	d.Set("application_id", output.ApplicationId)
+	d.Set("configuration_profile_id", output.ConfigurationProfileId)
+	d.Set("content", string(output.Content))
+	d.Set("content_type", output.ContentType)
+	d.Set("description", output.Description)
+	d.Set("version_number", output.VersionNumber)
+
+	arn := arn.ARN{
+		AccountID: meta.(*AWSClient).accountid,
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("application/%s/configurationprofile/%s/hostedconfigurationversion/%d", appID, confProfID, versionNumber),
+		Service:   "appconfig",
+	}.String()
+
+	d.Set("arn", arn)
+
+	return nil
+}
+
+func resourceAwsAppconfigHostedConfigurationVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appconfigconn
+
+	appID, confProfID, versionNumber, err := tfappconfig.HostedConfigurationVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &appconfig.DeleteHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(confProfID),
+		VersionNumber:          aws.Int64(int64(versionNumber)),
+	}
+
+	_, err = conn.DeleteHostedConfigurationVersion(input)
+
+	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppConfig Hosted Configuration Version (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
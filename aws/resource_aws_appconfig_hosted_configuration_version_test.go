@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappconfig "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appconfig"
+)
+
+func TestAccAWSAppConfigHostedConfigurationVersion_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigHostedConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigHostedConfigurationVersion(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigHostedConfigurationVersionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_appconfig_application.test", "id"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "appconfig", regexp.MustCompile(`application/[a-z0-9]{4,7}/configurationprofile/[a-z0-9]{4,7}/hostedconfigurationversion/[0-9]+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_profile_id", "aws_appconfig_configuration_profile.test", "configuration_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "content", "{\"foo\":\"test1\"}"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigHostedConfigurationVersion_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigHostedConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigHostedConfigurationVersion(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigHostedConfigurationVersionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppconfigHostedConfigurationVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppConfigHostedConfigurationVersion_Content(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAppConfig(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppConfigHostedConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppConfigHostedConfigurationVersion(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigHostedConfigurationVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content", "{\"foo\":\"test1\"}"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				// Hosted configuration versions are immutable; new content results in a new version
+				Config: testAccAWSAppConfigHostedConfigurationVersion(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppConfigHostedConfigurationVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content", "{\"foo\":\"test2\"}"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppConfigHostedConfigurationVersionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appconfig_hosted_configuration_version" {
+			continue
+		}
+
+		appID, confProfID, versionNumber, err := tfappconfig.HostedConfigurationVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		input := &appconfig.GetHostedConfigurationVersionInput{
+			ApplicationId:          aws.String(appID),
+			ConfigurationProfileId: aws.String(confProfID),
+			VersionNumber:          aws.Int64(int64(versionNumber)),
+		}
+
+		output, err := conn.GetHostedConfigurationVersion(input)
+
+		if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading AppConfig Hosted Configuration Version (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil {
+			return fmt.Errorf("AppConfig Hosted Configuration Version (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppConfigHostedConfigurationVersionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		appID, confProfID, versionNumber, err := tfappconfig.HostedConfigurationVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+
+		output, err := conn.GetHostedConfigurationVersion(&appconfig.GetHostedConfigurationVersionInput{
+			ApplicationId:          aws.String(appID),
+			ConfigurationProfileId: aws.String(confProfID),
+			VersionNumber:          aws.Int64(int64(versionNumber)),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading AppConfig Hosted Configuration Version (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output == nil {
+			return fmt.Errorf("AppConfig Hosted Configuration Version (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSAppConfigHostedConfigurationVersion(rName, content string) string {
+	return composeConfig(
+		testAccAWSAppConfigConfigurationProfileConfigName(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  content_type             = "application/json"
+
+  content = jsonencode({
+    foo = %[2]q
+  })
+
+  description = %[1]q
+}
+`, rName, content))
+}
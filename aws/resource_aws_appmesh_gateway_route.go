@@ -76,6 +76,32 @@ func resourceAwsAppmeshGatewayRoute() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"rewrite": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 0,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"hostname": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MinItems: 0,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"default_target_hostname": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validation.StringInSlice(appmesh.DefaultGatewayRouteRewrite_Values(), false),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+
 												"target": {
 													Type:     schema.TypeList,
 													Required: true,
@@ -142,6 +168,54 @@ func resourceAwsAppmeshGatewayRoute() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"rewrite": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 0,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"hostname": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MinItems: 0,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"default_target_hostname": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validation.StringInSlice(appmesh.DefaultGatewayRouteRewrite_Values(), false),
+																		},
+																	},
+																},
+															},
+
+															"prefix": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MinItems: 0,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"default_prefix": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringInSlice(appmesh.DefaultGatewayRouteRewrite_Values(), false),
+																		},
+
+																		"value": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with /"),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+
 												"target": {
 													Type:     schema.TypeList,
 													Required: true,
@@ -209,6 +283,54 @@ func resourceAwsAppmeshGatewayRoute() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"rewrite": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 0,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"hostname": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MinItems: 0,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"default_target_hostname": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validation.StringInSlice(appmesh.DefaultGatewayRouteRewrite_Values(), false),
+																		},
+																	},
+																},
+															},
+
+															"prefix": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MinItems: 0,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"default_prefix": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringInSlice(appmesh.DefaultGatewayRouteRewrite_Values(), false),
+																		},
+
+																		"value": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with /"),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+
 												"target": {
 													Type:     schema.TypeList,
 													Required: true,
@@ -495,6 +617,22 @@ func expandAppmeshGatewayRouteTarget(vRouteTarget []interface{}) *appmesh.Gatewa
 	return routeTarget
 }
 
+func expandAppmeshGatewayRouteHostnameRewrite(vHostname []interface{}) *appmesh.GatewayRouteHostnameRewrite {
+	if len(vHostname) == 0 || vHostname[0] == nil {
+		return nil
+	}
+
+	hostname := &appmesh.GatewayRouteHostnameRewrite{}
+
+	mHostname := vHostname[0].(map[string]interface{})
+
+	if vDefaultTargetHostname, ok := mHostname["default_target_hostname"].(string); ok && vDefaultTargetHostname != "" {
+		hostname.DefaultTargetHostname = aws.String(vDefaultTargetHostname)
+	}
+
+	return hostname
+}
+
 func expandAppmeshGrpcGatewayRoute(vGrpcRoute []interface{}) *appmesh.GrpcGatewayRoute {
 	if len(vGrpcRoute) == 0 || vGrpcRoute[0] == nil {
 		return nil
@@ -509,6 +647,18 @@ func expandAppmeshGrpcGatewayRoute(vGrpcRoute []interface{}) *appmesh.GrpcGatewa
 
 		mRouteAction := vRouteAction[0].(map[string]interface{})
 
+		if vRouteRewrite, ok := mRouteAction["rewrite"].([]interface{}); ok && len(vRouteRewrite) > 0 && vRouteRewrite[0] != nil {
+			routeRewrite := &appmesh.GrpcGatewayRouteRewrite{}
+
+			mRouteRewrite := vRouteRewrite[0].(map[string]interface{})
+
+			if vHostname, ok := mRouteRewrite["hostname"].([]interface{}); ok {
+				routeRewrite.Hostname = expandAppmeshGatewayRouteHostnameRewrite(vHostname)
+			}
+
+			routeAction.Rewrite = routeRewrite
+		}
+
 		if vRouteTarget, ok := mRouteAction["target"].([]interface{}); ok {
 			routeAction.Target = expandAppmeshGatewayRouteTarget(vRouteTarget)
 		}
@@ -545,6 +695,33 @@ func expandAppmeshHttpGatewayRoute(vHttpRoute []interface{}) *appmesh.HttpGatewa
 
 		mRouteAction := vRouteAction[0].(map[string]interface{})
 
+		if vRouteRewrite, ok := mRouteAction["rewrite"].([]interface{}); ok && len(vRouteRewrite) > 0 && vRouteRewrite[0] != nil {
+			routeRewrite := &appmesh.HttpGatewayRouteRewrite{}
+
+			mRouteRewrite := vRouteRewrite[0].(map[string]interface{})
+
+			if vHostname, ok := mRouteRewrite["hostname"].([]interface{}); ok {
+				routeRewrite.Hostname = expandAppmeshGatewayRouteHostnameRewrite(vHostname)
+			}
+
+			if vPrefix, ok := mRouteRewrite["prefix"].([]interface{}); ok && len(vPrefix) > 0 && vPrefix[0] != nil {
+				prefix := &appmesh.HttpGatewayRoutePrefixRewrite{}
+
+				mPrefix := vPrefix[0].(map[string]interface{})
+
+				if vDefaultPrefix, ok := mPrefix["default_prefix"].(string); ok && vDefaultPrefix != "" {
+					prefix.DefaultPrefix = aws.String(vDefaultPrefix)
+				}
+				if vValue, ok := mPrefix["value"].(string); ok && vValue != "" {
+					prefix.Value = aws.String(vValue)
+				}
+
+				routeRewrite.Prefix = prefix
+			}
+
+			routeAction.Rewrite = routeRewrite
+		}
+
 		if vRouteTarget, ok := mRouteAction["target"].([]interface{}); ok {
 			routeAction.Target = expandAppmeshGatewayRouteTarget(vRouteTarget)
 		}
@@ -599,6 +776,18 @@ func flattenAppmeshGatewayRouteTarget(routeTarget *appmesh.GatewayRouteTarget) [
 	return []interface{}{mRouteTarget}
 }
 
+func flattenAppmeshGatewayRouteHostnameRewrite(hostname *appmesh.GatewayRouteHostnameRewrite) []interface{} {
+	if hostname == nil {
+		return []interface{}{}
+	}
+
+	mHostname := map[string]interface{}{
+		"default_target_hostname": aws.StringValue(hostname.DefaultTargetHostname),
+	}
+
+	return []interface{}{mHostname}
+}
+
 func flattenAppmeshGrpcGatewayRoute(grpcRoute *appmesh.GrpcGatewayRoute) []interface{} {
 	if grpcRoute == nil {
 		return []interface{}{}
@@ -611,6 +800,14 @@ func flattenAppmeshGrpcGatewayRoute(grpcRoute *appmesh.GrpcGatewayRoute) []inter
 			"target": flattenAppmeshGatewayRouteTarget(routeAction.Target),
 		}
 
+		if routeRewrite := routeAction.Rewrite; routeRewrite != nil {
+			mRouteRewrite := map[string]interface{}{
+				"hostname": flattenAppmeshGatewayRouteHostnameRewrite(routeRewrite.Hostname),
+			}
+
+			mRouteAction["rewrite"] = []interface{}{mRouteRewrite}
+		}
+
 		mGrpcRoute["action"] = []interface{}{mRouteAction}
 	}
 
@@ -637,6 +834,23 @@ func flattenAppmeshHttpGatewayRoute(httpRoute *appmesh.HttpGatewayRoute) []inter
 			"target": flattenAppmeshGatewayRouteTarget(routeAction.Target),
 		}
 
+		if routeRewrite := routeAction.Rewrite; routeRewrite != nil {
+			mRouteRewrite := map[string]interface{}{
+				"hostname": flattenAppmeshGatewayRouteHostnameRewrite(routeRewrite.Hostname),
+			}
+
+			if prefix := routeRewrite.Prefix; prefix != nil {
+				mPrefix := map[string]interface{}{
+					"default_prefix": aws.StringValue(prefix.DefaultPrefix),
+					"value":          aws.StringValue(prefix.Value),
+				}
+
+				mRouteRewrite["prefix"] = []interface{}{mPrefix}
+			}
+
+			mRouteAction["rewrite"] = []interface{}{mRouteRewrite}
+		}
+
 		mHttpRoute["action"] = []interface{}{mRouteAction}
 	}
 
@@ -7,12 +7,13 @@ import (
 func TestAccAWSAppmesh_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"GatewayRoute": {
-			"basic":      testAccAwsAppmeshGatewayRoute_basic,
-			"disappears": testAccAwsAppmeshGatewayRoute_disappears,
-			"grpcRoute":  testAccAwsAppmeshGatewayRoute_GrpcRoute,
-			"httpRoute":  testAccAwsAppmeshGatewayRoute_HttpRoute,
-			"http2Route": testAccAwsAppmeshGatewayRoute_Http2Route,
-			"tags":       testAccAwsAppmeshGatewayRoute_Tags,
+			"basic":                testAccAwsAppmeshGatewayRoute_basic,
+			"disappears":           testAccAwsAppmeshGatewayRoute_disappears,
+			"grpcRoute":            testAccAwsAppmeshGatewayRoute_GrpcRoute,
+			"httpRoute":            testAccAwsAppmeshGatewayRoute_HttpRoute,
+			"httpRouteWithRewrite": testAccAwsAppmeshGatewayRoute_HttpRouteWithRewrite,
+			"http2Route":           testAccAwsAppmeshGatewayRoute_Http2Route,
+			"tags":                 testAccAwsAppmeshGatewayRoute_Tags,
 		},
 		"Mesh": {
 			"basic":        testAccAwsAppmeshMesh_basic,
@@ -34,29 +35,33 @@ func TestAccAWSAppmesh_serial(t *testing.T) {
 			"tags":              testAccAwsAppmeshRoute_tags,
 		},
 		"VirtualGateway": {
-			"basic":                  testAccAwsAppmeshVirtualGateway_basic,
-			"disappears":             testAccAwsAppmeshVirtualGateway_disappears,
-			"backendDefaults":        testAccAwsAppmeshVirtualGateway_BackendDefaults,
-			"listenerConnectionPool": testAccAwsAppmeshVirtualGateway_ListenerConnectionPool,
-			"listenerHealthChecks":   testAccAwsAppmeshVirtualGateway_ListenerHealthChecks,
-			"listenerTls":            testAccAwsAppmeshVirtualGateway_ListenerTls,
-			"logging":                testAccAwsAppmeshVirtualGateway_Logging,
-			"tags":                   testAccAwsAppmeshVirtualGateway_Tags,
+			"basic":                      testAccAwsAppmeshVirtualGateway_basic,
+			"disappears":                 testAccAwsAppmeshVirtualGateway_disappears,
+			"backendDefaults":            testAccAwsAppmeshVirtualGateway_BackendDefaults,
+			"backendDefaultsCertificate": testAccAwsAppmeshVirtualGateway_BackendDefaultsCertificate,
+			"listenerConnectionPool":     testAccAwsAppmeshVirtualGateway_ListenerConnectionPool,
+			"listenerHealthChecks":       testAccAwsAppmeshVirtualGateway_ListenerHealthChecks,
+			"listenerTls":                testAccAwsAppmeshVirtualGateway_ListenerTls,
+			"listenerValidation":         testAccAwsAppmeshVirtualGateway_ListenerValidation,
+			"logging":                    testAccAwsAppmeshVirtualGateway_Logging,
+			"tags":                       testAccAwsAppmeshVirtualGateway_Tags,
 		},
 		"VirtualNode": {
-			"basic":                    testAccAwsAppmeshVirtualNode_basic,
-			"disappears":               testAccAwsAppmeshVirtualNode_disappears,
-			"backendClientPolicyAcm":   testAccAwsAppmeshVirtualNode_backendClientPolicyAcm,
-			"backendClientPolicyFile":  testAccAwsAppmeshVirtualNode_backendClientPolicyFile,
-			"backendDefaults":          testAccAwsAppmeshVirtualNode_backendDefaults,
-			"cloudMapServiceDiscovery": testAccAwsAppmeshVirtualNode_cloudMapServiceDiscovery,
-			"listenerConnectionPool":   testAccAwsAppmeshVirtualNode_listenerConnectionPool,
-			"listenerOutlierDetection": testAccAwsAppmeshVirtualNode_listenerOutlierDetection,
-			"listenerHealthChecks":     testAccAwsAppmeshVirtualNode_listenerHealthChecks,
-			"listenerTimeout":          testAccAwsAppmeshVirtualNode_listenerTimeout,
-			"listenerTls":              testAccAwsAppmeshVirtualNode_listenerTls,
-			"logging":                  testAccAwsAppmeshVirtualNode_logging,
-			"tags":                     testAccAwsAppmeshVirtualNode_tags,
+			"basic":                      testAccAwsAppmeshVirtualNode_basic,
+			"disappears":                 testAccAwsAppmeshVirtualNode_disappears,
+			"backendClientPolicyAcm":     testAccAwsAppmeshVirtualNode_backendClientPolicyAcm,
+			"backendClientPolicyFile":    testAccAwsAppmeshVirtualNode_backendClientPolicyFile,
+			"backendDefaults":            testAccAwsAppmeshVirtualNode_backendDefaults,
+			"backendDefaultsCertificate": testAccAwsAppmeshVirtualNode_backendDefaultsCertificate,
+			"cloudMapServiceDiscovery":   testAccAwsAppmeshVirtualNode_cloudMapServiceDiscovery,
+			"listenerConnectionPool":     testAccAwsAppmeshVirtualNode_listenerConnectionPool,
+			"listenerOutlierDetection":   testAccAwsAppmeshVirtualNode_listenerOutlierDetection,
+			"listenerHealthChecks":       testAccAwsAppmeshVirtualNode_listenerHealthChecks,
+			"listenerTimeout":            testAccAwsAppmeshVirtualNode_listenerTimeout,
+			"listenerTls":                testAccAwsAppmeshVirtualNode_listenerTls,
+			"listenerValidation":         testAccAwsAppmeshVirtualNode_listenerValidation,
+			"logging":                    testAccAwsAppmeshVirtualNode_logging,
+			"tags":                       testAccAwsAppmeshVirtualNode_tags,
 		},
 		"VirtualRouter": {
 			"basic": testAccAwsAppmeshVirtualRouter_basic,
@@ -75,6 +75,61 @@ func resourceAwsAppmeshVirtualGateway() *schema.Resource {
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
+															"certificate": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MinItems: 0,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"file": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			MinItems: 0,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"certificate_chain": {
+																						Type:         schema.TypeString,
+																						Required:     true,
+																						ValidateFunc: validation.StringLenBetween(1, 255),
+																					},
+
+																					"private_key": {
+																						Type:         schema.TypeString,
+																						Required:     true,
+																						ValidateFunc: validation.StringLenBetween(1, 255),
+																					},
+																				},
+																			},
+																			ExactlyOneOf: []string{
+																				"spec.0.backend_defaults.0.client_policy.0.tls.0.certificate.0.file",
+																				"spec.0.backend_defaults.0.client_policy.0.tls.0.certificate.0.sds",
+																			},
+																		},
+
+																		"sds": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			MinItems: 0,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"secret_name": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																				},
+																			},
+																			ExactlyOneOf: []string{
+																				"spec.0.backend_defaults.0.client_policy.0.tls.0.certificate.0.file",
+																				"spec.0.backend_defaults.0.client_policy.0.tls.0.certificate.0.sds",
+																			},
+																		},
+																	},
+																},
+															},
+
 															"enforce": {
 																Type:     schema.TypeBool,
 																Optional: true,
@@ -98,6 +153,33 @@ func resourceAwsAppmeshVirtualGateway() *schema.Resource {
 																MaxItems: 1,
 																Elem: &schema.Resource{
 																	Schema: map[string]*schema.Schema{
+																		"subject_alternative_names": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			MinItems: 0,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"match": {
+																						Type:     schema.TypeList,
+																						Required: true,
+																						MinItems: 1,
+																						MaxItems: 1,
+																						Elem: &schema.Resource{
+																							Schema: map[string]*schema.Schema{
+																								"exact": {
+																									Type:     schema.TypeSet,
+																									Required: true,
+																									Elem:     &schema.Schema{Type: schema.TypeString},
+																									Set:      schema.HashString,
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+
 																		"trust": {
 																			Type:     schema.TypeList,
 																			Required: true,
@@ -126,6 +208,7 @@ func resourceAwsAppmeshVirtualGateway() *schema.Resource {
 																						ExactlyOneOf: []string{
 																							"spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.acm",
 																							"spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.file",
+																							"spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.sds",
 																						},
 																					},
 
@@ -146,6 +229,27 @@ func resourceAwsAppmeshVirtualGateway() *schema.Resource {
 																						ExactlyOneOf: []string{
 																							"spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.acm",
 																							"spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.file",
+																							"spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.sds",
+																						},
+																					},
+
+																					"sds": {
+																						Type:     schema.TypeList,
+																						Optional: true,
+																						MinItems: 0,
+																						MaxItems: 1,
+																						Elem: &schema.Resource{
+																							Schema: map[string]*schema.Schema{
+																								"secret_name": {
+																									Type:     schema.TypeString,
+																									Required: true,
+																								},
+																							},
+																						},
+																						ExactlyOneOf: []string{
+																							"spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.acm",
+																							"spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.file",
+																							"spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.sds",
 																						},
 																					},
 																				},
@@ -355,6 +459,7 @@ func resourceAwsAppmeshVirtualGateway() *schema.Resource {
 																ExactlyOneOf: []string{
 																	"spec.0.listener.0.tls.0.certificate.0.acm",
 																	"spec.0.listener.0.tls.0.certificate.0.file",
+																	"spec.0.listener.0.tls.0.certificate.0.sds",
 																},
 															},
 
@@ -381,6 +486,27 @@ func resourceAwsAppmeshVirtualGateway() *schema.Resource {
 																ExactlyOneOf: []string{
 																	"spec.0.listener.0.tls.0.certificate.0.acm",
 																	"spec.0.listener.0.tls.0.certificate.0.file",
+																	"spec.0.listener.0.tls.0.certificate.0.sds",
+																},
+															},
+
+															"sds": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MinItems: 0,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"secret_name": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																	},
+																},
+																ExactlyOneOf: []string{
+																	"spec.0.listener.0.tls.0.certificate.0.acm",
+																	"spec.0.listener.0.tls.0.certificate.0.file",
+																	"spec.0.listener.0.tls.0.certificate.0.sds",
 																},
 															},
 														},
@@ -392,6 +518,92 @@ func resourceAwsAppmeshVirtualGateway() *schema.Resource {
 													Required:     true,
 													ValidateFunc: validation.StringInSlice(appmesh.VirtualGatewayListenerTlsMode_Values(), false),
 												},
+
+												"validation": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 0,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"subject_alternative_names": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MinItems: 0,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"match": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			MinItems: 1,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"exact": {
+																						Type:     schema.TypeSet,
+																						Required: true,
+																						Elem:     &schema.Schema{Type: schema.TypeString},
+																						Set:      schema.HashString,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+
+															"trust": {
+																Type:     schema.TypeList,
+																Required: true,
+																MinItems: 1,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"file": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			MinItems: 0,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"certificate_chain": {
+																						Type:         schema.TypeString,
+																						Required:     true,
+																						ValidateFunc: validation.StringLenBetween(1, 255),
+																					},
+																				},
+																			},
+																			ExactlyOneOf: []string{
+																				"spec.0.listener.0.tls.0.validation.0.trust.0.file",
+																				"spec.0.listener.0.tls.0.validation.0.trust.0.sds",
+																			},
+																		},
+
+																		"sds": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			MinItems: 0,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"secret_name": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																				},
+																			},
+																			ExactlyOneOf: []string{
+																				"spec.0.listener.0.tls.0.validation.0.trust.0.file",
+																				"spec.0.listener.0.tls.0.validation.0.trust.0.sds",
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -776,9 +988,65 @@ func expandAppmeshVirtualGatewaySpec(vSpec []interface{}) *appmesh.VirtualGatewa
 						certificate.File = file
 					}
 
+					if vSds, ok := mCertificate["sds"].([]interface{}); ok && len(vSds) > 0 && vSds[0] != nil {
+						sds := &appmesh.VirtualGatewayListenerTlsSdsCertificate{}
+
+						mSds := vSds[0].(map[string]interface{})
+
+						if vSecretName, ok := mSds["secret_name"].(string); ok && vSecretName != "" {
+							sds.SecretName = aws.String(vSecretName)
+						}
+
+						certificate.Sds = sds
+					}
+
 					tls.Certificate = certificate
 				}
 
+				if vValidation, ok := mTls["validation"].([]interface{}); ok && len(vValidation) > 0 && vValidation[0] != nil {
+					validation := &appmesh.VirtualGatewayListenerTlsValidationContext{}
+
+					mValidation := vValidation[0].(map[string]interface{})
+
+					if vSubjectAlternativeNames, ok := mValidation["subject_alternative_names"].([]interface{}); ok {
+						validation.SubjectAlternativeNames = expandAppmeshSubjectAlternativeNames(vSubjectAlternativeNames)
+					}
+
+					if vTrust, ok := mValidation["trust"].([]interface{}); ok && len(vTrust) > 0 && vTrust[0] != nil {
+						trust := &appmesh.VirtualGatewayListenerTlsValidationContextTrust{}
+
+						mTrust := vTrust[0].(map[string]interface{})
+
+						if vFile, ok := mTrust["file"].([]interface{}); ok && len(vFile) > 0 && vFile[0] != nil {
+							file := &appmesh.VirtualGatewayTlsValidationContextFileTrust{}
+
+							mFile := vFile[0].(map[string]interface{})
+
+							if vCertificateChain, ok := mFile["certificate_chain"].(string); ok && vCertificateChain != "" {
+								file.CertificateChain = aws.String(vCertificateChain)
+							}
+
+							trust.File = file
+						}
+
+						if vSds, ok := mTrust["sds"].([]interface{}); ok && len(vSds) > 0 && vSds[0] != nil {
+							sds := &appmesh.VirtualGatewayTlsValidationContextSdsTrust{}
+
+							mSds := vSds[0].(map[string]interface{})
+
+							if vSecretName, ok := mSds["secret_name"].(string); ok && vSecretName != "" {
+								sds.SecretName = aws.String(vSecretName)
+							}
+
+							trust.Sds = sds
+						}
+
+						validation.Trust = trust
+					}
+
+					tls.Validation = validation
+				}
+
 				listener.Tls = tls
 			}
 
@@ -841,11 +1109,50 @@ func expandAppmeshVirtualGatewayClientPolicy(vClientPolicy []interface{}) *appme
 			tls.Ports = expandInt64Set(vPorts)
 		}
 
+		if vCertificate, ok := mTls["certificate"].([]interface{}); ok && len(vCertificate) > 0 && vCertificate[0] != nil {
+			certificate := &appmesh.VirtualGatewayClientTlsCertificate{}
+
+			mCertificate := vCertificate[0].(map[string]interface{})
+
+			if vFile, ok := mCertificate["file"].([]interface{}); ok && len(vFile) > 0 && vFile[0] != nil {
+				file := &appmesh.VirtualGatewayListenerTlsFileCertificate{}
+
+				mFile := vFile[0].(map[string]interface{})
+
+				if vCertificateChain, ok := mFile["certificate_chain"].(string); ok && vCertificateChain != "" {
+					file.CertificateChain = aws.String(vCertificateChain)
+				}
+				if vPrivateKey, ok := mFile["private_key"].(string); ok && vPrivateKey != "" {
+					file.PrivateKey = aws.String(vPrivateKey)
+				}
+
+				certificate.File = file
+			}
+
+			if vSds, ok := mCertificate["sds"].([]interface{}); ok && len(vSds) > 0 && vSds[0] != nil {
+				sds := &appmesh.VirtualGatewayListenerTlsSdsCertificate{}
+
+				mSds := vSds[0].(map[string]interface{})
+
+				if vSecretName, ok := mSds["secret_name"].(string); ok && vSecretName != "" {
+					sds.SecretName = aws.String(vSecretName)
+				}
+
+				certificate.Sds = sds
+			}
+
+			tls.Certificate = certificate
+		}
+
 		if vValidation, ok := mTls["validation"].([]interface{}); ok && len(vValidation) > 0 && vValidation[0] != nil {
 			validation := &appmesh.VirtualGatewayTlsValidationContext{}
 
 			mValidation := vValidation[0].(map[string]interface{})
 
+			if vSubjectAlternativeNames, ok := mValidation["subject_alternative_names"].([]interface{}); ok {
+				validation.SubjectAlternativeNames = expandAppmeshSubjectAlternativeNames(vSubjectAlternativeNames)
+			}
+
 			if vTrust, ok := mValidation["trust"].([]interface{}); ok && len(vTrust) > 0 && vTrust[0] != nil {
 				trust := &appmesh.VirtualGatewayTlsValidationContextTrust{}
 
@@ -875,6 +1182,18 @@ func expandAppmeshVirtualGatewayClientPolicy(vClientPolicy []interface{}) *appme
 					trust.File = file
 				}
 
+				if vSds, ok := mTrust["sds"].([]interface{}); ok && len(vSds) > 0 && vSds[0] != nil {
+					sds := &appmesh.VirtualGatewayTlsValidationContextSdsTrust{}
+
+					mSds := vSds[0].(map[string]interface{})
+
+					if vSecretName, ok := mSds["secret_name"].(string); ok && vSecretName != "" {
+						sds.SecretName = aws.String(vSecretName)
+					}
+
+					trust.Sds = sds
+				}
+
 				validation.Trust = trust
 			}
 
@@ -981,9 +1300,47 @@ func flattenAppmeshVirtualGatewaySpec(spec *appmesh.VirtualGatewaySpec) []interf
 					mCertificate["file"] = []interface{}{mFile}
 				}
 
+				if sds := certificate.Sds; sds != nil {
+					mSds := map[string]interface{}{
+						"secret_name": aws.StringValue(sds.SecretName),
+					}
+
+					mCertificate["sds"] = []interface{}{mSds}
+				}
+
 				mTls["certificate"] = []interface{}{mCertificate}
 			}
 
+			if validation := tls.Validation; validation != nil {
+				mValidation := map[string]interface{}{
+					"subject_alternative_names": flattenAppmeshSubjectAlternativeNames(validation.SubjectAlternativeNames),
+				}
+
+				if trust := validation.Trust; trust != nil {
+					mTrust := map[string]interface{}{}
+
+					if file := trust.File; file != nil {
+						mFile := map[string]interface{}{
+							"certificate_chain": aws.StringValue(file.CertificateChain),
+						}
+
+						mTrust["file"] = []interface{}{mFile}
+					}
+
+					if sds := trust.Sds; sds != nil {
+						mSds := map[string]interface{}{
+							"secret_name": aws.StringValue(sds.SecretName),
+						}
+
+						mTrust["sds"] = []interface{}{mSds}
+					}
+
+					mValidation["trust"] = []interface{}{mTrust}
+				}
+
+				mTls["validation"] = []interface{}{mValidation}
+			}
+
 			mListener["tls"] = []interface{}{mTls}
 		}
 
@@ -1026,8 +1383,33 @@ func flattenAppmeshVirtualGatewayClientPolicy(clientPolicy *appmesh.VirtualGatew
 			"ports":   flattenInt64Set(tls.Ports),
 		}
 
+		if certificate := tls.Certificate; certificate != nil {
+			mCertificate := map[string]interface{}{}
+
+			if file := certificate.File; file != nil {
+				mFile := map[string]interface{}{
+					"certificate_chain": aws.StringValue(file.CertificateChain),
+					"private_key":       aws.StringValue(file.PrivateKey),
+				}
+
+				mCertificate["file"] = []interface{}{mFile}
+			}
+
+			if sds := certificate.Sds; sds != nil {
+				mSds := map[string]interface{}{
+					"secret_name": aws.StringValue(sds.SecretName),
+				}
+
+				mCertificate["sds"] = []interface{}{mSds}
+			}
+
+			mTls["certificate"] = []interface{}{mCertificate}
+		}
+
 		if validation := tls.Validation; validation != nil {
-			mValidation := map[string]interface{}{}
+			mValidation := map[string]interface{}{
+				"subject_alternative_names": flattenAppmeshSubjectAlternativeNames(validation.SubjectAlternativeNames),
+			}
 
 			if trust := validation.Trust; trust != nil {
 				mTrust := map[string]interface{}{}
@@ -1048,6 +1430,14 @@ func flattenAppmeshVirtualGatewayClientPolicy(clientPolicy *appmesh.VirtualGatew
 					mTrust["file"] = []interface{}{mFile}
 				}
 
+				if sds := trust.Sds; sds != nil {
+					mSds := map[string]interface{}{
+						"secret_name": aws.StringValue(sds.SecretName),
+					}
+
+					mTrust["sds"] = []interface{}{mSds}
+				}
+
 				mValidation["trust"] = []interface{}{mTrust}
 			}
 
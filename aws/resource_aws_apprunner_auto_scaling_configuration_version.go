@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppRunnerAutoScalingConfigurationVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppRunnerAutoScalingConfigurationCreate,
+		Read:   resourceAwsAppRunnerAutoScalingConfigurationRead,
+		Update: resourceAwsAppRunnerAutoScalingConfigurationUpdate,
+		Delete: resourceAwsAppRunnerAutoScalingConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_scaling_configuration_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 32),
+			},
+			"auto_scaling_configuration_revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"latest": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 200),
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      25,
+				ValidateFunc: validation.IntBetween(1, 25),
+			},
+			"min_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 25),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsAppRunnerAutoScalingConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apprunnerconn
+
+	name := d.Get("auto_scaling_configuration_name").(string)
+	input := &apprunner.CreateAutoScalingConfigurationInput{
+		AutoScalingConfigurationName: aws.String(name),
+		MaxConcurrency:               aws.Int64(int64(d.Get("max_concurrency").(int))),
+		MaxSize:                      aws.Int64(int64(d.Get("max_size").(int))),
+		MinSize:                      aws.Int64(int64(d.Get("min_size").(int))),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().ApprunnerTags()
+	}
+
+	output, err := conn.CreateAutoScalingConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating App Runner AutoScaling Configuration Version (%s): %w", name, err)
+	}
+
+	if output == nil || output.AutoScalingConfiguration == nil {
+		return fmt.Errorf("error creating App Runner AutoScaling Configuration Version (%s): empty output", name)
+	}
+
+	d.SetId(aws.StringValue(output.AutoScalingConfiguration.AutoScalingConfigurationArn))
+
+	if _, err := waiter.AutoScalingConfigurationActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for App Runner AutoScaling Configuration Version (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsAppRunnerAutoScalingConfigurationRead(d, meta)
+}
+
+func resourceAwsAppRunnerAutoScalingConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apprunnerconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	config, err := finder.AutoScalingConfigurationByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] App Runner AutoScaling Configuration Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading App Runner AutoScaling Configuration Version (%s): %w", d.Id(), err)
+	}
+
+	if aws.StringValue(config.Status) == apprunner.AutoScalingConfigurationStatusInactive {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading App Runner AutoScaling Configuration Version (%s): %s after creation", d.Id(), aws.StringValue(config.Status))
+		}
+		log.Printf("[WARN] App Runner AutoScaling Configuration Version (%s) is %s, removing from state", d.Id(), aws.StringValue(config.Status))
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(config.AutoScalingConfigurationArn)
+
+	d.Set("arn", arn)
+	d.Set("auto_scaling_configuration_name", config.AutoScalingConfigurationName)
+	d.Set("auto_scaling_configuration_revision", config.AutoScalingConfigurationRevision)
+	d.Set("latest", config.Latest)
+	d.Set("max_concurrency", config.MaxConcurrency)
+	d.Set("max_size", config.MaxSize)
+	d.Set("min_size", config.MinSize)
+	d.Set("status", config.Status)
+
+	tags, err := keyvaluetags.ApprunnerListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for App Runner AutoScaling Configuration Version (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppRunnerAutoScalingConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apprunnerconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.ApprunnerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating App Runner AutoScaling Configuration Version (%s) tags: %w", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceAwsAppRunnerAutoScalingConfigurationRead(d, meta)
+}
+
+func resourceAwsAppRunnerAutoScalingConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apprunnerconn
+
+	input := &apprunner.DeleteAutoScalingConfigurationInput{
+		AutoScalingConfigurationArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteAutoScalingConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting App Runner AutoScaling Configuration Version (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.AutoScalingConfigurationInactive(conn, d.Id()); err != nil {
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error waiting for App Runner AutoScaling Configuration Version (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
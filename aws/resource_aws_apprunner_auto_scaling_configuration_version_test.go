@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAwsAppRunnerAutoScalingConfigurationVersion_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apprunner_auto_scaling_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAppRunner(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppRunnerAutoScalingConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerAutoScalingConfigurationVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerAutoScalingConfigurationVersionExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "apprunner", regexp.MustCompile(fmt.Sprintf(`autoscalingconfiguration/%s/1/.+`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_configuration_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_configuration_revision", "1"),
+					resource.TestCheckResourceAttr(resourceName, "latest", "true"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "100"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "25"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", apprunner.AutoScalingConfigurationStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsAppRunnerAutoScalingConfigurationVersion_complex(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apprunner_auto_scaling_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAppRunner(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppRunnerAutoScalingConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerAutoScalingConfigurationVersionConfig_nonDefaults(rName, 50, 10, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerAutoScalingConfigurationVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "50"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppRunnerAutoScalingConfigurationVersionConfig_nonDefaults(rName, 150, 20, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerAutoScalingConfigurationVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "150"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsAppRunnerAutoScalingConfigurationVersion_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apprunner_auto_scaling_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAppRunner(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppRunnerAutoScalingConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerAutoScalingConfigurationVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerAutoScalingConfigurationVersionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppRunnerAutoScalingConfigurationVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsAppRunnerAutoScalingConfigurationVersion_tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apprunner_auto_scaling_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAppRunner(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppRunnerAutoScalingConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerAutoScalingConfigurationVersionConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerAutoScalingConfigurationVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppRunnerAutoScalingConfigurationVersionConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerAutoScalingConfigurationVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAppRunnerAutoScalingConfigurationVersionConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerAutoScalingConfigurationVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAppRunner(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+
+	input := &apprunner.ListServicesInput{}
+
+	_, err := conn.ListServices(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAwsAppRunnerAutoScalingConfigurationVersionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apprunner_auto_scaling_configuration_version" {
+			continue
+		}
+
+		config, err := finder.AutoScalingConfigurationByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(config.Status) != apprunner.AutoScalingConfigurationStatusInactive {
+			return fmt.Errorf("App Runner AutoScaling Configuration Version (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsAppRunnerAutoScalingConfigurationVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No App Runner AutoScaling Configuration Version ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+
+		_, err := finder.AutoScalingConfigurationByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAppRunnerAutoScalingConfigurationVersionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_auto_scaling_configuration_version" "test" {
+  auto_scaling_configuration_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAppRunnerAutoScalingConfigurationVersionConfig_nonDefaults(rName string, maxConcurrency, maxSize, minSize int) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_auto_scaling_configuration_version" "test" {
+  auto_scaling_configuration_name = %[1]q
+
+  max_concurrency = %[2]d
+  max_size        = %[3]d
+  min_size        = %[4]d
+}
+`, rName, maxConcurrency, maxSize, minSize)
+}
+
+func testAccAppRunnerAutoScalingConfigurationVersionConfigTags1(rName string, tagKey1 string, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_auto_scaling_configuration_version" "test" {
+  auto_scaling_configuration_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAppRunnerAutoScalingConfigurationVersionConfigTags2(rName string, tagKey1 string, tagValue1 string, tagKey2 string, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_auto_scaling_configuration_version" "test" {
+  auto_scaling_configuration_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
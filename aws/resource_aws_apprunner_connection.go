@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppRunnerConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppRunnerConnectionCreate,
+		Read:   resourceAwsAppRunnerConnectionRead,
+		Update: resourceAwsAppRunnerConnectionUpdate,
+		Delete: resourceAwsAppRunnerConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 32),
+			},
+			"provider_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(apprunner.ProviderType_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsAppRunnerConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apprunnerconn
+
+	name := d.Get("connection_name").(string)
+	input := &apprunner.CreateConnectionInput{
+		ConnectionName: aws.String(name),
+		ProviderType:   aws.String(d.Get("provider_type").(string)),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().ApprunnerTags()
+	}
+
+	output, err := conn.CreateConnection(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating App Runner Connection (%s): %w", name, err)
+	}
+
+	if output == nil || output.Connection == nil {
+		return fmt.Errorf("error creating App Runner Connection (%s): empty output", name)
+	}
+
+	d.SetId(aws.StringValue(output.Connection.ConnectionName))
+
+	return resourceAwsAppRunnerConnectionRead(d, meta)
+}
+
+func resourceAwsAppRunnerConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apprunnerconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	c, err := finder.ConnectionSummaryByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] App Runner Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading App Runner Connection (%s): %w", d.Id(), err)
+	}
+
+	if aws.StringValue(c.Status) == apprunner.ConnectionStatusDeleted {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading App Runner Connection (%s): %s after creation", d.Id(), aws.StringValue(c.Status))
+		}
+		log.Printf("[WARN] App Runner Connection (%s) is %s, removing from state", d.Id(), aws.StringValue(c.Status))
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(c.ConnectionArn)
+
+	d.Set("arn", arn)
+	d.Set("connection_name", c.ConnectionName)
+	d.Set("provider_type", c.ProviderType)
+	d.Set("status", c.Status)
+
+	tags, err := keyvaluetags.ApprunnerListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for App Runner Connection (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppRunnerConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apprunnerconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.ApprunnerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating App Runner Connection (%s) tags: %w", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceAwsAppRunnerConnectionRead(d, meta)
+}
+
+func resourceAwsAppRunnerConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apprunnerconn
+
+	input := &apprunner.DeleteConnectionInput{
+		ConnectionArn: aws.String(d.Get("arn").(string)),
+	}
+
+	_, err := conn.DeleteConnection(input)
+
+	if tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting App Runner Connection (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ConnectionDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for App Runner Connection (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
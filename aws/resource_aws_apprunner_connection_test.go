@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAwsAppRunnerConnection_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apprunner_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAppRunner(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppRunnerConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerConnection_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerConnectionExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "apprunner", regexp.MustCompile(fmt.Sprintf(`connection/%s/.+`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "connection_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", apprunner.ProviderTypeGithub),
+					resource.TestCheckResourceAttr(resourceName, "status", apprunner.ConnectionStatusPendingHandshake),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsAppRunnerConnection_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apprunner_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAppRunner(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppRunnerConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerConnection_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerConnectionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppRunnerConnection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsAppRunnerConnection_tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apprunner_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAppRunner(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppRunnerConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerConnectionConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppRunnerConnectionConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAppRunnerConnectionConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppRunnerConnectionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apprunner_connection" {
+			continue
+		}
+
+		c, err := finder.ConnectionSummaryByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(c.Status) != apprunner.ConnectionStatusDeleted {
+			return fmt.Errorf("App Runner Connection (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsAppRunnerConnectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No App Runner Connection ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+
+		_, err := finder.ConnectionSummaryByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAppRunnerConnection_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_connection" "test" {
+  connection_name = %[1]q
+  provider_type   = "GITHUB"
+}
+`, rName)
+}
+
+func testAccAppRunnerConnectionConfigTags1(rName string, tagKey1 string, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_connection" "test" {
+  connection_name = %[1]q
+  provider_type   = "GITHUB"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAppRunnerConnectionConfigTags2(rName string, tagKey1 string, tagValue1 string, tagKey2 string, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_connection" "test" {
+  connection_name = %[1]q
+  provider_type   = "GITHUB"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
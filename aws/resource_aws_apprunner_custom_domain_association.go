@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfapprunner "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppRunnerCustomDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppRunnerCustomDomainAssociationCreate,
+		Read:   resourceAwsAppRunnerCustomDomainAssociationRead,
+		Delete: resourceAwsAppRunnerCustomDomainAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_validation_records": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dns_target": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"enable_www_subdomain": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"service_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppRunnerCustomDomainAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apprunnerconn
+
+	domainName := d.Get("domain_name").(string)
+	serviceArn := d.Get("service_arn").(string)
+
+	input := &apprunner.AssociateCustomDomainInput{
+		DomainName:         aws.String(domainName),
+		EnableWWWSubdomain: aws.Bool(d.Get("enable_www_subdomain").(bool)),
+		ServiceArn:         aws.String(serviceArn),
+	}
+
+	output, err := conn.AssociateCustomDomain(input)
+
+	if err != nil {
+		return fmt.Errorf("error associating App Runner Custom Domain (%s) with Service (%s): %w", domainName, serviceArn, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error associating App Runner Custom Domain (%s) with Service (%s): empty output", domainName, serviceArn)
+	}
+
+	d.SetId(tfapprunner.CustomDomainAssociationCreateResourceID(aws.StringValue(output.CustomDomain.DomainName), aws.StringValue(output.ServiceArn)))
+	d.Set("dns_target", output.DNSTarget)
+
+	if _, err := waiter.CustomDomainAssociationCreated(conn, domainName, serviceArn); err != nil {
+		return fmt.Errorf("error waiting for App Runner Custom Domain Association (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsAppRunnerCustomDomainAssociationRead(d, meta)
+}
+
+func resourceAwsAppRunnerCustomDomainAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apprunnerconn
+
+	domainName, serviceArn, err := tfapprunner.CustomDomainAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	customDomain, err := finder.CustomDomainByDomainNameAndServiceARN(conn, domainName, serviceArn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] App Runner Custom Domain Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading App Runner Custom Domain Association (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("certificate_validation_records", flattenAppRunnerCustomDomainCertificateValidationRecords(customDomain.CertificateValidationRecords)); err != nil {
+		return fmt.Errorf("error setting certificate_validation_records: %w", err)
+	}
+
+	d.Set("domain_name", customDomain.DomainName)
+	d.Set("enable_www_subdomain", customDomain.EnableWWWSubdomain)
+	d.Set("service_arn", serviceArn)
+	d.Set("status", customDomain.Status)
+
+	return nil
+}
+
+func resourceAwsAppRunnerCustomDomainAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apprunnerconn
+
+	domainName, serviceArn, err := tfapprunner.CustomDomainAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &apprunner.DisassociateCustomDomainInput{
+		DomainName: aws.String(domainName),
+		ServiceArn: aws.String(serviceArn),
+	}
+
+	_, err = conn.DisassociateCustomDomain(input)
+
+	if tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating App Runner Custom Domain (%s) from Service (%s): %w", domainName, serviceArn, err)
+	}
+
+	if _, err := waiter.CustomDomainAssociationDeleted(conn, domainName, serviceArn); err != nil {
+		return fmt.Errorf("error waiting for App Runner Custom Domain Association (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenAppRunnerCustomDomainCertificateValidationRecords(records []*apprunner.CertificateValidationRecord) []interface{} {
+	var results []interface{}
+
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"name":   aws.StringValue(record.Name),
+			"status": aws.StringValue(record.Status),
+			"type":   aws.StringValue(record.Type),
+			"value":  aws.StringValue(record.Value),
+		}
+
+		results = append(results, m)
+	}
+
+	return results
+}
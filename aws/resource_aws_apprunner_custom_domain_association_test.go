@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfapprunner "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apprunner/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAwsAppRunnerCustomDomainAssociation_basic(t *testing.T) {
+	domain := os.Getenv("APPRUNNER_CUSTOM_DOMAIN")
+	if domain == "" {
+		t.Skip("Environment variable APPRUNNER_CUSTOM_DOMAIN is not set")
+	}
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apprunner_custom_domain_association.test"
+	serviceResourceName := "aws_apprunner_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAppRunner(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppRunnerCustomDomainAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerCustomDomainAssociationConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerCustomDomainAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "certificate_validation_records.#", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_target"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domain),
+					resource.TestCheckResourceAttr(resourceName, "enable_www_subdomain", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", apprunner.CustomDomainAssociationStatusPendingCertificateDnsValidation),
+					resource.TestCheckResourceAttrPair(resourceName, "service_arn", serviceResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dns_target"},
+			},
+		},
+	})
+}
+
+func TestAccAwsAppRunnerCustomDomainAssociation_disappears(t *testing.T) {
+	domain := os.Getenv("APPRUNNER_CUSTOM_DOMAIN")
+	if domain == "" {
+		t.Skip("Environment variable APPRUNNER_CUSTOM_DOMAIN is not set")
+	}
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apprunner_custom_domain_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAppRunner(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppRunnerCustomDomainAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRunnerCustomDomainAssociationConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppRunnerCustomDomainAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppRunnerCustomDomainAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAppRunnerCustomDomainAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apprunner_custom_domain_association" {
+			continue
+		}
+
+		domainName, serviceArn, err := tfapprunner.CustomDomainAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.CustomDomainByDomainNameAndServiceARN(conn, domainName, serviceArn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("App Runner Custom Domain Association (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsAppRunnerCustomDomainAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No App Runner Custom Domain Association ID is set")
+		}
+
+		domainName, serviceArn, err := tfapprunner.CustomDomainAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+
+		_, err = finder.CustomDomainByDomainNameAndServiceARN(conn, domainName, serviceArn)
+
+		return err
+	}
+}
+
+func testAccAppRunnerCustomDomainAssociationConfig_basic(rName, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+
+  source_configuration {
+    auto_deployments_enabled = false
+
+    image_repository {
+      image_configuration {
+        port = "8000"
+      }
+
+      image_identifier      = "public.ecr.aws/aws-containers/hello-app-runner:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+}
+
+resource "aws_apprunner_custom_domain_association" "test" {
+  domain_name = %[2]q
+  service_arn = aws_apprunner_service.test.arn
+}
+`, rName, domain)
+}
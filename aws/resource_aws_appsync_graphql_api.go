@@ -35,6 +35,7 @@ func resourceAwsAppsyncGraphqlApi() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringInSlice(appsync.AuthenticationType_Values(), false),
 						},
+						"lambda_authorizer_config": appsyncGraphqlApiLambdaAuthorizerConfigSchema(),
 						"openid_connect_config": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -105,6 +106,7 @@ func resourceAwsAppsyncGraphqlApi() *schema.Resource {
 					return
 				},
 			},
+			"lambda_authorizer_config": appsyncGraphqlApiLambdaAuthorizerConfigSchema(),
 			"log_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -205,6 +207,33 @@ func resourceAwsAppsyncGraphqlApi() *schema.Resource {
 	}
 }
 
+func appsyncGraphqlApiLambdaAuthorizerConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"authorizer_result_ttl_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      300,
+					ValidateFunc: validation.IntBetween(0, 3600),
+				},
+				"authorizer_uri": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+				"identity_validation_expression": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
 func resourceAwsAppsyncGraphqlApiCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).appsyncconn
 
@@ -213,6 +242,10 @@ func resourceAwsAppsyncGraphqlApiCreate(d *schema.ResourceData, meta interface{}
 		Name:               aws.String(d.Get("name").(string)),
 	}
 
+	if v, ok := d.GetOk("lambda_authorizer_config"); ok {
+		input.LambdaAuthorizerConfig = expandAppsyncGraphqlApiLambdaAuthorizerConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("log_config"); ok {
 		input.LogConfig = expandAppsyncGraphqlApiLogConfig(v.([]interface{}))
 	}
@@ -275,6 +308,10 @@ func resourceAwsAppsyncGraphqlApiRead(d *schema.ResourceData, meta interface{})
 	d.Set("authentication_type", resp.GraphqlApi.AuthenticationType)
 	d.Set("name", resp.GraphqlApi.Name)
 
+	if err := d.Set("lambda_authorizer_config", flattenAppsyncGraphqlApiLambdaAuthorizerConfig(resp.GraphqlApi.LambdaAuthorizerConfig)); err != nil {
+		return fmt.Errorf("error setting lambda_authorizer_config: %s", err)
+	}
+
 	if err := d.Set("log_config", flattenAppsyncGraphqlApiLogConfig(resp.GraphqlApi.LogConfig)); err != nil {
 		return fmt.Errorf("error setting log_config: %s", err)
 	}
@@ -287,7 +324,9 @@ func resourceAwsAppsyncGraphqlApiRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error setting user_pool_config: %s", err)
 	}
 
-	if err := d.Set("additional_authentication_provider", flattenAppsyncGraphqlApiAdditionalAuthenticationProviders(resp.GraphqlApi.AdditionalAuthenticationProviders)); err != nil {
+	additionalAuthenticationProviders := sortAppsyncGraphqlApiAdditionalAuthenticationProviders(resp.GraphqlApi.AdditionalAuthenticationProviders, d.Get("additional_authentication_provider").([]interface{}))
+
+	if err := d.Set("additional_authentication_provider", flattenAppsyncGraphqlApiAdditionalAuthenticationProviders(additionalAuthenticationProviders)); err != nil {
 		return fmt.Errorf("error setting additional_authentication_provider: %s", err)
 	}
 
@@ -323,6 +362,10 @@ func resourceAwsAppsyncGraphqlApiUpdate(d *schema.ResourceData, meta interface{}
 		Name:               aws.String(d.Get("name").(string)),
 	}
 
+	if v, ok := d.GetOk("lambda_authorizer_config"); ok {
+		input.LambdaAuthorizerConfig = expandAppsyncGraphqlApiLambdaAuthorizerConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("log_config"); ok {
 		input.LogConfig = expandAppsyncGraphqlApiLogConfig(v.([]interface{}))
 	}
@@ -337,6 +380,9 @@ func resourceAwsAppsyncGraphqlApiUpdate(d *schema.ResourceData, meta interface{}
 
 	if v, ok := d.GetOk("additional_authentication_provider"); ok {
 		input.AdditionalAuthenticationProviders = expandAppsyncGraphqlApiAdditionalAuthProviders(v.([]interface{}), meta.(*AWSClient).region)
+	} else if d.HasChange("additional_authentication_provider") {
+		// An empty list removes all previously configured providers
+		input.AdditionalAuthenticationProviders = []*appsync.AdditionalAuthenticationProvider{}
 	}
 
 	if v, ok := d.GetOk("xray_enabled"); ok {
@@ -458,6 +504,10 @@ func expandAppsyncGraphqlApiAdditionalAuthProviders(items []interface{}, current
 			AuthenticationType: aws.String(m["authentication_type"].(string)),
 		}
 
+		if v, ok := m["lambda_authorizer_config"]; ok {
+			additionalAuthProvider.LambdaAuthorizerConfig = expandAppsyncGraphqlApiLambdaAuthorizerConfig(v.([]interface{}))
+		}
+
 		if v, ok := m["openid_connect_config"]; ok {
 			additionalAuthProvider.OpenIDConnectConfig = expandAppsyncGraphqlApiOpenIDConnectConfig(v.([]interface{}))
 		}
@@ -472,6 +522,25 @@ func expandAppsyncGraphqlApiAdditionalAuthProviders(items []interface{}, current
 	return additionalAuthProviders
 }
 
+func expandAppsyncGraphqlApiLambdaAuthorizerConfig(l []interface{}) *appsync.LambdaAuthorizerConfig {
+	if len(l) < 1 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	lambdaAuthorizerConfig := &appsync.LambdaAuthorizerConfig{
+		AuthorizerResultTtlInSeconds: aws.Int64(int64(m["authorizer_result_ttl_in_seconds"].(int))),
+		AuthorizerUri:                aws.String(m["authorizer_uri"].(string)),
+	}
+
+	if v, ok := m["identity_validation_expression"].(string); ok && v != "" {
+		lambdaAuthorizerConfig.IdentityValidationExpression = aws.String(v)
+	}
+
+	return lambdaAuthorizerConfig
+}
+
 func expandAppsyncGraphqlApiCognitoUserPoolConfig(l []interface{}, currentRegion string) *appsync.CognitoUserPoolConfig {
 	if len(l) < 1 || l[0] == nil {
 		return nil
@@ -550,15 +619,100 @@ func flattenAppsyncGraphqlApiAdditionalAuthenticationProviders(additionalAuthent
 	result := make([]interface{}, len(additionalAuthenticationProviders))
 	for i, provider := range additionalAuthenticationProviders {
 		result[i] = map[string]interface{}{
-			"authentication_type":   aws.StringValue(provider.AuthenticationType),
-			"openid_connect_config": flattenAppsyncGraphqlApiOpenIDConnectConfig(provider.OpenIDConnectConfig),
-			"user_pool_config":      flattenAppsyncGraphqlApiCognitoUserPoolConfig(provider.UserPoolConfig),
+			"authentication_type":      aws.StringValue(provider.AuthenticationType),
+			"lambda_authorizer_config": flattenAppsyncGraphqlApiLambdaAuthorizerConfig(provider.LambdaAuthorizerConfig),
+			"openid_connect_config":    flattenAppsyncGraphqlApiOpenIDConnectConfig(provider.OpenIDConnectConfig),
+			"user_pool_config":         flattenAppsyncGraphqlApiCognitoUserPoolConfig(provider.UserPoolConfig),
+		}
+	}
+
+	return result
+}
+
+// sortAppsyncGraphqlApiAdditionalAuthenticationProviders returns the API's additional
+// authentication providers in the order they are configured, so that reordering by
+// the service does not show up as a difference. Unconfigured providers (e.g. on
+// import) are appended in the order returned by the API.
+func sortAppsyncGraphqlApiAdditionalAuthenticationProviders(providers []*appsync.AdditionalAuthenticationProvider, configured []interface{}) []*appsync.AdditionalAuthenticationProvider {
+	if len(providers) == 0 || len(configured) == 0 {
+		return providers
+	}
+
+	result := make([]*appsync.AdditionalAuthenticationProvider, 0, len(providers))
+	used := make([]bool, len(providers))
+
+	for _, c := range configured {
+		m, ok := c.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := appsyncGraphqlApiAdditionalAuthenticationProviderConfigKey(m)
+
+		for i, provider := range providers {
+			if used[i] || provider == nil || appsyncGraphqlApiAdditionalAuthenticationProviderKey(provider) != key {
+				continue
+			}
+
+			used[i] = true
+			result = append(result, provider)
+			break
+		}
+	}
+
+	for i, provider := range providers {
+		if !used[i] {
+			result = append(result, provider)
 		}
 	}
 
 	return result
 }
 
+func appsyncGraphqlApiAdditionalAuthenticationProviderKey(provider *appsync.AdditionalAuthenticationProvider) string {
+	var id string
+
+	switch {
+	case provider.LambdaAuthorizerConfig != nil:
+		id = aws.StringValue(provider.LambdaAuthorizerConfig.AuthorizerUri)
+	case provider.OpenIDConnectConfig != nil:
+		id = aws.StringValue(provider.OpenIDConnectConfig.Issuer)
+	case provider.UserPoolConfig != nil:
+		id = aws.StringValue(provider.UserPoolConfig.UserPoolId)
+	}
+
+	return aws.StringValue(provider.AuthenticationType) + "/" + id
+}
+
+func appsyncGraphqlApiAdditionalAuthenticationProviderConfigKey(m map[string]interface{}) string {
+	var id string
+
+	if v, ok := m["lambda_authorizer_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		id = v[0].(map[string]interface{})["authorizer_uri"].(string)
+	} else if v, ok := m["openid_connect_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		id = v[0].(map[string]interface{})["issuer"].(string)
+	} else if v, ok := m["user_pool_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		id = v[0].(map[string]interface{})["user_pool_id"].(string)
+	}
+
+	return m["authentication_type"].(string) + "/" + id
+}
+
+func flattenAppsyncGraphqlApiLambdaAuthorizerConfig(lambdaAuthorizerConfig *appsync.LambdaAuthorizerConfig) []interface{} {
+	if lambdaAuthorizerConfig == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"authorizer_result_ttl_in_seconds": aws.Int64Value(lambdaAuthorizerConfig.AuthorizerResultTtlInSeconds),
+		"authorizer_uri":                   aws.StringValue(lambdaAuthorizerConfig.AuthorizerUri),
+		"identity_validation_expression":   aws.StringValue(lambdaAuthorizerConfig.IdentityValidationExpression),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenAppsyncGraphqlApiCognitoUserPoolConfig(userPoolConfig *appsync.CognitoUserPoolConfig) []interface{} {
 	if userPoolConfig == nil {
 		return []interface{}{}
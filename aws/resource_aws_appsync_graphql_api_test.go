@@ -914,6 +914,135 @@ func TestAccAWSAppsyncGraphqlApi_AdditionalAuthentication_Multiple(t *testing.T)
 	})
 }
 
+func TestAccAWSAppsyncGraphqlApi_LambdaAuthorizerConfig(t *testing.T) {
+	var api1, api2 appsync.GraphqlApi
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appsync_graphql_api.test"
+	lambdaAuthorizerResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appsync.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_LambdaAuthorizerConfig(rName, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "AWS_LAMBDA"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_authorizer_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lambda_authorizer_config.0.authorizer_uri", lambdaAuthorizerResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_authorizer_config.0.authorizer_result_ttl_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_authorizer_config.0.identity_validation_expression", "^Bearer [A-Za-z0-9]+$"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_LambdaAuthorizerConfig(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName, &api2),
+					resource.TestCheckResourceAttr(resourceName, "lambda_authorizer_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_authorizer_config.0.authorizer_result_ttl_in_seconds", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppsyncGraphqlApi_AdditionalAuthentication_LambdaAuthorizerConfig(t *testing.T) {
+	var api1, api2 appsync.GraphqlApi
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appsync_graphql_api.test"
+	cognitoUserPoolResourceName := "aws_cognito_user_pool.test"
+	lambdaAuthorizerResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appsync.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAppsyncGraphqlApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppsyncGraphqlApiConfig_AdditionalAuth_LambdaAuthorizerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "AMAZON_COGNITO_USER_POOLS"),
+					resource.TestCheckResourceAttr(resourceName, "additional_authentication_provider.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "additional_authentication_provider.0.authentication_type", "AWS_IAM"),
+					resource.TestCheckResourceAttr(resourceName, "additional_authentication_provider.1.authentication_type", "AWS_LAMBDA"),
+					resource.TestCheckResourceAttr(resourceName, "additional_authentication_provider.1.lambda_authorizer_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "additional_authentication_provider.1.lambda_authorizer_config.0.authorizer_uri", lambdaAuthorizerResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_config.0.user_pool_id", cognitoUserPoolResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppsyncGraphqlApiConfig_AdditionalAuth_UserPoolDefault(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppsyncGraphqlApiExists(resourceName, &api2),
+					testAccCheckAwsAppsyncGraphqlApiNotRecreated(&api1, &api2),
+					resource.TestCheckResourceAttr(resourceName, "additional_authentication_provider.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestSortAppsyncGraphqlApiAdditionalAuthenticationProviders(t *testing.T) {
+	providers := []*appsync.AdditionalAuthenticationProvider{
+		{
+			AuthenticationType: aws.String(appsync.AuthenticationTypeOpenidConnect),
+			OpenIDConnectConfig: &appsync.OpenIDConnectConfig{
+				Issuer: aws.String("https://example.com"),
+			},
+		},
+		{
+			AuthenticationType: aws.String(appsync.AuthenticationTypeApiKey),
+		},
+		{
+			AuthenticationType: aws.String(appsync.AuthenticationTypeAwsIam),
+		},
+	}
+	configured := []interface{}{
+		map[string]interface{}{
+			"authentication_type": appsync.AuthenticationTypeAwsIam,
+		},
+		map[string]interface{}{
+			"authentication_type": appsync.AuthenticationTypeOpenidConnect,
+			"openid_connect_config": []interface{}{
+				map[string]interface{}{
+					"issuer": "https://example.com",
+				},
+			},
+		},
+	}
+
+	result := sortAppsyncGraphqlApiAdditionalAuthenticationProviders(providers, configured)
+
+	expected := []string{
+		appsync.AuthenticationTypeAwsIam,
+		appsync.AuthenticationTypeOpenidConnect,
+		appsync.AuthenticationTypeApiKey,
+	}
+
+	if len(result) != len(expected) {
+		t.Fatalf("expected %d providers, got %d", len(expected), len(result))
+	}
+
+	for i, authenticationType := range expected {
+		if got := aws.StringValue(result[i].AuthenticationType); got != authenticationType {
+			t.Errorf("expected provider %d to be %s, got %s", i, authenticationType, got)
+		}
+	}
+}
+
 func TestAccAWSAppsyncGraphqlApi_XrayEnabled(t *testing.T) {
 	var api1, api2 appsync.GraphqlApi
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -942,6 +1071,16 @@ func TestAccAWSAppsyncGraphqlApi_XrayEnabled(t *testing.T) {
 	})
 }
 
+func testAccCheckAwsAppsyncGraphqlApiNotRecreated(before, after *appsync.GraphqlApi) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.ApiId) != aws.StringValue(after.ApiId) {
+			return fmt.Errorf("AppSync GraphQL API recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAwsAppsyncGraphqlApiDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
 	for _, rs := range s.RootModule().Resources {
@@ -1327,6 +1466,113 @@ resource "aws_appsync_graphql_api" "test" {
 `, rName, rName, issuer)
 }
 
+func testAccAppsyncGraphqlApiConfig_base_LambdaAuthorizer(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  handler       = "exports.example"
+  role          = aws_iam_role.test.arn
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_lambda_permission" "test" {
+  statement_id  = "appsync_lambda_authorizer"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "appsync.${data.aws_partition.current.dns_suffix}"
+}
+`, rName)
+}
+
+func testAccAppsyncGraphqlApiConfig_LambdaAuthorizerConfig(rName string, ttl int) string {
+	return composeConfig(testAccAppsyncGraphqlApiConfig_base_LambdaAuthorizer(rName), fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "AWS_LAMBDA"
+  name                = %[1]q
+
+  lambda_authorizer_config {
+    authorizer_uri                   = aws_lambda_function.test.arn
+    authorizer_result_ttl_in_seconds = %[2]d
+    identity_validation_expression   = "^Bearer [A-Za-z0-9]+$"
+  }
+
+  depends_on = [aws_lambda_permission.test]
+}
+`, rName, ttl))
+}
+
+func testAccAppsyncGraphqlApiConfig_AdditionalAuth_LambdaAuthorizerConfig(rName string) string {
+	return composeConfig(testAccAppsyncGraphqlApiConfig_base_LambdaAuthorizer(rName), fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "AMAZON_COGNITO_USER_POOLS"
+  name                = %[1]q
+
+  user_pool_config {
+    default_action = "ALLOW"
+    user_pool_id   = aws_cognito_user_pool.test.id
+  }
+
+  additional_authentication_provider {
+    authentication_type = "AWS_IAM"
+  }
+
+  additional_authentication_provider {
+    authentication_type = "AWS_LAMBDA"
+
+    lambda_authorizer_config {
+      authorizer_uri = aws_lambda_function.test.arn
+    }
+  }
+
+  depends_on = [aws_lambda_permission.test]
+}
+`, rName))
+}
+
+func testAccAppsyncGraphqlApiConfig_AdditionalAuth_UserPoolDefault(rName string) string {
+	return composeConfig(testAccAppsyncGraphqlApiConfig_base_LambdaAuthorizer(rName), fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "AMAZON_COGNITO_USER_POOLS"
+  name                = %[1]q
+
+  user_pool_config {
+    default_action = "ALLOW"
+    user_pool_id   = aws_cognito_user_pool.test.id
+  }
+}
+`, rName))
+}
+
 func testAccAppsyncGraphqlApiConfig_XrayEnabled(rName string, xrayEnabled bool) string {
 	return fmt.Sprintf(`
 resource "aws_appsync_graphql_api" "test" {
@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsAthenaPreparedStatement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAthenaPreparedStatementCreate,
+		Read:   resourceAwsAthenaPreparedStatementRead,
+		Update: resourceAwsAthenaPreparedStatementUpdate,
+		Delete: resourceAwsAthenaPreparedStatementDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_@:]*$`), "must start with a letter or underscore and contain only alphanumeric characters, underscores, at signs, and colons"),
+				),
+			},
+			"query_statement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262144),
+			},
+			"workgroup": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAthenaPreparedStatementCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	workGroupName := d.Get("workgroup").(string)
+	statementName := d.Get("name").(string)
+
+	input := &athena.CreatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(statementName),
+		WorkGroup:      aws.String(workGroupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreatePreparedStatement(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Athena Prepared Statement (%s) in WorkGroup (%s): %w", statementName, workGroupName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workGroupName, statementName))
+
+	return resourceAwsAthenaPreparedStatementRead(d, meta)
+}
+
+func resourceAwsAthenaPreparedStatementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	workGroupName, statementName, err := resourceAwsAthenaPreparedStatementParseId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &athena.GetPreparedStatementInput{
+		StatementName: aws.String(statementName),
+		WorkGroup:     aws.String(workGroupName),
+	}
+
+	output, err := conn.GetPreparedStatement(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, athena.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Athena Prepared Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Athena Prepared Statement (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.PreparedStatement == nil {
+		return fmt.Errorf("error reading Athena Prepared Statement (%s): empty response", d.Id())
+	}
+
+	preparedStatement := output.PreparedStatement
+
+	d.Set("description", preparedStatement.Description)
+	d.Set("name", preparedStatement.StatementName)
+	d.Set("query_statement", preparedStatement.QueryStatement)
+	d.Set("workgroup", workGroupName)
+
+	return nil
+}
+
+func resourceAwsAthenaPreparedStatementUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	workGroupName, statementName, err := resourceAwsAthenaPreparedStatementParseId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &athena.UpdatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(statementName),
+		WorkGroup:      aws.String(workGroupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err = conn.UpdatePreparedStatement(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Athena Prepared Statement (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsAthenaPreparedStatementRead(d, meta)
+}
+
+func resourceAwsAthenaPreparedStatementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).athenaconn
+
+	workGroupName, statementName, err := resourceAwsAthenaPreparedStatementParseId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &athena.DeletePreparedStatementInput{
+		StatementName: aws.String(statementName),
+		WorkGroup:     aws.String(workGroupName),
+	}
+
+	_, err = conn.DeletePreparedStatement(input)
+
+	if tfawserr.ErrCodeEquals(err, athena.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Athena Prepared Statement (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsAthenaPreparedStatementParseId(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected WORKGROUP/NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSAthenaPreparedStatement_basic(t *testing.T) {
+	var preparedStatement athena.PreparedStatement
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaPreparedStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaPreparedStatementConfig(rName, "SELECT 1 WHERE 1 = ?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaPreparedStatementExists(resourceName, &preparedStatement),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT 1 WHERE 1 = ?"),
+					resource.TestCheckResourceAttrPair(resourceName, "workgroup", "aws_athena_workgroup.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAthenaPreparedStatement_disappears(t *testing.T) {
+	var preparedStatement athena.PreparedStatement
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaPreparedStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaPreparedStatementConfig(rName, "SELECT 1 WHERE 1 = ?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaPreparedStatementExists(resourceName, &preparedStatement),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAthenaPreparedStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAthenaPreparedStatement_Update(t *testing.T) {
+	var preparedStatement1, preparedStatement2 athena.PreparedStatement
+	rName := acctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAthenaPreparedStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaPreparedStatementConfigDescription(rName, "SELECT 1 WHERE 1 = ?", "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaPreparedStatementExists(resourceName, &preparedStatement1),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT 1 WHERE 1 = ?"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAthenaPreparedStatementConfigDescription(rName, "SELECT 2 WHERE 2 = ?", "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAthenaPreparedStatementExists(resourceName, &preparedStatement2),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT 2 WHERE 2 = ?"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAthenaPreparedStatementDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).athenaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_athena_prepared_statement" {
+			continue
+		}
+
+		workGroupName, statementName, err := resourceAwsAthenaPreparedStatementParseId(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetPreparedStatement(&athena.GetPreparedStatementInput{
+			StatementName: aws.String(statementName),
+			WorkGroup:     aws.String(workGroupName),
+		})
+
+		// Deleting the workgroup also removes its prepared statements.
+		if tfawserr.ErrCodeEquals(err, athena.ErrCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "is not found") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Athena Prepared Statement (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAthenaPreparedStatementExists(name string, preparedStatement *athena.PreparedStatement) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).athenaconn
+
+		workGroupName, statementName, err := resourceAwsAthenaPreparedStatementParseId(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetPreparedStatement(&athena.GetPreparedStatementInput{
+			StatementName: aws.String(statementName),
+			WorkGroup:     aws.String(workGroupName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*preparedStatement = *output.PreparedStatement
+
+		return nil
+	}
+}
+
+func testAccAthenaPreparedStatementConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccAthenaPreparedStatementConfig(rName, queryStatement string) string {
+	return composeConfig(
+		testAccAthenaPreparedStatementConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_athena_prepared_statement" "test" {
+  name            = %[1]q
+  query_statement = %[2]q
+  workgroup       = aws_athena_workgroup.test.name
+}
+`, rName, queryStatement))
+}
+
+func testAccAthenaPreparedStatementConfigDescription(rName, queryStatement, description string) string {
+	return composeConfig(
+		testAccAthenaPreparedStatementConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_athena_prepared_statement" "test" {
+  description     = %[3]q
+  name            = %[1]q
+  query_statement = %[2]q
+  workgroup       = aws_athena_workgroup.test.name
+}
+`, rName, queryStatement, description))
+}
@@ -48,6 +48,26 @@ func resourceAwsAthenaWorkgroup() *schema.Resource {
 							Optional: true,
 							Default:  true,
 						},
+						"engine_version": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"effective_engine_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"selected_engine_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "AUTO",
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
 						"publish_cloudwatch_metrics_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -302,6 +322,10 @@ func expandAthenaWorkGroupConfiguration(l []interface{}) *athena.WorkGroupConfig
 		configuration.EnforceWorkGroupConfiguration = aws.Bool(v.(bool))
 	}
 
+	if v, ok := m["engine_version"]; ok {
+		configuration.EngineVersion = expandAthenaWorkGroupEngineVersion(v.([]interface{}))
+	}
+
 	if v, ok := m["publish_cloudwatch_metrics_enabled"]; ok {
 		configuration.PublishCloudWatchMetricsEnabled = aws.Bool(v.(bool))
 	}
@@ -332,6 +356,10 @@ func expandAthenaWorkGroupConfigurationUpdates(l []interface{}) *athena.WorkGrou
 		configurationUpdates.EnforceWorkGroupConfiguration = aws.Bool(v.(bool))
 	}
 
+	if v, ok := m["engine_version"]; ok {
+		configurationUpdates.EngineVersion = expandAthenaWorkGroupEngineVersion(v.([]interface{}))
+	}
+
 	if v, ok := m["publish_cloudwatch_metrics_enabled"]; ok {
 		configurationUpdates.PublishCloudWatchMetricsEnabled = aws.Bool(v.(bool))
 	}
@@ -343,6 +371,22 @@ func expandAthenaWorkGroupConfigurationUpdates(l []interface{}) *athena.WorkGrou
 	return configurationUpdates
 }
 
+func expandAthenaWorkGroupEngineVersion(l []interface{}) *athena.EngineVersion {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	engineVersion := &athena.EngineVersion{}
+
+	if v, ok := m["selected_engine_version"]; ok && v.(string) != "" {
+		engineVersion.SelectedEngineVersion = aws.String(v.(string))
+	}
+
+	return engineVersion
+}
+
 func expandAthenaWorkGroupResultConfiguration(l []interface{}) *athena.ResultConfiguration {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -415,6 +459,7 @@ func flattenAthenaWorkGroupConfiguration(configuration *athena.WorkGroupConfigur
 	m := map[string]interface{}{
 		"bytes_scanned_cutoff_per_query":     aws.Int64Value(configuration.BytesScannedCutoffPerQuery),
 		"enforce_workgroup_configuration":    aws.BoolValue(configuration.EnforceWorkGroupConfiguration),
+		"engine_version":                     flattenAthenaWorkGroupEngineVersion(configuration.EngineVersion),
 		"publish_cloudwatch_metrics_enabled": aws.BoolValue(configuration.PublishCloudWatchMetricsEnabled),
 		"result_configuration":               flattenAthenaWorkGroupResultConfiguration(configuration.ResultConfiguration),
 	}
@@ -422,6 +467,19 @@ func flattenAthenaWorkGroupConfiguration(configuration *athena.WorkGroupConfigur
 	return []interface{}{m}
 }
 
+func flattenAthenaWorkGroupEngineVersion(engineVersion *athena.EngineVersion) []interface{} {
+	if engineVersion == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"effective_engine_version": aws.StringValue(engineVersion.EffectiveEngineVersion),
+		"selected_engine_version":  aws.StringValue(engineVersion.SelectedEngineVersion),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenAthenaWorkGroupResultConfiguration(resultConfiguration *athena.ResultConfiguration) []interface{} {
 	if resultConfiguration == nil {
 		return []interface{}{}
@@ -51,6 +51,7 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 					"SimpleScaling",
 					"StepScaling",
 					"TargetTrackingScaling",
+					"PredictiveScaling",
 				}, false),
 			},
 			"cooldown": {
@@ -71,6 +72,57 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
+			"predictive_scaling_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity_breach_behavior": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      autoscaling.PredictiveScalingMaxCapacityBreachBehaviorHonorMaxCapacity,
+							ValidateFunc: validation.StringInSlice(autoscaling.PredictiveScalingMaxCapacityBreachBehavior_Values(), false),
+						},
+						"max_capacity_buffer": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"metric_specification": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"customized_capacity_metric_specification": resourceAwsAutoscalingPolicyPredictiveScalingCustomizedMetricSchema(),
+									"customized_load_metric_specification":     resourceAwsAutoscalingPolicyPredictiveScalingCustomizedMetricSchema(),
+									"customized_scaling_metric_specification":  resourceAwsAutoscalingPolicyPredictiveScalingCustomizedMetricSchema(),
+									"predefined_load_metric_specification":     resourceAwsAutoscalingPolicyPredictiveScalingPredefinedMetricSchema(autoscaling.PredefinedLoadMetricType_Values()),
+									"predefined_metric_pair_specification":     resourceAwsAutoscalingPolicyPredictiveScalingPredefinedMetricSchema(autoscaling.PredefinedMetricPairType_Values()),
+									"predefined_scaling_metric_specification":  resourceAwsAutoscalingPolicyPredictiveScalingPredefinedMetricSchema(autoscaling.PredefinedScalingMetricType_Values()),
+									"target_value": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+								},
+							},
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      autoscaling.PredictiveScalingModeForecastOnly,
+							ValidateFunc: validation.StringInSlice(autoscaling.PredictiveScalingMode_Values(), false),
+						},
+						"scheduling_buffer_time": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 3600),
+						},
+					},
+				},
+			},
 			"scaling_adjustment": {
 				Type:          schema.TypeInt,
 				Optional:      true,
@@ -229,6 +281,9 @@ func resourceAwsAutoscalingPolicyRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("step_adjustment", flattenStepAdjustments(p.StepAdjustments)); err != nil {
 		return fmt.Errorf("error setting step_adjustment: %s", err)
 	}
+	if err := d.Set("predictive_scaling_configuration", flattenAutoscalingPredictiveScalingConfiguration(p.PredictiveScalingConfiguration)); err != nil {
+		return fmt.Errorf("error setting predictive_scaling_configuration: %s", err)
+	}
 	if err := d.Set("target_tracking_configuration", flattenTargetTrackingConfiguration(p.TargetTrackingConfiguration)); err != nil {
 		return fmt.Errorf("error setting target_tracking_configuration: %s", err)
 	}
@@ -367,6 +422,16 @@ func getAwsAutoscalingPutScalingPolicyInput(d *schema.ResourceData) (autoscaling
 		return params, fmt.Errorf("step_adjustment is required for policy type StepScaling")
 	}
 
+	// This parameter is required if the policy type is PredictiveScaling and not supported otherwise.
+	if v, ok := d.GetOk("predictive_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		params.PredictiveScalingConfiguration = expandAutoscalingPredictiveScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		if policyType != "PredictiveScaling" {
+			return params, fmt.Errorf("predictive_scaling_configuration is only supported for policy type PredictiveScaling")
+		}
+	} else if policyType == "PredictiveScaling" {
+		return params, fmt.Errorf("predictive_scaling_configuration is required for policy type PredictiveScaling")
+	}
+
 	// This parameter is required if the policy type is TargetTrackingScaling and not supported otherwise.
 	if v, ok := d.GetOk("target_tracking_configuration"); ok {
 		params.TargetTrackingConfiguration = expandTargetTrackingConfiguration(v.([]interface{}))
@@ -413,6 +478,121 @@ func getAwsAutoscalingPolicy(d *schema.ResourceData, meta interface{}) (*autosca
 	return nil, nil
 }
 
+func resourceAwsAutoscalingPolicyPredictiveScalingPredefinedMetricSchema(validTypes []string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"predefined_metric_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(validTypes, false),
+				},
+				"resource_label": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1023),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAutoscalingPolicyPredictiveScalingCustomizedMetricSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metric_data_queries": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 10,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"expression": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1023),
+							},
+							"id": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+							"label": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 2047),
+							},
+							"metric_stat": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"metric": {
+											Type:     schema.TypeList,
+											Required: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"dimensions": {
+														Type:     schema.TypeSet,
+														Optional: true,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"name": {
+																	Type:     schema.TypeString,
+																	Required: true,
+																},
+																"value": {
+																	Type:     schema.TypeString,
+																	Required: true,
+																},
+															},
+														},
+													},
+													"metric_name": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+													"namespace": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+										"stat": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 100),
+										},
+										"unit": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+							"return_data": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceAwsAutoscalingScalingAdjustmentHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -517,3 +697,296 @@ func flattenTargetTrackingConfiguration(config *autoscaling.TargetTrackingConfig
 	}
 	return []interface{}{result}
 }
+
+func expandAutoscalingPredictiveScalingConfiguration(tfMap map[string]interface{}) *autoscaling.PredictiveScalingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &autoscaling.PredictiveScalingConfiguration{}
+
+	if v, ok := tfMap["max_capacity_breach_behavior"].(string); ok && v != "" {
+		apiObject.MaxCapacityBreachBehavior = aws.String(v)
+
+		// MaxCapacityBuffer is only valid when the group may scale beyond its maximum capacity.
+		if v == autoscaling.PredictiveScalingMaxCapacityBreachBehaviorIncreaseMaxCapacity {
+			if v, ok := tfMap["max_capacity_buffer"].(int); ok {
+				apiObject.MaxCapacityBuffer = aws.Int64(int64(v))
+			}
+		}
+	}
+
+	if v, ok := tfMap["metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MetricSpecifications = []*autoscaling.PredictiveScalingMetricSpecification{
+			expandAutoscalingPredictiveScalingMetricSpecification(v[0].(map[string]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = aws.String(v)
+	}
+
+	if v, ok := tfMap["scheduling_buffer_time"].(int); ok && v != 0 {
+		apiObject.SchedulingBufferTime = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandAutoscalingPredictiveScalingMetricSpecification(tfMap map[string]interface{}) *autoscaling.PredictiveScalingMetricSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &autoscaling.PredictiveScalingMetricSpecification{
+		TargetValue: aws.Float64(tfMap["target_value"].(float64)),
+	}
+
+	if v, ok := tfMap["customized_capacity_metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CustomizedCapacityMetricSpecification = &autoscaling.PredictiveScalingCustomizedCapacityMetric{
+			MetricDataQueries: expandAutoscalingMetricDataQueries(v[0].(map[string]interface{})["metric_data_queries"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["customized_load_metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CustomizedLoadMetricSpecification = &autoscaling.PredictiveScalingCustomizedLoadMetric{
+			MetricDataQueries: expandAutoscalingMetricDataQueries(v[0].(map[string]interface{})["metric_data_queries"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["customized_scaling_metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CustomizedScalingMetricSpecification = &autoscaling.PredictiveScalingCustomizedScalingMetric{
+			MetricDataQueries: expandAutoscalingMetricDataQueries(v[0].(map[string]interface{})["metric_data_queries"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["predefined_load_metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		metricType, resourceLabel := expandAutoscalingPredictiveScalingPredefinedMetric(v[0].(map[string]interface{}))
+		apiObject.PredefinedLoadMetricSpecification = &autoscaling.PredictiveScalingPredefinedLoadMetric{
+			PredefinedMetricType: metricType,
+			ResourceLabel:        resourceLabel,
+		}
+	}
+
+	if v, ok := tfMap["predefined_metric_pair_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		metricType, resourceLabel := expandAutoscalingPredictiveScalingPredefinedMetric(v[0].(map[string]interface{}))
+		apiObject.PredefinedMetricPairSpecification = &autoscaling.PredictiveScalingPredefinedMetricPair{
+			PredefinedMetricType: metricType,
+			ResourceLabel:        resourceLabel,
+		}
+	}
+
+	if v, ok := tfMap["predefined_scaling_metric_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		metricType, resourceLabel := expandAutoscalingPredictiveScalingPredefinedMetric(v[0].(map[string]interface{}))
+		apiObject.PredefinedScalingMetricSpecification = &autoscaling.PredictiveScalingPredefinedScalingMetric{
+			PredefinedMetricType: metricType,
+			ResourceLabel:        resourceLabel,
+		}
+	}
+
+	return apiObject
+}
+
+func expandAutoscalingPredictiveScalingPredefinedMetric(tfMap map[string]interface{}) (*string, *string) {
+	metricType := aws.String(tfMap["predefined_metric_type"].(string))
+
+	if v, ok := tfMap["resource_label"].(string); ok && v != "" {
+		return metricType, aws.String(v)
+	}
+
+	return metricType, nil
+}
+
+func expandAutoscalingMetricDataQueries(tfList []interface{}) []*autoscaling.MetricDataQuery {
+	var apiObjects []*autoscaling.MetricDataQuery
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &autoscaling.MetricDataQuery{
+			Id: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["expression"].(string); ok && v != "" {
+			apiObject.Expression = aws.String(v)
+		}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			apiObject.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["metric_stat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.MetricStat = expandAutoscalingMetricStat(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["return_data"].(bool); ok {
+			apiObject.ReturnData = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAutoscalingMetricStat(tfMap map[string]interface{}) *autoscaling.MetricStat {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &autoscaling.MetricStat{
+		Stat: aws.String(tfMap["stat"].(string)),
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		metric := v[0].(map[string]interface{})
+
+		apiObject.Metric = &autoscaling.Metric{
+			MetricName: aws.String(metric["metric_name"].(string)),
+			Namespace:  aws.String(metric["namespace"].(string)),
+		}
+
+		if s, ok := metric["dimensions"].(*schema.Set); ok && s.Len() > 0 {
+			for _, d := range s.List() {
+				dimension := d.(map[string]interface{})
+				apiObject.Metric.Dimensions = append(apiObject.Metric.Dimensions, &autoscaling.MetricDimension{
+					Name:  aws.String(dimension["name"].(string)),
+					Value: aws.String(dimension["value"].(string)),
+				})
+			}
+		}
+	}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAutoscalingPredictiveScalingConfiguration(apiObject *autoscaling.PredictiveScalingConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"max_capacity_breach_behavior": aws.StringValue(apiObject.MaxCapacityBreachBehavior),
+		"max_capacity_buffer":          aws.Int64Value(apiObject.MaxCapacityBuffer),
+		"mode":                         aws.StringValue(apiObject.Mode),
+		"scheduling_buffer_time":       aws.Int64Value(apiObject.SchedulingBufferTime),
+	}
+
+	if len(apiObject.MetricSpecifications) > 0 && apiObject.MetricSpecifications[0] != nil {
+		tfMap["metric_specification"] = []interface{}{flattenAutoscalingPredictiveScalingMetricSpecification(apiObject.MetricSpecifications[0])}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAutoscalingPredictiveScalingMetricSpecification(apiObject *autoscaling.PredictiveScalingMetricSpecification) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"target_value": aws.Float64Value(apiObject.TargetValue),
+	}
+
+	if v := apiObject.CustomizedCapacityMetricSpecification; v != nil {
+		tfMap["customized_capacity_metric_specification"] = flattenAutoscalingPredictiveScalingCustomizedMetric(v.MetricDataQueries)
+	}
+
+	if v := apiObject.CustomizedLoadMetricSpecification; v != nil {
+		tfMap["customized_load_metric_specification"] = flattenAutoscalingPredictiveScalingCustomizedMetric(v.MetricDataQueries)
+	}
+
+	if v := apiObject.CustomizedScalingMetricSpecification; v != nil {
+		tfMap["customized_scaling_metric_specification"] = flattenAutoscalingPredictiveScalingCustomizedMetric(v.MetricDataQueries)
+	}
+
+	if v := apiObject.PredefinedLoadMetricSpecification; v != nil {
+		tfMap["predefined_load_metric_specification"] = flattenAutoscalingPredictiveScalingPredefinedMetric(v.PredefinedMetricType, v.ResourceLabel)
+	}
+
+	if v := apiObject.PredefinedMetricPairSpecification; v != nil {
+		tfMap["predefined_metric_pair_specification"] = flattenAutoscalingPredictiveScalingPredefinedMetric(v.PredefinedMetricType, v.ResourceLabel)
+	}
+
+	if v := apiObject.PredefinedScalingMetricSpecification; v != nil {
+		tfMap["predefined_scaling_metric_specification"] = flattenAutoscalingPredictiveScalingPredefinedMetric(v.PredefinedMetricType, v.ResourceLabel)
+	}
+
+	return tfMap
+}
+
+func flattenAutoscalingPredictiveScalingPredefinedMetric(metricType, resourceLabel *string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"predefined_metric_type": aws.StringValue(metricType),
+			"resource_label":         aws.StringValue(resourceLabel),
+		},
+	}
+}
+
+func flattenAutoscalingPredictiveScalingCustomizedMetric(apiObjects []*autoscaling.MetricDataQuery) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"metric_data_queries": flattenAutoscalingMetricDataQueries(apiObjects),
+		},
+	}
+}
+
+func flattenAutoscalingMetricDataQueries(apiObjects []*autoscaling.MetricDataQuery) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		// The API omits ReturnData when it is the default.
+		returnData := true
+		if apiObject.ReturnData != nil {
+			returnData = aws.BoolValue(apiObject.ReturnData)
+		}
+
+		tfMap := map[string]interface{}{
+			"expression":  aws.StringValue(apiObject.Expression),
+			"id":          aws.StringValue(apiObject.Id),
+			"label":       aws.StringValue(apiObject.Label),
+			"return_data": returnData,
+		}
+
+		if v := apiObject.MetricStat; v != nil {
+			metricStat := map[string]interface{}{
+				"stat": aws.StringValue(v.Stat),
+				"unit": aws.StringValue(v.Unit),
+			}
+
+			if m := v.Metric; m != nil {
+				var dimensions []interface{}
+
+				for _, dimension := range m.Dimensions {
+					dimensions = append(dimensions, map[string]interface{}{
+						"name":  aws.StringValue(dimension.Name),
+						"value": aws.StringValue(dimension.Value),
+					})
+				}
+
+				metricStat["metric"] = []interface{}{
+					map[string]interface{}{
+						"dimensions":  dimensions,
+						"metric_name": aws.StringValue(m.MetricName),
+						"namespace":   aws.StringValue(m.Namespace),
+					},
+				}
+			}
+
+			tfMap["metric_stat"] = []interface{}{metricStat}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
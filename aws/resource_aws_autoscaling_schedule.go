@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const awsAutoscalingScheduleTimeLayout = "2006-01-02T15:04:05Z"
@@ -56,6 +57,12 @@ func resourceAwsAutoscalingSchedule() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
 			"min_size": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -123,6 +130,10 @@ func resourceAwsAutoscalingScheduleCreate(d *schema.ResourceData, meta interface
 		params.Recurrence = aws.String(attr.(string))
 	}
 
+	if attr, ok := d.GetOk("time_zone"); ok {
+		params.TimeZone = aws.String(attr.(string))
+	}
+
 	// Scheduled actions don't need to set all three size parameters. For example,
 	// you may want to change the min or max without also forcing an immediate
 	// resize by changing a desired_capacity that may have changed due to other
@@ -185,6 +196,7 @@ func resourceAwsAutoscalingScheduleRead(d *schema.ResourceData, meta interface{}
 	}
 
 	d.Set("recurrence", sa.Recurrence)
+	d.Set("time_zone", sa.TimeZone)
 
 	if sa.StartTime != nil {
 		d.Set("start_time", sa.StartTime.Format(awsAutoscalingScheduleTimeLayout))
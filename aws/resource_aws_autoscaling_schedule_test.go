@@ -117,6 +117,45 @@ func TestAccAWSAutoscalingSchedule_recurrence(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoscalingSchedule_recurrenceTimeZone(t *testing.T) {
+	var schedule autoscaling.ScheduledUpdateGroupAction
+
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt())
+
+	scheduledActionName := "foobar"
+	resourceName := fmt.Sprintf("aws_autoscaling_schedule.%s", scheduledActionName)
+	importInput := fmt.Sprintf("%s/%s", rName, scheduledActionName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAutoscalingScheduleConfig_recurrenceTimeZone(rName, "Europe/London"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingScheduleExists(resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "recurrence", "0 8 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "Europe/London"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     importInput,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAutoscalingScheduleConfig_recurrenceTimeZone(rName, "America/New_York"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingScheduleExists(resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "America/New_York"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAutoscalingSchedule_zeroValues(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
 
@@ -359,6 +398,47 @@ resource "aws_autoscaling_schedule" "foobar" {
 `, r, r)
 }
 
+func testAccAWSAutoscalingScheduleConfig_recurrenceTimeZone(r, timeZone string) string {
+	return testAccLatestAmazonLinuxHvmEbsAmiConfig() + fmt.Sprintf(`
+resource "aws_launch_configuration" "foobar" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t1.micro"
+}
+
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_autoscaling_group" "foobar" {
+  availability_zones        = [data.aws_availability_zones.available.names[1]]
+  name                      = %[1]q
+  max_size                  = 1
+  min_size                  = 1
+  health_check_grace_period = 300
+  health_check_type         = "ELB"
+  force_delete              = true
+  termination_policies      = ["OldestInstance"]
+  launch_configuration      = aws_launch_configuration.foobar.name
+}
+
+resource "aws_autoscaling_schedule" "foobar" {
+  scheduled_action_name  = "foobar"
+  min_size               = 0
+  max_size               = 1
+  desired_capacity       = 0
+  recurrence             = "0 8 * * *"
+  time_zone              = %[2]q
+  autoscaling_group_name = aws_autoscaling_group.foobar.name
+}
+`, r, timeZone)
+}
+
 func testAccAWSAutoscalingScheduleConfig_zeroValues(r, start, end string) string {
 	return testAccLatestAmazonLinuxHvmEbsAmiConfig() + fmt.Sprintf(`
 resource "aws_launch_configuration" "foobar" {
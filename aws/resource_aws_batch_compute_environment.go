@@ -1,8 +1,10 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -26,6 +28,9 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+
+		CustomizeDiff: resourceAwsBatchComputeEnvironmentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"compute_environment_name": {
 				Type:          schema.TypeString,
@@ -80,13 +85,13 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 						},
 						"instance_role": {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							ForceNew:     true,
 							ValidateFunc: validateArn,
 						},
 						"instance_type": {
 							Type:     schema.TypeSet,
-							Required: true,
+							Optional: true,
 							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
@@ -123,7 +128,7 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 						},
 						"min_vcpus": {
 							Type:     schema.TypeInt,
-							Required: true,
+							Optional: true,
 						},
 						"security_group_ids": {
 							Type:     schema.TypeSet,
@@ -148,7 +153,7 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.StringInSlice([]string{batch.CRTypeEc2, batch.CRTypeSpot}, true),
+							ValidateFunc: validation.StringInSlice(batch.CRType_Values(), true),
 						},
 					},
 				},
@@ -229,16 +234,9 @@ func resourceAwsBatchComputeEnvironmentCreate(d *schema.ResourceData, meta inter
 		}
 		computeResource := computeResources[0].(map[string]interface{})
 
-		instanceRole := computeResource["instance_role"].(string)
 		maxvCpus := int64(computeResource["max_vcpus"].(int))
-		minvCpus := int64(computeResource["min_vcpus"].(int))
 		computeResourceType := computeResource["type"].(string)
 
-		var instanceTypes []*string
-		for _, v := range computeResource["instance_type"].(*schema.Set).List() {
-			instanceTypes = append(instanceTypes, aws.String(v.(string)))
-		}
-
 		var securityGroupIds []*string
 		for _, v := range computeResource["security_group_ids"].(*schema.Set).List() {
 			securityGroupIds = append(securityGroupIds, aws.String(v.(string)))
@@ -250,35 +248,44 @@ func resourceAwsBatchComputeEnvironmentCreate(d *schema.ResourceData, meta inter
 		}
 
 		input.ComputeResources = &batch.ComputeResource{
-			InstanceRole:     aws.String(instanceRole),
-			InstanceTypes:    instanceTypes,
 			MaxvCpus:         aws.Int64(maxvCpus),
-			MinvCpus:         aws.Int64(minvCpus),
 			SecurityGroupIds: securityGroupIds,
 			Subnets:          subnets,
 			Type:             aws.String(computeResourceType),
 		}
 
-		if v, ok := computeResource["allocation_strategy"]; ok {
-			input.ComputeResources.AllocationStrategy = aws.String(v.(string))
+		// Fargate compute resources don't support instance roles, instance types or minimum vCPUs.
+		if !isBatchFargateComputeResourceType(computeResourceType) {
+			var instanceTypes []*string
+			for _, v := range computeResource["instance_type"].(*schema.Set).List() {
+				instanceTypes = append(instanceTypes, aws.String(v.(string)))
+			}
+
+			input.ComputeResources.InstanceRole = aws.String(computeResource["instance_role"].(string))
+			input.ComputeResources.InstanceTypes = instanceTypes
+			input.ComputeResources.MinvCpus = aws.Int64(int64(computeResource["min_vcpus"].(int)))
+		}
+
+		if v, ok := computeResource["allocation_strategy"].(string); ok && v != "" {
+			input.ComputeResources.AllocationStrategy = aws.String(v)
 		}
-		if v, ok := computeResource["bid_percentage"]; ok {
-			input.ComputeResources.BidPercentage = aws.Int64(int64(v.(int)))
+		if v, ok := computeResource["bid_percentage"].(int); ok && v > 0 {
+			input.ComputeResources.BidPercentage = aws.Int64(int64(v))
 		}
 		if v, ok := computeResource["desired_vcpus"]; ok && v.(int) > 0 {
 			input.ComputeResources.DesiredvCpus = aws.Int64(int64(v.(int)))
 		}
-		if v, ok := computeResource["ec2_key_pair"]; ok {
-			input.ComputeResources.Ec2KeyPair = aws.String(v.(string))
+		if v, ok := computeResource["ec2_key_pair"].(string); ok && v != "" {
+			input.ComputeResources.Ec2KeyPair = aws.String(v)
 		}
-		if v, ok := computeResource["image_id"]; ok {
-			input.ComputeResources.ImageId = aws.String(v.(string))
+		if v, ok := computeResource["image_id"].(string); ok && v != "" {
+			input.ComputeResources.ImageId = aws.String(v)
 		}
-		if v, ok := computeResource["spot_iam_fleet_role"]; ok {
-			input.ComputeResources.SpotIamFleetRole = aws.String(v.(string))
+		if v, ok := computeResource["spot_iam_fleet_role"].(string); ok && v != "" {
+			input.ComputeResources.SpotIamFleetRole = aws.String(v)
 		}
-		if v, ok := computeResource["tags"]; ok {
-			input.ComputeResources.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().BatchTags()
+		if v, ok := computeResource["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			input.ComputeResources.Tags = keyvaluetags.New(v).IgnoreAws().BatchTags()
 		}
 
 		if raw, ok := computeResource["launch_template"]; ok && len(raw.([]interface{})) > 0 {
@@ -449,7 +456,10 @@ func resourceAwsBatchComputeEnvironmentUpdate(d *schema.ResourceData, meta inter
 			}
 
 			input.ComputeResources.MaxvCpus = aws.Int64(int64(computeResource["max_vcpus"].(int)))
-			input.ComputeResources.MinvCpus = aws.Int64(int64(computeResource["min_vcpus"].(int)))
+
+			if !isBatchFargateComputeResourceType(computeResource["type"].(string)) {
+				input.ComputeResources.MinvCpus = aws.Int64(int64(computeResource["min_vcpus"].(int)))
+			}
 		}
 
 		log.Printf("[DEBUG] Update compute environment %s.\n", input)
@@ -482,6 +492,42 @@ func resourceAwsBatchComputeEnvironmentUpdate(d *schema.ResourceData, meta inter
 	return resourceAwsBatchComputeEnvironmentRead(d, meta)
 }
 
+func resourceAwsBatchComputeEnvironmentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !strings.EqualFold(diff.Get("type").(string), batch.CETypeManaged) {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("compute_resources"); !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	computeResourceType := diff.Get("compute_resources.0.type").(string)
+
+	if isBatchFargateComputeResourceType(computeResourceType) {
+		for _, k := range []string{"allocation_strategy", "bid_percentage", "ec2_key_pair", "image_id", "instance_role", "instance_type", "launch_template", "min_vcpus", "spot_iam_fleet_role"} {
+			if _, ok := diff.GetOk("compute_resources.0." + k); ok {
+				return fmt.Errorf("compute_resources.0.%s cannot be specified for compute resources of type %s", k, computeResourceType)
+			}
+		}
+
+		return nil
+	}
+
+	if diff.NewValueKnown("compute_resources.0.instance_role") && diff.Get("compute_resources.0.instance_role").(string) == "" {
+		return fmt.Errorf("compute_resources.0.instance_role is required for compute resources of type %s", computeResourceType)
+	}
+
+	if diff.NewValueKnown("compute_resources.0.instance_type") && diff.Get("compute_resources.0.instance_type").(*schema.Set).Len() == 0 {
+		return fmt.Errorf("compute_resources.0.instance_type is required for compute resources of type %s", computeResourceType)
+	}
+
+	return nil
+}
+
+func isBatchFargateComputeResourceType(computeResourceType string) bool {
+	return strings.EqualFold(computeResourceType, batch.CRTypeFargate) || strings.EqualFold(computeResourceType, batch.CRTypeFargateSpot)
+}
+
 func resourceAwsBatchComputeEnvironmentStatusRefreshFunc(computeEnvironmentName string, conn *batch.Batch) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		result, err := conn.DescribeComputeEnvironments(&batch.DescribeComputeEnvironmentsInput{
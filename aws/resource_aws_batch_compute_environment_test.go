@@ -192,6 +192,53 @@ func TestAccAWSBatchComputeEnvironment_createEc2(t *testing.T) {
 	})
 }
 
+func TestAccAWSBatchComputeEnvironment_createFargate(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_batch_compute_environment.fargate"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSBatch(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchComputeEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBatchComputeEnvironmentConfigFargate(rInt, "FARGATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBatchComputeEnvironmentExists(),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.instance_role", ""),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.instance_type.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.max_vcpus", "16"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.type", "FARGATE"),
+				),
+			},
+			{
+				Config: testAccAWSBatchComputeEnvironmentConfigFargate(rInt, "FARGATE_SPOT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBatchComputeEnvironmentExists(),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.type", "FARGATE_SPOT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSBatchComputeEnvironment_createFargateWithInstanceRole(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSBatch(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchComputeEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSBatchComputeEnvironmentConfigFargateWithInstanceRole(rInt),
+				ExpectError: regexp.MustCompile(`compute_resources.0.instance_role cannot be specified`),
+			},
+		},
+	})
+}
+
 func TestAccAWSBatchComputeEnvironment_createWithNamePrefix(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -876,6 +923,53 @@ resource "aws_batch_compute_environment" "ec2" {
 `, rInt)
 }
 
+func testAccAWSBatchComputeEnvironmentConfigFargate(rInt int, computeResourceType string) string {
+	return testAccAWSBatchComputeEnvironmentConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_batch_compute_environment" "fargate" {
+  compute_environment_name = "tf_acc_test_%d"
+
+  compute_resources {
+    max_vcpus = 16
+    security_group_ids = [
+      aws_security_group.test_acc.id
+    ]
+    subnets = [
+      aws_subnet.test_acc.id
+    ]
+    type = %q
+  }
+
+  service_role = aws_iam_role.aws_batch_service_role.arn
+  type         = "MANAGED"
+  depends_on   = [aws_iam_role_policy_attachment.aws_batch_service_role]
+}
+`, rInt, computeResourceType)
+}
+
+func testAccAWSBatchComputeEnvironmentConfigFargateWithInstanceRole(rInt int) string {
+	return testAccAWSBatchComputeEnvironmentConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_batch_compute_environment" "fargate" {
+  compute_environment_name = "tf_acc_test_%d"
+
+  compute_resources {
+    instance_role = aws_iam_instance_profile.ecs_instance_role.arn
+    max_vcpus     = 16
+    security_group_ids = [
+      aws_security_group.test_acc.id
+    ]
+    subnets = [
+      aws_subnet.test_acc.id
+    ]
+    type = "FARGATE"
+  }
+
+  service_role = aws_iam_role.aws_batch_service_role.arn
+  type         = "MANAGED"
+  depends_on   = [aws_iam_role_policy_attachment.aws_batch_service_role]
+}
+`, rInt)
+}
+
 func testAccAWSBatchComputeEnvironmentConfigNamePrefix(rInt int) string {
 	return testAccAWSBatchComputeEnvironmentConfigBase(rInt) + `
 resource "aws_batch_compute_environment" "ec2" {
@@ -3,6 +3,8 @@ package aws
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
@@ -56,6 +58,15 @@ func resourceAwsBatchJobDefinition() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"platform_capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(batch.PlatformCapability_Values(), false),
+				},
+			},
 			"retry_strategy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -69,6 +80,53 @@ func resourceAwsBatchJobDefinition() *schema.Resource {
 							ForceNew:     true,
 							ValidateFunc: validation.IntBetween(1, 10),
 						},
+						"evaluate_on_exit": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MinItems: 0,
+							MaxItems: 5,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										StateFunc: func(v interface{}) string {
+											return strings.ToLower(v.(string))
+										},
+										ValidateFunc: validation.StringInSlice(batch.RetryAction_Values(), true),
+									},
+									"on_exit_code": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 512),
+											validation.StringMatch(regexp.MustCompile(`^[0-9]*\*?$`), "must contain only numbers, and can optionally end with an asterisk"),
+										),
+									},
+									"on_reason": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 512),
+											validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z.:\s]*\*?$`), "must contain letters, numbers, periods, colons, and white space, and can optionally end with an asterisk"),
+										),
+									},
+									"on_status_reason": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 512),
+											validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z.:\s]*\*?$`), "must contain letters, numbers, periods, colons, and white space, and can optionally end with an asterisk"),
+										),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -128,6 +186,10 @@ func resourceAwsBatchJobDefinitionCreate(d *schema.ResourceData, meta interface{
 		input.Parameters = expandJobDefinitionParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("platform_capabilities"); ok && v.(*schema.Set).Len() > 0 {
+		input.PlatformCapabilities = expandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("retry_strategy"); ok {
 		input.RetryStrategy = expandJobDefinitionRetryStrategy(v.([]interface{}))
 	}
@@ -178,6 +240,10 @@ func resourceAwsBatchJobDefinitionRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("parameters", aws.StringValueMap(job.Parameters))
 
+	if err := d.Set("platform_capabilities", aws.StringValueSlice(job.PlatformCapabilities)); err != nil {
+		return fmt.Errorf("error setting platform_capabilities: %s", err)
+	}
+
 	if err := d.Set("retry_strategy", flattenBatchRetryStrategy(job.RetryStrategy)); err != nil {
 		return fmt.Errorf("error setting retry_strategy: %s", err)
 	}
@@ -294,19 +360,77 @@ func expandJobDefinitionRetryStrategy(item []interface{}) *batch.RetryStrategy {
 		retryStrategy.Attempts = aws.Int64(int64(v))
 	}
 
+	if v, ok := data["evaluate_on_exit"].([]interface{}); ok && len(v) > 0 {
+		retryStrategy.EvaluateOnExit = expandBatchEvaluateOnExits(v)
+	}
+
 	return retryStrategy
 }
 
+func expandBatchEvaluateOnExits(tfList []interface{}) []*batch.EvaluateOnExit {
+	var apiObjects []*batch.EvaluateOnExit
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.EvaluateOnExit{}
+
+		if v, ok := tfMap["action"].(string); ok && v != "" {
+			apiObject.Action = aws.String(strings.ToLower(v))
+		}
+
+		if v, ok := tfMap["on_exit_code"].(string); ok && v != "" {
+			apiObject.OnExitCode = aws.String(v)
+		}
+
+		if v, ok := tfMap["on_reason"].(string); ok && v != "" {
+			apiObject.OnReason = aws.String(v)
+		}
+
+		if v, ok := tfMap["on_status_reason"].(string); ok && v != "" {
+			apiObject.OnStatusReason = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func flattenBatchRetryStrategy(item *batch.RetryStrategy) []map[string]interface{} {
 	data := []map[string]interface{}{}
-	if item != nil && item.Attempts != nil {
+	if item != nil && (item.Attempts != nil || len(item.EvaluateOnExit) > 0) {
 		data = append(data, map[string]interface{}{
-			"attempts": int(aws.Int64Value(item.Attempts)),
+			"attempts":         int(aws.Int64Value(item.Attempts)),
+			"evaluate_on_exit": flattenBatchEvaluateOnExits(item.EvaluateOnExit),
 		})
 	}
 	return data
 }
 
+func flattenBatchEvaluateOnExits(apiObjects []*batch.EvaluateOnExit) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":           strings.ToLower(aws.StringValue(apiObject.Action)),
+			"on_exit_code":     aws.StringValue(apiObject.OnExitCode),
+			"on_reason":        aws.StringValue(apiObject.OnReason),
+			"on_status_reason": aws.StringValue(apiObject.OnStatusReason),
+		})
+	}
+
+	return tfList
+}
+
 func expandJobDefinitionTimeout(item []interface{}) *batch.JobTimeout {
 	timeout := &batch.JobTimeout{}
 	data := item[0].(map[string]interface{})
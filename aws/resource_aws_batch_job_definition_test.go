@@ -94,6 +94,33 @@ func TestAccAWSBatchJobDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSBatchJobDefinition_PlatformCapabilities(t *testing.T) {
+	var jd batch.JobDefinition
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSBatch(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchJobDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchJobDefinitionConfigPlatformCapabilitiesFargate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchJobDefinitionExists(resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "platform_capabilities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "platform_capabilities.*", batch.PlatformCapabilityFargate),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSBatchJobDefinition_ContainerProperties_Advanced(t *testing.T) {
 	var jd batch.JobDefinition
 	compare := batch.JobDefinition{
@@ -155,6 +182,49 @@ func TestAccAWSBatchJobDefinition_ContainerProperties_Advanced(t *testing.T) {
 	})
 }
 
+func TestAccAWSBatchJobDefinition_RetryStrategy_EvaluateOnExit(t *testing.T) {
+	var before, after batch.JobDefinition
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSBatch(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBatchJobDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchJobDefinitionConfigRetryStrategyEvaluateOnExit(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchJobDefinitionExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "retry_strategy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retry_strategy.0.attempts", "5"),
+					resource.TestCheckResourceAttr(resourceName, "retry_strategy.0.evaluate_on_exit.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "retry_strategy.0.evaluate_on_exit.0.action", "retry"),
+					resource.TestCheckResourceAttr(resourceName, "retry_strategy.0.evaluate_on_exit.0.on_status_reason", "Host EC2*"),
+					resource.TestCheckResourceAttr(resourceName, "retry_strategy.0.evaluate_on_exit.1.action", "exit"),
+					resource.TestCheckResourceAttr(resourceName, "retry_strategy.0.evaluate_on_exit.1.on_exit_code", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timeout.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timeout.0.attempt_duration_seconds", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBatchJobDefinitionConfigRetryStrategyEvaluateOnExit(rName, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchJobDefinitionExists(resourceName, &after),
+					testAccCheckJobDefinitionRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "retry_strategy.0.evaluate_on_exit.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "timeout.0.attempt_duration_seconds", "120"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSBatchJobDefinition_updateForcesNewResource(t *testing.T) {
 	var before, after batch.JobDefinition
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -421,6 +491,99 @@ resource "aws_batch_job_definition" "test" {
 `, rName)
 }
 
+func testAccBatchJobDefinitionConfigPlatformCapabilitiesFargate(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "ecs_task_execution_role" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ecs-tasks.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "ecs_task_execution_role_policy" {
+  role       = aws_iam_role.ecs_task_execution_role.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy"
+}
+
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  platform_capabilities = [
+    "FARGATE",
+  ]
+
+  container_properties = jsonencode({
+    command          = ["echo", "test"]
+    image            = "busybox"
+    executionRoleArn = aws_iam_role.ecs_task_execution_role.arn
+
+    fargatePlatformConfiguration = {
+      platformVersion = "LATEST"
+    }
+
+    networkConfiguration = {
+      assignPublicIp = "DISABLED"
+    }
+
+    resourceRequirements = [
+      {
+        type  = "VCPU"
+        value = "0.25"
+      },
+      {
+        type  = "MEMORY"
+        value = "512"
+      },
+    ]
+  })
+}
+`, rName)
+}
+
+func testAccBatchJobDefinitionConfigRetryStrategyEvaluateOnExit(rName string, attemptDurationSeconds int) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  container_properties = jsonencode({
+    command = ["echo", "test"]
+    image   = "busybox"
+    memory  = 128
+    vcpus   = 1
+  })
+  name = %[1]q
+  type = "container"
+
+  retry_strategy {
+    attempts = 5
+
+    evaluate_on_exit {
+      action           = "RETRY"
+      on_status_reason = "Host EC2*"
+    }
+
+    evaluate_on_exit {
+      action       = "exit"
+      on_exit_code = "1"
+    }
+  }
+
+  timeout {
+    attempt_duration_seconds = %[2]d
+  }
+}
+`, rName, attemptDurationSeconds)
+}
+
 func testAccBatchJobDefinitionConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_batch_job_definition" "test" {
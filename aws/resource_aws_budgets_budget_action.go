@@ -0,0 +1,651 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfbudgets "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/budgets"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/budgets/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/budgets/waiter"
+)
+
+func resourceAwsBudgetsBudgetAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBudgetsBudgetActionCreate,
+		Read:   resourceAwsBudgetsBudgetActionRead,
+		Update: resourceAwsBudgetsBudgetActionUpdate,
+		Delete: resourceAwsBudgetsBudgetActionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"action_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"action_threshold": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_threshold_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(budgets.ThresholdType_Values(), false),
+						},
+						"action_threshold_value": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0, 40000000000),
+						},
+					},
+				},
+			},
+			"action_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(budgets.ActionType_Values(), false),
+			},
+			"approval_model": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(budgets.ApprovalModel_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"budget_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_action_definition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"groups": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"policy_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"roles": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"users": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+							ExactlyOneOf: []string{"definition.0.iam_action_definition", "definition.0.scp_action_definition", "definition.0.ssm_action_definition"},
+						},
+						"scp_action_definition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(10, 130),
+									},
+									"target_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+							ExactlyOneOf: []string{"definition.0.iam_action_definition", "definition.0.scp_action_definition", "definition.0.ssm_action_definition"},
+						},
+						"ssm_action_definition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_sub_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(budgets.ActionSubType_Values(), false),
+									},
+									"instance_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"definition.0.iam_action_definition", "definition.0.scp_action_definition", "definition.0.ssm_action_definition"},
+						},
+					},
+				},
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"notification_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(budgets.NotificationType_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscriber": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 11,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2147483647),
+						},
+						"subscription_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(budgets.SubscriptionType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsBudgetsBudgetActionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &budgets.CreateBudgetActionInput{
+		AccountId:        aws.String(accountID),
+		ActionThreshold:  expandAwsBudgetsBudgetActionActionThreshold(d.Get("action_threshold").([]interface{})),
+		ActionType:       aws.String(d.Get("action_type").(string)),
+		ApprovalModel:    aws.String(d.Get("approval_model").(string)),
+		BudgetName:       aws.String(d.Get("budget_name").(string)),
+		Definition:       expandAwsBudgetsBudgetActionActionDefinition(d.Get("definition").([]interface{})),
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		NotificationType: aws.String(d.Get("notification_type").(string)),
+		Subscribers:      expandAwsBudgetsBudgetActionSubscriber(d.Get("subscriber").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Creating Budget Action: %s", input)
+	output, err := conn.CreateBudgetAction(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Budget Action: %w", err)
+	}
+
+	actionID := aws.StringValue(output.ActionId)
+	budgetName := aws.StringValue(output.BudgetName)
+
+	d.SetId(tfbudgets.BudgetActionCreateResourceID(aws.StringValue(output.AccountId), actionID, budgetName))
+
+	if _, err := waiter.ActionAvailable(conn, aws.StringValue(output.AccountId), actionID, budgetName); err != nil {
+		return fmt.Errorf("error waiting for Budget Action (%s) to create: %w", d.Id(), err)
+	}
+
+	return resourceAwsBudgetsBudgetActionRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetActionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, actionID, budgetName, err := tfbudgets.BudgetActionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	action, err := finder.ActionByAccountIDActionIDAndBudgetName(conn, accountID, actionID, budgetName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, budgets.ErrCodeNotFoundException) {
+		log.Printf("[WARN] Budget Action (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Budget Action (%s): %w", d.Id(), err)
+	}
+
+	if action == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Budget Action (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Budget Action (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("action_id", actionID)
+
+	if err := d.Set("action_threshold", flattenAwsBudgetsBudgetActionActionThreshold(action.ActionThreshold)); err != nil {
+		return fmt.Errorf("error setting action_threshold: %w", err)
+	}
+
+	d.Set("action_type", action.ActionType)
+	d.Set("approval_model", action.ApprovalModel)
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   budgets.ServiceName,
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("budget/%s/action/%s", budgetName, actionID),
+	}
+	d.Set("arn", arn.String())
+
+	d.Set("budget_name", budgetName)
+
+	if err := d.Set("definition", flattenAwsBudgetsBudgetActionDefinition(action.Definition)); err != nil {
+		return fmt.Errorf("error setting definition: %w", err)
+	}
+
+	d.Set("execution_role_arn", action.ExecutionRoleArn)
+	d.Set("notification_type", action.NotificationType)
+	d.Set("status", action.Status)
+
+	if err := d.Set("subscriber", flattenAwsBudgetsBudgetActionSubscriber(action.Subscribers)); err != nil {
+		return fmt.Errorf("error setting subscriber: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBudgetsBudgetActionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, actionID, budgetName, err := tfbudgets.BudgetActionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &budgets.UpdateBudgetActionInput{
+		AccountId:  aws.String(accountID),
+		ActionId:   aws.String(actionID),
+		BudgetName: aws.String(budgetName),
+	}
+
+	if d.HasChange("action_threshold") {
+		input.ActionThreshold = expandAwsBudgetsBudgetActionActionThreshold(d.Get("action_threshold").([]interface{}))
+	}
+
+	if d.HasChange("approval_model") {
+		input.ApprovalModel = aws.String(d.Get("approval_model").(string))
+	}
+
+	if d.HasChange("definition") {
+		input.Definition = expandAwsBudgetsBudgetActionActionDefinition(d.Get("definition").([]interface{}))
+	}
+
+	if d.HasChange("execution_role_arn") {
+		input.ExecutionRoleArn = aws.String(d.Get("execution_role_arn").(string))
+	}
+
+	if d.HasChange("notification_type") {
+		input.NotificationType = aws.String(d.Get("notification_type").(string))
+	}
+
+	if d.HasChange("subscriber") {
+		input.Subscribers = expandAwsBudgetsBudgetActionSubscriber(d.Get("subscriber").(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Updating Budget Action: %s", input)
+	_, err = conn.UpdateBudgetAction(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Budget Action (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ActionAvailable(conn, accountID, actionID, budgetName); err != nil {
+		return fmt.Errorf("error waiting for Budget Action (%s) to update: %w", d.Id(), err)
+	}
+
+	return resourceAwsBudgetsBudgetActionRead(d, meta)
+}
+
+func resourceAwsBudgetsBudgetActionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).budgetconn
+
+	accountID, actionID, budgetName, err := tfbudgets.BudgetActionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Budget Action: %s", d.Id())
+	_, err = conn.DeleteBudgetAction(&budgets.DeleteBudgetActionInput{
+		AccountId:  aws.String(accountID),
+		ActionId:   aws.String(actionID),
+		BudgetName: aws.String(budgetName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, budgets.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Budget Action (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAwsBudgetsBudgetActionActionThreshold(tfList []interface{}) *budgets.ActionThreshold {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &budgets.ActionThreshold{}
+
+	if v, ok := tfMap["action_threshold_type"].(string); ok && v != "" {
+		apiObject.ActionThresholdType = aws.String(v)
+	}
+
+	if v, ok := tfMap["action_threshold_value"].(float64); ok {
+		apiObject.ActionThresholdValue = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandAwsBudgetsBudgetActionSubscriber(tfSet *schema.Set) []*budgets.Subscriber {
+	if tfSet.Len() == 0 {
+		return nil
+	}
+
+	var apiObjects []*budgets.Subscriber
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &budgets.Subscriber{}
+
+		if v, ok := tfMap["address"].(string); ok && v != "" {
+			apiObject.Address = aws.String(v)
+		}
+
+		if v, ok := tfMap["subscription_type"].(string); ok && v != "" {
+			apiObject.SubscriptionType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAwsBudgetsBudgetActionActionDefinition(tfList []interface{}) *budgets.Definition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &budgets.Definition{}
+
+	if v, ok := tfMap["iam_action_definition"].([]interface{}); ok && len(v) > 0 {
+		apiObject.IamActionDefinition = expandAwsBudgetsBudgetActionActionIamActionDefinition(v)
+	}
+
+	if v, ok := tfMap["scp_action_definition"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ScpActionDefinition = expandAwsBudgetsBudgetActionActionScpActionDefinition(v)
+	}
+
+	if v, ok := tfMap["ssm_action_definition"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SsmActionDefinition = expandAwsBudgetsBudgetActionActionSsmActionDefinition(v)
+	}
+
+	return apiObject
+}
+
+func expandAwsBudgetsBudgetActionActionIamActionDefinition(tfList []interface{}) *budgets.IamActionDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &budgets.IamActionDefinition{}
+
+	if v, ok := tfMap["groups"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Groups = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["policy_arn"].(string); ok && v != "" {
+		apiObject.PolicyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["roles"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Roles = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["users"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Users = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandAwsBudgetsBudgetActionActionScpActionDefinition(tfList []interface{}) *budgets.ScpActionDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &budgets.ScpActionDefinition{}
+
+	if v, ok := tfMap["policy_id"].(string); ok && v != "" {
+		apiObject.PolicyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TargetIds = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandAwsBudgetsBudgetActionActionSsmActionDefinition(tfList []interface{}) *budgets.SsmActionDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &budgets.SsmActionDefinition{}
+
+	if v, ok := tfMap["action_sub_type"].(string); ok && v != "" {
+		apiObject.ActionSubType = aws.String(v)
+	}
+
+	if v, ok := tfMap["instance_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InstanceIds = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAwsBudgetsBudgetActionActionThreshold(apiObject *budgets.ActionThreshold) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"action_threshold_type":  aws.StringValue(apiObject.ActionThresholdType),
+		"action_threshold_value": aws.Float64Value(apiObject.ActionThresholdValue),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAwsBudgetsBudgetActionSubscriber(apiObjects []*budgets.Subscriber) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"address":           aws.StringValue(apiObject.Address),
+			"subscription_type": aws.StringValue(apiObject.SubscriptionType),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAwsBudgetsBudgetActionDefinition(apiObject *budgets.Definition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IamActionDefinition; v != nil {
+		tfMap["iam_action_definition"] = flattenAwsBudgetsBudgetActionIamActionDefinition(v)
+	}
+
+	if v := apiObject.ScpActionDefinition; v != nil {
+		tfMap["scp_action_definition"] = flattenAwsBudgetsBudgetActionScpActionDefinition(v)
+	}
+
+	if v := apiObject.SsmActionDefinition; v != nil {
+		tfMap["ssm_action_definition"] = flattenAwsBudgetsBudgetActionSsmActionDefinition(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAwsBudgetsBudgetActionIamActionDefinition(apiObject *budgets.IamActionDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"policy_arn": aws.StringValue(apiObject.PolicyArn),
+	}
+
+	if v := apiObject.Groups; v != nil {
+		tfMap["groups"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Roles; v != nil {
+		tfMap["roles"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Users; v != nil {
+		tfMap["users"] = aws.StringValueSlice(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAwsBudgetsBudgetActionScpActionDefinition(apiObject *budgets.ScpActionDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"policy_id": aws.StringValue(apiObject.PolicyId),
+	}
+
+	if v := apiObject.TargetIds; v != nil {
+		tfMap["target_ids"] = aws.StringValueSlice(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAwsBudgetsBudgetActionSsmActionDefinition(apiObject *budgets.SsmActionDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"action_sub_type": aws.StringValue(apiObject.ActionSubType),
+		"region":          aws.StringValue(apiObject.Region),
+	}
+
+	if v := apiObject.InstanceIds; v != nil {
+		tfMap["instance_ids"] = aws.StringValueSlice(v)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,238 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfbudgets "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/budgets"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/budgets/finder"
+)
+
+func TestAccAWSBudgetsBudgetAction_basic(t *testing.T) {
+	var conf budgets.Action
+	resourceName := "aws_budgets_budget_action.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetActionConfigBasic(rName, budgets.ApprovalModelAutomatic, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetActionExists(resourceName, &conf),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "budgets", regexp.MustCompile(fmt.Sprintf(`budget/%s/action/.+`, rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "budget_name", "aws_budgets_budget.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "action_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "action_type", "APPLY_IAM_POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "approval_model", "AUTOMATIC"),
+					resource.TestCheckResourceAttr(resourceName, "notification_type", "ACTUAL"),
+					resource.TestCheckResourceAttr(resourceName, "action_threshold.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action_threshold.0.action_threshold_type", "ABSOLUTE_VALUE"),
+					resource.TestCheckResourceAttr(resourceName, "action_threshold.0.action_threshold_value", "100"),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.iam_action_definition.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.iam_action_definition.0.policy_arn", "aws_iam_policy.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.iam_action_definition.0.roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subscriber.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscriber.*", map[string]string{
+						"address":           "example@example.example",
+						"subscription_type": "EMAIL",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSBudgetsBudgetActionConfigBasic(rName, budgets.ApprovalModelManual, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetActionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "approval_model", "MANUAL"),
+					resource.TestCheckResourceAttr(resourceName, "action_threshold.0.action_threshold_value", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSBudgetsBudgetAction_disappears(t *testing.T) {
+	var conf budgets.Action
+	resourceName := "aws_budgets_budget_action.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(budgets.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSBudgetsBudgetActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSBudgetsBudgetActionConfigBasic(rName, budgets.ApprovalModelAutomatic, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSBudgetsBudgetActionExists(resourceName, &conf),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsBudgetsBudgetAction(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSBudgetsBudgetActionExists(resourceName string, config *budgets.Action) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Budget Action ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).budgetconn
+
+		accountID, actionID, budgetName, err := tfbudgets.BudgetActionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.ActionByAccountIDActionIDAndBudgetName(conn, accountID, actionID, budgetName)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Budget Action (%s) not found", rs.Primary.ID)
+		}
+
+		*config = *output
+
+		return nil
+	}
+}
+
+func testAccAWSBudgetsBudgetActionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).budgetconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_budgets_budget_action" {
+			continue
+		}
+
+		accountID, actionID, budgetName, err := tfbudgets.BudgetActionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ActionByAccountIDActionIDAndBudgetName(conn, accountID, actionID, budgetName)
+
+		if tfawserr.ErrCodeEquals(err, budgets.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Budget Action (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSBudgetsBudgetActionConfigBasic(rName, approvalModel string, thresholdValue int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_policy" "test" {
+  name        = %[1]q
+  description = "My test policy"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "ec2:Describe*"
+      ],
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "budgets.${data.aws_partition.current.dns_suffix}"
+        ]
+      },
+      "Action": [
+        "sts:AssumeRole"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_budgets_budget" "test" {
+  name              = %[1]q
+  budget_type       = "USAGE"
+  limit_amount      = "10.0"
+  limit_unit        = "dollars"
+  time_period_start = "2006-01-02_15:04"
+  time_unit         = "MONTHLY"
+}
+
+resource "aws_budgets_budget_action" "test" {
+  budget_name        = aws_budgets_budget.test.name
+  action_type        = "APPLY_IAM_POLICY"
+  approval_model     = %[2]q
+  notification_type  = "ACTUAL"
+  execution_role_arn = aws_iam_role.test.arn
+
+  action_threshold {
+    action_threshold_type  = "ABSOLUTE_VALUE"
+    action_threshold_value = %[3]d
+  }
+
+  definition {
+    iam_action_definition {
+      policy_arn = aws_iam_policy.test.arn
+      roles      = [aws_iam_role.test.name]
+    }
+  }
+
+  subscriber {
+    address           = "example@example.example"
+    subscription_type = "EMAIL"
+  }
+}
+`, rName, approvalModel, thresholdValue)
+}
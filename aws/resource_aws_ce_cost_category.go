@@ -0,0 +1,805 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// Number of levels of "and", "or" and "not" operators supported in a cost category rule expression.
+	costCategoryRuleExpressionMaxDepth = 2
+)
+
+func resourceAwsCECostCategory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCECostCategoryCreate,
+		Read:   resourceAwsCECostCategoryRead,
+		Update: resourceAwsCECostCategoryUpdate,
+		Delete: resourceAwsCECostCategoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"effective_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"effective_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inherited_value": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"dimension_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryInheritedValueDimensionName_Values(), false),
+									},
+								},
+							},
+						},
+						"rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     costCategoryRuleExpressionSchema(costCategoryRuleExpressionMaxDepth),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryRuleType_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50),
+						},
+					},
+				},
+			},
+			"rule_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryRuleVersion_Values(), false),
+			},
+			"split_charge_rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.CostCategorySplitChargeMethod_Values(), false),
+						},
+						"parameter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(costexplorer.CostCategorySplitChargeRuleParameterType_Values(), false),
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 500,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(0, 1024),
+										},
+									},
+								},
+							},
+						},
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"targets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 500,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// costCategoryRuleExpressionSchema returns the schema for a cost category rule expression.
+// The expression grammar is recursive, so "and", "or" and "not" operators are only
+// supported to the specified depth; deeper expressions contain only dimension,
+// tag and cost category operands.
+func costCategoryRuleExpressionSchema(depth int) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"cost_category": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"match_options": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(costexplorer.MatchOption_Values(), false),
+						},
+					},
+					"values": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"dimension": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(costexplorer.Dimension_Values(), false),
+					},
+					"match_options": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(costexplorer.MatchOption_Values(), false),
+						},
+					},
+					"values": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"tags": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"match_options": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice(costexplorer.MatchOption_Values(), false),
+						},
+					},
+					"values": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+
+	if depth > 0 {
+		s["and"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     costCategoryRuleExpressionSchema(depth - 1),
+		}
+		s["not"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     costCategoryRuleExpressionSchema(depth - 1),
+		}
+		s["or"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     costCategoryRuleExpressionSchema(depth - 1),
+		}
+	}
+
+	return &schema.Resource{
+		Schema: s,
+	}
+}
+
+func resourceAwsCECostCategoryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ceconn
+
+	name := d.Get("name").(string)
+	input := &costexplorer.CreateCostCategoryDefinitionInput{
+		Name:        aws.String(name),
+		Rules:       expandCECostCategoryRules(d.Get("rule").([]interface{})),
+		RuleVersion: aws.String(d.Get("rule_version").(string)),
+	}
+
+	if v, ok := d.GetOk("default_value"); ok {
+		input.DefaultValue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("split_charge_rule"); ok && v.(*schema.Set).Len() > 0 {
+		input.SplitChargeRules = expandCECostCategorySplitChargeRules(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating Cost Explorer Cost Category: %s", input)
+	output, err := conn.CreateCostCategoryDefinition(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Cost Explorer Cost Category (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CostCategoryArn))
+
+	return resourceAwsCECostCategoryRead(d, meta)
+}
+
+func resourceAwsCECostCategoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ceconn
+
+	output, err := conn.DescribeCostCategoryDefinition(&costexplorer.DescribeCostCategoryDefinitionInput{
+		CostCategoryArn: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Cost Explorer Cost Category (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cost Explorer Cost Category (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.CostCategory == nil {
+		return fmt.Errorf("error reading Cost Explorer Cost Category (%s): empty response", d.Id())
+	}
+
+	costCategory := output.CostCategory
+
+	d.Set("arn", costCategory.CostCategoryArn)
+	d.Set("default_value", costCategory.DefaultValue)
+	d.Set("effective_end", costCategory.EffectiveEnd)
+	d.Set("effective_start", costCategory.EffectiveStart)
+	d.Set("name", costCategory.Name)
+
+	if err := d.Set("rule", flattenCECostCategoryRules(costCategory.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	d.Set("rule_version", costCategory.RuleVersion)
+
+	if err := d.Set("split_charge_rule", flattenCECostCategorySplitChargeRules(costCategory.SplitChargeRules)); err != nil {
+		return fmt.Errorf("error setting split_charge_rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCECostCategoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ceconn
+
+	input := &costexplorer.UpdateCostCategoryDefinitionInput{
+		CostCategoryArn: aws.String(d.Id()),
+		Rules:           expandCECostCategoryRules(d.Get("rule").([]interface{})),
+		RuleVersion:     aws.String(d.Get("rule_version").(string)),
+	}
+
+	if v, ok := d.GetOk("default_value"); ok {
+		input.DefaultValue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("split_charge_rule"); ok && v.(*schema.Set).Len() > 0 {
+		input.SplitChargeRules = expandCECostCategorySplitChargeRules(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Updating Cost Explorer Cost Category: %s", input)
+	_, err := conn.UpdateCostCategoryDefinition(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Cost Explorer Cost Category (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCECostCategoryRead(d, meta)
+}
+
+func resourceAwsCECostCategoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ceconn
+
+	log.Printf("[DEBUG] Deleting Cost Explorer Cost Category: %s", d.Id())
+	_, err := conn.DeleteCostCategoryDefinition(&costexplorer.DeleteCostCategoryDefinitionInput{
+		CostCategoryArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cost Explorer Cost Category (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCECostCategoryRules(tfList []interface{}) []*costexplorer.CostCategoryRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.CostCategoryRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &costexplorer.CostCategoryRule{}
+
+		if v, ok := tfMap["inherited_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.InheritedValue = expandCECostCategoryInheritedValue(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Rule = expandCECostCategoryExpression(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCECostCategoryInheritedValue(tfMap map[string]interface{}) *costexplorer.CostCategoryInheritedValueDimension {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategoryInheritedValueDimension{}
+
+	if v, ok := tfMap["dimension_key"].(string); ok && v != "" {
+		apiObject.DimensionKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["dimension_name"].(string); ok && v != "" {
+		apiObject.DimensionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCECostCategoryExpression(tfMap map[string]interface{}) *costexplorer.Expression {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.Expression{}
+
+	if v, ok := tfMap["and"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.And = expandCECostCategoryExpressions(v.List())
+	}
+
+	if v, ok := tfMap["cost_category"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CostCategories = expandCECostCategoryValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["dimension"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Dimensions = expandCECostCategoryDimensionValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["not"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Not = expandCECostCategoryExpression(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["or"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Or = expandCECostCategoryExpressions(v.List())
+	}
+
+	if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Tags = expandCECostCategoryTagValues(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandCECostCategoryExpressions(tfList []interface{}) []*costexplorer.Expression {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.Expression
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandCECostCategoryExpression(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandCECostCategoryValues(tfMap map[string]interface{}) *costexplorer.CostCategoryValues {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategoryValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCECostCategoryDimensionValues(tfMap map[string]interface{}) *costexplorer.DimensionValues {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.DimensionValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCECostCategoryTagValues(tfMap map[string]interface{}) *costexplorer.TagValues {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.TagValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCECostCategorySplitChargeRules(tfList []interface{}) []*costexplorer.CostCategorySplitChargeRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.CostCategorySplitChargeRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &costexplorer.CostCategorySplitChargeRule{}
+
+		if v, ok := tfMap["method"].(string); ok && v != "" {
+			apiObject.Method = aws.String(v)
+		}
+
+		if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Parameters = expandCECostCategorySplitChargeRuleParameters(v.List())
+		}
+
+		if v, ok := tfMap["source"].(string); ok && v != "" {
+			apiObject.Source = aws.String(v)
+		}
+
+		if v, ok := tfMap["targets"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Targets = expandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCECostCategorySplitChargeRuleParameters(tfList []interface{}) []*costexplorer.CostCategorySplitChargeRuleParameter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.CostCategorySplitChargeRuleParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &costexplorer.CostCategorySplitChargeRuleParameter{}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		if v, ok := tfMap["values"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Values = expandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCECostCategoryRules(apiObjects []*costexplorer.CostCategoryRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"type":  aws.StringValue(apiObject.Type),
+			"value": aws.StringValue(apiObject.Value),
+		}
+
+		if v := apiObject.InheritedValue; v != nil {
+			tfMap["inherited_value"] = []interface{}{flattenCECostCategoryInheritedValue(v)}
+		}
+
+		if v := apiObject.Rule; v != nil {
+			tfMap["rule"] = []interface{}{flattenCECostCategoryExpression(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenCECostCategoryInheritedValue(apiObject *costexplorer.CostCategoryInheritedValueDimension) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"dimension_key":  aws.StringValue(apiObject.DimensionKey),
+		"dimension_name": aws.StringValue(apiObject.DimensionName),
+	}
+}
+
+func flattenCECostCategoryExpression(apiObject *costexplorer.Expression) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = flattenCECostCategoryExpressions(v)
+	}
+
+	if v := apiObject.CostCategories; v != nil {
+		tfMap["cost_category"] = []interface{}{flattenCECostCategoryValues(v)}
+	}
+
+	if v := apiObject.Dimensions; v != nil {
+		tfMap["dimension"] = []interface{}{flattenCECostCategoryDimensionValues(v)}
+	}
+
+	if v := apiObject.Not; v != nil {
+		tfMap["not"] = []interface{}{flattenCECostCategoryExpression(v)}
+	}
+
+	if v := apiObject.Or; v != nil {
+		tfMap["or"] = flattenCECostCategoryExpressions(v)
+	}
+
+	if v := apiObject.Tags; v != nil {
+		tfMap["tags"] = []interface{}{flattenCECostCategoryTagValues(v)}
+	}
+
+	return tfMap
+}
+
+func flattenCECostCategoryExpressions(apiObjects []*costexplorer.Expression) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCECostCategoryExpression(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenCECostCategoryValues(apiObject *costexplorer.CostCategoryValues) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"key":           aws.StringValue(apiObject.Key),
+		"match_options": aws.StringValueSlice(apiObject.MatchOptions),
+		"values":        aws.StringValueSlice(apiObject.Values),
+	}
+}
+
+func flattenCECostCategoryDimensionValues(apiObject *costexplorer.DimensionValues) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"key":           aws.StringValue(apiObject.Key),
+		"match_options": aws.StringValueSlice(apiObject.MatchOptions),
+		"values":        aws.StringValueSlice(apiObject.Values),
+	}
+}
+
+func flattenCECostCategoryTagValues(apiObject *costexplorer.TagValues) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"key":           aws.StringValue(apiObject.Key),
+		"match_options": aws.StringValueSlice(apiObject.MatchOptions),
+		"values":        aws.StringValueSlice(apiObject.Values),
+	}
+}
+
+func flattenCECostCategorySplitChargeRules(apiObjects []*costexplorer.CostCategorySplitChargeRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"method":    aws.StringValue(apiObject.Method),
+			"parameter": flattenCECostCategorySplitChargeRuleParameters(apiObject.Parameters),
+			"source":    aws.StringValue(apiObject.Source),
+			"targets":   aws.StringValueSlice(apiObject.Targets),
+		})
+	}
+
+	return tfList
+}
+
+func flattenCECostCategorySplitChargeRuleParameters(apiObjects []*costexplorer.CostCategorySplitChargeRuleParameter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"type":   aws.StringValue(apiObject.Type),
+			"values": aws.StringValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,414 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCostCategoryRuleExpressionSchemaDepth(t *testing.T) {
+	s := costCategoryRuleExpressionSchema(costCategoryRuleExpressionMaxDepth)
+
+	for depth := 0; depth < costCategoryRuleExpressionMaxDepth; depth++ {
+		for _, operator := range []string{"and", "not", "or"} {
+			if _, ok := s.Schema[operator]; !ok {
+				t.Fatalf("expected %q operator at depth %d", operator, depth)
+			}
+		}
+
+		s = s.Schema["and"].Elem.(*schema.Resource)
+	}
+
+	for _, operator := range []string{"and", "not", "or"} {
+		if _, ok := s.Schema[operator]; ok {
+			t.Errorf("unexpected %q operator at depth %d", operator, costCategoryRuleExpressionMaxDepth)
+		}
+	}
+
+	for _, operand := range []string{"cost_category", "dimension", "tags"} {
+		if _, ok := s.Schema[operand]; !ok {
+			t.Errorf("expected %q operand at depth %d", operand, costCategoryRuleExpressionMaxDepth)
+		}
+	}
+}
+
+func TestAccAwsCECostCategory_basic(t *testing.T) {
+	var output costexplorer.CostCategory
+	resourceName := "aws_ce_cost_category.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(costexplorer.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCECostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCECostCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCECostCategoryExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`costcategory/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "effective_start"),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", "CostCategoryExpression.v1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.value", "production"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.dimension.0.key", "LINKED_ACCOUNT_NAME"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.value", "staging"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsCECostCategory_disappears(t *testing.T) {
+	var output costexplorer.CostCategory
+	resourceName := "aws_ce_cost_category.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(costexplorer.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCECostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCECostCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCECostCategoryExists(resourceName, &output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCECostCategory(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsCECostCategory_complete(t *testing.T) {
+	var output costexplorer.CostCategory
+	resourceName := "aws_ce_cost_category.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(costexplorer.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCECostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCECostCategoryConfigOperandAnd(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCECostCategoryExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.and.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "other"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsCECostCategoryConfigOperandNestedOr(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCECostCategoryExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.or.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsCECostCategory_splitChargeRule(t *testing.T) {
+	var output costexplorer.CostCategory
+	resourceName := "aws_ce_cost_category.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(costexplorer.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCECostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCECostCategoryConfigSplitChargeRule(rName, "PROPORTIONAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCECostCategoryExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "split_charge_rule.*", map[string]string{
+						"method":    "PROPORTIONAL",
+						"source":    "production",
+						"targets.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsCECostCategoryConfigSplitChargeRule(rName, "EVEN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCECostCategoryExists(resourceName, &output),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "split_charge_rule.*", map[string]string{
+						"method": "EVEN",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsCECostCategory_tooDeeplyNested(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(costexplorer.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCECostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsCECostCategoryConfigTooDeeplyNested(rName),
+				ExpectError: regexp.MustCompile(`Blocks of type "not" are not expected here`),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsCECostCategoryExists(resourceName string, output *costexplorer.CostCategory) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Cost Category ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ceconn
+
+		resp, err := conn.DescribeCostCategoryDefinition(&costexplorer.DescribeCostCategoryDefinitionInput{
+			CostCategoryArn: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.CostCategory == nil {
+			return fmt.Errorf("Cost Explorer Cost Category (%s) not found", rs.Primary.ID)
+		}
+
+		*output = *resp.CostCategory
+
+		return nil
+	}
+}
+
+func testAccCheckAwsCECostCategoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ceconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_cost_category" {
+			continue
+		}
+
+		resp, err := conn.DescribeCostCategoryDefinition(&costexplorer.DescribeCostCategoryDefinitionInput{
+			CostCategoryArn: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if resp != nil && resp.CostCategory != nil {
+			return fmt.Errorf("Cost Explorer Cost Category (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsCECostCategoryConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+
+  rule {
+    value = "staging"
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-stg"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAwsCECostCategoryConfigOperandAnd(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name          = %[1]q
+  rule_version  = "CostCategoryExpression.v1"
+  default_value = "other"
+
+  rule {
+    value = "production"
+    rule {
+      and {
+        dimension {
+          key           = "LINKED_ACCOUNT_NAME"
+          values        = ["-prod"]
+          match_options = ["ENDS_WITH"]
+        }
+      }
+
+      and {
+        tags {
+          key           = "environment"
+          values        = ["production"]
+          match_options = ["EQUALS"]
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAwsCECostCategoryConfigOperandNestedOr(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name          = %[1]q
+  rule_version  = "CostCategoryExpression.v1"
+  default_value = "other"
+
+  rule {
+    value = "production"
+    rule {
+      or {
+        dimension {
+          key           = "LINKED_ACCOUNT_NAME"
+          values        = ["-prod"]
+          match_options = ["ENDS_WITH"]
+        }
+      }
+
+      or {
+        and {
+          tags {
+            key           = "environment"
+            values        = ["production"]
+            match_options = ["EQUALS"]
+          }
+        }
+
+        and {
+          not {
+            dimension {
+              key           = "REGION"
+              values        = ["us-gov-west-1"]
+              match_options = ["EQUALS"]
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAwsCECostCategoryConfigSplitChargeRule(rName, method string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+
+  rule {
+    value = "staging"
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-stg"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+
+  split_charge_rule {
+    method  = %[2]q
+    source  = "production"
+    targets = ["staging"]
+  }
+}
+`, rName, method)
+}
+
+func testAccAwsCECostCategoryConfigTooDeeplyNested(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+    rule {
+      and {
+        and {
+          not {
+            dimension {
+              key    = "LINKED_ACCOUNT_NAME"
+              values = ["-prod"]
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
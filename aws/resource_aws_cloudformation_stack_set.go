@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -22,7 +23,7 @@ func resourceAwsCloudFormationStackSet() *schema.Resource {
 		Delete: resourceAwsCloudFormationStackSetDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsCloudFormationStackSetImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -32,13 +33,37 @@ func resourceAwsCloudFormationStackSet() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"administration_role_arn": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validateArn,
 			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_deployment": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"administration_role_arn"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"retain_stacks_on_account_removal": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"call_as": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cloudformation.CallAsSelf,
+				ValidateFunc: validation.StringInSlice(cloudformation.CallAs_Values(), false),
+			},
 			"capabilities": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -59,7 +84,7 @@ func resourceAwsCloudFormationStackSet() *schema.Resource {
 			"execution_role_name": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "AWSCloudFormationStackSetExecutionRole",
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -71,11 +96,19 @@ func resourceAwsCloudFormationStackSet() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]+$`), "must contain only alphanumeric and hyphen characters"),
 				),
 			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
 			"parameters": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"permission_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cloudformation.PermissionModelsSelfManaged,
+				ValidateFunc: validation.StringInSlice(cloudformation.PermissionModels_Values(), false),
+			},
 			"stack_set_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -103,10 +136,21 @@ func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interf
 	name := d.Get("name").(string)
 
 	input := &cloudformation.CreateStackSetInput{
-		AdministrationRoleARN: aws.String(d.Get("administration_role_arn").(string)),
-		ClientRequestToken:    aws.String(resource.UniqueId()),
-		ExecutionRoleName:     aws.String(d.Get("execution_role_name").(string)),
-		StackSetName:          aws.String(name),
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		PermissionModel:    aws.String(d.Get("permission_model").(string)),
+		StackSetName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("administration_role_arn"); ok {
+		input.AdministrationRoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("auto_deployment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoDeployment = expandCloudFormationStackSetAutoDeployment(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("call_as"); ok {
+		input.CallAs = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("capabilities"); ok {
@@ -117,6 +161,10 @@ func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interf
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("execution_role_name"); ok {
+		input.ExecutionRoleName = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
@@ -149,10 +197,19 @@ func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interfac
 	conn := meta.(*AWSClient).cfconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
+	callAs := d.Get("call_as").(string)
+
 	input := &cloudformation.DescribeStackSetInput{
 		StackSetName: aws.String(d.Id()),
 	}
 
+	if callAs != "" {
+		input.CallAs = aws.String(callAs)
+	} else {
+		// State written before call_as was introduced has no value.
+		callAs = cloudformation.CallAsSelf
+	}
+
 	log.Printf("[DEBUG] Reading CloudFormation StackSet: %s", d.Id())
 	output, err := conn.DescribeStackSet(input)
 
@@ -174,6 +231,11 @@ func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interfac
 
 	d.Set("administration_role_arn", stackSet.AdministrationRoleARN)
 	d.Set("arn", stackSet.StackSetARN)
+	d.Set("call_as", callAs)
+
+	if err := d.Set("auto_deployment", flattenCloudFormationStackSetAutoDeployment(stackSet.AutoDeployment)); err != nil {
+		return fmt.Errorf("error setting auto_deployment: %w", err)
+	}
 
 	if err := d.Set("capabilities", aws.StringValueSlice(stackSet.Capabilities)); err != nil {
 		return fmt.Errorf("error setting capabilities: %s", err)
@@ -187,6 +249,7 @@ func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("error setting parameters: %s", err)
 	}
 
+	d.Set("permission_model", stackSet.PermissionModel)
 	d.Set("stack_set_id", stackSet.StackSetId)
 
 	if err := d.Set("tags", keyvaluetags.CloudformationKeyValueTags(stackSet.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
@@ -201,13 +264,26 @@ func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interfac
 func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
+	callAs := d.Get("call_as").(string)
+
 	input := &cloudformation.UpdateStackSetInput{
-		AdministrationRoleARN: aws.String(d.Get("administration_role_arn").(string)),
-		ExecutionRoleName:     aws.String(d.Get("execution_role_name").(string)),
-		OperationId:           aws.String(resource.UniqueId()),
-		StackSetName:          aws.String(d.Id()),
-		Tags:                  []*cloudformation.Tag{},
-		TemplateBody:          aws.String(d.Get("template_body").(string)),
+		OperationId:     aws.String(resource.UniqueId()),
+		PermissionModel: aws.String(d.Get("permission_model").(string)),
+		StackSetName:    aws.String(d.Id()),
+		Tags:            []*cloudformation.Tag{},
+		TemplateBody:    aws.String(d.Get("template_body").(string)),
+	}
+
+	if v, ok := d.GetOk("administration_role_arn"); ok {
+		input.AdministrationRoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("auto_deployment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoDeployment = expandCloudFormationStackSetAutoDeployment(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("call_as"); ok {
+		input.CallAs = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("capabilities"); ok {
@@ -218,6 +294,14 @@ func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interf
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("execution_role_name"); ok {
+		input.ExecutionRoleName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
@@ -240,7 +324,7 @@ func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("error updating CloudFormation StackSet (%s): %s", d.Id(), err)
 	}
 
-	if err := waiter.StackSetOperationSucceeded(conn, d.Id(), aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if err := waiter.StackSetOperationSucceeded(conn, d.Id(), aws.StringValue(output.OperationId), callAs, d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("error waiting for CloudFormation StackSet (%s) update: %s", d.Id(), err)
 	}
 
@@ -254,6 +338,10 @@ func resourceAwsCloudFormationStackSetDelete(d *schema.ResourceData, meta interf
 		StackSetName: aws.String(d.Id()),
 	}
 
+	if v, ok := d.GetOk("call_as"); ok {
+		input.CallAs = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] Deleting CloudFormation StackSet: %s", d.Id())
 	_, err := conn.DeleteStackSet(input)
 
@@ -268,6 +356,137 @@ func resourceAwsCloudFormationStackSetDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceAwsCloudFormationStackSetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Import ID is NAME or NAME,CALL_AS for stack sets managed by a delegated administrator
+	parts := strings.Split(d.Id(), ",")
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		d.Set("call_as", cloudformation.CallAsSelf)
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		d.SetId(parts[0])
+		d.Set("call_as", parts[1])
+	default:
+		return nil, fmt.Errorf("unexpected format of import ID (%s), expected NAME or NAME,CALL_AS", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func cloudFormationStackSetOperationPreferencesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"failure_tolerance_count": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(0),
+					ConflictsWith: []string{"operation_preferences.0.failure_tolerance_percentage"},
+				},
+				"failure_tolerance_percentage": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntBetween(0, 100),
+					ConflictsWith: []string{"operation_preferences.0.failure_tolerance_count"},
+				},
+				"max_concurrent_count": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"operation_preferences.0.max_concurrent_percentage"},
+				},
+				"max_concurrent_percentage": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntBetween(1, 100),
+					ConflictsWith: []string{"operation_preferences.0.max_concurrent_count"},
+				},
+				"region_concurrency_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(cloudformation.RegionConcurrencyType_Values(), false),
+				},
+				"region_order": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]{1,128}$`), ""),
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandCloudFormationStackSetAutoDeployment(tfMap map[string]interface{}) *cloudformation.AutoDeployment {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudformation.AutoDeployment{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["retain_stacks_on_account_removal"].(bool); ok {
+		apiObject.RetainStacksOnAccountRemoval = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenCloudFormationStackSetAutoDeployment(apiObject *cloudformation.AutoDeployment) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":                          aws.BoolValue(apiObject.Enabled),
+		"retain_stacks_on_account_removal": aws.BoolValue(apiObject.RetainStacksOnAccountRemoval),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandCloudFormationStackSetOperationPreferences(tfMap map[string]interface{}) *cloudformation.StackSetOperationPreferences {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudformation.StackSetOperationPreferences{}
+
+	if v, ok := tfMap["failure_tolerance_count"].(int); ok && v != 0 {
+		apiObject.FailureToleranceCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["failure_tolerance_percentage"].(int); ok && v != 0 {
+		apiObject.FailureTolerancePercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_concurrent_count"].(int); ok && v != 0 {
+		apiObject.MaxConcurrentCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_concurrent_percentage"].(int); ok && v != 0 {
+		apiObject.MaxConcurrentPercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["region_concurrency_type"].(string); ok && v != "" {
+		apiObject.RegionConcurrencyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["region_order"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RegionOrder = expandStringList(v)
+	}
+
+	return apiObject
+}
+
 func listCloudFormationStackSets(conn *cloudformation.CloudFormation) ([]*cloudformation.StackSetSummary, error) {
 	input := &cloudformation.ListStackSetsInput{
 		Status: aws.String(cloudformation.StackSetStatusActive),
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -22,7 +23,7 @@ func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
 		Delete: resourceAwsCloudFormationStackSetInstanceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsCloudFormationStackSetInstanceImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -33,11 +34,43 @@ func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateAwsAccountId,
+				ConflictsWith: []string{"deployment_targets"},
+			},
+			"call_as": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: validateAwsAccountId,
+				Default:      cloudformation.CallAsSelf,
+				ValidateFunc: validation.StringInSlice(cloudformation.CallAs_Values(), false),
+			},
+			"deployment_targets": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"account_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"organizational_unit_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(ou-[a-z0-9]{4,32}-[a-z0-9]{8,32}|r-[a-z0-9]{4,32})$`), ""),
+							},
+						},
+					},
+				},
+			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
+			"organizational_unit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"parameter_overrides": {
 				Type:     schema.TypeMap,
@@ -72,25 +105,43 @@ func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
 func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
-	accountID := meta.(*AWSClient).accountid
-	if v, ok := d.GetOk("account_id"); ok {
-		accountID = v.(string)
-	}
-
 	region := meta.(*AWSClient).region
 	if v, ok := d.GetOk("region"); ok {
 		region = v.(string)
 	}
 
+	callAs := d.Get("call_as").(string)
 	stackSetName := d.Get("stack_set_name").(string)
 
 	input := &cloudformation.CreateStackInstancesInput{
-		Accounts:     aws.StringSlice([]string{accountID}),
 		OperationId:  aws.String(resource.UniqueId()),
 		Regions:      aws.StringSlice([]string{region}),
 		StackSetName: aws.String(stackSetName),
 	}
 
+	if callAs != "" {
+		input.CallAs = aws.String(callAs)
+	}
+
+	// The second ID part is either the target account or the target organizational units
+	var accountOrOrgID string
+
+	if v, ok := d.GetOk("deployment_targets"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeploymentTargets = expandCloudFormationStackSetInstanceDeploymentTargets(v.([]interface{})[0].(map[string]interface{}))
+		accountOrOrgID = strings.Join(aws.StringValueSlice(input.DeploymentTargets.OrganizationalUnitIds), "/")
+	} else {
+		accountOrOrgID = meta.(*AWSClient).accountid
+		if v, ok := d.GetOk("account_id"); ok {
+			accountOrOrgID = v.(string)
+		}
+
+		input.Accounts = aws.StringSlice([]string{accountOrOrgID})
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameter_overrides"); ok {
 		input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
@@ -103,9 +154,9 @@ func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, met
 			return resource.NonRetryableError(fmt.Errorf("error creating CloudFormation StackSet Instance: %w", err))
 		}
 
-		d.SetId(fmt.Sprintf("%s,%s,%s", stackSetName, accountID, region))
+		d.SetId(fmt.Sprintf("%s,%s,%s", stackSetName, accountOrOrgID, region))
 
-		err = waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutCreate))
+		err = waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), callAs, d.Timeout(schema.TimeoutCreate))
 
 		if err != nil {
 			// IAM eventual consistency
@@ -155,9 +206,9 @@ func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, met
 			return fmt.Errorf("error creating CloudFormation StackSet Instance: %w", err)
 		}
 
-		d.SetId(fmt.Sprintf("%s,%s,%s", stackSetName, accountID, region))
+		d.SetId(fmt.Sprintf("%s,%s,%s", stackSetName, accountOrOrgID, region))
 
-		err = waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutCreate))
+		err = waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), callAs, d.Timeout(schema.TimeoutCreate))
 
 		if err != nil {
 			return fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) creation: %w", d.Id(), err)
@@ -174,14 +225,57 @@ func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, met
 func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
-	stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
+	stackSetName, accountOrOrgID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
 
 	if err != nil {
 		return err
 	}
 
+	callAs := d.Get("call_as").(string)
+
+	if callAs == "" {
+		// State written before call_as was introduced has no value.
+		callAs = cloudformation.CallAsSelf
+	}
+
+	d.Set("call_as", callAs)
+
+	if !resourceAwsCloudFormationStackSetInstanceIsAccountID(accountOrOrgID) {
+		orgIDs := strings.Split(accountOrOrgID, "/")
+
+		log.Printf("[DEBUG] Reading CloudFormation StackSet Instance: %s", d.Id())
+		summaries, err := listCloudFormationStackSetInstancesByOrganizationalUnits(conn, stackSetName, region, callAs, orgIDs)
+
+		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			log.Printf("[WARN] CloudFormation StackSet (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudFormation StackSet Instance (%s): %w", d.Id(), err)
+		}
+
+		if len(summaries) == 0 {
+			log.Printf("[WARN] CloudFormation StackSet Instance (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err := d.Set("deployment_targets", flattenCloudFormationStackSetInstanceDeploymentTargets(orgIDs)); err != nil {
+			return fmt.Errorf("error setting deployment_targets: %w", err)
+		}
+
+		d.Set("organizational_unit_id", summaries[0].OrganizationalUnitId)
+		d.Set("region", region)
+		d.Set("stack_set_name", stackSetName)
+
+		return nil
+	}
+
 	input := &cloudformation.DescribeStackInstanceInput{
-		StackInstanceAccount: aws.String(accountID),
+		CallAs:               aws.String(callAs),
+		StackInstanceAccount: aws.String(accountOrOrgID),
 		StackInstanceRegion:  aws.String(region),
 		StackSetName:         aws.String(stackSetName),
 	}
@@ -212,6 +306,7 @@ func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta
 	stackInstance := output.StackInstance
 
 	d.Set("account_id", stackInstance.Account)
+	d.Set("organizational_unit_id", stackInstance.OrganizationalUnitId)
 
 	if err := d.Set("parameter_overrides", flattenAllCloudFormationParameters(stackInstance.ParameterOverrides)); err != nil {
 		return fmt.Errorf("error setting parameters: %s", err)
@@ -227,21 +322,38 @@ func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta
 func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
-	if d.HasChange("parameter_overrides") {
-		stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
+	if d.HasChanges("call_as", "operation_preferences", "parameter_overrides") {
+		stackSetName, accountOrOrgID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
 
 		if err != nil {
 			return err
 		}
 
+		callAs := d.Get("call_as").(string)
+
 		input := &cloudformation.UpdateStackInstancesInput{
-			Accounts:           aws.StringSlice([]string{accountID}),
 			OperationId:        aws.String(resource.UniqueId()),
 			ParameterOverrides: []*cloudformation.Parameter{},
 			Regions:            aws.StringSlice([]string{region}),
 			StackSetName:       aws.String(stackSetName),
 		}
 
+		if callAs != "" {
+			input.CallAs = aws.String(callAs)
+		}
+
+		if resourceAwsCloudFormationStackSetInstanceIsAccountID(accountOrOrgID) {
+			input.Accounts = aws.StringSlice([]string{accountOrOrgID})
+		} else {
+			input.DeploymentTargets = &cloudformation.DeploymentTargets{
+				OrganizationalUnitIds: aws.StringSlice(strings.Split(accountOrOrgID, "/")),
+			}
+		}
+
+		if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk("parameter_overrides"); ok {
 			input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
 		}
@@ -253,7 +365,7 @@ func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, met
 			return fmt.Errorf("error updating CloudFormation StackSet Instance (%s): %s", d.Id(), err)
 		}
 
-		if err := waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err := waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), callAs, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -264,20 +376,37 @@ func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, met
 func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
-	stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
+	stackSetName, accountOrOrgID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
 
 	if err != nil {
 		return err
 	}
 
+	callAs := d.Get("call_as").(string)
+
 	input := &cloudformation.DeleteStackInstancesInput{
-		Accounts:     aws.StringSlice([]string{accountID}),
 		OperationId:  aws.String(resource.UniqueId()),
 		Regions:      aws.StringSlice([]string{region}),
 		RetainStacks: aws.Bool(d.Get("retain_stack").(bool)),
 		StackSetName: aws.String(stackSetName),
 	}
 
+	if callAs != "" {
+		input.CallAs = aws.String(callAs)
+	}
+
+	if resourceAwsCloudFormationStackSetInstanceIsAccountID(accountOrOrgID) {
+		input.Accounts = aws.StringSlice([]string{accountOrOrgID})
+	} else {
+		input.DeploymentTargets = &cloudformation.DeploymentTargets{
+			OrganizationalUnitIds: aws.StringSlice(strings.Split(accountOrOrgID, "/")),
+		}
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandCloudFormationStackSetOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[DEBUG] Deleting CloudFormation StackSet Instance: %s", d.Id())
 	output, err := conn.DeleteStackInstances(input)
 
@@ -293,15 +422,27 @@ func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, met
 		return fmt.Errorf("error deleting CloudFormation StackSet Instance (%s): %s", d.Id(), err)
 	}
 
-	if err := waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err := waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), callAs, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) deletion: %s", d.Id(), err)
 	}
 
 	return nil
 }
 
+func resourceAwsCloudFormationStackSetInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id()); err != nil {
+		return nil, err
+	}
+
+	d.Set("call_as", cloudformation.CallAsSelf)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceAwsCloudFormationStackSetInstanceParseId parses an ID of the form
+// NAME,ACCOUNT_ID,REGION or NAME,OU_ID[/OU_ID...],REGION.
 func resourceAwsCloudFormationStackSetInstanceParseId(id string) (string, string, string, error) {
-	idFormatErr := fmt.Errorf("unexpected format of ID (%s), expected NAME,ACCOUNT,REGION", id)
+	idFormatErr := fmt.Errorf("unexpected format of ID (%s), expected NAME,ACCOUNT_ID,REGION or NAME,OU_IDS,REGION", id)
 
 	parts := strings.SplitN(id, ",", 3)
 	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
@@ -311,6 +452,74 @@ func resourceAwsCloudFormationStackSetInstanceParseId(id string) (string, string
 	return parts[0], parts[1], parts[2], nil
 }
 
+func resourceAwsCloudFormationStackSetInstanceIsAccountID(accountOrOrgID string) bool {
+	return regexp.MustCompile(`^\d{12}$`).MatchString(accountOrOrgID)
+}
+
+func expandCloudFormationStackSetInstanceDeploymentTargets(tfMap map[string]interface{}) *cloudformation.DeploymentTargets {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudformation.DeploymentTargets{}
+
+	if v, ok := tfMap["organizational_unit_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.OrganizationalUnitIds = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenCloudFormationStackSetInstanceDeploymentTargets(orgIDs []string) []interface{} {
+	tfMap := map[string]interface{}{
+		"organizational_unit_ids": orgIDs,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func listCloudFormationStackSetInstancesByOrganizationalUnits(conn *cloudformation.CloudFormation, stackSetName, region, callAs string, orgIDs []string) ([]*cloudformation.StackInstanceSummary, error) {
+	input := &cloudformation.ListStackInstancesInput{
+		StackInstanceRegion: aws.String(region),
+		StackSetName:        aws.String(stackSetName),
+	}
+
+	if callAs != "" {
+		input.CallAs = aws.String(callAs)
+	}
+
+	result := make([]*cloudformation.StackInstanceSummary, 0)
+
+	for {
+		output, err := conn.ListStackInstances(input)
+
+		if err != nil {
+			return result, err
+		}
+
+		for _, summary := range output.Summaries {
+			if summary == nil {
+				continue
+			}
+
+			for _, orgID := range orgIDs {
+				if aws.StringValue(summary.OrganizationalUnitId) == orgID {
+					result = append(result, summary)
+					break
+				}
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return result, nil
+}
+
 func listCloudFormationStackSetInstances(conn *cloudformation.CloudFormation, stackSetName string) ([]*cloudformation.StackInstanceSummary, error) {
 	input := &cloudformation.ListStackInstancesInput{
 		StackSetName: aws.String(stackSetName),
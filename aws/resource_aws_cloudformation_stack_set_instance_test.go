@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -84,7 +85,7 @@ func testSweepCloudformationStackSetInstances(region string) error {
 				continue
 			}
 
-			if err := waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), waiter.StackSetInstanceDeletedDefaultTimeout); err != nil {
+			if err := waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), cloudformation.CallAsSelf, waiter.StackSetInstanceDeletedDefaultTimeout); err != nil {
 				sweeperErr := fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) deletion: %w", id, err)
 				log.Printf("[ERROR] %s", sweeperErr)
 				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
@@ -237,6 +238,85 @@ func TestAccAWSCloudFormationStackSetInstance_ParameterOverrides(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudFormationStackSetInstance_OperationPreferences(t *testing.T) {
+	var stackInstance1, stackInstance2 cloudformation.StackInstance
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigOperationPreferences(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceExists(resourceName, &stackInstance1),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.failure_tolerance_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.max_concurrent_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.region_concurrency_type", cloudformation.RegionConcurrencyTypeSequential),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"operation_preferences",
+					"retain_stack",
+				},
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigOperationPreferences(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceExists(resourceName, &stackInstance2),
+					testAccCheckCloudFormationStackSetInstanceNotRecreated(&stackInstance1, &stackInstance2),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.failure_tolerance_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.max_concurrent_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSetInstance_DeploymentTargets(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSCloudFormationStackSet(t)
+			testAccOrganizationsEnabledPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigDeploymentTargets(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceOrganizationalUnitsExist(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.0.organizational_unit_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_targets.0.organizational_unit_ids.0", "data.aws_organizations_organization.test", "roots.0.id"),
+					resource.TestCheckResourceAttrPair(resourceName, "organizational_unit_id", "data.aws_organizations_organization.test", "roots.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "region", testAccGetRegion()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"retain_stack",
+				},
+			},
+		},
+	})
+}
+
 // TestAccAWSCloudFrontDistribution_RetainStack verifies retain_stack = true
 // This acceptance test performs the following steps:
 //  * Trigger a Terraform destroy of the resource, which should only remove the instance from the StackSet
@@ -334,6 +414,36 @@ func testAccCheckCloudFormationStackSetInstanceExists(resourceName string, stack
 	}
 }
 
+func testAccCheckCloudFormationStackSetInstanceOrganizationalUnitsExist(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		stackSetName, orgIDs, region, err := resourceAwsCloudFormationStackSetInstanceParseId(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		summaries, err := listCloudFormationStackSetInstancesByOrganizationalUnits(conn, stackSetName, region, rs.Primary.Attributes["call_as"], strings.Split(orgIDs, "/"))
+
+		if err != nil {
+			return err
+		}
+
+		if len(summaries) == 0 {
+			return fmt.Errorf("CloudFormation StackSet Instance (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckCloudFormationStackSetInstanceStackExists(stackInstance *cloudformation.StackInstance, stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).cfconn
@@ -366,14 +476,32 @@ func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) er
 			continue
 		}
 
-		stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(rs.Primary.ID)
+		stackSetName, accountOrOrgID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(rs.Primary.ID)
 
 		if err != nil {
 			return err
 		}
 
+		if !resourceAwsCloudFormationStackSetInstanceIsAccountID(accountOrOrgID) {
+			summaries, err := listCloudFormationStackSetInstancesByOrganizationalUnits(conn, stackSetName, region, rs.Primary.Attributes["call_as"], strings.Split(accountOrOrgID, "/"))
+
+			if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(summaries) > 0 {
+				return fmt.Errorf("CloudFormation StackSet Instance (%s) still exists", rs.Primary.ID)
+			}
+
+			continue
+		}
+
 		input := &cloudformation.DescribeStackInstanceInput{
-			StackInstanceAccount: aws.String(accountID),
+			StackInstanceAccount: aws.String(accountOrOrgID),
 			StackInstanceRegion:  aws.String(region),
 			StackSetName:         aws.String(stackSetName),
 		}
@@ -589,3 +717,47 @@ resource "aws_cloudformation_stack_set_instance" "test" {
 }
 `, retainStack)
 }
+
+func testAccAWSCloudFormationStackSetInstanceConfigOperationPreferences(rName string, maxConcurrentCount int) string {
+	return testAccAWSCloudFormationStackSetInstanceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_instance" "test" {
+  depends_on = [aws_iam_role_policy.Administration, aws_iam_role_policy.Execution]
+
+  operation_preferences {
+    failure_tolerance_count = 1
+    max_concurrent_count    = %[1]d
+    region_concurrency_type = "SEQUENTIAL"
+  }
+
+  stack_set_name = aws_cloudformation_stack_set.test.name
+}
+`, maxConcurrentCount)
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfigDeploymentTargets(rName string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_cloudformation_stack_set" "test" {
+  name             = %[1]q
+  permission_model = "SERVICE_MANAGED"
+
+  auto_deployment {
+    enabled                          = true
+    retain_stacks_on_account_removal = false
+  }
+
+  template_body = <<TEMPLATE
+%[2]s
+TEMPLATE
+}
+
+resource "aws_cloudformation_stack_set_instance" "test" {
+  deployment_targets {
+    organizational_unit_ids = [data.aws_organizations_organization.test.roots[0].id]
+  }
+
+  stack_set_name = aws_cloudformation_stack_set.test.name
+}
+`, rName, testAccAWSCloudFormationStackSetTemplateBodyVpc(rName))
+}
@@ -0,0 +1,370 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCloudFormationType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationTypeCreate,
+		Read:   resourceAwsCloudFormationTypeRead,
+		Delete: resourceAwsCloudFormationTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(waiter.TypeDeregisteredDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deprecated_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"documentation_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"is_default_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"logging_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_group_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"log_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"provisioning_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema_handler_package": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 4096),
+					validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
+				),
+			},
+			"source_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cloudformation.RegistryType_Values(), false),
+			},
+			"type_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(10, 204),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}(::MODULE){0,1}$`), "three alphanumeric character sections separated by double colons (::)"),
+				),
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"visibility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	typeName := d.Get("type_name").(string)
+	input := &cloudformation.RegisterTypeInput{
+		SchemaHandlerPackage: aws.String(d.Get("schema_handler_package").(string)),
+		TypeName:             aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("execution_role_arn"); ok {
+		input.ExecutionRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("logging_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LoggingConfig = expandCloudFormationLoggingConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Registering CloudFormation Type: %s", input)
+	output, err := conn.RegisterType(input)
+
+	if err != nil {
+		return fmt.Errorf("error registering CloudFormation Type (%s): %w", typeName, err)
+	}
+
+	registration, err := waiter.TypeRegistrationProgressStatusComplete(conn, aws.StringValue(output.RegistrationToken))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for CloudFormation Type (%s) registration: %w", typeName, err)
+	}
+
+	// The type version ARN uniquely identifies this registration
+	d.SetId(aws.StringValue(registration.TypeVersionArn))
+
+	return resourceAwsCloudFormationTypeRead(d, meta)
+}
+
+func resourceAwsCloudFormationTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	output, err := finder.TypeByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFormation Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation Type (%s): %w", d.Id(), err)
+	}
+
+	typeARN, versionID, err := cloudFormationTypeVersionArnToTypeArnAndVersionID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("default_version_id", output.DefaultVersionId)
+	d.Set("deprecated_status", output.DeprecatedStatus)
+	d.Set("description", output.Description)
+	d.Set("documentation_url", output.DocumentationUrl)
+	d.Set("execution_role_arn", output.ExecutionRoleArn)
+	d.Set("is_default_version", output.IsDefaultVersion)
+
+	if output.LoggingConfig != nil {
+		if err := d.Set("logging_config", []interface{}{flattenCloudFormationLoggingConfig(output.LoggingConfig)}); err != nil {
+			return fmt.Errorf("error setting logging_config: %w", err)
+		}
+	} else {
+		d.Set("logging_config", nil)
+	}
+
+	d.Set("provisioning_type", output.ProvisioningType)
+	d.Set("schema", output.Schema)
+	d.Set("source_url", output.SourceUrl)
+	d.Set("type", output.Type)
+	d.Set("type_arn", typeARN)
+	d.Set("type_name", output.TypeName)
+	d.Set("version_id", versionID)
+	d.Set("visibility", output.Visibility)
+
+	return nil
+}
+
+func resourceAwsCloudFormationTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	input := &cloudformation.DeregisterTypeInput{
+		Arn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deregistering CloudFormation Type: %s", d.Id())
+	_, err := conn.DeregisterType(input)
+
+	// The default version cannot be deregistered on its own. Once all other
+	// LIVE versions have been deregistered, the type itself must be deregistered.
+	if tfawserr.ErrMessageContains(err, cloudformation.ErrCodeCFNRegistryException, "is the default version and cannot be deregistered") {
+		err = deregisterCloudFormationTypeDefaultVersion(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+	}
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeTypeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering CloudFormation Type (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// deregisterCloudFormationTypeDefaultVersion waits for all other LIVE versions of the
+// type to be deregistered (e.g. by other resources in the same run) before
+// deregistering the type, which removes the remaining default version.
+func deregisterCloudFormationTypeDefaultVersion(conn *cloudformation.CloudFormation, versionARN string, timeout time.Duration) error {
+	typeARN, _, err := cloudFormationTypeVersionArnToTypeArnAndVersionID(versionARN)
+
+	if err != nil {
+		return err
+	}
+
+	input := &cloudformation.DeregisterTypeInput{
+		Arn: aws.String(typeARN),
+	}
+
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		versions, err := listCloudFormationTypeVersions(conn, typeARN)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if len(versions) > 1 {
+			return resource.RetryableError(fmt.Errorf("CloudFormation Type (%s) has %d other LIVE versions", typeARN, len(versions)-1))
+		}
+
+		log.Printf("[DEBUG] Deregistering CloudFormation Type: %s", typeARN)
+		_, err = conn.DeregisterType(input)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.DeregisterType(input)
+	}
+
+	return err
+}
+
+// cloudFormationTypeVersionArnToTypeArnAndVersionID splits a type version ARN,
+// e.g. arn:aws:cloudformation:us-east-1:123456789012:type/resource/Example-Test-Type/00000001,
+// into the type ARN and the version ID.
+func cloudFormationTypeVersionArnToTypeArnAndVersionID(versionARN string) (string, string, error) {
+	idx := strings.LastIndex(versionARN, "/")
+
+	if idx == -1 || idx == len(versionARN)-1 || strings.Count(versionARN, "/") != 3 {
+		return "", "", fmt.Errorf("unexpected format of CloudFormation Type version ARN (%s), expected arn:PARTITION:cloudformation:REGION:ACCOUNT:type/TYPE/NAME/VERSION", versionARN)
+	}
+
+	return versionARN[:idx], versionARN[idx+1:], nil
+}
+
+func listCloudFormationTypeVersions(conn *cloudformation.CloudFormation, typeARN string) ([]*cloudformation.TypeVersionSummary, error) {
+	input := &cloudformation.ListTypeVersionsInput{
+		Arn:              aws.String(typeARN),
+		DeprecatedStatus: aws.String(cloudformation.DeprecatedStatusLive),
+	}
+	var result []*cloudformation.TypeVersionSummary
+
+	err := conn.ListTypeVersionsPages(input, func(page *cloudformation.ListTypeVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, summary := range page.TypeVersionSummaries {
+			if summary != nil {
+				result = append(result, summary)
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func expandCloudFormationLoggingConfig(tfMap map[string]interface{}) *cloudformation.LoggingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudformation.LoggingConfig{}
+
+	if v, ok := tfMap["log_group_name"].(string); ok && v != "" {
+		apiObject.LogGroupName = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_role_arn"].(string); ok && v != "" {
+		apiObject.LogRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenCloudFormationLoggingConfig(apiObject *cloudformation.LoggingConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LogGroupName; v != nil {
+		tfMap["log_group_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LogRoleArn; v != nil {
+		tfMap["log_role_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
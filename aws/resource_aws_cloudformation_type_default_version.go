@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCloudFormationTypeDefaultVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationTypeDefaultVersionPut,
+		Read:   resourceAwsCloudFormationTypeDefaultVersionRead,
+		Update: resourceAwsCloudFormationTypeDefaultVersionPut,
+		Delete: resourceAwsCloudFormationTypeDefaultVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateArn,
+				ConflictsWith: []string{"type_name", "type_version_arn"},
+			},
+			"type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringInSlice(cloudformation.RegistryType_Values(), false),
+				ConflictsWith: []string{"arn", "type_version_arn"},
+			},
+			"type_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(10, 204),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}(::MODULE){0,1}$`), "three alphanumeric character sections separated by double colons (::)"),
+				),
+				ConflictsWith: []string{"arn", "type_version_arn"},
+			},
+			"type_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+				ExactlyOneOf: []string{"type_version_arn", "version_id"},
+			},
+			"version_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+				ExactlyOneOf: []string{"type_version_arn", "version_id"},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationTypeDefaultVersionPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	input := &cloudformation.SetTypeDefaultVersionInput{}
+
+	if d.HasChange("type_version_arn") {
+		if v, ok := d.GetOk("type_version_arn"); ok {
+			input.Arn = aws.String(v.(string))
+		}
+	}
+
+	if input.Arn == nil {
+		if v, ok := d.GetOk("arn"); ok {
+			input.Arn = aws.String(v.(string))
+		} else {
+			input.TypeName = aws.String(d.Get("type_name").(string))
+
+			if v, ok := d.GetOk("type"); ok {
+				input.Type = aws.String(v.(string))
+			}
+		}
+
+		if v, ok := d.GetOk("version_id"); ok {
+			input.VersionId = aws.String(v.(string))
+		}
+	}
+
+	log.Printf("[DEBUG] Setting CloudFormation Type default version: %s", input)
+	_, err := conn.SetTypeDefaultVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting CloudFormation Type default version: %w", err)
+	}
+
+	if d.IsNewResource() {
+		typeARN := d.Get("arn").(string)
+
+		if typeARN == "" {
+			if v, ok := d.GetOk("type_version_arn"); ok {
+				typeARN, _, err = cloudFormationTypeVersionArnToTypeArnAndVersionID(v.(string))
+
+				if err != nil {
+					return err
+				}
+			} else {
+				describeInput := &cloudformation.DescribeTypeInput{
+					TypeName: aws.String(d.Get("type_name").(string)),
+				}
+
+				if v, ok := d.GetOk("type"); ok {
+					describeInput.Type = aws.String(v.(string))
+				}
+
+				output, err := conn.DescribeType(describeInput)
+
+				if err != nil {
+					return fmt.Errorf("error reading CloudFormation Type (%s): %w", d.Get("type_name").(string), err)
+				}
+
+				typeARN = aws.StringValue(output.Arn)
+			}
+		}
+
+		d.SetId(typeARN)
+	}
+
+	return resourceAwsCloudFormationTypeDefaultVersionRead(d, meta)
+}
+
+func resourceAwsCloudFormationTypeDefaultVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	output, err := finder.TypeByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFormation Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation Type (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("type", output.Type)
+	d.Set("type_name", output.TypeName)
+	d.Set("type_version_arn", fmt.Sprintf("%s/%s", aws.StringValue(output.Arn), aws.StringValue(output.DefaultVersionId)))
+	d.Set("version_id", output.DefaultVersionId)
+
+	return nil
+}
+
+func resourceAwsCloudFormationTypeDefaultVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	// The default version of a type cannot be unset, only changed or deregistered along with the type.
+	log.Printf("[WARN] CloudFormation Type (%s) default version cannot be removed, only removing from Terraform state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSCloudFormationTypeDefaultVersion_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	typeName := fmt.Sprintf("HashiCorp::TerraformAwsProvider::TfAccTest%s", acctest.RandString(8))
+	zipPath := testAccAwsCloudformationTypeZipGenerator(t, typeName)
+	resourceName := "aws_cloudformation_type_default_version.test"
+	typeResourceName := "aws_cloudformation_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCloudformationTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCloudformationTypeDefaultVersionConfigTypeVersionArn(rName, zipPath, typeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "arn", typeResourceName, "type_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "type", typeResourceName, "type"),
+					resource.TestCheckResourceAttrPair(resourceName, "type_name", typeResourceName, "type_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "type_version_arn", typeResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "version_id", typeResourceName, "version_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationTypeDefaultVersion_VersionId(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	typeName := fmt.Sprintf("HashiCorp::TerraformAwsProvider::TfAccTest%s", acctest.RandString(8))
+	zipPath := testAccAwsCloudformationTypeZipGenerator(t, typeName)
+	resourceName := "aws_cloudformation_type_default_version.test"
+	typeResourceName := "aws_cloudformation_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCloudformationTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCloudformationTypeDefaultVersionConfigVersionId(rName, zipPath, typeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "arn", typeResourceName, "type_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "type_name", typeResourceName, "type_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "version_id", typeResourceName, "version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsCloudformationTypeDefaultVersionConfigBase(rName string, zipPath string, typeName string) string {
+	return composeConfig(
+		testAccAwsCloudformationTypeConfigBase(rName, zipPath),
+		fmt.Sprintf(`
+resource "aws_cloudformation_type" "test" {
+  schema_handler_package = "s3://${aws_s3_bucket_object.test.bucket}/${aws_s3_bucket_object.test.key}"
+  type                   = "RESOURCE"
+  type_name              = %[1]q
+}
+`, typeName))
+}
+
+func testAccAwsCloudformationTypeDefaultVersionConfigTypeVersionArn(rName string, zipPath string, typeName string) string {
+	return composeConfig(
+		testAccAwsCloudformationTypeDefaultVersionConfigBase(rName, zipPath, typeName),
+		`
+resource "aws_cloudformation_type_default_version" "test" {
+  type_version_arn = aws_cloudformation_type.test.arn
+}
+`)
+}
+
+func testAccAwsCloudformationTypeDefaultVersionConfigVersionId(rName string, zipPath string, typeName string) string {
+	return composeConfig(
+		testAccAwsCloudformationTypeDefaultVersionConfigBase(rName, zipPath, typeName),
+		`
+resource "aws_cloudformation_type_default_version" "test" {
+  type       = aws_cloudformation_type.test.type
+  type_name  = aws_cloudformation_type.test.type_name
+  version_id = aws_cloudformation_type.test.version_id
+}
+`)
+}
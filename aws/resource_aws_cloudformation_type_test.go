@@ -0,0 +1,277 @@
+package aws
+
+import (
+	"archive/zip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCloudFormationType_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	typeName := fmt.Sprintf("HashiCorp::TerraformAwsProvider::TfAccTest%s", acctest.RandString(8))
+	zipPath := testAccAwsCloudformationTypeZipGenerator(t, typeName)
+	resourceName := "aws_cloudformation_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCloudformationTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCloudformationTypeConfigTypeName(rName, zipPath, typeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudformationTypeExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "cloudformation", fmt.Sprintf("type/resource/%s/00000001", strings.ReplaceAll(typeName, "::", "-"))),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", "00000001"),
+					resource.TestCheckResourceAttr(resourceName, "deprecated_status", cloudformation.DeprecatedStatusLive),
+					resource.TestCheckResourceAttr(resourceName, "description", "An example resource schema demonstrating some basic constructs and validation rules."),
+					resource.TestCheckResourceAttr(resourceName, "execution_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "is_default_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_type", cloudformation.ProvisioningTypeFullyMutable),
+					resource.TestMatchResourceAttr(resourceName, "schema", regexp.MustCompile(`^\{.*`)),
+					resource.TestCheckResourceAttr(resourceName, "source_url", "https://github.com/aws-cloudformation/aws-cloudformation-rpdk.git"),
+					resource.TestCheckResourceAttr(resourceName, "type", cloudformation.RegistryTypeResource),
+					testAccCheckResourceAttrRegionalARN(resourceName, "type_arn", "cloudformation", fmt.Sprintf("type/resource/%s", strings.ReplaceAll(typeName, "::", "-"))),
+					resource.TestCheckResourceAttr(resourceName, "type_name", typeName),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "00000001"),
+					resource.TestCheckResourceAttr(resourceName, "visibility", cloudformation.VisibilityPrivate),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"schema_handler_package",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationType_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	typeName := fmt.Sprintf("HashiCorp::TerraformAwsProvider::TfAccTest%s", acctest.RandString(8))
+	zipPath := testAccAwsCloudformationTypeZipGenerator(t, typeName)
+	resourceName := "aws_cloudformation_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCloudformationTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCloudformationTypeConfigTypeName(rName, zipPath, typeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudformationTypeExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudFormationType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationType_LoggingConfig(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	typeName := fmt.Sprintf("HashiCorp::TerraformAwsProvider::TfAccTest%s", acctest.RandString(8))
+	zipPath := testAccAwsCloudformationTypeZipGenerator(t, typeName)
+	cloudwatchLogGroupResourceName := "aws_cloudwatch_log_group.test"
+	iamRoleResourceName := "aws_iam_role.test"
+	resourceName := "aws_cloudformation_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsCloudformationTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsCloudformationTypeConfigLoggingConfig(rName, zipPath, typeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsCloudformationTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_config.0.log_group_name", cloudwatchLogGroupResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_config.0.log_role_arn", iamRoleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"schema_handler_package",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsCloudformationTypeExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFormation Type ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		_, err := finder.TypeByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAwsCloudformationTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_type" {
+			continue
+		}
+
+		_, err := finder.TypeByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFormation Type (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAwsCloudformationTypeZipGenerator builds a schema handler package from
+// the example resource fixture, substituting the given type name into the schema.
+func testAccAwsCloudformationTypeZipGenerator(t *testing.T, typeName string) string {
+	t.Helper()
+
+	fixtureDir := "test-fixtures/cloudformation/examplecompany-exampleservice-exampleresource"
+	zipFile, err := ioutil.TempFile(os.TempDir(), "tf-acc-test-cloudformation-type-*.zip")
+
+	if err != nil {
+		t.Fatalf("error creating CloudFormation Type schema handler package: %s", err)
+	}
+
+	t.Cleanup(func() {
+		os.Remove(zipFile.Name())
+	})
+
+	defer zipFile.Close()
+
+	w := zip.NewWriter(zipFile)
+
+	for _, name := range []string{".rpdk-config", "schema.json"} {
+		content, err := ioutil.ReadFile(filepath.Join(fixtureDir, name))
+
+		if err != nil {
+			t.Fatalf("error reading CloudFormation Type fixture (%s): %s", name, err)
+		}
+
+		f, err := w.Create(name)
+
+		if err != nil {
+			t.Fatalf("error creating CloudFormation Type schema handler package file (%s): %s", name, err)
+		}
+
+		if _, err := f.Write([]byte(strings.ReplaceAll(string(content), "ExampleCompany::ExampleService::ExampleResource", typeName))); err != nil {
+			t.Fatalf("error writing CloudFormation Type schema handler package file (%s): %s", name, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("error closing CloudFormation Type schema handler package: %s", err)
+	}
+
+	return zipFile.Name()
+}
+
+func testAccAwsCloudformationTypeConfigBase(rName string, zipPath string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test"
+  source = %[2]q
+}
+`, rName, zipPath)
+}
+
+func testAccAwsCloudformationTypeConfigLoggingConfig(rName string, zipPath string, typeName string) string {
+	return composeConfig(
+		testAccAwsCloudformationTypeConfigBase(rName, zipPath),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "cloudformation.${data.aws_partition.current.dns_suffix}",
+          "resources.cloudformation.${data.aws_partition.current.dns_suffix}",
+        ]
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_cloudformation_type" "test" {
+  schema_handler_package = "s3://${aws_s3_bucket_object.test.bucket}/${aws_s3_bucket_object.test.key}"
+  type                   = "RESOURCE"
+  type_name              = %[2]q
+
+  logging_config {
+    log_group_name = aws_cloudwatch_log_group.test.name
+    log_role_arn   = aws_iam_role.test.arn
+  }
+}
+`, rName, typeName))
+}
+
+func testAccAwsCloudformationTypeConfigTypeName(rName string, zipPath string, typeName string) string {
+	return composeConfig(
+		testAccAwsCloudformationTypeConfigBase(rName, zipPath),
+		fmt.Sprintf(`
+resource "aws_cloudformation_type" "test" {
+  schema_handler_package = "s3://${aws_s3_bucket_object.test.bucket}/${aws_s3_bucket_object.test.key}"
+  type                   = "RESOURCE"
+  type_name              = %[1]q
+}
+`, typeName))
+}
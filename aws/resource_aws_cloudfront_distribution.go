@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -32,6 +33,8 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 		MigrateState:  resourceAwsCloudFrontDistributionMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: resourceAwsCloudFrontDistributionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -476,6 +479,10 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 								},
 							},
 						},
+						"origin_access_control_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"origin_id": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -970,6 +977,36 @@ func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
+// resourceAwsCloudFrontDistributionCustomizeDiff rejects origins that configure
+// both an origin access identity and an origin access control.
+func resourceAwsCloudFrontDistributionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	origins, ok := diff.Get("origin").(*schema.Set)
+
+	if !ok {
+		return nil
+	}
+
+	for _, tfMapRaw := range origins.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["origin_access_control_id"].(string); !ok || v == "" {
+			continue
+		}
+
+		if v, ok := tfMap["s3_origin_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if oai, ok := v[0].(map[string]interface{})["origin_access_identity"].(string); ok && oai != "" {
+				return fmt.Errorf("origin (%s): origin_access_control_id cannot be combined with s3_origin_config origin_access_identity", tfMap["origin_id"])
+			}
+		}
+	}
+
+	return nil
+}
+
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
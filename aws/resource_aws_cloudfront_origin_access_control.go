@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCloudFrontOriginAccessControl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontOriginAccessControlCreate,
+		Read:   resourceAwsCloudFrontOriginAccessControlRead,
+		Update: resourceAwsCloudFrontOriginAccessControlUpdate,
+		Delete: resourceAwsCloudFrontOriginAccessControlDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Managed by Terraform",
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"origin_access_control_origin_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cloudfront.OriginAccessControlOriginTypes_Values(), false),
+			},
+			"signing_behavior": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cloudfront.OriginAccessControlSigningBehaviors_Values(), false),
+			},
+			"signing_protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cloudfront.OriginAccessControlSigningProtocols_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontOriginAccessControlCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateOriginAccessControlInput{
+		OriginAccessControlConfig: expandCloudFrontOriginAccessControlConfig(d),
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Origin Access Control: %s", input)
+	output, err := conn.CreateOriginAccessControl(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Origin Access Control (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.OriginAccessControl.Id))
+
+	return resourceAwsCloudFrontOriginAccessControlRead(d, meta)
+}
+
+func resourceAwsCloudFrontOriginAccessControlRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	output, err := finder.OriginAccessControlByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Origin Access Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Origin Access Control (%s): %w", d.Id(), err)
+	}
+
+	config := output.OriginAccessControl.OriginAccessControlConfig
+
+	d.Set("description", config.Description)
+	d.Set("etag", output.ETag)
+	d.Set("name", config.Name)
+	d.Set("origin_access_control_origin_type", config.OriginAccessControlOriginType)
+	d.Set("signing_behavior", config.SigningBehavior)
+	d.Set("signing_protocol", config.SigningProtocol)
+
+	return nil
+}
+
+func resourceAwsCloudFrontOriginAccessControlUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.UpdateOriginAccessControlInput{
+		Id:                        aws.String(d.Id()),
+		IfMatch:                   aws.String(d.Get("etag").(string)),
+		OriginAccessControlConfig: expandCloudFrontOriginAccessControlConfig(d),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Origin Access Control: %s", input)
+	_, err := conn.UpdateOriginAccessControl(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Origin Access Control (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontOriginAccessControlRead(d, meta)
+}
+
+func resourceAwsCloudFrontOriginAccessControlDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	log.Printf("[DEBUG] Deleting CloudFront Origin Access Control: %s", d.Id())
+	_, err := conn.DeleteOriginAccessControl(&cloudfront.DeleteOriginAccessControlInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginAccessControl) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Origin Access Control (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFrontOriginAccessControlConfig(d *schema.ResourceData) *cloudfront.OriginAccessControlConfig {
+	return &cloudfront.OriginAccessControlConfig{
+		Description:                   aws.String(d.Get("description").(string)),
+		Name:                          aws.String(d.Get("name").(string)),
+		OriginAccessControlOriginType: aws.String(d.Get("origin_access_control_origin_type").(string)),
+		SigningBehavior:               aws.String(d.Get("signing_behavior").(string)),
+		SigningProtocol:               aws.String(d.Get("signing_protocol").(string)),
+	}
+}
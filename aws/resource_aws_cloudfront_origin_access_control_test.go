@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCloudFrontOriginAccessControl_basic(t *testing.T) {
+	var v cloudfront.GetOriginAccessControlOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_origin_access_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontOriginAccessControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginAccessControlConfig(rName, "always"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginAccessControlExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "origin_access_control_origin_type", "s3"),
+					resource.TestCheckResourceAttr(resourceName, "signing_behavior", "always"),
+					resource.TestCheckResourceAttr(resourceName, "signing_protocol", "sigv4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontOriginAccessControl_disappears(t *testing.T) {
+	var v cloudfront.GetOriginAccessControlOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_origin_access_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontOriginAccessControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginAccessControlConfig(rName, "always"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginAccessControlExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudFrontOriginAccessControl(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontOriginAccessControl_update(t *testing.T) {
+	var v1, v2 cloudfront.GetOriginAccessControlOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_origin_access_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontOriginAccessControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginAccessControlConfig(rName, "always"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginAccessControlExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "signing_behavior", "always"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFrontOriginAccessControlConfigDescription(rName, "no-override", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontOriginAccessControlExists(resourceName, &v2),
+					testAccCheckCloudFrontOriginAccessControlNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "signing_behavior", "no-override"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFrontOriginAccessControlDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_origin_access_control" {
+			continue
+		}
+
+		_, err := finder.OriginAccessControlByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFront Origin Access Control %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFrontOriginAccessControlExists(n string, v *cloudfront.GetOriginAccessControlOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Origin Access Control ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		output, err := finder.OriginAccessControlByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCloudFrontOriginAccessControlNotRecreated(before, after *cloudfront.GetOriginAccessControlOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.OriginAccessControl.Id), aws.StringValue(after.OriginAccessControl.Id); before != after {
+			return fmt.Errorf("CloudFront Origin Access Control (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSCloudFrontOriginAccessControlConfig(rName, signingBehavior string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_origin_access_control" "test" {
+  name                              = %[1]q
+  origin_access_control_origin_type = "s3"
+  signing_behavior                  = %[2]q
+  signing_protocol                  = "sigv4"
+}
+`, rName, signingBehavior)
+}
+
+func testAccAWSCloudFrontOriginAccessControlConfigDescription(rName, signingBehavior, description string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_origin_access_control" "test" {
+  name                              = %[1]q
+  description                       = %[3]q
+  origin_access_control_origin_type = "s3"
+  signing_behavior                  = %[2]q
+  signing_protocol                  = "sigv4"
+}
+`, rName, signingBehavior, description)
+}
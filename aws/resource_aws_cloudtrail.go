@@ -134,7 +134,7 @@ func resourceAwsCloudTrail() *schema.Resource {
 			},
 			"tags": tagsSchema(),
 			"insight_selector": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -323,15 +323,13 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 	insightSelectors, err := conn.GetInsightSelectors(&cloudtrail.GetInsightSelectorsInput{
 		TrailName: aws.String(d.Id()),
 	})
-	if err != nil {
-		if !isAWSErr(err, cloudtrail.ErrCodeInsightNotEnabledException, "") {
-			return fmt.Errorf("error getting Cloud Trail (%s) Insight Selectors: %w", d.Id(), err)
-		}
-	}
-	if insightSelectors != nil {
-		if err := d.Set("insight_selector", flattenAwsCloudTrailInsightSelector(insightSelectors.InsightSelectors)); err != nil {
-			return err
-		}
+	if isAWSErr(err, cloudtrail.ErrCodeInsightNotEnabledException, "") {
+		// Insights are not enabled on the trail, so there are no insight selectors.
+		d.Set("insight_selector", nil)
+	} else if err != nil {
+		return fmt.Errorf("error getting Cloud Trail (%s) Insight Selectors: %w", d.Id(), err)
+	} else if err := d.Set("insight_selector", flattenAwsCloudTrailInsightSelector(insightSelectors.InsightSelectors)); err != nil {
+		return fmt.Errorf("error setting insight_selector: %w", err)
 	}
 
 	return nil
@@ -595,8 +593,8 @@ func cloudTrailSetInsightSelectors(conn *cloudtrail.CloudTrail, d *schema.Resour
 		TrailName: aws.String(d.Id()),
 	}
 
-	insightSelector := expandAwsCloudTrailInsightSelector(d.Get("insight_selector").([]interface{}))
-	input.InsightSelectors = insightSelector
+	// An empty list of insight selectors disables CloudTrail Insights on the trail.
+	input.InsightSelectors = expandAwsCloudTrailInsightSelector(d.Get("insight_selector").(*schema.Set).List())
 
 	if err := input.Validate(); err != nil {
 		return fmt.Errorf("Error validate CloudTrail (%s): %s", d.Id(), err)
@@ -558,7 +558,9 @@ func testAccAWSCloudTrail_insight_selector(t *testing.T) {
 				Config: testAccAWSCloudTrailConfig_insightSelector(rName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "insight_selector.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "insight_selector.0.insight_type", "ApiCallRateInsight"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "insight_selector.*", map[string]string{
+						"insight_type": "ApiCallRateInsight",
+					}),
 				),
 			},
 			{
@@ -566,6 +568,24 @@ func testAccAWSCloudTrail_insight_selector(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccAWSCloudTrailConfig_insightSelectorMultiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "insight_selector.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "insight_selector.*", map[string]string{
+						"insight_type": "ApiCallRateInsight",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "insight_selector.*", map[string]string{
+						"insight_type": "ApiErrorRateInsight",
+					}),
+				),
+			},
+			{
+				Config: testAccAWSCloudTrailConfig_insightSelectorNone(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "insight_selector.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -1597,18 +1617,8 @@ POLICY
 `, cloudTrailRandInt)
 }
 
-func testAccAWSCloudTrailConfig_insightSelector(rName string) string {
+func testAccAWSCloudTrailConfigInsightSelectorBase(rName string) string {
 	return fmt.Sprintf(`
-resource "aws_cloudtrail" "test" {
-  name           = %[1]q
-  s3_bucket_name = aws_s3_bucket.test.id
-
-
-  insight_selector {
-    insight_type = "ApiCallRateInsight"
-  }
-}
-
 data "aws_partition" "current" {}
 
 resource "aws_s3_bucket" "test" {
@@ -1644,3 +1654,48 @@ POLICY
 }
 `, rName)
 }
+
+func testAccAWSCloudTrailConfig_insightSelector(rName string) string {
+	return composeConfig(
+		testAccAWSCloudTrailConfigInsightSelectorBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+
+  insight_selector {
+    insight_type = "ApiCallRateInsight"
+  }
+}
+`, rName))
+}
+
+func testAccAWSCloudTrailConfig_insightSelectorMultiple(rName string) string {
+	return composeConfig(
+		testAccAWSCloudTrailConfigInsightSelectorBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+
+  insight_selector {
+    insight_type = "ApiCallRateInsight"
+  }
+
+  insight_selector {
+    insight_type = "ApiErrorRateInsight"
+  }
+}
+`, rName))
+}
+
+func testAccAWSCloudTrailConfig_insightSelectorNone(rName string) string {
+	return composeConfig(
+		testAccAWSCloudTrailConfigInsightSelectorBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+}
+`, rName))
+}
@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsCloudWatchEventApiDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventApiDestinationCreate,
+		Read:   resourceAwsCloudWatchEventApiDestinationRead,
+		Update: resourceAwsCloudWatchEventApiDestinationUpdate,
+		Delete: resourceAwsCloudWatchEventApiDestinationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"http_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(events.ApiDestinationHttpMethod_Values(), false),
+			},
+			"invocation_endpoint": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"invocation_rate_limit_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]+$`), ""),
+				),
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventApiDestinationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	name := d.Get("name").(string)
+	input := &events.CreateApiDestinationInput{
+		ConnectionArn:                aws.String(d.Get("connection_arn").(string)),
+		HttpMethod:                   aws.String(d.Get("http_method").(string)),
+		InvocationEndpoint:           aws.String(d.Get("invocation_endpoint").(string)),
+		InvocationRateLimitPerSecond: aws.Int64(int64(d.Get("invocation_rate_limit_per_second").(int))),
+		Name:                         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Events API destination: %s", input)
+	_, err := conn.CreateApiDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch Events API destination (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCloudWatchEventApiDestinationRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventApiDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	output, err := conn.DescribeApiDestination(&events.DescribeApiDestinationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] CloudWatch Events API destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Events API destination (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.ApiDestinationArn)
+	d.Set("connection_arn", output.ConnectionArn)
+	d.Set("description", output.Description)
+	d.Set("http_method", output.HttpMethod)
+	d.Set("invocation_endpoint", output.InvocationEndpoint)
+	d.Set("invocation_rate_limit_per_second", output.InvocationRateLimitPerSecond)
+	d.Set("name", output.Name)
+
+	return nil
+}
+
+func resourceAwsCloudWatchEventApiDestinationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	input := &events.UpdateApiDestinationInput{
+		ConnectionArn:                aws.String(d.Get("connection_arn").(string)),
+		Description:                  aws.String(d.Get("description").(string)),
+		HttpMethod:                   aws.String(d.Get("http_method").(string)),
+		InvocationEndpoint:           aws.String(d.Get("invocation_endpoint").(string)),
+		InvocationRateLimitPerSecond: aws.Int64(int64(d.Get("invocation_rate_limit_per_second").(int))),
+		Name:                         aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Updating CloudWatch Events API destination: %s", input)
+	_, err := conn.UpdateApiDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudWatch Events API destination (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchEventApiDestinationRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventApiDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	log.Printf("[INFO] Deleting CloudWatch Events API destination (%s)", d.Id())
+	_, err := conn.DeleteApiDestination(&events.DeleteApiDestinationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Events API destination (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSCloudWatchEventApiDestination_basic(t *testing.T) {
+	var v1, v2 events.DescribeApiDestinationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_api_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventApiDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventApiDestinationConfig(rName, "https://example.com/1", "POST"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventApiDestinationExists(resourceName, &v1),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "events", regexp.MustCompile(fmt.Sprintf("api-destination/%s/.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "http_method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "invocation_endpoint", "https://example.com/1"),
+					resource.TestCheckResourceAttr(resourceName, "invocation_rate_limit_per_second", "300"),
+					resource.TestCheckResourceAttrPair(resourceName, "connection_arn", "aws_cloudwatch_event_connection.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchEventApiDestinationConfigFull(rName, "description", "https://example.com/2", "GET", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventApiDestinationExists(resourceName, &v2),
+					testAccCheckCloudWatchEventApiDestinationNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+					resource.TestCheckResourceAttr(resourceName, "http_method", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "invocation_endpoint", "https://example.com/2"),
+					resource.TestCheckResourceAttr(resourceName, "invocation_rate_limit_per_second", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventApiDestination_disappears(t *testing.T) {
+	var v events.DescribeApiDestinationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_api_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventApiDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventApiDestinationConfig(rName, "https://example.com/1", "POST"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventApiDestinationExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudWatchEventApiDestination(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchEventApiDestinationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_api_destination" {
+			continue
+		}
+
+		_, err := conn.DescribeApiDestination(&events.DescribeApiDestinationInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Events API destination (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCloudWatchEventApiDestinationExists(n string, v *events.DescribeApiDestinationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		resp, err := conn.DescribeApiDestination(&events.DescribeApiDestinationInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("CloudWatch Events API destination (%s) not found", n)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckCloudWatchEventApiDestinationNotRecreated(i, j *events.DescribeApiDestinationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.CreationTime).Equal(aws.TimeValue(j.CreationTime)) {
+			return fmt.Errorf("CloudWatch Events API destination was recreated")
+		}
+		return nil
+	}
+}
+
+func testAccAWSCloudWatchEventApiDestinationConfigBase(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  authorization_type = "API_KEY"
+
+  auth_parameters {
+    api_key {
+      key   = "X-API-Key"
+      value = "secret"
+    }
+  }
+}
+`, name)
+}
+
+func testAccAWSCloudWatchEventApiDestinationConfig(name, endpoint, httpMethod string) string {
+	return composeConfig(
+		testAccAWSCloudWatchEventApiDestinationConfigBase(name),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_event_api_destination" "test" {
+  name                = %[1]q
+  invocation_endpoint = %[2]q
+  http_method         = %[3]q
+  connection_arn      = aws_cloudwatch_event_connection.test.arn
+}
+`, name, endpoint, httpMethod))
+}
+
+func testAccAWSCloudWatchEventApiDestinationConfigFull(name, description, endpoint, httpMethod string, rateLimit int) string {
+	return composeConfig(
+		testAccAWSCloudWatchEventApiDestinationConfigBase(name),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_event_api_destination" "test" {
+  name                             = %[1]q
+  description                      = %[2]q
+  invocation_endpoint              = %[3]q
+  http_method                      = %[4]q
+  invocation_rate_limit_per_second = %[5]d
+  connection_arn                   = aws_cloudwatch_event_connection.test.arn
+}
+`, name, description, endpoint, httpMethod, rateLimit))
+}
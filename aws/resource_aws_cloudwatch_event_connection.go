@@ -0,0 +1,558 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/waiter"
+)
+
+func resourceAwsCloudWatchEventConnection() *schema.Resource {
+	connectionHttpParameters := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"body":         cloudWatchEventConnectionParameterSchema(),
+				"header":       cloudWatchEventConnectionParameterSchema(),
+				"query_string": cloudWatchEventConnectionParameterSchema(),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventConnectionCreate,
+		Read:   resourceAwsCloudWatchEventConnectionRead,
+		Update: resourceAwsCloudWatchEventConnectionUpdate,
+		Delete: resourceAwsCloudWatchEventConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"auth_parameters.0.api_key", "auth_parameters.0.basic", "auth_parameters.0.oauth"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+						"basic": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"auth_parameters.0.api_key", "auth_parameters.0.basic", "auth_parameters.0.oauth"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"username": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+						"invocation_http_parameters": connectionHttpParameters,
+						"oauth": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"auth_parameters.0.api_key", "auth_parameters.0.basic", "auth_parameters.0.oauth"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authorization_endpoint": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2048),
+									},
+									"client_parameters": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"client_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"client_secret": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"http_method": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(events.ConnectionOAuthHttpMethod_Values(), false),
+									},
+									"oauth_http_parameters": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"body":         cloudWatchEventConnectionParameterSchema(),
+												"header":       cloudWatchEventConnectionParameterSchema(),
+												"query_string": cloudWatchEventConnectionParameterSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"authorization_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(events.ConnectionAuthorizationType_Values(), false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]+$`), ""),
+				),
+			},
+			"secret_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func cloudWatchEventConnectionParameterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"is_value_secret": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"key": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"value": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	name := d.Get("name").(string)
+	input := &events.CreateConnectionInput{
+		AuthParameters:    expandAwsCloudWatchEventConnectionCreateAuthParameters(d.Get("auth_parameters").([]interface{})),
+		AuthorizationType: aws.String(d.Get("authorization_type").(string)),
+		Name:              aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Events connection: %s", name)
+	_, err := conn.CreateConnection(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch Events connection (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.ConnectionCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Events connection (%s) to create: %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchEventConnectionRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	output, err := conn.DescribeConnection(&events.DescribeConnectionInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] CloudWatch Events connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Events connection (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.ConnectionArn)
+	d.Set("authorization_type", output.AuthorizationType)
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	d.Set("secret_arn", output.SecretArn)
+
+	if output.AuthParameters != nil {
+		if err := d.Set("auth_parameters", flattenAwsCloudWatchEventConnectionAuthParameters(output.AuthParameters, d)); err != nil {
+			return fmt.Errorf("error setting auth_parameters: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchEventConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	input := &events.UpdateConnectionInput{
+		AuthorizationType: aws.String(d.Get("authorization_type").(string)),
+		Name:              aws.String(d.Id()),
+	}
+
+	if d.HasChange("auth_parameters") {
+		input.AuthParameters = expandAwsCloudWatchEventConnectionUpdateAuthParameters(d.Get("auth_parameters").([]interface{}))
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	log.Printf("[DEBUG] Updating CloudWatch Events connection: %s", d.Id())
+	_, err := conn.UpdateConnection(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudWatch Events connection (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ConnectionUpdated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Events connection (%s) to update: %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchEventConnectionRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	log.Printf("[INFO] Deleting CloudWatch Events connection (%s)", d.Id())
+	_, err := conn.DeleteConnection(&events.DeleteConnectionInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Events connection (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ConnectionDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for CloudWatch Events connection (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAwsCloudWatchEventConnectionCreateAuthParameters(config []interface{}) *events.CreateConnectionAuthRequestParameters {
+	authParameters := &events.CreateConnectionAuthRequestParameters{}
+
+	for _, c := range config {
+		param, ok := c.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := param["api_key"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			authParameters.ApiKeyAuthParameters = &events.CreateConnectionApiKeyAuthRequestParameters{
+				ApiKeyName:  aws.String(m["key"].(string)),
+				ApiKeyValue: aws.String(m["value"].(string)),
+			}
+		}
+
+		if v, ok := param["basic"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			authParameters.BasicAuthParameters = &events.CreateConnectionBasicAuthRequestParameters{
+				Password: aws.String(m["password"].(string)),
+				Username: aws.String(m["username"].(string)),
+			}
+		}
+
+		if v, ok := param["oauth"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			oauthParameters := &events.CreateConnectionOAuthRequestParameters{
+				AuthorizationEndpoint: aws.String(m["authorization_endpoint"].(string)),
+				HttpMethod:            aws.String(m["http_method"].(string)),
+				OAuthHttpParameters:   expandAwsCloudWatchEventConnectionHttpParameters(m["oauth_http_parameters"].([]interface{})),
+			}
+
+			if v, ok := m["client_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				m := v[0].(map[string]interface{})
+				oauthParameters.ClientParameters = &events.CreateConnectionOAuthClientRequestParameters{
+					ClientID:     aws.String(m["client_id"].(string)),
+					ClientSecret: aws.String(m["client_secret"].(string)),
+				}
+			}
+
+			authParameters.OAuthParameters = oauthParameters
+		}
+
+		if v, ok := param["invocation_http_parameters"].([]interface{}); ok {
+			authParameters.InvocationHttpParameters = expandAwsCloudWatchEventConnectionHttpParameters(v)
+		}
+	}
+
+	return authParameters
+}
+
+func expandAwsCloudWatchEventConnectionUpdateAuthParameters(config []interface{}) *events.UpdateConnectionAuthRequestParameters {
+	authParameters := &events.UpdateConnectionAuthRequestParameters{}
+
+	for _, c := range config {
+		param, ok := c.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := param["api_key"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			authParameters.ApiKeyAuthParameters = &events.UpdateConnectionApiKeyAuthRequestParameters{
+				ApiKeyName:  aws.String(m["key"].(string)),
+				ApiKeyValue: aws.String(m["value"].(string)),
+			}
+		}
+
+		if v, ok := param["basic"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			authParameters.BasicAuthParameters = &events.UpdateConnectionBasicAuthRequestParameters{
+				Password: aws.String(m["password"].(string)),
+				Username: aws.String(m["username"].(string)),
+			}
+		}
+
+		if v, ok := param["oauth"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			oauthParameters := &events.UpdateConnectionOAuthRequestParameters{
+				AuthorizationEndpoint: aws.String(m["authorization_endpoint"].(string)),
+				HttpMethod:            aws.String(m["http_method"].(string)),
+				OAuthHttpParameters:   expandAwsCloudWatchEventConnectionHttpParameters(m["oauth_http_parameters"].([]interface{})),
+			}
+
+			if v, ok := m["client_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				m := v[0].(map[string]interface{})
+				oauthParameters.ClientParameters = &events.UpdateConnectionOAuthClientRequestParameters{
+					ClientID:     aws.String(m["client_id"].(string)),
+					ClientSecret: aws.String(m["client_secret"].(string)),
+				}
+			}
+
+			authParameters.OAuthParameters = oauthParameters
+		}
+
+		if v, ok := param["invocation_http_parameters"].([]interface{}); ok {
+			// An empty configuration removes any previously configured invocation parameters
+			authParameters.InvocationHttpParameters = expandAwsCloudWatchEventConnectionHttpParameters(v)
+
+			if authParameters.InvocationHttpParameters == nil {
+				authParameters.InvocationHttpParameters = &events.ConnectionHttpParameters{}
+			}
+		}
+	}
+
+	return authParameters
+}
+
+func expandAwsCloudWatchEventConnectionHttpParameters(config []interface{}) *events.ConnectionHttpParameters {
+	if len(config) == 0 || config[0] == nil {
+		return nil
+	}
+
+	m := config[0].(map[string]interface{})
+	httpParameters := &events.ConnectionHttpParameters{}
+
+	for _, raw := range m["body"].([]interface{}) {
+		if p, ok := raw.(map[string]interface{}); ok {
+			httpParameters.BodyParameters = append(httpParameters.BodyParameters, &events.ConnectionBodyParameter{
+				IsValueSecret: aws.Bool(p["is_value_secret"].(bool)),
+				Key:           aws.String(p["key"].(string)),
+				Value:         aws.String(p["value"].(string)),
+			})
+		}
+	}
+
+	for _, raw := range m["header"].([]interface{}) {
+		if p, ok := raw.(map[string]interface{}); ok {
+			httpParameters.HeaderParameters = append(httpParameters.HeaderParameters, &events.ConnectionHeaderParameter{
+				IsValueSecret: aws.Bool(p["is_value_secret"].(bool)),
+				Key:           aws.String(p["key"].(string)),
+				Value:         aws.String(p["value"].(string)),
+			})
+		}
+	}
+
+	for _, raw := range m["query_string"].([]interface{}) {
+		if p, ok := raw.(map[string]interface{}); ok {
+			httpParameters.QueryStringParameters = append(httpParameters.QueryStringParameters, &events.ConnectionQueryStringParameter{
+				IsValueSecret: aws.Bool(p["is_value_secret"].(bool)),
+				Key:           aws.String(p["key"].(string)),
+				Value:         aws.String(p["value"].(string)),
+			})
+		}
+	}
+
+	return httpParameters
+}
+
+// flattenAwsCloudWatchEventConnectionAuthParameters flattens the connection's authorization parameters.
+// The API never returns secret values, so those are carried over from the existing state.
+func flattenAwsCloudWatchEventConnectionAuthParameters(authParameters *events.ConnectionAuthResponseParameters, d *schema.ResourceData) []map[string]interface{} {
+	config := make(map[string]interface{})
+
+	if authParameters.ApiKeyAuthParameters != nil {
+		config["api_key"] = []map[string]interface{}{
+			{
+				"key":   aws.StringValue(authParameters.ApiKeyAuthParameters.ApiKeyName),
+				"value": d.Get("auth_parameters.0.api_key.0.value").(string),
+			},
+		}
+	}
+
+	if authParameters.BasicAuthParameters != nil {
+		config["basic"] = []map[string]interface{}{
+			{
+				"password": d.Get("auth_parameters.0.basic.0.password").(string),
+				"username": aws.StringValue(authParameters.BasicAuthParameters.Username),
+			},
+		}
+	}
+
+	if authParameters.OAuthParameters != nil {
+		oauth := map[string]interface{}{
+			"authorization_endpoint": aws.StringValue(authParameters.OAuthParameters.AuthorizationEndpoint),
+			"http_method":            aws.StringValue(authParameters.OAuthParameters.HttpMethod),
+			"oauth_http_parameters":  flattenAwsCloudWatchEventConnectionHttpParameters(authParameters.OAuthParameters.OAuthHttpParameters, d, "auth_parameters.0.oauth.0.oauth_http_parameters"),
+		}
+
+		if authParameters.OAuthParameters.ClientParameters != nil {
+			oauth["client_parameters"] = []map[string]interface{}{
+				{
+					"client_id":     aws.StringValue(authParameters.OAuthParameters.ClientParameters.ClientID),
+					"client_secret": d.Get("auth_parameters.0.oauth.0.client_parameters.0.client_secret").(string),
+				},
+			}
+		}
+
+		config["oauth"] = []map[string]interface{}{oauth}
+	}
+
+	if authParameters.InvocationHttpParameters != nil {
+		config["invocation_http_parameters"] = flattenAwsCloudWatchEventConnectionHttpParameters(authParameters.InvocationHttpParameters, d, "auth_parameters.0.invocation_http_parameters")
+	}
+
+	return []map[string]interface{}{config}
+}
+
+func flattenAwsCloudWatchEventConnectionHttpParameters(httpParameters *events.ConnectionHttpParameters, d *schema.ResourceData, path string) []map[string]interface{} {
+	if httpParameters == nil {
+		return nil
+	}
+
+	flattenParameter := func(kind string, i int, isValueSecret *bool, key, value *string) map[string]interface{} {
+		v := aws.StringValue(value)
+
+		// Secret values are not returned by the API
+		if aws.BoolValue(isValueSecret) {
+			v = d.Get(fmt.Sprintf("%s.0.%s.%d.value", path, kind, i)).(string)
+		}
+
+		return map[string]interface{}{
+			"is_value_secret": aws.BoolValue(isValueSecret),
+			"key":             aws.StringValue(key),
+			"value":           v,
+		}
+	}
+
+	var bodyParameters []map[string]interface{}
+	for i, p := range httpParameters.BodyParameters {
+		bodyParameters = append(bodyParameters, flattenParameter("body", i, p.IsValueSecret, p.Key, p.Value))
+	}
+
+	var headerParameters []map[string]interface{}
+	for i, p := range httpParameters.HeaderParameters {
+		headerParameters = append(headerParameters, flattenParameter("header", i, p.IsValueSecret, p.Key, p.Value))
+	}
+
+	var queryStringParameters []map[string]interface{}
+	for i, p := range httpParameters.QueryStringParameters {
+		queryStringParameters = append(queryStringParameters, flattenParameter("query_string", i, p.IsValueSecret, p.Key, p.Value))
+	}
+
+	return []map[string]interface{}{
+		{
+			"body":         bodyParameters,
+			"header":       headerParameters,
+			"query_string": queryStringParameters,
+		},
+	}
+}
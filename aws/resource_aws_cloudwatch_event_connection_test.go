@@ -0,0 +1,286 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSCloudWatchEventConnection_apiKey(t *testing.T) {
+	var v1, v2 events.DescribeConnectionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventConnectionConfigApiKey(rName, "description 1", "X-API-Key", "secret1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventConnectionExists(resourceName, &v1),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "events", regexp.MustCompile(fmt.Sprintf("connection/%s/.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", events.ConnectionAuthorizationTypeApiKey),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.api_key.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.api_key.0.key", "X-API-Key"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.api_key.0.value", "secret1"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth_parameters.0.api_key.0.value"},
+			},
+			{
+				Config: testAccAWSCloudWatchEventConnectionConfigApiKey(rName, "description 2", "X-API-Key-2", "secret2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventConnectionExists(resourceName, &v2),
+					testAccCheckCloudWatchEventConnectionNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.api_key.0.key", "X-API-Key-2"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.api_key.0.value", "secret2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventConnection_basic(t *testing.T) {
+	var v events.DescribeConnectionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventConnectionConfigBasic(rName, "user1", "password1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", events.ConnectionAuthorizationTypeBasic),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.username", "user1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.password", "password1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth_parameters.0.basic.0.password"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventConnection_oAuth(t *testing.T) {
+	var v events.DescribeConnectionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventConnectionConfigOAuth(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", events.ConnectionAuthorizationTypeOauthClientCredentials),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.authorization_endpoint", "https://example.com/auth"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.http_method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.client_parameters.0.client_id", "client1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.client_parameters.0.client_secret", "secret1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.oauth_http_parameters.0.body.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.oauth_http_parameters.0.body.0.key", "grant_type"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.oauth_http_parameters.0.body.0.value", "client_credentials"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.oauth_http_parameters.0.header.0.is_value_secret", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.oauth_http_parameters.0.header.0.value", "header-secret"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.invocation_http_parameters.0.query_string.0.key", "env"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.invocation_http_parameters.0.query_string.0.value", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auth_parameters.0.oauth.0.client_parameters.0.client_secret",
+					"auth_parameters.0.oauth.0.oauth_http_parameters.0.header.0.value",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventConnection_disappears(t *testing.T) {
+	var v events.DescribeConnectionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventConnectionConfigApiKey(rName, "description", "X-API-Key", "secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventConnectionExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudWatchEventConnection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchEventConnectionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_connection" {
+			continue
+		}
+
+		_, err := conn.DescribeConnection(&events.DescribeConnectionInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Events connection (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCloudWatchEventConnectionExists(n string, v *events.DescribeConnectionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		resp, err := conn.DescribeConnection(&events.DescribeConnectionInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("CloudWatch Events connection (%s) not found", n)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckCloudWatchEventConnectionNotRecreated(i, j *events.DescribeConnectionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.CreationTime).Equal(aws.TimeValue(j.CreationTime)) {
+			return fmt.Errorf("CloudWatch Events connection was recreated")
+		}
+		return nil
+	}
+}
+
+func testAccAWSCloudWatchEventConnectionConfigApiKey(name, description, key, value string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  description        = %[2]q
+  authorization_type = "API_KEY"
+
+  auth_parameters {
+    api_key {
+      key   = %[3]q
+      value = %[4]q
+    }
+  }
+}
+`, name, description, key, value)
+}
+
+func testAccAWSCloudWatchEventConnectionConfigBasic(name, username, password string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  authorization_type = "BASIC"
+
+  auth_parameters {
+    basic {
+      username = %[2]q
+      password = %[3]q
+    }
+  }
+}
+`, name, username, password)
+}
+
+func testAccAWSCloudWatchEventConnectionConfigOAuth(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  authorization_type = "OAUTH_CLIENT_CREDENTIALS"
+
+  auth_parameters {
+    oauth {
+      authorization_endpoint = "https://example.com/auth"
+      http_method            = "POST"
+
+      client_parameters {
+        client_id     = "client1"
+        client_secret = "secret1"
+      }
+
+      oauth_http_parameters {
+        body {
+          key   = "grant_type"
+          value = "client_credentials"
+        }
+
+        header {
+          key             = "X-Auth"
+          value           = "header-secret"
+          is_value_secret = true
+        }
+      }
+    }
+
+    invocation_http_parameters {
+      query_string {
+        key   = "env"
+        value = "test"
+      }
+    }
+  }
+}
+`, name)
+}
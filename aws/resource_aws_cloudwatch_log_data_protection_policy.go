@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchlogs/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCloudWatchLogDataProtectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchLogDataProtectionPolicyPut,
+		Read:   resourceAwsCloudWatchLogDataProtectionPolicyRead,
+		Update: resourceAwsCloudWatchLogDataProtectionPolicyPut,
+		Delete: resourceAwsCloudWatchLogDataProtectionPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLogGroupName,
+			},
+			"policy_document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchLogDataProtectionPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	logGroupName := d.Get("log_group_name").(string)
+
+	input := &cloudwatchlogs.PutDataProtectionPolicyInput{
+		LogGroupIdentifier: aws.String(logGroupName),
+		PolicyDocument:     aws.String(d.Get("policy_document").(string)),
+	}
+
+	log.Printf("[DEBUG] Putting CloudWatch Logs Data Protection Policy: %s", input)
+	_, err := conn.PutDataProtectionPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting CloudWatch Logs Data Protection Policy (%s): %w", logGroupName, err)
+	}
+
+	d.SetId(logGroupName)
+
+	return resourceAwsCloudWatchLogDataProtectionPolicyRead(d, meta)
+}
+
+func resourceAwsCloudWatchLogDataProtectionPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	output, err := finder.DataProtectionPolicyByLogGroupName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Data Protection Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Logs Data Protection Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("log_group_name", d.Id())
+	d.Set("policy_document", output.PolicyDocument)
+
+	return nil
+}
+
+func resourceAwsCloudWatchLogDataProtectionPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Logs Data Protection Policy: %s", d.Id())
+	_, err := conn.DeleteDataProtectionPolicy(&cloudwatchlogs.DeleteDataProtectionPolicyInput{
+		LogGroupIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Logs Data Protection Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
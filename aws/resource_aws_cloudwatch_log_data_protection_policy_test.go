@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchlogs/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCloudWatchLogDataProtectionPolicy_basic(t *testing.T) {
+	var policy cloudwatchlogs.GetDataProtectionPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_log_data_protection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchLogDataProtectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchLogDataProtectionPolicyConfig(rName, "EmailAddress"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchLogDataProtectionPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_name", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_document"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchLogDataProtectionPolicyConfig(rName, "DriversLicense-US"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchLogDataProtectionPolicyExists(resourceName, &policy),
+					resource.TestMatchResourceAttr(resourceName, "policy_document", regexp.MustCompile(`DriversLicense-US`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchLogDataProtectionPolicy_disappears(t *testing.T) {
+	var policy cloudwatchlogs.GetDataProtectionPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_log_data_protection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchLogDataProtectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchLogDataProtectionPolicyConfig(rName, "EmailAddress"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchLogDataProtectionPolicyExists(resourceName, &policy),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudWatchLogDataProtectionPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchLogDataProtectionPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_log_data_protection_policy" {
+			continue
+		}
+
+		_, err := finder.DataProtectionPolicyByLogGroupName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Logs Data Protection Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCloudWatchLogDataProtectionPolicyExists(n string, v *cloudwatchlogs.GetDataProtectionPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Logs Data Protection Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+
+		output, err := finder.DataProtectionPolicyByLogGroupName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSCloudWatchLogDataProtectionPolicyConfig(rName, dataIdentifier string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_data_protection_policy" "test" {
+  log_group_name = aws_cloudwatch_log_group.test.name
+
+  policy_document = jsonencode({
+    Name    = "Test"
+    Version = "2021-06-01"
+
+    Statement = [
+      {
+        Sid            = "Audit"
+        DataIdentifier = ["arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/%[2]s"]
+        Operation = {
+          Audit = {
+            FindingsDestination = {}
+          }
+        }
+      },
+      {
+        Sid            = "Redact"
+        DataIdentifier = ["arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/%[2]s"]
+        Operation = {
+          Deidentify = {
+            MaskConfig = {}
+          }
+        }
+      }
+    ]
+  })
+}
+`, rName, dataIdentifier)
+}
@@ -134,7 +134,9 @@ func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{})
 	d.Set("arn", strings.TrimSuffix(aws.StringValue(lg.Arn), ":*"))
 	d.Set("name", lg.LogGroupName)
 	d.Set("kms_key_id", lg.KmsKeyId)
-	d.Set("retention_in_days", lg.RetentionInDays)
+	// A log group that never expires has no retention policy; report it as 0 so
+	// that it round-trips with the schema default and drift is detected.
+	d.Set("retention_in_days", aws.Int64Value(lg.RetentionInDays))
 
 	tags, err := keyvaluetags.CloudwatchlogsListTags(conn, d.Id())
 
@@ -115,10 +115,9 @@ func TestAccAWSCloudWatchLogGroup_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retention_in_days"}, //this has a default value
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
@@ -245,6 +244,36 @@ func TestAccAWSCloudWatchLogGroup_retentionPolicy(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchLogGroup_retentionPolicyRemovedOutsideTerraform(t *testing.T) {
+	var lg cloudwatchlogs.LogGroup
+	rInt := acctest.RandInt()
+	resourceName := "aws_cloudwatch_log_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchLogGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchLogGroupConfig_withRetention(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchLogGroupExists(resourceName, &lg),
+					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "365"),
+					testAccCheckCloudWatchLogGroupRetentionPolicyDisappears(&lg),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSCloudWatchLogGroupConfig_withRetention(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchLogGroupExists(resourceName, &lg),
+					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "365"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSCloudWatchLogGroup_multiple(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
 	rInt := acctest.RandInt()
@@ -404,6 +433,17 @@ func testAccCheckCloudWatchLogGroupDisappears(lg *cloudwatchlogs.LogGroup) resou
 	}
 }
 
+func testAccCheckCloudWatchLogGroupRetentionPolicyDisappears(lg *cloudwatchlogs.LogGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+		input := &cloudwatchlogs.DeleteRetentionPolicyInput{
+			LogGroupName: lg.LogGroupName,
+		}
+		_, err := conn.DeleteRetentionPolicy(input)
+		return err
+	}
+}
+
 func testAccCheckCloudWatchLogGroupExists(n string, lg *cloudwatchlogs.LogGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -114,6 +114,52 @@ func resourceAwsCodeBuildProject() *schema.Resource {
 					},
 				},
 			},
+			"build_batch_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"combine_artifacts": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"restrictions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"compute_types_allowed": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(codebuild.ComputeType_Values(), false),
+										},
+									},
+									"maximum_builds_allowed": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+									},
+								},
+							},
+						},
+						"service_role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"timeout_in_mins": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      480,
+							ValidateFunc: validation.IntBetween(5, 480),
+						},
+					},
+				},
+			},
 			"cache": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -258,6 +304,36 @@ func resourceAwsCodeBuildProject() *schema.Resource {
 					},
 				},
 			},
+			"file_system_locations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mount_options": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mount_point": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      codebuild.FileSystemTypeEfs,
+							ValidateFunc: validation.StringInSlice(codebuild.FileSystemType_Values(), false),
+						},
+					},
+				},
+			},
 			"logs_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -652,6 +728,10 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		Tags:               keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().CodebuildTags(),
 	}
 
+	if v, ok := d.GetOk("build_batch_config"); ok {
+		params.BuildBatchConfig = expandCodeBuildBuildBatchConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("cache"); ok {
 		params.Cache = expandProjectCache(v.([]interface{}))
 	}
@@ -684,6 +764,10 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		params.VpcConfig = expandCodeBuildVpcConfig(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("file_system_locations"); ok && v.(*schema.Set).Len() > 0 {
+		params.FileSystemLocations = expandCodeBuildProjectFileSystemLocations(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("badge_enabled"); ok {
 		params.BadgeEnabled = aws.Bool(v.(bool))
 	}
@@ -987,6 +1071,82 @@ func expandCodeBuildVpcConfig(rawVpcConfig []interface{}) *codebuild.VpcConfig {
 	return &vpcConfig
 }
 
+func expandCodeBuildBuildBatchConfig(l []interface{}) *codebuild.ProjectBuildBatchConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &codebuild.ProjectBuildBatchConfig{
+		ServiceRole: aws.String(m["service_role"].(string)),
+	}
+
+	if v, ok := m["combine_artifacts"].(bool); ok {
+		config.CombineArtifacts = aws.Bool(v)
+	}
+
+	if v, ok := m["restrictions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.Restrictions = expandCodeBuildBatchRestrictions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["timeout_in_mins"].(int); ok && v != 0 {
+		config.TimeoutInMins = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+func expandCodeBuildBatchRestrictions(m map[string]interface{}) *codebuild.BatchRestrictions {
+	restrictions := &codebuild.BatchRestrictions{}
+
+	if v, ok := m["compute_types_allowed"].([]interface{}); ok && len(v) > 0 {
+		restrictions.ComputeTypesAllowed = expandStringList(v)
+	}
+
+	if v, ok := m["maximum_builds_allowed"].(int); ok && v != 0 {
+		restrictions.MaximumBuildsAllowed = aws.Int64(int64(v))
+	}
+
+	return restrictions
+}
+
+func expandCodeBuildProjectFileSystemLocations(l []interface{}) []*codebuild.ProjectFileSystemLocation {
+	fileSystemLocations := make([]*codebuild.ProjectFileSystemLocation, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		fileSystemLocation := &codebuild.ProjectFileSystemLocation{
+			Type: aws.String(m["type"].(string)),
+		}
+
+		if v, ok := m["identifier"].(string); ok && v != "" {
+			fileSystemLocation.Identifier = aws.String(v)
+		}
+
+		if v, ok := m["location"].(string); ok && v != "" {
+			fileSystemLocation.Location = aws.String(v)
+		}
+
+		if v, ok := m["mount_options"].(string); ok && v != "" {
+			fileSystemLocation.MountOptions = aws.String(v)
+		}
+
+		if v, ok := m["mount_point"].(string); ok && v != "" {
+			fileSystemLocation.MountPoint = aws.String(v)
+		}
+
+		fileSystemLocations = append(fileSystemLocations, fileSystemLocation)
+	}
+
+	return fileSystemLocations
+}
+
 func expandProjectSecondarySources(d *schema.ResourceData) []*codebuild.ProjectSource {
 	configs := d.Get("secondary_sources").(*schema.Set).List()
 
@@ -1095,6 +1255,14 @@ func resourceAwsCodeBuildProjectRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("error setting environment: %s", err)
 	}
 
+	if err := d.Set("build_batch_config", flattenAwsCodeBuildBuildBatchConfig(project.BuildBatchConfig)); err != nil {
+		return fmt.Errorf("error setting build_batch_config: %s", err)
+	}
+
+	if err := d.Set("file_system_locations", flattenAwsCodeBuildProjectFileSystemLocations(project.FileSystemLocations)); err != nil {
+		return fmt.Errorf("error setting file_system_locations: %s", err)
+	}
+
 	if err := d.Set("cache", flattenAwsCodebuildProjectCache(project.Cache)); err != nil {
 		return fmt.Errorf("error setting cache: %s", err)
 	}
@@ -1183,6 +1351,19 @@ func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{})
 		params.LogsConfig = logsConfig
 	}
 
+	if d.HasChange("build_batch_config") {
+		if v, ok := d.GetOk("build_batch_config"); ok {
+			params.BuildBatchConfig = expandCodeBuildBuildBatchConfig(v.([]interface{}))
+		} else {
+			// An empty configuration disables batch builds
+			params.BuildBatchConfig = &codebuild.ProjectBuildBatchConfig{}
+		}
+	}
+
+	if d.HasChange("file_system_locations") {
+		params.FileSystemLocations = expandCodeBuildProjectFileSystemLocations(d.Get("file_system_locations").(*schema.Set).List())
+	}
+
 	if d.HasChange("cache") {
 		if v, ok := d.GetOk("cache"); ok {
 			params.Cache = expandProjectCache(v.([]interface{}))
@@ -1364,6 +1545,49 @@ func flattenAwsCodeBuildProjectArtifactsData(artifacts codebuild.ProjectArtifact
 	return values
 }
 
+func flattenAwsCodeBuildBuildBatchConfig(config *codebuild.ProjectBuildBatchConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	values := map[string]interface{}{
+		"combine_artifacts": aws.BoolValue(config.CombineArtifacts),
+		"service_role":      aws.StringValue(config.ServiceRole),
+		"timeout_in_mins":   int(aws.Int64Value(config.TimeoutInMins)),
+	}
+
+	if config.Restrictions != nil {
+		values["restrictions"] = []interface{}{
+			map[string]interface{}{
+				"compute_types_allowed":  aws.StringValueSlice(config.Restrictions.ComputeTypesAllowed),
+				"maximum_builds_allowed": int(aws.Int64Value(config.Restrictions.MaximumBuildsAllowed)),
+			},
+		}
+	}
+
+	return []interface{}{values}
+}
+
+func flattenAwsCodeBuildProjectFileSystemLocations(fileSystemLocations []*codebuild.ProjectFileSystemLocation) []interface{} {
+	l := make([]interface{}, 0, len(fileSystemLocations))
+
+	for _, fileSystemLocation := range fileSystemLocations {
+		if fileSystemLocation == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"identifier":    aws.StringValue(fileSystemLocation.Identifier),
+			"location":      aws.StringValue(fileSystemLocation.Location),
+			"mount_options": aws.StringValue(fileSystemLocation.MountOptions),
+			"mount_point":   aws.StringValue(fileSystemLocation.MountPoint),
+			"type":          aws.StringValue(fileSystemLocation.Type),
+		})
+	}
+
+	return l
+}
+
 func flattenAwsCodebuildProjectCache(cache *codebuild.ProjectCache) []interface{} {
 	if cache == nil {
 		return []interface{}{}
@@ -29,6 +29,11 @@ func resourceAwsCodeBuildWebhook() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"build_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(codebuild.WebhookBuildType_Values(), false),
+			},
 			"branch_filter": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -98,6 +103,10 @@ func resourceAwsCodeBuildWebhookCreate(d *schema.ResourceData, meta interface{})
 		FilterGroups: expandWebhookFilterGroups(d),
 	}
 
+	if v, ok := d.GetOk("build_type"); ok {
+		input.BuildType = aws.String(v.(string))
+	}
+
 	// The CodeBuild API requires this to be non-empty if defined
 	if v, ok := d.GetOk("branch_filter"); ok {
 		input.BranchFilter = aws.String(v.(string))
@@ -181,6 +190,7 @@ func resourceAwsCodeBuildWebhookRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.Set("branch_filter", project.Webhook.BranchFilter)
+	d.Set("build_type", project.Webhook.BuildType)
 	d.Set("filter_group", flattenAwsCodeBuildWebhookFilterGroups(project.Webhook.FilterGroups))
 	d.Set("payload_url", project.Webhook.PayloadUrl)
 	d.Set("project_name", project.Name)
@@ -193,23 +203,25 @@ func resourceAwsCodeBuildWebhookRead(d *schema.ResourceData, meta interface{}) e
 func resourceAwsCodeBuildWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).codebuildconn
 
-	var err error
+	input := &codebuild.UpdateWebhookInput{
+		ProjectName:  aws.String(d.Id()),
+		RotateSecret: aws.Bool(false),
+	}
+
+	if v, ok := d.GetOk("build_type"); ok {
+		input.BuildType = aws.String(v.(string))
+	}
+
 	filterGroups := expandWebhookFilterGroups(d)
 
 	if len(filterGroups) >= 1 {
-		_, err = conn.UpdateWebhook(&codebuild.UpdateWebhookInput{
-			ProjectName:  aws.String(d.Id()),
-			FilterGroups: filterGroups,
-			RotateSecret: aws.Bool(false),
-		})
+		input.FilterGroups = filterGroups
 	} else {
-		_, err = conn.UpdateWebhook(&codebuild.UpdateWebhookInput{
-			ProjectName:  aws.String(d.Id()),
-			BranchFilter: aws.String(d.Get("branch_filter").(string)),
-			RotateSecret: aws.Bool(false),
-		})
+		input.BranchFilter = aws.String(d.Get("branch_filter").(string))
 	}
 
+	_, err := conn.UpdateWebhook(input)
+
 	if err != nil {
 		return err
 	}
@@ -75,6 +75,40 @@ func TestAccAWSCodeBuildWebhook_GitHub(t *testing.T) {
 	})
 }
 
+func TestAccAWSCodeBuildWebhook_BuildType(t *testing.T) {
+	var webhook codebuild.Webhook
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_codebuild_webhook.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCodeBuild(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildWebhookConfig_BuildType(rName, codebuild.WebhookBuildTypeBuildBatch),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildWebhookExists(resourceName, &webhook),
+					resource.TestCheckResourceAttr(resourceName, "build_type", codebuild.WebhookBuildTypeBuildBatch),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+			{
+				Config: testAccAWSCodeBuildWebhookConfig_BuildType(rName, codebuild.WebhookBuildTypeBuild),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildWebhookExists(resourceName, &webhook),
+					resource.TestCheckResourceAttr(resourceName, "build_type", codebuild.WebhookBuildTypeBuild),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSCodeBuildWebhook_GitHubEnterprise(t *testing.T) {
 	var webhook codebuild.Webhook
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -298,6 +332,15 @@ resource "aws_codebuild_webhook" "test" {
 `)
 }
 
+func testAccAWSCodeBuildWebhookConfig_BuildType(rName, buildType string) string {
+	return testAccAWSCodeBuildProjectConfig_BuildBatchConfig(rName, true, "BUILD_GENERAL1_SMALL", 10, 5) + fmt.Sprintf(`
+resource "aws_codebuild_webhook" "test" {
+  build_type   = %[1]q
+  project_name = aws_codebuild_project.test.name
+}
+`, buildType)
+}
+
 func testAccAWSCodeBuildWebhookConfig_GitHubEnterprise(rName string, branchFilter string) string {
 	return testAccAWSCodeBuildProjectConfig_Base_ServiceRole(rName) + fmt.Sprintf(`
 resource "aws_codebuild_project" "test" {
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -18,11 +19,39 @@ import (
 )
 
 const (
-	CodePipelineProviderGitHub = "GitHub"
+	CodePipelineProviderCodeBuild                = "CodeBuild"
+	CodePipelineProviderCodeCommit               = "CodeCommit"
+	CodePipelineProviderCodeStarSourceConnection = "CodeStarSourceConnection"
+	CodePipelineProviderECR                      = "ECR"
+	CodePipelineProviderGitHub                   = "GitHub"
+	CodePipelineProviderS3                       = "S3"
 
 	CodePipelineGitHubActionConfigurationOAuthToken = "OAuthToken"
 )
 
+// codePipelineActionRequiredConfigurationKeys lists the configuration keys the
+// CodePipeline API requires for well-known action types, keyed by category/owner/provider.
+var codePipelineActionRequiredConfigurationKeys = map[string][]string{
+	codePipelineActionTypeKey(codepipeline.ActionCategoryBuild, codepipeline.ActionOwnerAws, CodePipelineProviderCodeBuild):                 {"ProjectName"},
+	codePipelineActionTypeKey(codepipeline.ActionCategorySource, codepipeline.ActionOwnerAws, CodePipelineProviderCodeCommit):               {"BranchName", "RepositoryName"},
+	codePipelineActionTypeKey(codepipeline.ActionCategorySource, codepipeline.ActionOwnerAws, CodePipelineProviderCodeStarSourceConnection): {"BranchName", "ConnectionArn", "FullRepositoryId"},
+	codePipelineActionTypeKey(codepipeline.ActionCategorySource, codepipeline.ActionOwnerAws, CodePipelineProviderECR):                      {"RepositoryName"},
+	codePipelineActionTypeKey(codepipeline.ActionCategorySource, codepipeline.ActionOwnerAws, CodePipelineProviderS3):                       {"S3Bucket", "S3ObjectKey"},
+	codePipelineActionTypeKey(codepipeline.ActionCategorySource, codepipeline.ActionOwnerThirdParty, CodePipelineProviderGitHub):            {"Branch", "Owner", "Repo"},
+}
+
+// codePipelineActionConfigurationDefaults lists configuration values the CodePipeline API
+// echoes back for well-known action types even when they are not configured.
+var codePipelineActionConfigurationDefaults = map[string]map[string]string{
+	codePipelineActionTypeKey(codepipeline.ActionCategorySource, codepipeline.ActionOwnerAws, CodePipelineProviderCodeStarSourceConnection): {
+		"DetectChanges":        "true",
+		"OutputArtifactFormat": "CODE_ZIP",
+	},
+	codePipelineActionTypeKey(codepipeline.ActionCategorySource, codepipeline.ActionOwnerAws, CodePipelineProviderECR): {
+		"ImageTag": "latest",
+	},
+}
+
 func resourceAwsCodePipeline() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsCodePipelineCreate,
@@ -183,9 +212,45 @@ func resourceAwsCodePipeline() *schema.Resource {
 			},
 			"tags": tagsSchema(),
 		},
+
+		CustomizeDiff: resourceAwsCodePipelineCustomizeDiff,
 	}
 }
 
+func resourceAwsCodePipelineCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for si, stage := range diff.Get("stage").([]interface{}) {
+		stageData, ok := stage.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for ai, action := range stageData["action"].([]interface{}) {
+			actionData, ok := action.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			// Values interpolated from resources that don't exist yet can't be validated.
+			if !diff.NewValueKnown(fmt.Sprintf("stage.%d.action.%d.configuration", si, ai)) {
+				continue
+			}
+
+			category := actionData["category"].(string)
+			owner := actionData["owner"].(string)
+			provider := actionData["provider"].(string)
+			config := actionData["configuration"].(map[string]interface{})
+
+			if err := validateAwsCodePipelineActionConfiguration(category, owner, provider, config); err != nil {
+				return fmt.Errorf("stage %q action %q: %w", stageData["name"].(string), actionData["name"].(string), err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsCodePipelineCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).codepipelineconn
 
@@ -420,6 +485,14 @@ func flattenAwsCodePipelineStageActions(si int, actions []*codepipeline.ActionDe
 		if action.Configuration != nil {
 			config := flattenAwsCodePipelineStageActionConfiguration(action.Configuration)
 
+			var configured map[string]interface{}
+			if v, ok := d.Get(fmt.Sprintf("stage.%d.action.%d.configuration", si, ai)).(map[string]interface{}); ok {
+				configured = v
+			}
+
+			actionTypeKey := codePipelineActionTypeKey(aws.StringValue(action.ActionTypeId.Category), aws.StringValue(action.ActionTypeId.Owner), aws.StringValue(action.ActionTypeId.Provider))
+			config = flattenAwsCodePipelineStageActionConfigurationWithDefaults(actionTypeKey, config, configured)
+
 			actionProvider := aws.StringValue(action.ActionTypeId.Provider)
 			if actionProvider == CodePipelineProviderGitHub {
 				if _, ok := config[CodePipelineGitHubActionConfigurationOAuthToken]; ok {
@@ -620,6 +693,69 @@ func resourceAwsCodePipelineDelete(d *schema.ResourceData, meta interface{}) err
 	return err
 }
 
+func codePipelineActionTypeKey(category, owner, provider string) string {
+	return category + "/" + owner + "/" + provider
+}
+
+func validateAwsCodePipelineActionConfiguration(category, owner, provider string, config map[string]interface{}) error {
+	requiredKeys, ok := codePipelineActionRequiredConfigurationKeys[codePipelineActionTypeKey(category, owner, provider)]
+
+	if !ok {
+		return nil
+	}
+
+	for _, requiredKey := range requiredKeys {
+		if _, ok := config[requiredKey]; ok {
+			continue
+		}
+
+		for k := range config {
+			if strings.EqualFold(k, requiredKey) {
+				return fmt.Errorf("configuration key %q is invalid for %s %s action, did you mean %q?", k, provider, category, requiredKey)
+			}
+		}
+
+		return fmt.Errorf("configuration key %q is required for %s %s action", requiredKey, provider, category)
+	}
+
+	if provider == CodePipelineProviderCodeStarSourceConnection {
+		if v, ok := config["OutputArtifactFormat"]; ok {
+			switch v.(string) {
+			case "CODE_ZIP", "CODEBUILD_CLONE_REF":
+			default:
+				return fmt.Errorf("configuration key %q must be one of CODE_ZIP or CODEBUILD_CLONE_REF, got %q", "OutputArtifactFormat", v.(string))
+			}
+		}
+	}
+
+	return nil
+}
+
+// flattenAwsCodePipelineStageActionConfigurationWithDefaults removes values echoed back by
+// the API for keys that are not configured and still hold the service default, and keeps the
+// configured form of values the API returns with different casing (e.g. "True" vs "true").
+func flattenAwsCodePipelineStageActionConfigurationWithDefaults(actionTypeKey string, apiConfig map[string]string, configured map[string]interface{}) map[string]string {
+	defaults := codePipelineActionConfigurationDefaults[actionTypeKey]
+
+	for k, v := range apiConfig {
+		configuredValue, ok := configured[k]
+
+		if !ok {
+			if defaultValue, ok := defaults[k]; ok && strings.EqualFold(v, defaultValue) {
+				delete(apiConfig, k)
+			}
+
+			continue
+		}
+
+		if s, ok := configuredValue.(string); ok && s != v && strings.EqualFold(s, v) {
+			apiConfig[k] = s
+		}
+	}
+
+	return apiConfig
+}
+
 func suppressCodePipelineStageActionConfiguration(k, old, new string, d *schema.ResourceData) bool {
 	parts := strings.Split(k, ".")
 	parts = parts[:len(parts)-2]
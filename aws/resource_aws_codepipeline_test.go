@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -493,6 +494,83 @@ func TestAccAWSCodePipeline_WithNamespace(t *testing.T) {
 	})
 }
 
+func TestAccAWSCodePipeline_WithCodeStarConnection(t *testing.T) {
+	var p1 codepipeline.PipelineDeclaration
+	name := acctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+	codestarConnectionResourceName := "aws_codestarconnections_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCodePipeline(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodePipelineConfigWithCodeStarConnection(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodePipelineExists(resourceName, &p1),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.action.0.provider", "CodeStarSourceConnection"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.action.0.configuration.%", "4"),
+					resource.TestCheckResourceAttrPair(resourceName, "stage.0.action.0.configuration.ConnectionArn", codestarConnectionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.action.0.configuration.FullRepositoryId", "lifesum-terraform/test"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.action.0.configuration.BranchName", "main"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.action.0.configuration.OutputArtifactFormat", "CODEBUILD_CLONE_REF"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCodePipeline_WithEcrSource(t *testing.T) {
+	var p1 codepipeline.PipelineDeclaration
+	name := acctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+	ecrRepositoryResourceName := "aws_ecr_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCodePipeline(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodePipelineConfigWithEcrSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodePipelineExists(resourceName, &p1),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.action.0.provider", "ECR"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.action.0.configuration.%", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "stage.0.action.0.configuration.RepositoryName", ecrRepositoryResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCodePipeline_ActionConfigurationValidation(t *testing.T) {
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCodePipeline(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCodePipelineConfigWithEcrSourceInvalidKey(name),
+				ExpectError: regexp.MustCompile(`configuration key "Repositoryname" is invalid for ECR Source action, did you mean "RepositoryName"\?`),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSCodePipelineExists(n string, pipeline *codepipeline.PipelineDeclaration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1338,6 +1416,176 @@ resource "aws_s3_bucket" "foo" {
 `, rName, githubToken))
 }
 
+func testAccAWSCodePipelineConfigWithCodeStarConnection(rName string) string {
+	return composeConfig(
+		testAccAWSCodePipelineS3DefaultBucket(rName),
+		testAccAWSCodePipelineServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+
+resource "aws_codepipeline" "test" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = aws_iam_role.codepipeline_role.arn
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn        = aws_codestarconnections_connection.test.arn
+        FullRepositoryId     = "lifesum-terraform/test"
+        BranchName           = "main"
+        OutputArtifactFormat = "CODEBUILD_CLONE_REF"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSCodePipelineConfigWithEcrSourceBase(rName string) string {
+	return composeConfig(
+		testAccAWSCodePipelineS3DefaultBucket(rName),
+		testAccAWSCodePipelineServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = "tf-acc-test-%[1]s"
+}
+`, rName))
+}
+
+func testAccAWSCodePipelineConfigWithEcrSource(rName string) string {
+	return composeConfig(
+		testAccAWSCodePipelineConfigWithEcrSourceBase(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = aws_iam_role.codepipeline_role.arn
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "ECR"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        RepositoryName = aws_ecr_repository.test.name
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSCodePipelineConfigWithEcrSourceInvalidKey(rName string) string {
+	return composeConfig(
+		testAccAWSCodePipelineConfigWithEcrSourceBase(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = aws_iam_role.codepipeline_role.arn
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "ECR"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        Repositoryname = "tf-acc-test-%[1]s"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func TestResourceAWSCodePipelineExpandArtifactStoresValidation(t *testing.T) {
 	cases := []struct {
 		Name          string
@@ -1445,16 +1693,135 @@ func TestResourceAWSCodePipelineExpandArtifactStoresValidation(t *testing.T) {
 		_, err := expandAwsCodePipelineArtifactStores(tc.Input)
 		if tc.ExpectedError == "" {
 			if err != nil {
-				t.Errorf("%s: Did not expect an error, but got: %w", tc.Name, err)
+				t.Errorf("%s: Did not expect an error, but got: %s", tc.Name, err)
 			}
 		} else {
 			if err == nil {
 				t.Errorf("%s: Expected an error, but did not get one", tc.Name)
 			} else {
 				if err.Error() != tc.ExpectedError {
-					t.Errorf("%s: Expected error %q, got %w", tc.Name, tc.ExpectedError, err)
+					t.Errorf("%s: Expected error %q, got %s", tc.Name, tc.ExpectedError, err)
 				}
 			}
 		}
 	}
 }
+
+func TestValidateAwsCodePipelineActionConfiguration(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Category      string
+		Owner         string
+		Provider      string
+		Config        map[string]interface{}
+		ExpectedError string
+	}{
+		{
+			Name:     "CodeStar Connections",
+			Category: codepipeline.ActionCategorySource,
+			Owner:    codepipeline.ActionOwnerAws,
+			Provider: CodePipelineProviderCodeStarSourceConnection,
+			Config: map[string]interface{}{
+				"BranchName":           "main",
+				"ConnectionArn":        "arn:aws:codestar-connections:us-west-2:123456789012:connection/test", //lintignore:AWSAT003,AWSAT005
+				"FullRepositoryId":     "owner/repo",
+				"OutputArtifactFormat": "CODEBUILD_CLONE_REF",
+			},
+		},
+		{
+			Name:     "CodeStar Connections missing key",
+			Category: codepipeline.ActionCategorySource,
+			Owner:    codepipeline.ActionOwnerAws,
+			Provider: CodePipelineProviderCodeStarSourceConnection,
+			Config: map[string]interface{}{
+				"BranchName":    "main",
+				"ConnectionArn": "arn:aws:codestar-connections:us-west-2:123456789012:connection/test", //lintignore:AWSAT003,AWSAT005
+			},
+			ExpectedError: `configuration key "FullRepositoryId" is required for CodeStarSourceConnection Source action`,
+		},
+		{
+			Name:     "CodeStar Connections invalid output format",
+			Category: codepipeline.ActionCategorySource,
+			Owner:    codepipeline.ActionOwnerAws,
+			Provider: CodePipelineProviderCodeStarSourceConnection,
+			Config: map[string]interface{}{
+				"BranchName":           "main",
+				"ConnectionArn":        "arn:aws:codestar-connections:us-west-2:123456789012:connection/test", //lintignore:AWSAT003,AWSAT005
+				"FullRepositoryId":     "owner/repo",
+				"OutputArtifactFormat": "ZIP",
+			},
+			ExpectedError: `configuration key "OutputArtifactFormat" must be one of CODE_ZIP or CODEBUILD_CLONE_REF, got "ZIP"`,
+		},
+		{
+			Name:     "ECR",
+			Category: codepipeline.ActionCategorySource,
+			Owner:    codepipeline.ActionOwnerAws,
+			Provider: CodePipelineProviderECR,
+			Config: map[string]interface{}{
+				"RepositoryName": "test",
+				"ImageTag":       "v1",
+			},
+		},
+		{
+			Name:     "ECR misspelled key",
+			Category: codepipeline.ActionCategorySource,
+			Owner:    codepipeline.ActionOwnerAws,
+			Provider: CodePipelineProviderECR,
+			Config: map[string]interface{}{
+				"repositoryName": "test",
+			},
+			ExpectedError: `configuration key "repositoryName" is invalid for ECR Source action, did you mean "RepositoryName"?`,
+		},
+		{
+			Name:     "Unknown provider",
+			Category: codepipeline.ActionCategoryDeploy,
+			Owner:    codepipeline.ActionOwnerCustom,
+			Provider: "Custom",
+			Config:   map[string]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateAwsCodePipelineActionConfiguration(tc.Category, tc.Owner, tc.Provider, tc.Config)
+
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Errorf("%s: Did not expect an error, but got: %s", tc.Name, err)
+			}
+		} else {
+			if err == nil {
+				t.Errorf("%s: Expected an error, but did not get one", tc.Name)
+			} else if err.Error() != tc.ExpectedError {
+				t.Errorf("%s: Expected error %q, got %s", tc.Name, tc.ExpectedError, err)
+			}
+		}
+	}
+}
+
+func TestFlattenAwsCodePipelineStageActionConfigurationWithDefaults(t *testing.T) {
+	actionTypeKey := codePipelineActionTypeKey(codepipeline.ActionCategorySource, codepipeline.ActionOwnerAws, CodePipelineProviderCodeStarSourceConnection)
+
+	apiConfig := map[string]string{
+		"BranchName":           "main",
+		"DetectChanges":        "True",
+		"FullRepositoryId":     "owner/repo",
+		"OutputArtifactFormat": "CODE_ZIP",
+	}
+	configured := map[string]interface{}{
+		"BranchName":       "main",
+		"DetectChanges":    "true",
+		"FullRepositoryId": "owner/repo",
+	}
+
+	result := flattenAwsCodePipelineStageActionConfigurationWithDefaults(actionTypeKey, apiConfig, configured)
+
+	expected := map[string]string{
+		"BranchName":       "main",
+		"DetectChanges":    "true",
+		"FullRepositoryId": "owner/repo",
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
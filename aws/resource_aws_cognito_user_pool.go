@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -197,11 +198,54 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validateArn,
 						},
+						"custom_email_sender": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"lambda_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"lambda_version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cognitoidentityprovider.CustomEmailSenderLambdaVersionType_Values(), false),
+									},
+								},
+							},
+						},
+						"custom_sms_sender": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"lambda_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"lambda_version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cognitoidentityprovider.CustomSMSSenderLambdaVersionType_Values(), false),
+									},
+								},
+							},
+						},
 						"define_auth_challenge": {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ValidateFunc: validateArn,
 						},
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
 						"post_authentication": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -553,8 +597,9 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 										ValidateFunc: validation.StringInSlice(cognitoidentityprovider.RecoveryOptionNameType_Values(), false),
 									},
 									"priority": {
-										Type:     schema.TypeInt,
-										Required: true,
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 2),
 									},
 								},
 							},
@@ -563,6 +608,31 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			// Cognito encrypts the codes passed to custom sender triggers and rejects the configuration without a KMS key.
+			v, ok := diff.GetOk("lambda_config")
+
+			if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+				return nil
+			}
+
+			config := v.([]interface{})[0].(map[string]interface{})
+
+			if len(config["custom_email_sender"].([]interface{})) == 0 && len(config["custom_sms_sender"].([]interface{})) == 0 {
+				return nil
+			}
+
+			if !diff.NewValueKnown("lambda_config.0.kms_key_id") {
+				return nil
+			}
+
+			if config["kms_key_id"].(string) == "" {
+				return fmt.Errorf("lambda_config.0.kms_key_id must be set when lambda_config.0.custom_email_sender or lambda_config.0.custom_sms_sender is configured")
+			}
+
+			return nil
+		},
 	}
 }
 
@@ -757,7 +827,7 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		resp, err = conn.CreateUserPool(params)
 	}
 	if err != nil {
-		return fmt.Errorf("error creating Cognito User Pool: %w", err)
+		return fmt.Errorf("error creating Cognito User Pool: %w", cognitoUserPoolLambdaConfigError(params.LambdaConfig, err))
 	}
 
 	d.SetId(aws.StringValue(resp.UserPool.Id))
@@ -1190,7 +1260,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 			_, err = conn.UpdateUserPool(params)
 		}
 		if err != nil {
-			return fmt.Errorf("Error updating Cognito User pool: %s", err)
+			return fmt.Errorf("Error updating Cognito User pool: %w", cognitoUserPoolLambdaConfigError(params.LambdaConfig, err))
 		}
 	}
 
@@ -1283,6 +1353,20 @@ func flattenCognitoSoftwareTokenMfaConfiguration(apiObject *cognitoidentityprovi
 	return []interface{}{tfMap}
 }
 
+// cognitoUserPoolLambdaConfigError adds a hint about the permissions Cognito needs
+// when the API rejects a custom sender trigger configuration.
+func cognitoUserPoolLambdaConfigError(config *cognitoidentityprovider.LambdaConfigType, err error) error {
+	if config == nil || (config.CustomEmailSender == nil && config.CustomSMSSender == nil) {
+		return err
+	}
+
+	if !isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidParameterException, "") && !isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidLambdaResponseException, "") {
+		return err
+	}
+
+	return fmt.Errorf("%w (custom sender triggers require an aws_lambda_permission allowing lambda:InvokeFunction by the cognito-idp.amazonaws.com principal, and a KMS key policy allowing Cognito to use the key)", err)
+}
+
 func expandCognitoUserPoolAccountRecoverySettingConfig(config map[string]interface{}) *cognitoidentityprovider.AccountRecoverySettingType {
 	configs := &cognitoidentityprovider.AccountRecoverySettingType{}
 
@@ -145,6 +145,64 @@ func TestAccAWSCognitoUserPool_recovery(t *testing.T) {
 	})
 }
 
+func TestAccAWSCognitoUserPool_recoveryAndAdvancedSecurityMode(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfigAccountRecoveryAndAdvancedSecurityMode(rName, "AUDIT", "verified_email"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_pool_add_ons.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "user_pool_add_ons.0.advanced_security_mode", "AUDIT"),
+					resource.TestCheckResourceAttr(resourceName, "account_recovery_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_recovery_setting.0.recovery_mechanism.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "account_recovery_setting.0.recovery_mechanism.*", map[string]string{
+						"name":     "verified_email",
+						"priority": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Changing only the add-ons must not reset the account recovery setting.
+				Config: testAccAWSCognitoUserPoolConfigAccountRecoveryAndAdvancedSecurityMode(rName, "ENFORCED", "verified_email"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_pool_add_ons.0.advanced_security_mode", "ENFORCED"),
+					resource.TestCheckResourceAttr(resourceName, "account_recovery_setting.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "account_recovery_setting.0.recovery_mechanism.*", map[string]string{
+						"name":     "verified_email",
+						"priority": "1",
+					}),
+				),
+			},
+			{
+				// Changing only the account recovery setting must not reset the add-ons.
+				Config: testAccAWSCognitoUserPoolConfigAccountRecoveryAndAdvancedSecurityMode(rName, "ENFORCED", "admin_only"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_pool_add_ons.0.advanced_security_mode", "ENFORCED"),
+					resource.TestCheckResourceAttr(resourceName, "account_recovery_setting.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "account_recovery_setting.0.recovery_mechanism.*", map[string]string{
+						"name":     "admin_only",
+						"priority": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSCognitoUserPool_withAdminCreateUserConfiguration(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_cognito_user_pool.test"
@@ -968,6 +1026,44 @@ func TestAccAWSCognitoUserPool_withLambdaConfig(t *testing.T) {
 	})
 }
 
+func TestAccAWSCognitoUserPool_withLambdaConfigCustomSenders(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool.test"
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCognitoUserPoolConfig_withLambdaConfigCustomSendersNoKmsKey(rName),
+				ExpectError: regexp.MustCompile(`kms_key_id must be set`),
+			},
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withLambdaConfigCustomSenders(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lambda_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_config.0.custom_email_sender.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lambda_config.0.custom_email_sender.0.lambda_arn", lambdaFunctionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_config.0.custom_email_sender.0.lambda_version", "V1_0"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_config.0.custom_sms_sender.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lambda_config.0.custom_sms_sender.0.lambda_arn", lambdaFunctionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_config.0.custom_sms_sender.0.lambda_version", "V1_0"),
+					resource.TestCheckResourceAttrPair(resourceName, "lambda_config.0.kms_key_id", kmsKeyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSCognitoUserPool_withSchemaAttributes(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_cognito_user_pool.test"
@@ -1383,6 +1479,25 @@ resource "aws_cognito_user_pool" "test" {
 `, rName)
 }
 
+func testAccAWSCognitoUserPoolConfigAccountRecoveryAndAdvancedSecurityMode(rName, advancedSecurityMode, recoveryMechanism string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  account_recovery_setting {
+    recovery_mechanism {
+      name     = %[3]q
+      priority = 1
+    }
+  }
+
+  user_pool_add_ons {
+    advanced_security_mode = %[2]q
+  }
+}
+`, rName, advancedSecurityMode, recoveryMechanism)
+}
+
 func testAccAWSCognitoUserPoolConfig_withAdminCreateUserConfiguration(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_user_pool" "test" {
@@ -1758,6 +1873,94 @@ resource "aws_cognito_user_pool" "test" {
 `, name)
 }
 
+func testAccAWSCognitoUserPoolConfig_withLambdaConfigCustomSendersBase(name string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "cognito-idp.${data.aws_partition.current.dns_suffix}"
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+`, name)
+}
+
+func testAccAWSCognitoUserPoolConfig_withLambdaConfigCustomSenders(name string) string {
+	return composeConfig(
+		testAccAWSCognitoUserPoolConfig_withLambdaConfigCustomSendersBase(name),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  lambda_config {
+    kms_key_id = aws_kms_key.test.arn
+
+    custom_email_sender {
+      lambda_arn     = aws_lambda_function.test.arn
+      lambda_version = "V1_0"
+    }
+
+    custom_sms_sender {
+      lambda_arn     = aws_lambda_function.test.arn
+      lambda_version = "V1_0"
+    }
+  }
+
+  depends_on = [aws_lambda_permission.test]
+}
+`, name))
+}
+
+func testAccAWSCognitoUserPoolConfig_withLambdaConfigCustomSendersNoKmsKey(name string) string {
+	return composeConfig(
+		testAccAWSCognitoUserPoolConfig_withLambdaConfigCustomSendersBase(name),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  lambda_config {
+    custom_email_sender {
+      lambda_arn     = aws_lambda_function.test.arn
+      lambda_version = "V1_0"
+    }
+  }
+}
+`, name))
+}
+
 func testAccAWSCognitoUserPoolConfig_withLambdaConfigUpdated(name string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -0,0 +1,415 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccConfigConformancePack_basic(t *testing.T) {
+	var pack configservice.ConformancePackDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConformancePackConfigRuleIdentifier(rName, "IAM_PASSWORD_POLICY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName, &pack),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "config", regexp.MustCompile(fmt.Sprintf("conformance-pack/%s/.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_bucket", ""),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_key_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "input_parameter.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+		},
+	})
+}
+
+func testAccConfigConformancePack_disappears(t *testing.T) {
+	var pack configservice.ConformancePackDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConformancePackConfigRuleIdentifier(rName, "IAM_PASSWORD_POLICY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName, &pack),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsConfigConformancePack(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConfigConformancePack_updateTemplateBody(t *testing.T) {
+	var before, after configservice.ConformancePackDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConformancePackConfigRuleIdentifier(rName, "IAM_PASSWORD_POLICY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName, &before),
+				),
+			},
+			{
+				Config: testAccConfigConformancePackConfigRuleIdentifier(rName, "S3_BUCKET_PUBLIC_READ_PROHIBITED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName, &after),
+					testAccCheckConfigConformancePackNotRecreated(&before, &after),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+		},
+	})
+}
+
+func testAccConfigConformancePack_inputParameters(t *testing.T) {
+	var pack configservice.ConformancePackDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConformancePackConfigInputParameter(rName, "TestKey", "TestValue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "input_parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "input_parameter.*", map[string]string{
+						"parameter_name":  "TestKey",
+						"parameter_value": "TestValue",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+		},
+	})
+}
+
+func testAccConfigConformancePack_S3Delivery(t *testing.T) {
+	var pack configservice.ConformancePackDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_conformance_pack.test"
+	bucketName := acctest.RandomWithPrefix("awsconfigconforms")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConformancePackConfigS3Delivery(rName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_key_prefix", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_body"},
+			},
+		},
+	})
+}
+
+func testAccConfigConformancePack_S3Template(t *testing.T) {
+	var pack configservice.ConformancePackDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConformancePackConfigS3Template(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_s3_uri"},
+			},
+		},
+	})
+}
+
+func testAccConfigConformancePack_failedTemplate(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigConformancePackConfigRuleIdentifier(rName, "NOT_A_REAL_RULE_IDENTIFIER"),
+				ExpectError: regexp.MustCompile(`CREATE_FAILED`),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigConformancePackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).configconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_config_conformance_pack" {
+			continue
+		}
+
+		pack, err := configDescribeConformancePack(conn, rs.Primary.ID)
+
+		if isAWSErr(err, configservice.ErrCodeNoSuchConformancePackException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if pack != nil {
+			return fmt.Errorf("Config Conformance Pack (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckConfigConformancePackExists(resourceName string, pack *configservice.ConformancePackDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+
+		output, err := configDescribeConformancePack(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Config Conformance Pack (%s) not found", rs.Primary.ID)
+		}
+
+		*pack = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConfigConformancePackNotRecreated(before, after *configservice.ConformancePackDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.ConformancePackId), aws.StringValue(after.ConformancePackId); before != after {
+			return fmt.Errorf("Config Conformance Pack (%s) recreated", after)
+		}
+
+		return nil
+	}
+}
+
+func testAccConfigConformancePackConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_config_configuration_recorder" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test]
+  name       = %[1]q
+  role_arn   = aws_iam_role.test.arn
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "config.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWS_ConfigRole"
+}
+`, rName)
+}
+
+func testAccConfigConformancePackConfigRuleIdentifier(rName, ruleIdentifier string) string {
+	return composeConfig(
+		testAccConfigConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test]
+  name       = %[1]q
+
+  template_body = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: %[2]s
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName, ruleIdentifier))
+}
+
+func testAccConfigConformancePackConfigInputParameter(rName, paramName, paramValue string) string {
+	return composeConfig(
+		testAccConfigConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test]
+  name       = %[1]q
+
+  input_parameter {
+    parameter_name  = %[2]q
+    parameter_value = %[3]q
+  }
+
+  template_body = <<EOT
+Parameters:
+  %[2]s:
+    Type: String
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName, paramName, paramValue))
+}
+
+func testAccConfigConformancePackConfigS3Delivery(rName, bucketName string) string {
+	return composeConfig(
+		testAccConfigConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[2]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_config_conformance_pack" "test" {
+  depends_on             = [aws_config_configuration_recorder.test]
+  name                   = %[1]q
+  delivery_s3_bucket     = aws_s3_bucket.test.id
+  delivery_s3_key_prefix = %[1]q
+
+  template_body = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName, bucketName))
+}
+
+func testAccConfigConformancePackConfigS3Template(rName string) string {
+	return composeConfig(
+		testAccConfigConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = %[1]q
+  content = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+
+resource "aws_config_conformance_pack" "test" {
+  depends_on      = [aws_config_configuration_recorder.test]
+  name            = %[1]q
+  template_s3_uri = "s3://${aws_s3_bucket.test.id}/${aws_s3_bucket_object.test.id}"
+}
+`, rName))
+}
@@ -27,6 +27,15 @@ func TestAccAWSConfig_serial(t *testing.T) {
 			"allParams":   testAccConfigConfigurationRecorder_allParams,
 			"importBasic": testAccConfigConfigurationRecorder_importBasic,
 		},
+		"ConformancePack": {
+			"basic":              testAccConfigConformancePack_basic,
+			"disappears":         testAccConfigConformancePack_disappears,
+			"failedTemplate":     testAccConfigConformancePack_failedTemplate,
+			"inputParameters":    testAccConfigConformancePack_inputParameters,
+			"S3Delivery":         testAccConfigConformancePack_S3Delivery,
+			"S3Template":         testAccConfigConformancePack_S3Template,
+			"updateTemplateBody": testAccConfigConformancePack_updateTemplateBody,
+		},
 		"DeliveryChannel": {
 			"basic":       testAccConfigDeliveryChannel_basic,
 			"allParams":   testAccConfigDeliveryChannel_allParams,
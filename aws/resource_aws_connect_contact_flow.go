@@ -0,0 +1,248 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+// connectContactFlowContentDisconnect is a contact flow that immediately disconnects
+// the contact. It replaces the content of contact flows that cannot be deleted.
+const connectContactFlowContentDisconnect = `{"Version":"2019-10-30","StartAction":"disconnect","Metadata":{},"Actions":[{"Identifier":"disconnect","Type":"DisconnectParticipant","Parameters":{},"Transitions":{}}]}`
+
+func resourceAwsConnectContactFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConnectContactFlowCreate,
+		Read:   resourceAwsConnectContactFlowRead,
+		Update: resourceAwsConnectContactFlowUpdate,
+		Delete: resourceAwsConnectContactFlowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_flow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      connect.ContactFlowTypeContactFlow,
+				ValidateFunc: validation.StringInSlice(connect.ContactFlowType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsConnectContactFlowCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect.CreateContactFlowInput{
+		Content:    aws.String(d.Get("content").(string)),
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+		Type:       aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().ConnectTags()
+	}
+
+	log.Printf("[DEBUG] Creating Connect Contact Flow: %s", input)
+	output, err := conn.CreateContactFlow(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Connect Contact Flow (%s): %w", name, err)
+	}
+
+	d.SetId(tfconnect.ContactFlowCreateID(instanceID, aws.StringValue(output.ContactFlowId)))
+
+	return resourceAwsConnectContactFlowRead(d, meta)
+}
+
+func resourceAwsConnectContactFlowRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	instanceID, contactFlowID, err := tfconnect.ContactFlowParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	contactFlow, err := finder.ContactFlowByTwoPartKey(conn, instanceID, contactFlowID)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Connect Contact Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Connect Contact Flow (%s): %w", d.Id(), err)
+	}
+
+	if contactFlow == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Connect Contact Flow (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Connect Contact Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", contactFlow.Arn)
+	d.Set("contact_flow_id", contactFlow.Id)
+	d.Set("content", contactFlow.Content)
+	d.Set("description", contactFlow.Description)
+	d.Set("instance_id", instanceID)
+	d.Set("name", contactFlow.Name)
+	d.Set("type", contactFlow.Type)
+
+	if err := d.Set("tags", keyvaluetags.ConnectKeyValueTags(contactFlow.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConnectContactFlowUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID, contactFlowID, err := tfconnect.ContactFlowParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("name", "description") {
+		input := &connect.UpdateContactFlowNameInput{
+			ContactFlowId: aws.String(contactFlowID),
+			Description:   aws.String(d.Get("description").(string)),
+			InstanceId:    aws.String(instanceID),
+			Name:          aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Contact Flow name: %s", input)
+		if _, err := conn.UpdateContactFlowName(input); err != nil {
+			return fmt.Errorf("error updating Connect Contact Flow (%s) name: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("content") {
+		input := &connect.UpdateContactFlowContentInput{
+			ContactFlowId: aws.String(contactFlowID),
+			Content:       aws.String(d.Get("content").(string)),
+			InstanceId:    aws.String(instanceID),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Contact Flow content: %s", input)
+		if _, err := conn.UpdateContactFlowContent(input); err != nil {
+			return fmt.Errorf("error updating Connect Contact Flow (%s) content: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.ConnectUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Connect Contact Flow (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsConnectContactFlowRead(d, meta)
+}
+
+func resourceAwsConnectContactFlowDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID, contactFlowID, err := tfconnect.ContactFlowParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Contact Flow: %s", d.Id())
+	_, err = conn.DeleteContactFlow(&connect.DeleteContactFlowInput{
+		ContactFlowId: aws.String(contactFlowID),
+		InstanceId:    aws.String(instanceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	// Some contact flow types cannot be deleted. Neutralize them by replacing
+	// their content with a flow that disconnects the contact, then only remove
+	// them from state so that the destroy can proceed.
+	if tfawserr.ErrMessageContains(err, connect.ErrCodeInvalidRequestException, tfconnect.ErrMessageContactFlowCannotBeDeleted) {
+		input := &connect.UpdateContactFlowContentInput{
+			ContactFlowId: aws.String(contactFlowID),
+			Content:       aws.String(connectContactFlowContentDisconnect),
+			InstanceId:    aws.String(instanceID),
+		}
+
+		log.Printf("[DEBUG] Replacing Connect Contact Flow content: %s", input)
+		if _, err := conn.UpdateContactFlowContent(input); err != nil {
+			return fmt.Errorf("error replacing Connect Contact Flow (%s) content: %w", d.Id(), err)
+		}
+
+		log.Printf("[WARN] Connect Contact Flow (%s) cannot be deleted, replaced its content with a disconnect flow and removed it from state", d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Connect Contact Flow (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func testAccAWSConnectContactFlow_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_contact_flow.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsConnectContactFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectContactFlowConfig(rName, "Created", "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsConnectContactFlowExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "connect", regexp.MustCompile(`instance/.+/contact-flow/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "contact_flow_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", connect.ContactFlowTypeContactFlow),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfig(rName, "Updated", "Goodbye"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsConnectContactFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`Goodbye`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSConnectContactFlow_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_contact_flow.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsConnectContactFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectContactFlowConfig(rName, "Created", "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsConnectContactFlowExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsConnectContactFlow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSConnectContactFlow_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_contact_flow.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsConnectContactFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectContactFlowConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsConnectContactFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsConnectContactFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsConnectContactFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsConnectContactFlowDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_contact_flow" {
+			continue
+		}
+
+		instanceID, contactFlowID, err := tfconnect.ContactFlowParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.ContactFlowByTwoPartKey(conn, instanceID, contactFlowID)
+
+		if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Connect Contact Flow (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsConnectContactFlowExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		instanceID, contactFlowID, err := tfconnect.ContactFlowParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+		output, err := finder.ContactFlowByTwoPartKey(conn, instanceID, contactFlowID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Connect Contact Flow (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSConnectContactFlowConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccAWSConnectContactFlowContent(message string) string {
+	return fmt.Sprintf(`
+  content = jsonencode({
+    Version     = "2019-10-30"
+    StartAction = "12345678-1234-1234-1234-123456789012"
+    Actions = [
+      {
+        Identifier = "12345678-1234-1234-1234-123456789012"
+        Type       = "MessageParticipant"
+        Parameters = {
+          Text = %[1]q
+        }
+        Transitions = {
+          NextAction = "abcdef-abcd-abcd-abcd-abcdefghijkl"
+          Errors     = []
+          Conditions = []
+        }
+      },
+      {
+        Identifier  = "abcdef-abcd-abcd-abcd-abcdefghijkl"
+        Type        = "DisconnectParticipant"
+        Parameters  = {}
+        Transitions = {}
+      }
+    ]
+  })
+`, message)
+}
+
+func testAccAWSConnectContactFlowConfig(rName, description, message string) string {
+	return composeConfig(
+		testAccAWSConnectContactFlowConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = %[2]q
+%[3]s
+}
+`, rName, description, testAccAWSConnectContactFlowContent(message)))
+}
+
+func testAccAWSConnectContactFlowConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSConnectContactFlowConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccAWSConnectContactFlowContent("Hello"), tagKey1, tagValue1))
+}
+
+func testAccAWSConnectContactFlowConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSConnectContactFlowConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccAWSConnectContactFlowContent("Hello"), tagKey1, tagValue1, tagKey2, tagValue2))
+}
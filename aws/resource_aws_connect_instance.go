@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/waiter"
+)
+
+// connectInstanceAttributes maps the instance attribute types managed through
+// UpdateInstanceAttribute to their schema keys.
+var connectInstanceAttributes = map[string]string{
+	connect.InstanceAttributeTypeContactLens:     "contact_lens_enabled",
+	connect.InstanceAttributeTypeContactflowLogs: "contact_flow_logs_enabled",
+	connect.InstanceAttributeTypeInboundCalls:    "inbound_calls_enabled",
+	connect.InstanceAttributeTypeOutboundCalls:   "outbound_calls_enabled",
+}
+
+func resourceAwsConnectInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConnectInstanceCreate,
+		Read:   resourceAwsConnectInstanceRead,
+		Update: resourceAwsConnectInstanceUpdate,
+		Delete: resourceAwsConnectInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_flow_logs_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"contact_lens_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(12, 12),
+				ConflictsWith: []string{"instance_alias"},
+			},
+			"identity_management_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connect.DirectoryType_Values(), false),
+			},
+			"inbound_calls_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"instance_alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 45),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z]([0-9a-zA-Z-]*[0-9a-zA-Z])?$`), "must contain only alphanumeric characters and hyphens, and must begin and end with an alphanumeric character"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`^d-`), "must not begin with d-"),
+				),
+				ConflictsWith: []string{"directory_id"},
+			},
+			"outbound_calls_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"service_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsConnectInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	input := &connect.CreateInstanceInput{
+		ClientToken:            aws.String(resource.UniqueId()),
+		IdentityManagementType: aws.String(d.Get("identity_management_type").(string)),
+		InboundCallsEnabled:    aws.Bool(d.Get("inbound_calls_enabled").(bool)),
+		OutboundCallsEnabled:   aws.Bool(d.Get("outbound_calls_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("directory_id"); ok {
+		input.DirectoryId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_alias"); ok {
+		input.InstanceAlias = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Connect Instance: %s", input)
+	output, err := conn.CreateInstance(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Connect Instance: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waiter.InstanceCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Connect Instance (%s) creation: %w", d.Id(), err)
+	}
+
+	for _, attributeType := range []string{connect.InstanceAttributeTypeContactLens, connect.InstanceAttributeTypeContactflowLogs} {
+		if err := connectInstanceUpdateAttribute(conn, d.Id(), attributeType, d.Get(connectInstanceAttributes[attributeType]).(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsConnectInstanceRead(d, meta)
+}
+
+func resourceAwsConnectInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	instance, err := finder.InstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Connect Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Connect Instance (%s): %w", d.Id(), err)
+	}
+
+	if instance == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Connect Instance (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Connect Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", instance.Arn)
+	if instance.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(instance.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("identity_management_type", instance.IdentityManagementType)
+	d.Set("inbound_calls_enabled", instance.InboundCallsEnabled)
+	d.Set("instance_alias", instance.InstanceAlias)
+	d.Set("outbound_calls_enabled", instance.OutboundCallsEnabled)
+	d.Set("service_role", instance.ServiceRole)
+	d.Set("status", instance.InstanceStatus)
+
+	for _, attributeType := range []string{connect.InstanceAttributeTypeContactLens, connect.InstanceAttributeTypeContactflowLogs} {
+		attribute, err := finder.InstanceAttributeByTwoPartKey(conn, d.Id(), attributeType)
+
+		if err != nil {
+			return fmt.Errorf("error reading Connect Instance (%s) attribute (%s): %w", d.Id(), attributeType, err)
+		}
+
+		if attribute == nil {
+			continue
+		}
+
+		value, err := strconv.ParseBool(aws.StringValue(attribute.Value))
+
+		if err != nil {
+			return fmt.Errorf("error parsing Connect Instance (%s) attribute (%s): %w", d.Id(), attributeType, err)
+		}
+
+		d.Set(connectInstanceAttributes[attributeType], value)
+	}
+
+	return nil
+}
+
+func resourceAwsConnectInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	for attributeType, key := range connectInstanceAttributes {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		if err := connectInstanceUpdateAttribute(conn, d.Id(), attributeType, d.Get(key).(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsConnectInstanceRead(d, meta)
+}
+
+func resourceAwsConnectInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).connectconn
+
+	log.Printf("[DEBUG] Deleting Connect Instance: %s", d.Id())
+	_, err := conn.DeleteInstance(&connect.DeleteInstanceInput{
+		InstanceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Connect Instance (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func connectInstanceUpdateAttribute(conn *connect.Connect, instanceID, attributeType string, value bool) error {
+	input := &connect.UpdateInstanceAttributeInput{
+		AttributeType: aws.String(attributeType),
+		InstanceId:    aws.String(instanceID),
+		Value:         aws.String(strconv.FormatBool(value)),
+	}
+
+	log.Printf("[DEBUG] Updating Connect Instance attribute: %s", input)
+	if _, err := conn.UpdateInstanceAttribute(input); err != nil {
+		return fmt.Errorf("error updating Connect Instance (%s) attribute (%s): %w", instanceID, attributeType, err)
+	}
+
+	return nil
+}
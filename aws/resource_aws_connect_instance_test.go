@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_connect_instance", &resource.Sweeper{
+		Name: "aws_connect_instance",
+		F:    testSweepConnectInstances,
+	})
+}
+
+func testSweepConnectInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).connectconn
+	input := &connect.ListInstancesInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListInstancesPages(input, func(page *connect.ListInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, instance := range page.InstanceSummaryList {
+			id := aws.StringValue(instance.Id)
+
+			log.Printf("[INFO] Deleting Connect Instance: %s", id)
+			_, err := conn.DeleteInstance(&connect.DeleteInstanceInput{
+				InstanceId: aws.String(id),
+			})
+
+			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting Connect Instance (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Connect Instance sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Connect Instances: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func testAccAWSConnectInstance_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectInstanceConfig(rName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsConnectInstanceExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "connect", regexp.MustCompile(`instance/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "contact_flow_logs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "contact_lens_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "identity_management_type", connect.DirectoryTypeConnectManaged),
+					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_alias", rName),
+					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_role"),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.InstanceStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSConnectInstance_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectInstanceConfig(rName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsConnectInstanceExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsConnectInstance(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSConnectInstance_Attributes(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectInstanceConfig(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsConnectInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_flow_logs_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "contact_lens_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectInstanceConfigCalls(rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsConnectInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_flow_logs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "contact_lens_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsConnectInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_instance" {
+			continue
+		}
+
+		output, err := finder.InstanceByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Connect Instance (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsConnectInstanceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+		output, err := finder.InstanceByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Connect Instance (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSConnectInstanceConfig(rName string, contactFlowLogsEnabled, contactLensEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type  = "CONNECT_MANAGED"
+  inbound_calls_enabled     = true
+  instance_alias            = %[1]q
+  outbound_calls_enabled    = true
+  contact_flow_logs_enabled = %[2]t
+  contact_lens_enabled      = %[3]t
+}
+`, rName, contactFlowLogsEnabled, contactLensEnabled)
+}
+
+func testAccAWSConnectInstanceConfigCalls(rName string, inboundCallsEnabled, outboundCallsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = %[2]t
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = %[3]t
+}
+`, rName, inboundCallsEnabled, outboundCallsEnabled)
+}
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+)
+
+// Connect instance creation is heavily rate limited per account, so run serially
+// locally and in TeamCity.
+func TestAccAWSConnect_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Instance": {
+			"basic":      testAccAWSConnectInstance_basic,
+			"disappears": testAccAWSConnectInstance_disappears,
+			"Attributes": testAccAWSConnectInstance_Attributes,
+		},
+		"ContactFlow": {
+			"basic":      testAccAWSConnectContactFlow_basic,
+			"disappears": testAccAWSConnectContactFlow_disappears,
+			"Tags":       testAccAWSConnectContactFlow_Tags,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccPreCheckAWSConnect(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+	input := &connect.ListInstancesInput{
+		MaxResults: aws.Int64(1),
+	}
+
+	_, err := conn.ListInstances(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsDataSyncLocationFsxLustreFileSystem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDataSyncLocationFsxLustreFileSystemCreate,
+		Read:   resourceAwsDataSyncLocationFsxLustreFileSystemRead,
+		Update: resourceAwsDataSyncLocationFsxLustreFileSystemUpdate,
+		Delete: resourceAwsDataSyncLocationFsxLustreFileSystemDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fsx_filesystem_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"security_group_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"subdirectory": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"tags": tagsSchema(),
+			"uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDataSyncLocationFsxLustreFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+	fsxArn := d.Get("fsx_filesystem_arn").(string)
+
+	input := &datasync.CreateLocationFsxLustreInput{
+		FsxFilesystemArn:  aws.String(fsxArn),
+		SecurityGroupArns: expandStringSet(d.Get("security_group_arns").(*schema.Set)),
+		Tags:              keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().DatasyncTags(),
+	}
+
+	if v, ok := d.GetOk("subdirectory"); ok {
+		input.Subdirectory = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DataSync Location Fsx Lustre File System: %#v", input)
+	output, err := conn.CreateLocationFsxLustre(input)
+	if err != nil {
+		return fmt.Errorf("error creating DataSync Location Fsx Lustre File System (%s): %w", fsxArn, err)
+	}
+
+	d.SetId(aws.StringValue(output.LocationArn))
+
+	return resourceAwsDataSyncLocationFsxLustreFileSystemRead(d, meta)
+}
+
+func resourceAwsDataSyncLocationFsxLustreFileSystemRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &datasync.DescribeLocationFsxLustreInput{
+		LocationArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading DataSync Location Fsx Lustre: %#v", input)
+	output, err := conn.DescribeLocationFsxLustre(input)
+
+	if !d.IsNewResource() && isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") {
+		log.Printf("[WARN] DataSync Location Fsx Lustre %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DataSync Location Fsx Lustre (%s): %w", d.Id(), err)
+	}
+
+	subdirectory, err := dataSyncParseLocationURI(aws.StringValue(output.LocationUri))
+
+	if err != nil {
+		return fmt.Errorf("error parsing Location Fsx Lustre File System (%s) URI (%s): %w", d.Id(), aws.StringValue(output.LocationUri), err)
+	}
+
+	// The FSx file system ARN is not returned by DescribeLocationFsxLustre,
+	// so rebuild it from the location ARN and URI to support import.
+	fsxArn, err := dataSyncLocationFsxLustreFileSystemArn(aws.StringValue(output.LocationArn), aws.StringValue(output.LocationUri))
+
+	if err != nil {
+		return fmt.Errorf("error parsing Location Fsx Lustre File System (%s) FSx ARN: %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.LocationArn)
+	d.Set("fsx_filesystem_arn", fsxArn)
+	d.Set("subdirectory", subdirectory)
+	d.Set("uri", output.LocationUri)
+
+	if err := d.Set("security_group_arns", flattenStringSet(output.SecurityGroupArns)); err != nil {
+		return fmt.Errorf("error setting security_group_arns: %w", err)
+	}
+
+	if err := d.Set("creation_time", output.CreationTime.Format(time.RFC3339)); err != nil {
+		return fmt.Errorf("error setting creation_time: %w", err)
+	}
+
+	tags, err := keyvaluetags.DatasyncListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for DataSync Location Fsx Lustre (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDataSyncLocationFsxLustreFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.DatasyncUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating DataSync Location Fsx Lustre File System (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDataSyncLocationFsxLustreFileSystemRead(d, meta)
+}
+
+func resourceAwsDataSyncLocationFsxLustreFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datasyncconn
+
+	input := &datasync.DeleteLocationInput{
+		LocationArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting DataSync Location Fsx Lustre File System: %#v", input)
+	_, err := conn.DeleteLocation(input)
+
+	if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DataSync Location Fsx Lustre (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// dataSyncLocationFsxLustreFileSystemArn builds the FSx file system ARN from a
+// location ARN and a location URI of the form fsxl://REGION.FILE-SYSTEM-ID/PATH.
+func dataSyncLocationFsxLustreFileSystemArn(locationArn, uri string) (string, error) {
+	parsedArn, err := arn.Parse(locationArn)
+
+	if err != nil {
+		return "", err
+	}
+
+	parsedURL, err := url.ParseRequestURI(uri)
+
+	if err != nil {
+		return "", err
+	}
+
+	hostParts := strings.SplitN(parsedURL.Host, ".", 2)
+
+	if len(hostParts) != 2 || hostParts[0] == "" || hostParts[1] == "" {
+		return "", fmt.Errorf("unexpected format of URI host (%s), expected REGION.FILE-SYSTEM-ID", parsedURL.Host)
+	}
+
+	return arn.ARN{
+		Partition: parsedArn.Partition,
+		Service:   fsx.EndpointsID,
+		Region:    hostParts[0],
+		AccountID: parsedArn.AccountID,
+		Resource:  fmt.Sprintf("file-system/%s", hostParts[1]),
+	}.String(), nil
+}
@@ -0,0 +1,365 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_datasync_location_fsx_lustre_file_system", &resource.Sweeper{
+		Name: "aws_datasync_location_fsx_lustre_file_system",
+		F:    testSweepDataSyncLocationFsxLustres,
+	})
+}
+
+func testSweepDataSyncLocationFsxLustres(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).datasyncconn
+
+	input := &datasync.ListLocationsInput{}
+	for {
+		output, err := conn.ListLocations(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping DataSync Location FSX Lustre sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error retrieving DataSync Location FSX Lustre: %w", err)
+		}
+
+		if len(output.Locations) == 0 {
+			log.Print("[DEBUG] No DataSync Location FSX Lustre File System to sweep")
+			return nil
+		}
+
+		for _, location := range output.Locations {
+			uri := aws.StringValue(location.LocationUri)
+			if !strings.HasPrefix(uri, "fsxl://") {
+				log.Printf("[INFO] Skipping DataSync Location FSX Lustre File System: %s", uri)
+				continue
+			}
+			log.Printf("[INFO] Deleting DataSync Location FSX Lustre File System: %s", uri)
+			input := &datasync.DeleteLocationInput{
+				LocationArn: location.LocationArn,
+			}
+
+			_, err := conn.DeleteLocation(input)
+
+			if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") {
+				continue
+			}
+
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete DataSync Location FSX Lustre (%s): %s", uri, err)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+func TestDataSyncLocationFsxLustreFileSystemArn(t *testing.T) {
+	testCases := []struct {
+		LocationArn   string
+		LocationURI   string
+		FsxArn        string
+		ErrorExpected bool
+	}{
+		{
+			LocationArn: "arn:aws:datasync:us-east-2:123456789012:location/loc-12345678901234567", // lintignore:AWSAT003,AWSAT005
+			LocationURI: "fsxl://us-east-2.fs-0123456789abcdef0/",                                 // lintignore:AWSAT003
+			FsxArn:      "arn:aws:fsx:us-east-2:123456789012:file-system/fs-0123456789abcdef0",    // lintignore:AWSAT003,AWSAT005
+		},
+		{
+			LocationArn: "arn:aws-us-gov:datasync:us-gov-west-1:123456789012:location/loc-12345678901234567", // lintignore:AWSAT003,AWSAT005
+			LocationURI: "fsxl://us-gov-west-1.fs-0123456789abcdef0/path/",                                   // lintignore:AWSAT003
+			FsxArn:      "arn:aws-us-gov:fsx:us-gov-west-1:123456789012:file-system/fs-0123456789abcdef0",    // lintignore:AWSAT003,AWSAT005
+		},
+		{
+			LocationArn:   "arn:aws:datasync:us-east-2:123456789012:location/loc-12345678901234567", // lintignore:AWSAT003,AWSAT005
+			LocationURI:   "fsxl://fs-0123456789abcdef0/",
+			ErrorExpected: true,
+		},
+		{
+			LocationArn:   "loc-12345678901234567",
+			LocationURI:   "fsxl://us-east-2.fs-0123456789abcdef0/", // lintignore:AWSAT003
+			ErrorExpected: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		fsxArn, err := dataSyncLocationFsxLustreFileSystemArn(tc.LocationArn, tc.LocationURI)
+
+		if tc.ErrorExpected {
+			if err == nil {
+				t.Fatalf("%d: expected error parsing (%s, %s), received none", i, tc.LocationArn, tc.LocationURI)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%d: received error parsing (%s, %s): %s", i, tc.LocationArn, tc.LocationURI, err)
+		}
+
+		if fsxArn != tc.FsxArn {
+			t.Fatalf("%d: expected FSx ARN (%s), received: %s", i, tc.FsxArn, fsxArn)
+		}
+	}
+}
+
+func TestAccAWSDataSyncLocationFsxLustre_basic(t *testing.T) {
+	var locationFsxLustre1 datasync.DescribeLocationFsxLustreOutput
+	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
+	fsResourceName := "aws_fsx_lustre_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(fsx.EndpointsID, t)
+			testAccPreCheckAWSDataSync(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationFsxLustreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationFsxLustreConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationFsxLustreExists(resourceName, &locationFsxLustre1),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "datasync", regexp.MustCompile(`location/loc-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "fsx_filesystem_arn", fsResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subdirectory", "/"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "uri", regexp.MustCompile(`^fsxl://.+/`)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSyncLocationFsxLustre_disappears(t *testing.T) {
+	var locationFsxLustre1 datasync.DescribeLocationFsxLustreOutput
+	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(fsx.EndpointsID, t)
+			testAccPreCheckAWSDataSync(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationFsxLustreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationFsxLustreConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationFsxLustreExists(resourceName, &locationFsxLustre1),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDataSyncLocationFsxLustreFileSystem(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSyncLocationFsxLustre_subdirectory(t *testing.T) {
+	var locationFsxLustre1 datasync.DescribeLocationFsxLustreOutput
+	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(fsx.EndpointsID, t)
+			testAccPreCheckAWSDataSync(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationFsxLustreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationFsxLustreConfigSubdirectory("/subdirectory1/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationFsxLustreExists(resourceName, &locationFsxLustre1),
+					resource.TestCheckResourceAttr(resourceName, "subdirectory", "/subdirectory1/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSyncLocationFsxLustre_tags(t *testing.T) {
+	var locationFsxLustre1 datasync.DescribeLocationFsxLustreOutput
+	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(fsx.EndpointsID, t)
+			testAccPreCheckAWSDataSync(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataSyncLocationFsxLustreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSyncLocationFsxLustreConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationFsxLustreExists(resourceName, &locationFsxLustre1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDataSyncLocationFsxLustreConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationFsxLustreExists(resourceName, &locationFsxLustre1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSDataSyncLocationFsxLustreConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataSyncLocationFsxLustreExists(resourceName, &locationFsxLustre1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDataSyncLocationFsxLustreDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_datasync_location_fsx_lustre_file_system" {
+			continue
+		}
+
+		input := &datasync.DescribeLocationFsxLustreInput{
+			LocationArn: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.DescribeLocationFsxLustre(input)
+
+		if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDataSyncLocationFsxLustreExists(resourceName string, locationFsxLustre *datasync.DescribeLocationFsxLustreOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		input := &datasync.DescribeLocationFsxLustreInput{
+			LocationArn: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.DescribeLocationFsxLustre(input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Location %q does not exist", rs.Primary.ID)
+		}
+
+		*locationFsxLustre = *output
+
+		return nil
+	}
+}
+
+func testAccAWSDataSyncLocationFsxLustreConfig() string {
+	return testAccAwsFsxLustreFileSystemConfigSecurityGroupIds1() + `
+resource "aws_datasync_location_fsx_lustre_file_system" "test" {
+  fsx_filesystem_arn  = aws_fsx_lustre_file_system.test.arn
+  security_group_arns = [aws_security_group.test1.arn]
+}
+`
+}
+
+func testAccAWSDataSyncLocationFsxLustreConfigSubdirectory(subdirectory string) string {
+	return testAccAwsFsxLustreFileSystemConfigSecurityGroupIds1() + fmt.Sprintf(`
+resource "aws_datasync_location_fsx_lustre_file_system" "test" {
+  fsx_filesystem_arn  = aws_fsx_lustre_file_system.test.arn
+  security_group_arns = [aws_security_group.test1.arn]
+  subdirectory        = %[1]q
+}
+`, subdirectory)
+}
+
+func testAccAWSDataSyncLocationFsxLustreConfigTags1(key1, value1 string) string {
+	return testAccAwsFsxLustreFileSystemConfigSecurityGroupIds1() + fmt.Sprintf(`
+resource "aws_datasync_location_fsx_lustre_file_system" "test" {
+  fsx_filesystem_arn  = aws_fsx_lustre_file_system.test.arn
+  security_group_arns = [aws_security_group.test1.arn]
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, key1, value1)
+}
+
+func testAccAWSDataSyncLocationFsxLustreConfigTags2(key1, value1, key2, value2 string) string {
+	return testAccAwsFsxLustreFileSystemConfigSecurityGroupIds1() + fmt.Sprintf(`
+resource "aws_datasync_location_fsx_lustre_file_system" "test" {
+  fsx_filesystem_arn  = aws_fsx_lustre_file_system.test.arn
+  security_group_arns = [aws_security_group.test1.arn]
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, key1, value1, key2, value2)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 )
 
 // ACL Network ACLs all contain explicit deny-all rules that cannot be
@@ -40,13 +41,18 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 				Computed: true,
 			},
 			"vpc_id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"default_network_acl_id", "vpc_id"},
 			},
 			"default_network_acl_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"default_network_acl_id", "vpc_id"},
 			},
 			// We want explicit management of Subnets here, so we do not allow them to be
 			// computed. Instead, an empty config will enforce just that; removal of the
@@ -194,7 +200,27 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 }
 
 func resourceAwsDefaultNetworkAclCreate(d *schema.ResourceData, meta interface{}) error {
-	d.SetId(d.Get("default_network_acl_id").(string))
+	conn := meta.(*AWSClient).ec2conn
+
+	if v, ok := d.GetOk("default_network_acl_id"); ok {
+		d.SetId(v.(string))
+	} else {
+		vpcID := d.Get("vpc_id").(string)
+
+		networkAcl, err := finder.DefaultNetworkAclByVpcID(conn, vpcID)
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Default Network ACL for VPC (%s): %w", vpcID, err)
+		}
+
+		if networkAcl == nil {
+			return fmt.Errorf("error reading EC2 Default Network ACL for VPC (%s): not found", vpcID)
+		}
+
+		d.SetId(aws.StringValue(networkAcl.NetworkAclId))
+	}
+
+	d.Set("default_network_acl_id", d.Id())
 
 	// revoke all default and pre-existing rules on the default network acl.
 	// In the UPDATE method, we'll apply only the rules in the configuration.
@@ -78,6 +78,34 @@ func TestAccAWSDefaultNetworkAcl_basicIpv6Vpc(t *testing.T) {
 	})
 }
 
+func TestAccAWSDefaultNetworkAcl_VpcId(t *testing.T) {
+	var networkAcl ec2.NetworkAcl
+	resourceName := "aws_default_network_acl.default"
+	vpcResourceName := "aws_vpc.tftestvpc"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDefaultNetworkAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDefaultNetworkConfig_vpcId,
+				Check: resource.ComposeTestCheckFunc(
+					testAccGetAWSDefaultNetworkAcl(resourceName, &networkAcl),
+					testAccCheckAWSDefaultACLAttributes(&networkAcl, []*ec2.NetworkAclEntry{}, 0, 4),
+					resource.TestCheckResourceAttrPair(resourceName, "default_network_acl_id", vpcResourceName, "default_network_acl_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", vpcResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSDefaultNetworkAcl_deny_ingress(t *testing.T) {
 	// TestAccAWSDefaultNetworkAcl_deny_ingress will deny all Ingress rules, but
 	// not Egress. We then expect there to be 3 rules, 2 AWS defaults and 1
@@ -488,6 +516,25 @@ resource "aws_default_network_acl" "default" {
 }
 `
 
+const testAccAWSDefaultNetworkConfig_vpcId = `
+resource "aws_vpc" "tftestvpc" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = "terraform-testacc-default-network-acl-vpc-id"
+  }
+}
+
+resource "aws_default_network_acl" "default" {
+  vpc_id = aws_vpc.tftestvpc.id
+
+  tags = {
+    Name = "tf-acc-default-acl-vpc-id"
+  }
+}
+`
+
 const testAccAWSDefaultNetworkConfig_basicIpv6Vpc = `
 resource "aws_vpc" "tftestvpc" {
   cidr_block                       = "10.1.0.0/16"
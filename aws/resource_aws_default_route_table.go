@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 )
 
 func resourceAwsDefaultRouteTable() *schema.Resource {
@@ -26,14 +27,19 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"default_route_table_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"default_route_table_id", "vpc_id"},
 			},
 
 			"vpc_id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"default_route_table_id", "vpc_id"},
 			},
 
 			"propagating_vgws": {
@@ -123,20 +129,38 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 }
 
 func resourceAwsDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
-	d.SetId(d.Get("default_route_table_id").(string))
-
 	conn := meta.(*AWSClient).ec2conn
-	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, d.Id())()
-	if err != nil {
-		return fmt.Errorf("error reading EC2 Default Route Table (%s): %s", d.Id(), err)
-	}
-	if rtRaw == nil {
-		return fmt.Errorf("error reading EC2 Default Route Table (%s): not found", d.Id())
-	}
 
-	rt := rtRaw.(*ec2.RouteTable)
+	if v, ok := d.GetOk("default_route_table_id"); ok {
+		d.SetId(v.(string))
+
+		rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, d.Id())()
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Default Route Table (%s): %s", d.Id(), err)
+		}
+		if rtRaw == nil {
+			return fmt.Errorf("error reading EC2 Default Route Table (%s): not found", d.Id())
+		}
+
+		rt := rtRaw.(*ec2.RouteTable)
+
+		d.Set("vpc_id", rt.VpcId)
+	} else {
+		vpcID := d.Get("vpc_id").(string)
+
+		rt, err := finder.MainRouteTableByVpcID(conn, vpcID)
 
-	d.Set("vpc_id", rt.VpcId)
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Default Route Table for VPC (%s): %w", vpcID, err)
+		}
+
+		if rt == nil {
+			return fmt.Errorf("error reading EC2 Default Route Table for VPC (%s): not found", vpcID)
+		}
+
+		d.SetId(aws.StringValue(rt.RouteTableId))
+		d.Set("default_route_table_id", rt.RouteTableId)
+	}
 
 	// revoke all default and pre-existing routes on the default route table.
 	// In the UPDATE method, we'll apply only the rules in the configuration.
@@ -200,7 +224,6 @@ func resourceAwsDefaultRouteTableDelete(d *schema.ResourceData, meta interface{}
 // This should only be ran once at creation time of this resource
 func revokeAllRouteTableRules(defaultRouteTableId string, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	log.Printf("\n***\nrevokeAllRouteTableRules\n***\n")
 
 	resp, err := conn.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
 		RouteTableIds: []*string{aws.String(defaultRouteTableId)},
@@ -240,6 +263,11 @@ func revokeAllRouteTableRules(defaultRouteTableId string, meta interface{}) erro
 			// See aws_vpc_endpoint
 			continue
 		}
+		if aws.StringValue(r.Origin) == ec2.RouteOriginEnableVgwRoutePropagation {
+			// Propagated routes cannot be deleted directly; they are withdrawn
+			// when the VGW route propagation above is disabled
+			continue
+		}
 
 		if r.DestinationCidrBlock != nil {
 			log.Printf(
@@ -54,6 +54,41 @@ func TestAccAWSDefaultRouteTable_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDefaultRouteTable_VpcId(t *testing.T) {
+	var routeTable ec2.RouteTable
+	resourceName := "aws_default_route_table.test"
+	vpcResourceName := "aws_vpc.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDefaultRouteTableConfigVpcId("vpc-00000000"),
+				ExpectError: regexp.MustCompile(`EC2 Default Route Table for VPC \(vpc-00000000\): not found`),
+			},
+			{
+				Config: testAccDefaultRouteTableConfigVpcIdVpc(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(resourceName, &routeTable),
+					resource.TestCheckResourceAttrPair(resourceName, "default_route_table_id", vpcResourceName, "default_route_table_id"),
+					resource.TestCheckResourceAttr(resourceName, "propagating_vgws.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", vpcResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSDefaultRouteTableImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSDefaultRouteTable_disappears_Vpc(t *testing.T) {
 	var routeTable ec2.RouteTable
 	var vpc ec2.Vpc
@@ -446,6 +481,30 @@ resource "aws_default_route_table" "test" {
 `, defaultRouteTableId)
 }
 
+func testAccDefaultRouteTableConfigVpcId(vpcId string) string {
+	return fmt.Sprintf(`
+resource "aws_default_route_table" "test" {
+  vpc_id = %[1]q
+}
+`, vpcId)
+}
+
+func testAccDefaultRouteTableConfigVpcIdVpc(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_default_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+}
+`, rName)
+}
+
 func testAccDefaultRouteTableConfigBasic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func resourceAwsDetectiveGraph() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDetectiveGraphCreate,
+		Read:   resourceAwsDetectiveGraphRead,
+		Update: resourceAwsDetectiveGraphUpdate,
+		Delete: resourceAwsDetectiveGraphDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"graph_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsDetectiveGraphCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	input := &detective.CreateGraphInput{}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().DetectiveTags()
+	}
+
+	log.Printf("[DEBUG] Creating Detective Graph: %s", input)
+	output, err := conn.CreateGraph(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Detective Graph: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.GraphArn))
+
+	return resourceAwsDetectiveGraphRead(d, meta)
+}
+
+func resourceAwsDetectiveGraphRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	graph, err := finder.GraphByARN(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Detective Graph (%s): %w", d.Id(), err)
+	}
+
+	if graph == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Detective Graph (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Detective Graph (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("created_time", aws.TimeValue(graph.CreatedTime).Format(time.RFC3339))
+	d.Set("graph_arn", graph.Arn)
+
+	tags, err := keyvaluetags.DetectiveListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Detective Graph (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDetectiveGraphUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.DetectiveUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Detective Graph (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDetectiveGraphRead(d, meta)
+}
+
+func resourceAwsDetectiveGraphDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	log.Printf("[DEBUG] Deleting Detective Graph: %s", d.Id())
+	_, err := conn.DeleteGraph(&detective.DeleteGraphInput{
+		GraphArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Detective Graph (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func testAccAWSDetectiveGraph_basic(t *testing.T) {
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDetective(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDetectiveGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDetectiveGraphConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "graph_arn", "detective", regexp.MustCompile(`graph:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSDetectiveGraph_disappears(t *testing.T) {
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDetective(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDetectiveGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDetectiveGraphConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDetectiveGraph(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSDetectiveGraph_Tags(t *testing.T) {
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDetective(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDetectiveGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDetectiveGraphConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDetectiveGraphConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSDetectiveGraphConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveGraphExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDetectiveGraphDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_graph" {
+			continue
+		}
+
+		graph, err := finder.GraphByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if graph != nil {
+			return fmt.Errorf("Detective Graph (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDetectiveGraphExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+		graph, err := finder.GraphByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if graph == nil {
+			return fmt.Errorf("Detective Graph (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccAWSDetectiveGraphConfig = `
+resource "aws_detective_graph" "test" {}
+`
+
+func testAccAWSDetectiveGraphConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSDetectiveGraphConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func resourceAwsDetectiveInvitationAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDetectiveInvitationAccepterCreate,
+		Read:   resourceAwsDetectiveInvitationAccepterRead,
+		Delete: resourceAwsDetectiveInvitationAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsDetectiveInvitationAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	graphARN := d.Get("graph_arn").(string)
+	input := &detective.AcceptInvitationInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	log.Printf("[DEBUG] Accepting Detective Invitation: %s", input)
+	_, err := conn.AcceptInvitation(input)
+
+	if err != nil {
+		return fmt.Errorf("error accepting Detective Invitation (%s): %w", graphARN, err)
+	}
+
+	d.SetId(graphARN)
+
+	return resourceAwsDetectiveInvitationAccepterRead(d, meta)
+}
+
+func resourceAwsDetectiveInvitationAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	invitation, err := finder.InvitationByGraphARN(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Detective Invitation (%s): %w", d.Id(), err)
+	}
+
+	if invitation == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Detective Invitation (%s): not found after acceptance", d.Id())
+		}
+
+		log.Printf("[WARN] Detective Invitation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("graph_arn", invitation.GraphArn)
+
+	return nil
+}
+
+func resourceAwsDetectiveInvitationAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	log.Printf("[DEBUG] Disassociating from Detective Graph: %s", d.Id())
+	_, err := conn.DisassociateMembership(&detective.DisassociateMembershipInput{
+		GraphArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating from Detective Graph (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func testAccAWSDetectiveInvitationAccepter_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_invitation_accepter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSDetective(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveInvitationAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDetectiveInvitationAccepterConfig("example@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveInvitationAccepterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", "aws_detective_graph.test", "graph_arn"),
+				),
+			},
+			{
+				Config:            testAccAWSDetectiveInvitationAccepterConfig("example@example.com"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDetectiveInvitationAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_invitation_accepter" {
+			continue
+		}
+
+		invitation, err := finder.InvitationByGraphARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if invitation != nil {
+			return fmt.Errorf("Detective Invitation (%s) still accepted", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDetectiveInvitationAccepterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+		invitation, err := finder.InvitationByGraphARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if invitation == nil {
+			return fmt.Errorf("Detective Invitation (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// The graph and member are managed from the alternate (administrator)
+// account, the invitation is accepted from the default (member) account.
+func testAccAWSDetectiveInvitationAccepterConfig(email string) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "member" {}
+
+resource "aws_detective_graph" "test" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_member" "test" {
+  provider = "awsalternate"
+
+  account_id                 = data.aws_caller_identity.member.account_id
+  graph_arn                  = aws_detective_graph.test.graph_arn
+  email_address              = %[1]q
+  disable_email_notification = true
+}
+
+resource "aws_detective_invitation_accepter" "test" {
+  graph_arn = aws_detective_member.test.graph_arn
+}
+`, email))
+}
@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/waiter"
+)
+
+func resourceAwsDetectiveMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDetectiveMemberCreate,
+		Read:   resourceAwsDetectiveMemberRead,
+		Delete: resourceAwsDetectiveMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"administrator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disable_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"invited_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDetectiveMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	accountID := d.Get("account_id").(string)
+	graphARN := d.Get("graph_arn").(string)
+	id := tfdetective.MemberCreateID(graphARN, accountID)
+	input := &detective.CreateMembersInput{
+		Accounts: []*detective.Account{
+			{
+				AccountId:    aws.String(accountID),
+				EmailAddress: aws.String(d.Get("email_address").(string)),
+			},
+		},
+		GraphArn: aws.String(graphARN),
+	}
+
+	if v, ok := d.GetOk("disable_email_notification"); ok {
+		input.DisableEmailNotification = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Detective Member: %s", input)
+	output, err := conn.CreateMembers(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Detective Member (%s): %w", id, err)
+	}
+
+	for _, v := range output.UnprocessedAccounts {
+		if aws.StringValue(v.AccountId) == accountID {
+			return fmt.Errorf("error creating Detective Member (%s): %s", id, aws.StringValue(v.Reason))
+		}
+	}
+
+	d.SetId(id)
+
+	// Accounts that Detective enables automatically (e.g. organization
+	// accounts) become ENABLED, all others are INVITED.
+	if _, err := waiter.MemberCreated(conn, graphARN, accountID); err != nil {
+		return fmt.Errorf("error waiting for Detective Member (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsDetectiveMemberRead(d, meta)
+}
+
+func resourceAwsDetectiveMemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	graphARN, accountID, err := tfdetective.MemberParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	member, err := finder.MemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Detective Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Detective Member (%s): %w", d.Id(), err)
+	}
+
+	if member == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Detective Member (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Detective Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", member.AccountId)
+	d.Set("administrator_id", member.AdministratorId)
+	d.Set("disabled_reason", member.DisabledReason)
+	d.Set("email_address", member.EmailAddress)
+	d.Set("graph_arn", member.GraphArn)
+	d.Set("invited_time", aws.TimeValue(member.InvitedTime).Format(time.RFC3339))
+	d.Set("status", member.Status)
+	d.Set("updated_time", aws.TimeValue(member.UpdatedTime).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceAwsDetectiveMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).detectiveconn
+
+	graphARN, accountID, err := tfdetective.MemberParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Detective Member: %s", d.Id())
+	output, err := conn.DeleteMembers(&detective.DeleteMembersInput{
+		AccountIds: aws.StringSlice([]string{accountID}),
+		GraphArn:   aws.String(graphARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Detective Member (%s): %w", d.Id(), err)
+	}
+
+	for _, v := range output.UnprocessedAccounts {
+		if aws.StringValue(v.AccountId) == accountID {
+			return fmt.Errorf("error deleting Detective Member (%s): %s", d.Id(), aws.StringValue(v.Reason))
+		}
+	}
+
+	return nil
+}
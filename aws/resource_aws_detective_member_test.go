@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+)
+
+func testAccAWSDetectiveMember_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_member.test"
+	dataSourceAlternate := "data.aws_caller_identity.member"
+	email := "example@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSDetective(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDetectiveMemberConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveMemberExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceAlternate, "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", "aws_detective_graph.test", "graph_arn"),
+					resource.TestCheckResourceAttr(resourceName, "email_address", email),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusInvited),
+					resource.TestCheckResourceAttrSet(resourceName, "invited_time"),
+				),
+			},
+			{
+				Config:                  testAccAWSDetectiveMemberConfig(email),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_email_notification"},
+			},
+		},
+	})
+}
+
+func testAccAWSDetectiveMember_Message(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_member.test"
+	email := "example@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSDetective(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDetectiveMemberConfigMessage(email, "Please join"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "message", "Please join"),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusInvited),
+				),
+			},
+			{
+				Config:                  testAccAWSDetectiveMemberConfigMessage(email, "Please join"),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_email_notification", "message"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDetectiveMemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_member" {
+			continue
+		}
+
+		graphARN, accountID, err := tfdetective.MemberParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		member, err := finder.MemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+		if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if member != nil {
+			return fmt.Errorf("Detective Member (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDetectiveMemberExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		graphARN, accountID, err := tfdetective.MemberParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+		member, err := finder.MemberByGraphARNAndAccountID(conn, graphARN, accountID)
+
+		if err != nil {
+			return err
+		}
+
+		if member == nil {
+			return fmt.Errorf("Detective Member (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSDetectiveMemberConfigBase() string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), `
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_graph" "test" {}
+`)
+}
+
+func testAccAWSDetectiveMemberConfig(email string) string {
+	return composeConfig(testAccAWSDetectiveMemberConfigBase(), fmt.Sprintf(`
+resource "aws_detective_member" "test" {
+  account_id                 = data.aws_caller_identity.member.account_id
+  graph_arn                  = aws_detective_graph.test.graph_arn
+  email_address              = %[1]q
+  disable_email_notification = true
+}
+`, email))
+}
+
+func testAccAWSDetectiveMemberConfigMessage(email, message string) string {
+	return composeConfig(testAccAWSDetectiveMemberConfigBase(), fmt.Sprintf(`
+resource "aws_detective_member" "test" {
+  account_id                 = data.aws_caller_identity.member.account_id
+  graph_arn                  = aws_detective_graph.test.graph_arn
+  email_address              = %[1]q
+  message                    = %[2]q
+  disable_email_notification = true
+}
+`, email, message))
+}
@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+)
+
+// Only one Detective behavior graph can exist per account and Region, so all
+// Detective tests must run serially.
+func TestAccAWSDetective_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Graph": {
+			"basic":      testAccAWSDetectiveGraph_basic,
+			"disappears": testAccAWSDetectiveGraph_disappears,
+			"Tags":       testAccAWSDetectiveGraph_Tags,
+		},
+		"Member": {
+			"basic":   testAccAWSDetectiveMember_basic,
+			"Message": testAccAWSDetectiveMember_Message,
+		},
+		"InvitationAccepter": {
+			"basic": testAccAWSDetectiveInvitationAccepter_basic,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccPreCheckAWSDetective(t *testing.T) {
+	testAccPartitionHasServicePreCheck(detective.EndpointsID, t)
+}
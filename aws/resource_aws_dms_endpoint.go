@@ -149,6 +149,87 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.NoZeroValues,
 						},
+						"include_control_details": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"include_null_and_empty": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"include_partition_value": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"include_table_alter_operations": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"include_transaction_details": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"message_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      dms.MessageFormatValueJson,
+							ValidateFunc: validation.StringInSlice(dms.MessageFormatValue_Values(), false),
+						},
+						"message_max_bytes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1000000,
+						},
+						"no_hex_prefix": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"partition_include_schema_table": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"sasl_password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"sasl_username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"security_protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dms.KafkaSecurityProtocol_Values(), false),
+						},
+						"ssl_ca_certificate_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"ssl_client_certificate_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"ssl_client_key_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"ssl_client_key_password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
 						"topic": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -169,6 +250,31 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"include_control_details": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"include_null_and_empty": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"include_partition_value": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"include_table_alter_operations": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"include_transaction_details": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"message_format": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -178,6 +284,11 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 							}, false),
 							Default: dms.MessageFormatValueJson,
 						},
+						"partition_include_schema_table": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"service_access_role_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -354,15 +465,12 @@ func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 			FullLoadErrorPercentage: aws.Int64(int64(d.Get("elasticsearch_settings.0.full_load_error_percentage").(int))),
 		}
 	case "kafka":
-		request.KafkaSettings = &dms.KafkaSettings{
-			Broker: aws.String(d.Get("kafka_settings.0.broker").(string)),
-			Topic:  aws.String(d.Get("kafka_settings.0.topic").(string)),
+		if v, ok := d.GetOk("kafka_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			request.KafkaSettings = expandDmsKafkaSettings(v.([]interface{})[0].(map[string]interface{}))
 		}
 	case "kinesis":
-		request.KinesisSettings = &dms.KinesisSettings{
-			MessageFormat:        aws.String(d.Get("kinesis_settings.0.message_format").(string)),
-			ServiceAccessRoleArn: aws.String(d.Get("kinesis_settings.0.service_access_role_arn").(string)),
-			StreamArn:            aws.String(d.Get("kinesis_settings.0.stream_arn").(string)),
+		if v, ok := d.GetOk("kinesis_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			request.KinesisSettings = expandDmsKinesisSettings(v.([]interface{})[0].(map[string]interface{}))
 		}
 	case "mongodb":
 		request.MongoDbSettings = &dms.MongoDbSettings{
@@ -563,29 +671,24 @@ func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 			hasChanges = true
 		}
 	case "kafka":
-		if d.HasChanges(
-			"kafka_settings.0.broker",
-			"kafka_settings.0.topic") {
-			request.KafkaSettings = &dms.KafkaSettings{
-				Broker: aws.String(d.Get("kafka_settings.0.broker").(string)),
-				Topic:  aws.String(d.Get("kafka_settings.0.topic").(string)),
+		if d.HasChange("kafka_settings") {
+			if v, ok := d.GetOk("kafka_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				request.KafkaSettings = expandDmsKafkaSettings(v.([]interface{})[0].(map[string]interface{}))
+				request.EngineName = aws.String(d.Get("engine_name").(string))
+				hasChanges = true
 			}
-			request.EngineName = aws.String(d.Get("engine_name").(string))
-			hasChanges = true
 		}
 	case "kinesis":
-		if d.HasChanges(
-			"kinesis_settings.0.service_access_role_arn",
-			"kinesis_settings.0.stream_arn") {
-			// Intentionally omitting MessageFormat, because it's rejected on ModifyEndpoint calls.
-			// "An error occurred (InvalidParameterValueException) when calling the ModifyEndpoint
-			// operation: Message format  cannot be modified for kinesis endpoints."
-			request.KinesisSettings = &dms.KinesisSettings{
-				ServiceAccessRoleArn: aws.String(d.Get("kinesis_settings.0.service_access_role_arn").(string)),
-				StreamArn:            aws.String(d.Get("kinesis_settings.0.stream_arn").(string)),
+		if d.HasChange("kinesis_settings") {
+			if v, ok := d.GetOk("kinesis_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				request.KinesisSettings = expandDmsKinesisSettings(v.([]interface{})[0].(map[string]interface{}))
+				// Intentionally omitting MessageFormat, because it's rejected on ModifyEndpoint calls.
+				// "An error occurred (InvalidParameterValueException) when calling the ModifyEndpoint
+				// operation: Message format  cannot be modified for kinesis endpoints."
+				request.KinesisSettings.MessageFormat = nil
+				request.EngineName = aws.String(d.Get("engine_name").(string)) // Must be included (should be 'kinesis')
+				hasChanges = true
 			}
-			request.EngineName = aws.String(d.Get("engine_name").(string)) // Must be included (should be 'kinesis')
-			hasChanges = true
 		}
 	case "mongodb":
 		if d.HasChanges(
@@ -711,7 +814,15 @@ func resourceAwsDmsEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoi
 			return fmt.Errorf("Error setting elasticsearch for DMS: %s", err)
 		}
 	case "kafka":
-		if err := d.Set("kafka_settings", flattenDmsKafkaSettings(endpoint.KafkaSettings)); err != nil {
+		kafkaSettings := flattenDmsKafkaSettings(endpoint.KafkaSettings)
+
+		// The API does not return the SASL password or the SSL client key password.
+		if len(kafkaSettings) > 0 {
+			kafkaSettings[0]["sasl_password"] = d.Get("kafka_settings.0.sasl_password").(string)
+			kafkaSettings[0]["ssl_client_key_password"] = d.Get("kafka_settings.0.ssl_client_key_password").(string)
+		}
+
+		if err := d.Set("kafka_settings", kafkaSettings); err != nil {
 			return fmt.Errorf("Error setting kafka_settings for DMS: %s", err)
 		}
 	case "kinesis":
@@ -766,28 +877,176 @@ func flattenDmsElasticsearchSettings(settings *dms.ElasticsearchSettings) []map[
 	return []map[string]interface{}{m}
 }
 
+func expandDmsKafkaSettings(tfMap map[string]interface{}) *dms.KafkaSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.KafkaSettings{}
+
+	if v, ok := tfMap["broker"].(string); ok && v != "" {
+		apiObject.Broker = aws.String(v)
+	}
+
+	if v, ok := tfMap["include_control_details"].(bool); ok {
+		apiObject.IncludeControlDetails = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["include_null_and_empty"].(bool); ok {
+		apiObject.IncludeNullAndEmpty = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["include_partition_value"].(bool); ok {
+		apiObject.IncludePartitionValue = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["include_table_alter_operations"].(bool); ok {
+		apiObject.IncludeTableAlterOperations = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["include_transaction_details"].(bool); ok {
+		apiObject.IncludeTransactionDetails = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["message_format"].(string); ok && v != "" {
+		apiObject.MessageFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["message_max_bytes"].(int); ok && v != 0 {
+		apiObject.MessageMaxBytes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["no_hex_prefix"].(bool); ok {
+		apiObject.NoHexPrefix = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["partition_include_schema_table"].(bool); ok {
+		apiObject.PartitionIncludeSchemaTable = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["sasl_password"].(string); ok && v != "" {
+		apiObject.SaslPassword = aws.String(v)
+	}
+
+	if v, ok := tfMap["sasl_username"].(string); ok && v != "" {
+		apiObject.SaslUsername = aws.String(v)
+	}
+
+	if v, ok := tfMap["security_protocol"].(string); ok && v != "" {
+		apiObject.SecurityProtocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["ssl_ca_certificate_arn"].(string); ok && v != "" {
+		apiObject.SslCaCertificateArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ssl_client_certificate_arn"].(string); ok && v != "" {
+		apiObject.SslClientCertificateArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ssl_client_key_arn"].(string); ok && v != "" {
+		apiObject.SslClientKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ssl_client_key_password"].(string); ok && v != "" {
+		apiObject.SslClientKeyPassword = aws.String(v)
+	}
+
+	if v, ok := tfMap["topic"].(string); ok && v != "" {
+		apiObject.Topic = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func flattenDmsKafkaSettings(settings *dms.KafkaSettings) []map[string]interface{} {
 	if settings == nil {
 		return []map[string]interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"broker": aws.StringValue(settings.Broker),
-		"topic":  aws.StringValue(settings.Topic),
+		"broker":                         aws.StringValue(settings.Broker),
+		"include_control_details":        aws.BoolValue(settings.IncludeControlDetails),
+		"include_null_and_empty":         aws.BoolValue(settings.IncludeNullAndEmpty),
+		"include_partition_value":        aws.BoolValue(settings.IncludePartitionValue),
+		"include_table_alter_operations": aws.BoolValue(settings.IncludeTableAlterOperations),
+		"include_transaction_details":    aws.BoolValue(settings.IncludeTransactionDetails),
+		"message_format":                 aws.StringValue(settings.MessageFormat),
+		"message_max_bytes":              aws.Int64Value(settings.MessageMaxBytes),
+		"no_hex_prefix":                  aws.BoolValue(settings.NoHexPrefix),
+		"partition_include_schema_table": aws.BoolValue(settings.PartitionIncludeSchemaTable),
+		"sasl_username":                  aws.StringValue(settings.SaslUsername),
+		"security_protocol":              aws.StringValue(settings.SecurityProtocol),
+		"ssl_ca_certificate_arn":         aws.StringValue(settings.SslCaCertificateArn),
+		"ssl_client_certificate_arn":     aws.StringValue(settings.SslClientCertificateArn),
+		"ssl_client_key_arn":             aws.StringValue(settings.SslClientKeyArn),
+		"topic":                          aws.StringValue(settings.Topic),
 	}
 
 	return []map[string]interface{}{m}
 }
 
+func expandDmsKinesisSettings(tfMap map[string]interface{}) *dms.KinesisSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.KinesisSettings{}
+
+	if v, ok := tfMap["include_control_details"].(bool); ok {
+		apiObject.IncludeControlDetails = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["include_null_and_empty"].(bool); ok {
+		apiObject.IncludeNullAndEmpty = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["include_partition_value"].(bool); ok {
+		apiObject.IncludePartitionValue = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["include_table_alter_operations"].(bool); ok {
+		apiObject.IncludeTableAlterOperations = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["include_transaction_details"].(bool); ok {
+		apiObject.IncludeTransactionDetails = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["message_format"].(string); ok && v != "" {
+		apiObject.MessageFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["partition_include_schema_table"].(bool); ok {
+		apiObject.PartitionIncludeSchemaTable = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["service_access_role_arn"].(string); ok && v != "" {
+		apiObject.ServiceAccessRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_arn"].(string); ok && v != "" {
+		apiObject.StreamArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func flattenDmsKinesisSettings(settings *dms.KinesisSettings) []map[string]interface{} {
 	if settings == nil {
 		return []map[string]interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"message_format":          aws.StringValue(settings.MessageFormat),
-		"service_access_role_arn": aws.StringValue(settings.ServiceAccessRoleArn),
-		"stream_arn":              aws.StringValue(settings.StreamArn),
+		"include_control_details":        aws.BoolValue(settings.IncludeControlDetails),
+		"include_null_and_empty":         aws.BoolValue(settings.IncludeNullAndEmpty),
+		"include_partition_value":        aws.BoolValue(settings.IncludePartitionValue),
+		"include_table_alter_operations": aws.BoolValue(settings.IncludeTableAlterOperations),
+		"include_transaction_details":    aws.BoolValue(settings.IncludeTransactionDetails),
+		"message_format":                 aws.StringValue(settings.MessageFormat),
+		"partition_include_schema_table": aws.BoolValue(settings.PartitionIncludeSchemaTable),
+		"service_access_role_arn":        aws.StringValue(settings.ServiceAccessRoleArn),
+		"stream_arn":                     aws.StringValue(settings.StreamArn),
 	}
 
 	return []map[string]interface{}{m}
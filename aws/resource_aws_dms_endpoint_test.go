@@ -307,6 +307,49 @@ func TestAccAwsDmsEndpoint_Kafka_Topic(t *testing.T) {
 	})
 }
 
+func TestAccAwsDmsEndpoint_Kafka_SaslSsl(t *testing.T) {
+	resourceName := "aws_dms_endpoint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: dmsEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: dmsEndpointKafkaConfigSaslSsl(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					checkDmsEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.include_control_details", "false"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.include_null_and_empty", "false"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.message_format", "json-unformatted"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.partition_include_schema_table", "false"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.sasl_password", "tftest-password"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.sasl_username", "tftest"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.security_protocol", "sasl-ssl"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "kafka_settings.0.sasl_password"},
+			},
+			{
+				Config: dmsEndpointKafkaConfigSaslSsl(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					checkDmsEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.include_control_details", "true"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.include_null_and_empty", "true"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.partition_include_schema_table", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAwsDmsEndpoint_Kinesis(t *testing.T) {
 	resourceName := "aws_dms_endpoint.dms_endpoint"
 	randId := acctest.RandString(8) + "-kinesis"
@@ -336,7 +379,10 @@ func TestAccAwsDmsEndpoint_Kinesis(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					checkDmsEndpointExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "kinesis_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_settings.0.include_control_details", "true"),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_settings.0.include_null_and_empty", "true"),
 					resource.TestCheckResourceAttr(resourceName, "kinesis_settings.0.message_format", "json"),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_settings.0.partition_include_schema_table", "true"),
 					resource.TestCheckResourceAttrPair(resourceName, "kinesis_settings.0.stream_arn", "aws_kinesis_stream.stream2", "arn"),
 				),
 			},
@@ -991,6 +1037,30 @@ resource "aws_dms_endpoint" "test" {
 `, rName, topic)
 }
 
+func dmsEndpointKafkaConfigSaslSsl(rName string, includeDetails bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "target"
+  engine_name   = "kafka"
+
+  kafka_settings {
+    broker                         = "ec2-12-345-678-901.compute-1.${data.aws_partition.current.dns_suffix}:2345"
+    include_control_details        = %[2]t
+    include_null_and_empty         = %[2]t
+    message_format                 = "json-unformatted"
+    partition_include_schema_table = %[2]t
+    sasl_password                  = "tftest-password"
+    sasl_username                  = "tftest"
+    security_protocol              = "sasl-ssl"
+    topic                          = "topic1"
+  }
+}
+`, rName, includeDetails)
+}
+
 func dmsEndpointKinesisConfig(randId string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -1069,8 +1139,11 @@ resource "aws_dms_endpoint" "dms_endpoint" {
   engine_name   = "kinesis"
 
   kinesis_settings {
-    service_access_role_arn = aws_iam_role.iam_role.arn
-    stream_arn              = aws_kinesis_stream.stream2.arn
+    include_control_details        = true
+    include_null_and_empty         = true
+    partition_include_schema_table = true
+    service_access_role_arn        = aws_iam_role.iam_role.arn
+    stream_arn                     = aws_kinesis_stream.stream2.arn
   }
 
   depends_on = [aws_iam_role_policy.dms_kinesis_access]
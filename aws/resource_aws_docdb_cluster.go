@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -89,6 +91,11 @@ func resourceAwsDocDBCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"global_cluster_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"reader_endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -353,23 +360,34 @@ func resourceAwsDocDBClusterCreate(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("Error creating DocDB Cluster: %s", err)
 		}
 	} else {
-		if _, ok := d.GetOk("master_password"); !ok {
-			return fmt.Errorf(`provider.aws: aws_docdb_cluster: %s: "master_password": required field is not set`, identifier)
-		}
-
-		if _, ok := d.GetOk("master_username"); !ok {
-			return fmt.Errorf(`provider.aws: aws_docdb_cluster: %s: "master_username": required field is not set`, identifier)
-		}
-
 		createOpts := &docdb.CreateDBClusterInput{
 			DBClusterIdentifier: aws.String(identifier),
 			Engine:              aws.String(d.Get("engine").(string)),
-			MasterUserPassword:  aws.String(d.Get("master_password").(string)),
-			MasterUsername:      aws.String(d.Get("master_username").(string)),
 			DeletionProtection:  aws.Bool(d.Get("deletion_protection").(bool)),
 			Tags:                tags,
 		}
 
+		// Secondary clusters in a global cluster inherit the master credentials from the primary.
+		if attr, ok := d.GetOk("global_cluster_identifier"); ok {
+			createOpts.GlobalClusterIdentifier = aws.String(attr.(string))
+		} else {
+			if _, ok := d.GetOk("master_password"); !ok {
+				return fmt.Errorf(`provider.aws: aws_docdb_cluster: %s: "master_password": required field is not set`, identifier)
+			}
+
+			if _, ok := d.GetOk("master_username"); !ok {
+				return fmt.Errorf(`provider.aws: aws_docdb_cluster: %s: "master_username": required field is not set`, identifier)
+			}
+		}
+
+		if attr, ok := d.GetOk("master_password"); ok {
+			createOpts.MasterUserPassword = aws.String(attr.(string))
+		}
+
+		if attr, ok := d.GetOk("master_username"); ok {
+			createOpts.MasterUsername = aws.String(attr.(string))
+		}
+
 		if attr, ok := d.GetOk("port"); ok {
 			createOpts.Port = aws.Int64(int64(attr.(int)))
 		}
@@ -578,6 +596,20 @@ func resourceAwsDocDBClusterRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	d.Set("global_cluster_identifier", "")
+
+	globalCluster, err := docdbDescribeGlobalClusterFromDbClusterARN(conn, aws.StringValue(dbc.DBClusterArn))
+
+	// Ignore the following API error for regions/partitions that do not support DocDB Global Clusters:
+	// InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases
+	if err != nil && !tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Access Denied to API Version: APIGlobalDatabases") {
+		return fmt.Errorf("error reading DocDB Global Cluster information for DocDB Cluster (%s): %w", d.Id(), err)
+	}
+
+	if globalCluster != nil {
+		d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+	}
+
 	return nil
 }
 
@@ -673,6 +705,24 @@ func resourceAwsDocDBClusterUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.HasChange("global_cluster_identifier") {
+		oRaw, nRaw := d.GetChange("global_cluster_identifier")
+		o := oRaw.(string)
+		n := nRaw.(string)
+
+		if o == "" {
+			return errors.New("Existing DocDB Clusters cannot be added to an existing DocDB Global Cluster")
+		}
+
+		if n != "" {
+			return errors.New("Existing DocDB Clusters cannot be migrated between existing DocDB Global Clusters")
+		}
+
+		if err := removeDocDBClusterFromGlobalCluster(conn, d.Get("arn").(string), o); err != nil {
+			return fmt.Errorf("error removing DocDB Cluster (%s) from DocDB Global Cluster: %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		o, n := d.GetChange("tags")
 
@@ -689,6 +739,14 @@ func resourceAwsDocDBClusterDelete(d *schema.ResourceData, meta interface{}) err
 	conn := meta.(*AWSClient).docdbconn
 	log.Printf("[DEBUG] Destroying DocDB Cluster (%s)", d.Id())
 
+	// Automatically remove from global cluster to bypass this error on deletion:
+	// InvalidDBClusterStateFault: This cluster is a part of a global cluster, please remove it from globalcluster first
+	if v := d.Get("global_cluster_identifier").(string); v != "" {
+		if err := removeDocDBClusterFromGlobalCluster(conn, d.Get("arn").(string), v); err != nil {
+			return fmt.Errorf("error removing DocDB Cluster (%s) from DocDB Global Cluster: %w", d.Id(), err)
+		}
+	}
+
 	deleteOpts := docdb.DeleteDBClusterInput{
 		DBClusterIdentifier: aws.String(d.Id()),
 	}
@@ -744,6 +802,26 @@ func resourceAwsDocDBClusterDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func removeDocDBClusterFromGlobalCluster(conn *docdb.DocDB, dbClusterARN, globalClusterID string) error {
+	input := &docdb.RemoveFromGlobalClusterInput{
+		DbClusterIdentifier:     aws.String(dbClusterARN),
+		GlobalClusterIdentifier: aws.String(globalClusterID),
+	}
+
+	log.Printf("[DEBUG] Removing DocDB Cluster from DocDB Global Cluster: %s", input)
+	_, err := conn.RemoveFromGlobalCluster(input)
+
+	if tfawserr.ErrCodeEquals(err, docdb.ErrCodeGlobalClusterNotFoundFault) || tfawserr.ErrMessageContains(err, "InvalidParameterValue", "is not found in global cluster") {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return waitForDocDBGlobalClusterRemoval(conn, dbClusterARN)
+}
+
 func resourceAwsDocDBClusterStateRefreshFunc(conn *docdb.DocDB, dbClusterIdentifier string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := conn.DescribeDBClusters(&docdb.DescribeDBClustersInput{
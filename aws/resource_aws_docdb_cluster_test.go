@@ -460,6 +460,47 @@ func TestAccAWSDocDBCluster_deleteProtection(t *testing.T) {
 	})
 }
 
+func TestAccAWSDocDBCluster_GlobalClusterIdentifier(t *testing.T) {
+	var dbCluster docdb.DBCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	globalClusterResourceName := "aws_docdb_global_cluster.test"
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDocDBGlobalCluster(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocDBClusterConfigGlobalClusterIdentifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocDBClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttrPair(resourceName, "global_cluster_identifier", globalClusterResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"cluster_identifier_prefix",
+					"final_snapshot_identifier",
+					"master_password",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccDocDBClusterConfigGlobalClusterIdentifierRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocDBClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_identifier", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDocDBClusterDestroy(s *terraform.State) error {
 	return testAccCheckDocDBClusterDestroyWithProvider(s, testAccProvider)
 }
@@ -865,3 +906,44 @@ resource "aws_docdb_cluster" "default" {
 }
 `, isProtected)
 }
+
+func testAccDocDBClusterConfigGlobalClusterIdentifier(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_global_cluster" "test" {
+  engine                    = "docdb"
+  engine_version            = "4.0.0" # Minimum supported version for Global Clusters
+  global_cluster_identifier = %[1]q
+}
+
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier              = %[1]q
+  db_cluster_parameter_group_name = "default.docdb4.0"
+  engine                          = aws_docdb_global_cluster.test.engine
+  engine_version                  = aws_docdb_global_cluster.test.engine_version
+  global_cluster_identifier       = aws_docdb_global_cluster.test.id
+  master_password                 = "mustbeeightcharacters"
+  master_username                 = "test"
+  skip_final_snapshot             = true
+}
+`, rName)
+}
+
+func testAccDocDBClusterConfigGlobalClusterIdentifierRemoved(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_global_cluster" "test" {
+  engine                    = "docdb"
+  engine_version            = "4.0.0" # Minimum supported version for Global Clusters
+  global_cluster_identifier = %[1]q
+}
+
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier              = %[1]q
+  db_cluster_parameter_group_name = "default.docdb4.0"
+  engine                          = aws_docdb_global_cluster.test.engine
+  engine_version                  = aws_docdb_global_cluster.test.engine_version
+  master_password                 = "mustbeeightcharacters"
+  master_username                 = "test"
+  skip_final_snapshot             = true
+}
+`, rName)
+}
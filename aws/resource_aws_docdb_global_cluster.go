@@ -0,0 +1,507 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	docdbGlobalClusterRemovalTimeout = 2 * time.Minute
+)
+
+func resourceAwsDocDBGlobalCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDocDBGlobalClusterCreate,
+		Read:   resourceAwsDocDBGlobalClusterRead,
+		Update: resourceAwsDocDBGlobalClusterUpdate,
+		Delete: resourceAwsDocDBGlobalClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"engine": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_db_cluster_identifier"},
+				ValidateFunc:  validateDocDBEngine(),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"global_cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"global_cluster_members": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_cluster_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_writer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"global_cluster_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_db_cluster_identifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"engine"},
+				RequiredWith:  []string{"force_destroy"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDocDBGlobalClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	input := &docdb.CreateGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(d.Get("global_cluster_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("database_name"); ok {
+		input.DatabaseName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("deletion_protection"); ok {
+		input.DeletionProtection = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("engine"); ok {
+		input.Engine = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_db_cluster_identifier"); ok {
+		input.SourceDBClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_encrypted"); ok {
+		input.StorageEncrypted = aws.Bool(v.(bool))
+	}
+
+	// Prevent the following error:
+	// InvalidParameterValue: When creating standalone global cluster, value for engineName should be specified
+	if input.Engine == nil && input.SourceDBClusterIdentifier == nil {
+		input.Engine = aws.String("docdb")
+	}
+
+	log.Printf("[DEBUG] Creating DocDB Global Cluster: %s", input)
+	output, err := conn.CreateGlobalCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating DocDB Global Cluster: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.GlobalCluster.GlobalClusterIdentifier))
+
+	if err := waitForDocDBGlobalClusterCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for DocDB Global Cluster (%s) availability: %w", d.Id(), err)
+	}
+
+	return resourceAwsDocDBGlobalClusterRead(d, meta)
+}
+
+func resourceAwsDocDBGlobalClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	globalCluster, err := docdbDescribeGlobalCluster(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, docdb.ErrCodeGlobalClusterNotFoundFault) {
+		log.Printf("[WARN] DocDB Global Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DocDB Global Cluster (%s): %w", d.Id(), err)
+	}
+
+	if globalCluster == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading DocDB Global Cluster (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] DocDB Global Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && (aws.StringValue(globalCluster.Status) == "deleting" || aws.StringValue(globalCluster.Status) == "deleted") {
+		log.Printf("[WARN] DocDB Global Cluster (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(globalCluster.Status))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", globalCluster.GlobalClusterArn)
+	d.Set("database_name", globalCluster.DatabaseName)
+	d.Set("deletion_protection", globalCluster.DeletionProtection)
+	d.Set("engine", globalCluster.Engine)
+	d.Set("engine_version", globalCluster.EngineVersion)
+	d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
+
+	if err := d.Set("global_cluster_members", flattenDocDBGlobalClusterMembers(globalCluster.GlobalClusterMembers)); err != nil {
+		return fmt.Errorf("error setting global_cluster_members: %w", err)
+	}
+
+	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	d.Set("status", globalCluster.Status)
+	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
+
+	return nil
+}
+
+func resourceAwsDocDBGlobalClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	if d.HasChange("deletion_protection") {
+		input := &docdb.ModifyGlobalClusterInput{
+			DeletionProtection:      aws.Bool(d.Get("deletion_protection").(bool)),
+			GlobalClusterIdentifier: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating DocDB Global Cluster (%s): %s", d.Id(), input)
+		_, err := conn.ModifyGlobalCluster(input)
+
+		if tfawserr.ErrCodeEquals(err, docdb.ErrCodeGlobalClusterNotFoundFault) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error updating DocDB Global Cluster (%s): %w", d.Id(), err)
+		}
+
+		if err := waitForDocDBGlobalClusterUpdate(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for DocDB Global Cluster (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDocDBGlobalClusterRead(d, meta)
+}
+
+func resourceAwsDocDBGlobalClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).docdbconn
+
+	if d.Get("force_destroy").(bool) {
+		for _, globalClusterMemberRaw := range d.Get("global_cluster_members").(*schema.Set).List() {
+			globalClusterMember, ok := globalClusterMemberRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			dbClusterArn, ok := globalClusterMember["db_cluster_arn"].(string)
+
+			if !ok {
+				continue
+			}
+
+			input := &docdb.RemoveFromGlobalClusterInput{
+				DbClusterIdentifier:     aws.String(dbClusterArn),
+				GlobalClusterIdentifier: aws.String(d.Id()),
+			}
+
+			_, err := conn.RemoveFromGlobalCluster(input)
+
+			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "is not found in global cluster") {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error removing DocDB Cluster (%s) from Global Cluster (%s): %w", dbClusterArn, d.Id(), err)
+			}
+
+			if err := waitForDocDBGlobalClusterRemoval(conn, dbClusterArn); err != nil {
+				return fmt.Errorf("error waiting for DocDB Cluster (%s) removal from DocDB Global Cluster (%s): %w", dbClusterArn, d.Id(), err)
+			}
+		}
+	}
+
+	input := &docdb.DeleteGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting DocDB Global Cluster (%s): %s", d.Id(), input)
+
+	// Allow for eventual consistency
+	// InvalidGlobalClusterStateFault: Global Cluster arn:aws:rds::123456789012:global-cluster:tf-acc-test-5618525093076697001-0 is not empty
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteGlobalCluster(input)
+
+		if tfawserr.ErrMessageContains(err, docdb.ErrCodeInvalidGlobalClusterStateFault, "is not empty") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteGlobalCluster(input)
+	}
+
+	if tfawserr.ErrCodeEquals(err, docdb.ErrCodeGlobalClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DocDB Global Cluster (%s): %w", d.Id(), err)
+	}
+
+	if err := waitForDocDBGlobalClusterDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for DocDB Global Cluster (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenDocDBGlobalClusterMembers(apiObjects []*docdb.GlobalClusterMember) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"db_cluster_arn": aws.StringValue(apiObject.DBClusterArn),
+			"is_writer":      aws.BoolValue(apiObject.IsWriter),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func docdbDescribeGlobalCluster(conn *docdb.DocDB, globalClusterID string) (*docdb.GlobalCluster, error) {
+	var globalCluster *docdb.GlobalCluster
+
+	input := &docdb.DescribeGlobalClustersInput{
+		GlobalClusterIdentifier: aws.String(globalClusterID),
+	}
+
+	log.Printf("[DEBUG] Reading DocDB Global Cluster (%s): %s", globalClusterID, input)
+	err := conn.DescribeGlobalClustersPages(input, func(page *docdb.DescribeGlobalClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, gc := range page.GlobalClusters {
+			if gc == nil {
+				continue
+			}
+
+			if aws.StringValue(gc.GlobalClusterIdentifier) == globalClusterID {
+				globalCluster = gc
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return globalCluster, err
+}
+
+func docdbDescribeGlobalClusterFromDbClusterARN(conn *docdb.DocDB, dbClusterARN string) (*docdb.GlobalCluster, error) {
+	var globalCluster *docdb.GlobalCluster
+
+	input := &docdb.DescribeGlobalClustersInput{
+		Filters: []*docdb.Filter{
+			{
+				Name:   aws.String("db-cluster-id"),
+				Values: []*string{aws.String(dbClusterARN)},
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Reading DocDB Global Clusters: %s", input)
+	err := conn.DescribeGlobalClustersPages(input, func(page *docdb.DescribeGlobalClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, gc := range page.GlobalClusters {
+			if gc == nil {
+				continue
+			}
+
+			for _, globalClusterMember := range gc.GlobalClusterMembers {
+				if aws.StringValue(globalClusterMember.DBClusterArn) == dbClusterARN {
+					globalCluster = gc
+					return false
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	return globalCluster, err
+}
+
+func docdbGlobalClusterRefreshFunc(conn *docdb.DocDB, globalClusterID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		globalCluster, err := docdbDescribeGlobalCluster(conn, globalClusterID)
+
+		if tfawserr.ErrCodeEquals(err, docdb.ErrCodeGlobalClusterNotFoundFault) {
+			return nil, "deleted", nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading DocDB Global Cluster (%s): %w", globalClusterID, err)
+		}
+
+		if globalCluster == nil {
+			return nil, "deleted", nil
+		}
+
+		return globalCluster, aws.StringValue(globalCluster.Status), nil
+	}
+}
+
+func waitForDocDBGlobalClusterCreation(conn *docdb.DocDB, globalClusterID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Refresh: docdbGlobalClusterRefreshFunc(conn, globalClusterID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for DocDB Global Cluster (%s) availability", globalClusterID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForDocDBGlobalClusterUpdate(conn *docdb.DocDB, globalClusterID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"modifying", "upgrading"},
+		Target:  []string{"available"},
+		Refresh: docdbGlobalClusterRefreshFunc(conn, globalClusterID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for DocDB Global Cluster (%s) availability", globalClusterID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForDocDBGlobalClusterDeletion(conn *docdb.DocDB, globalClusterID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"available",
+			"deleting",
+		},
+		Target:         []string{"deleted"},
+		Refresh:        docdbGlobalClusterRefreshFunc(conn, globalClusterID),
+		Timeout:        10 * time.Minute,
+		NotFoundChecks: 1,
+	}
+
+	log.Printf("[DEBUG] Waiting for DocDB Global Cluster (%s) deletion", globalClusterID)
+	_, err := stateConf.WaitForState()
+
+	if isResourceNotFoundError(err) {
+		return nil
+	}
+
+	return err
+}
+
+func waitForDocDBGlobalClusterRemoval(conn *docdb.DocDB, dbClusterIdentifier string) error {
+	var globalCluster *docdb.GlobalCluster
+	stillExistsErr := fmt.Errorf("DocDB Cluster still exists in DocDB Global Cluster")
+
+	err := resource.Retry(docdbGlobalClusterRemovalTimeout, func() *resource.RetryError {
+		var err error
+
+		globalCluster, err = docdbDescribeGlobalClusterFromDbClusterARN(conn, dbClusterIdentifier)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if globalCluster != nil {
+			return resource.RetryableError(stillExistsErr)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = docdbDescribeGlobalClusterFromDbClusterARN(conn, dbClusterIdentifier)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if globalCluster != nil {
+		return stillExistsErr
+	}
+
+	return nil
+}
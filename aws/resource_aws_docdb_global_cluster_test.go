@@ -0,0 +1,379 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_docdb_global_cluster", &resource.Sweeper{
+		Name: "aws_docdb_global_cluster",
+		F:    testSweepDocDBGlobalClusters,
+		Dependencies: []string{
+			"aws_docdb_cluster",
+		},
+	})
+}
+
+func testSweepDocDBGlobalClusters(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*AWSClient).docdbconn
+	input := &docdb.DescribeGlobalClustersInput{}
+
+	err = conn.DescribeGlobalClustersPages(input, func(out *docdb.DescribeGlobalClustersOutput, lastPage bool) bool {
+		for _, globalCluster := range out.GlobalClusters {
+			id := aws.StringValue(globalCluster.GlobalClusterIdentifier)
+			input := &docdb.DeleteGlobalClusterInput{
+				GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
+			}
+
+			log.Printf("[INFO] Deleting DocDB Global Cluster: %s", id)
+
+			_, err := conn.DeleteGlobalCluster(input)
+
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete DocDB Global Cluster (%s): %s", id, err)
+				continue
+			}
+
+			if err := waitForDocDBGlobalClusterDeletion(conn, id); err != nil {
+				log.Printf("[ERROR] Failure while waiting for DocDB Global Cluster (%s) to be deleted: %s", id, err)
+			}
+		}
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping DocDB Global Cluster sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving DocDB Global Clusters: %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSDocDBGlobalCluster_basic(t *testing.T) {
+	var globalCluster1 docdb.GlobalCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDocDBGlobalCluster(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBGlobalClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBGlobalClusterExists(resourceName, &globalCluster1),
+					testAccCheckResourceAttrGlobalARN(resourceName, "arn", "rds", fmt.Sprintf("global-cluster:%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "database_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "docdb"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_identifier", rName),
+					resource.TestMatchResourceAttr(resourceName, "global_cluster_resource_id", regexp.MustCompile(`cluster-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBGlobalCluster_disappears(t *testing.T) {
+	var globalCluster1 docdb.GlobalCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDocDBGlobalCluster(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBGlobalClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBGlobalClusterExists(resourceName, &globalCluster1),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDocDBGlobalCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBGlobalCluster_DeletionProtection(t *testing.T) {
+	var globalCluster1, globalCluster2 docdb.GlobalCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDocDBGlobalCluster(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBGlobalClusterConfigDeletionProtection(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBGlobalClusterExists(resourceName, &globalCluster1),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDocDBGlobalClusterConfigDeletionProtection(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBGlobalClusterExists(resourceName, &globalCluster2),
+					testAccCheckAWSDocDBGlobalClusterNotRecreated(&globalCluster1, &globalCluster2),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBGlobalCluster_SourceDbClusterIdentifier(t *testing.T) {
+	var globalCluster1 docdb.GlobalCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	clusterResourceName := "aws_docdb_cluster.test"
+	resourceName := "aws_docdb_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDocDBGlobalCluster(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBGlobalClusterConfigSourceDbClusterIdentifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBGlobalClusterExists(resourceName, &globalCluster1),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_cluster_identifier", clusterResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "source_db_cluster_identifier"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBGlobalCluster_StorageEncrypted(t *testing.T) {
+	var globalCluster1, globalCluster2 docdb.GlobalCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_docdb_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDocDBGlobalCluster(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBGlobalClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBGlobalClusterConfigStorageEncrypted(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBGlobalClusterExists(resourceName, &globalCluster1),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDocDBGlobalClusterConfigStorageEncrypted(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBGlobalClusterExists(resourceName, &globalCluster2),
+					testAccCheckAWSDocDBGlobalClusterRecreated(&globalCluster1, &globalCluster2),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDocDBGlobalClusterExists(resourceName string, globalCluster *docdb.GlobalCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DocDB Global Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+		cluster, err := docdbDescribeGlobalCluster(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if cluster == nil {
+			return fmt.Errorf("DocDB Global Cluster not found")
+		}
+
+		if aws.StringValue(cluster.Status) != "available" {
+			return fmt.Errorf("DocDB Global Cluster (%s) exists in non-available (%s) state", rs.Primary.ID, aws.StringValue(cluster.Status))
+		}
+
+		*globalCluster = *cluster
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDocDBGlobalClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_docdb_global_cluster" {
+			continue
+		}
+
+		globalCluster, err := docdbDescribeGlobalCluster(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, docdb.ErrCodeGlobalClusterNotFoundFault) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if globalCluster == nil {
+			continue
+		}
+
+		return fmt.Errorf("DocDB Global Cluster (%s) still exists in non-deleted (%s) state", rs.Primary.ID, aws.StringValue(globalCluster.Status))
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDocDBGlobalClusterNotRecreated(i, j *docdb.GlobalCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.GlobalClusterResourceId) != aws.StringValue(j.GlobalClusterResourceId) {
+			return errors.New("DocDB Global Cluster was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDocDBGlobalClusterRecreated(i, j *docdb.GlobalCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.GlobalClusterResourceId) == aws.StringValue(j.GlobalClusterResourceId) {
+			return errors.New("DocDB Global Cluster was not recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccPreCheckAWSDocDBGlobalCluster(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+	input := &docdb.DescribeGlobalClustersInput{}
+
+	_, err := conn.DescribeGlobalClusters(input)
+
+	if testAccPreCheckSkipError(err) || tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Access Denied to API Version: APIGlobalDatabases") {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccAWSDocDBGlobalClusterConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_global_cluster" "test" {
+  engine                    = "docdb"
+  engine_version            = "4.0.0" # Minimum supported version for Global Clusters
+  global_cluster_identifier = %q
+}
+`, rName)
+}
+
+func testAccAWSDocDBGlobalClusterConfigDeletionProtection(rName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_global_cluster" "test" {
+  deletion_protection       = %[2]t
+  engine                    = "docdb"
+  engine_version            = "4.0.0" # Minimum supported version for Global Clusters
+  global_cluster_identifier = %[1]q
+}
+`, rName, deletionProtection)
+}
+
+func testAccAWSDocDBGlobalClusterConfigSourceDbClusterIdentifier(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier              = %[1]q
+  engine                          = "docdb"
+  engine_version                  = "4.0.0" # Minimum supported version for Global Clusters
+  db_cluster_parameter_group_name = "default.docdb4.0"
+  master_password                 = "mustbeeightcharacters"
+  master_username                 = "test"
+  skip_final_snapshot             = true
+
+  # global_cluster_identifier cannot be Computed
+
+  lifecycle {
+    ignore_changes = [global_cluster_identifier]
+  }
+}
+
+resource "aws_docdb_global_cluster" "test" {
+  force_destroy                = true
+  global_cluster_identifier    = %[1]q
+  source_db_cluster_identifier = aws_docdb_cluster.test.arn
+}
+`, rName)
+}
+
+func testAccAWSDocDBGlobalClusterConfigStorageEncrypted(rName string, storageEncrypted bool) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_global_cluster" "test" {
+  engine                    = "docdb"
+  engine_version            = "4.0.0" # Minimum supported version for Global Clusters
+  global_cluster_identifier = %[1]q
+  storage_encrypted         = %[2]t
+}
+`, rName, storageEncrypted)
+}
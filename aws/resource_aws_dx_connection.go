@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -39,11 +40,35 @@ func resourceAwsDxConnection() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateDxConnectionBandWidth(),
 			},
+			"encryption_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"must_encrypt",
+					"no_encrypt",
+					"should_encrypt",
+				}, false),
+			},
 			"location": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"macsec_capable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"port_encryption_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"request_macsec": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 			"jumbo_frame_capable": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -68,6 +93,7 @@ func resourceAwsDxConnectionCreate(d *schema.ResourceData, meta interface{}) err
 		Bandwidth:      aws.String(d.Get("bandwidth").(string)),
 		ConnectionName: aws.String(d.Get("name").(string)),
 		Location:       aws.String(d.Get("location").(string)),
+		RequestMACSec:  aws.Bool(d.Get("request_macsec").(bool)),
 	}
 
 	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
@@ -133,6 +159,12 @@ func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("jumbo_frame_capable", connection.JumboFrameCapable)
 	d.Set("has_logical_redundancy", connection.HasLogicalRedundancy)
 	d.Set("aws_device", connection.AwsDeviceV2)
+	d.Set("encryption_mode", connection.EncryptionMode)
+	d.Set("macsec_capable", connection.MacSecCapable)
+	d.Set("port_encryption_status", connection.PortEncryptionStatus)
+	// The API does not report whether MACsec was requested, so keep the configured value.
+	// Existing state and imported connections default to false.
+	d.Set("request_macsec", d.Get("request_macsec").(bool))
 
 	tags, err := keyvaluetags.DirectconnectListTags(conn, arn)
 
@@ -150,6 +182,18 @@ func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error
 func resourceAwsDxConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dxconn
 
+	if d.HasChange("encryption_mode") {
+		input := &directconnect.UpdateConnectionInput{
+			ConnectionId:   aws.String(d.Id()),
+			EncryptionMode: aws.String(d.Get("encryption_mode").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Direct Connect connection: %s", input)
+		if _, err := conn.UpdateConnection(input); err != nil {
+			return fmt.Errorf("error updating Direct Connect connection (%s) encryption_mode: %w", d.Id(), err)
+		}
+	}
+
 	arn := d.Get("arn").(string)
 	if d.HasChange("tags") {
 		o, n := d.GetChange("tags")
@@ -27,6 +27,8 @@ func TestAccAWSDxConnection_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", connectionName),
 					resource.TestCheckResourceAttr(resourceName, "bandwidth", "1Gbps"),
 					resource.TestCheckResourceAttr(resourceName, "location", "EqSe2-EQ"),
+					resource.TestCheckResourceAttr(resourceName, "macsec_capable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "request_macsec", "false"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
@@ -39,6 +41,37 @@ func TestAccAWSDxConnection_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDxConnection_RequestMACSec(t *testing.T) {
+	connectionName := fmt.Sprintf("tf-dx-%s", acctest.RandString(5))
+	resourceName := "aws_dx_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxConnectionConfig_requestMACSec(connectionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", connectionName),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth", "100Gbps"),
+					resource.TestCheckResourceAttr(resourceName, "macsec_capable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "request_macsec", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "encryption_mode"),
+					resource.TestCheckResourceAttrSet(resourceName, "port_encryption_status"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"request_macsec"},
+			},
+		},
+	})
+}
+
 func TestAccAWSDxConnection_tags(t *testing.T) {
 	connectionName := fmt.Sprintf("tf-dx-%s", acctest.RandString(5))
 	resourceName := "aws_dx_connection.test"
@@ -121,6 +154,17 @@ resource "aws_dx_connection" "test" {
 `, n)
 }
 
+func testAccDxConnectionConfig_requestMACSec(n string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_connection" "test" {
+  name           = %[1]q
+  bandwidth      = "100Gbps"
+  location       = "EqDA2"
+  request_macsec = true
+}
+`, n)
+}
+
 func testAccDxConnectionConfig_tags(n string) string {
 	return fmt.Sprintf(`
 resource "aws_dx_connection" "test" {
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	dxMacSecKeyStateAssociated     = "associated"
+	dxMacSecKeyStateAssociating    = "associating"
+	dxMacSecKeyStateDisassociated  = "disassociated"
+	dxMacSecKeyStateDisassociating = "disassociating"
+)
+
+func resourceAwsDxMacSecKeyAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxMacSecKeyAssociationCreate,
+		Read:   resourceAwsDxMacSecKeyAssociationRead,
+		Delete: resourceAwsDxMacSecKeyAssociationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cak": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"ckn"},
+				ExactlyOneOf: []string{"cak", "secret_arn"},
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-fA-F]{64}$`), "must be 64 hexadecimal characters"),
+			},
+			"ckn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"cak"},
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-fA-F]{64}$`), "must be 64 hexadecimal characters"),
+			},
+			"connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cak", "secret_arn"},
+				ValidateFunc: validateArn,
+			},
+			"start_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDxMacSecKeyAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	connectionID := d.Get("connection_id").(string)
+	input := &directconnect.AssociateMacSecKeyInput{
+		ConnectionId: aws.String(connectionID),
+	}
+
+	if v, ok := d.GetOk("cak"); ok {
+		input.Cak = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ckn"); ok {
+		input.Ckn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("secret_arn"); ok {
+		input.SecretARN = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Direct Connect MACsec key association: %s", connectionID)
+	output, err := conn.AssociateMacSecKey(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Direct Connect MACsec key association (%s): %w", connectionID, err)
+	}
+
+	// The response contains every key associated with the connection.
+	var secretARN string
+	for _, key := range output.MacSecKeys {
+		if v, ok := d.GetOk("secret_arn"); ok && aws.StringValue(key.SecretARN) == v.(string) {
+			secretARN = aws.StringValue(key.SecretARN)
+			break
+		}
+
+		if v, ok := d.GetOk("ckn"); ok && aws.StringValue(key.Ckn) == v.(string) {
+			secretARN = aws.StringValue(key.SecretARN)
+			break
+		}
+	}
+
+	if secretARN == "" {
+		return fmt.Errorf("error creating Direct Connect MACsec key association (%s): key not found in response", connectionID)
+	}
+
+	d.SetId(dxMacSecKeyAssociationCreateID(connectionID, secretARN))
+
+	return resourceAwsDxMacSecKeyAssociationRead(d, meta)
+}
+
+func resourceAwsDxMacSecKeyAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	connectionID, secretARN, err := dxMacSecKeyAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	key, err := dxMacSecKeyByConnectionIDAndSecretARN(conn, connectionID, secretARN)
+
+	if !d.IsNewResource() && isNoSuchDxConnectionErr(err) {
+		log.Printf("[WARN] Direct Connect MACsec key association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Direct Connect MACsec key association (%s): %w", d.Id(), err)
+	}
+
+	if key == nil || aws.StringValue(key.State) == dxMacSecKeyStateDisassociated {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Direct Connect MACsec key association (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Direct Connect MACsec key association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ckn", key.Ckn)
+	d.Set("connection_id", connectionID)
+	d.Set("secret_arn", key.SecretARN)
+	d.Set("start_on", key.StartOn)
+	d.Set("state", key.State)
+
+	return nil
+}
+
+func resourceAwsDxMacSecKeyAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	connectionID, secretARN, err := dxMacSecKeyAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Direct Connect MACsec key association: %s", d.Id())
+	_, err = conn.DisassociateMacSecKey(&directconnect.DisassociateMacSecKeyInput{
+		ConnectionId: aws.String(connectionID),
+		SecretARN:    aws.String(secretARN),
+	})
+
+	if isNoSuchDxConnectionErr(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Direct Connect MACsec key association (%s): %w", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dxMacSecKeyStateAssociated, dxMacSecKeyStateAssociating, dxMacSecKeyStateDisassociating},
+		Target:     []string{dxMacSecKeyStateDisassociated},
+		Refresh:    dxMacSecKeyAssociationRefreshStateFunc(conn, connectionID, secretARN),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Direct Connect MACsec key association (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func dxMacSecKeyAssociationRefreshStateFunc(conn *directconnect.DirectConnect, connectionID, secretARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		key, err := dxMacSecKeyByConnectionIDAndSecretARN(conn, connectionID, secretARN)
+
+		if isNoSuchDxConnectionErr(err) {
+			return "", dxMacSecKeyStateDisassociated, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The key is removed from the connection once fully disassociated.
+		if key == nil {
+			return "", dxMacSecKeyStateDisassociated, nil
+		}
+
+		return key, aws.StringValue(key.State), nil
+	}
+}
+
+// dxMacSecKeyByConnectionIDAndSecretARN returns the MACsec key with the specified secret ARN
+// associated with the specified connection, or nil if no such key is associated.
+func dxMacSecKeyByConnectionIDAndSecretARN(conn *directconnect.DirectConnect, connectionID, secretARN string) (*directconnect.MacSecKey, error) {
+	output, err := conn.DescribeConnections(&directconnect.DescribeConnectionsInput{
+		ConnectionId: aws.String(connectionID),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, connection := range output.Connections {
+		if aws.StringValue(connection.ConnectionId) != connectionID {
+			continue
+		}
+
+		for _, key := range connection.MacSecKeys {
+			if aws.StringValue(key.SecretARN) == secretARN {
+				return key, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+const dxMacSecKeyAssociationIDSeparator = "/"
+
+func dxMacSecKeyAssociationCreateID(connectionID, secretARN string) string {
+	parts := []string{connectionID, secretARN}
+	id := strings.Join(parts, dxMacSecKeyAssociationIDSeparator)
+
+	return id
+}
+
+func dxMacSecKeyAssociationParseID(id string) (string, string, error) {
+	// Secret ARNs may contain the separator so only split on its first occurrence.
+	parts := strings.SplitN(id, dxMacSecKeyAssociationIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CONNECTION-ID%[2]sSECRET-ARN", id, dxMacSecKeyAssociationIDSeparator)
+}
@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAwsDxMacSecKeyAssociation_withCkn(t *testing.T) {
+	key := "DX_CONNECTION_ID"
+	connectionId := os.Getenv(key)
+	if connectionId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_dx_macsec_key_association.test"
+	ckn := testAccDxMacSecKeyAssociationRandomHex()
+	cak := testAccDxMacSecKeyAssociationRandomHex()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxMacSecKeyAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxMacSecKeyAssociationConfigWithCkn(connectionId, ckn, cak),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxMacSecKeyAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_id", connectionId),
+					resource.TestCheckResourceAttr(resourceName, "ckn", ckn),
+					testAccMatchResourceAttrRegionalARN(resourceName, "secret_arn", "secretsmanager", regexp.MustCompile(`secret:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "state", dxMacSecKeyStateAssociated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsDxMacSecKeyAssociation_withSecret(t *testing.T) {
+	key := "DX_CONNECTION_ID"
+	connectionId := os.Getenv(key)
+	if connectionId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	key = "DX_MACSEC_SECRET_ARN"
+	secretArn := os.Getenv(key)
+	if secretArn == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_dx_macsec_key_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxMacSecKeyAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxMacSecKeyAssociationConfigWithSecret(connectionId, secretArn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxMacSecKeyAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_id", connectionId),
+					resource.TestCheckResourceAttrSet(resourceName, "ckn"),
+					resource.TestCheckResourceAttr(resourceName, "secret_arn", secretArn),
+					resource.TestCheckResourceAttr(resourceName, "state", dxMacSecKeyStateAssociated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxMacSecKeyAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_macsec_key_association" {
+			continue
+		}
+
+		connectionID, secretARN, err := dxMacSecKeyAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		key, err := dxMacSecKeyByConnectionIDAndSecretARN(conn, connectionID, secretARN)
+
+		if isNoSuchDxConnectionErr(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if key == nil || aws.StringValue(key.State) == dxMacSecKeyStateDisassociated {
+			continue
+		}
+
+		return fmt.Errorf("Direct Connect MACsec key association (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDxMacSecKeyAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Direct Connect MACsec key association ID is set")
+		}
+
+		connectionID, secretARN, err := dxMacSecKeyAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+		key, err := dxMacSecKeyByConnectionIDAndSecretARN(conn, connectionID, secretARN)
+
+		if err != nil {
+			return err
+		}
+
+		if key == nil {
+			return fmt.Errorf("Direct Connect MACsec key association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccDxMacSecKeyAssociationRandomHex returns a random 64 character hexadecimal string.
+func testAccDxMacSecKeyAssociationRandomHex() string {
+	return acctest.RandStringFromCharSet(64, "0123456789abcdef")
+}
+
+func testAccDxMacSecKeyAssociationConfigWithCkn(connectionId, ckn, cak string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_macsec_key_association" "test" {
+  connection_id = %[1]q
+  ckn           = %[2]q
+  cak           = %[3]q
+}
+`, connectionId, ckn, cak)
+}
+
+func testAccDxMacSecKeyAssociationConfigWithSecret(connectionId, secretArn string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_macsec_key_association" "test" {
+  connection_id = %[1]q
+  secret_arn    = %[2]q
+}
+`, connectionId, secretArn)
+}
@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+)
+
+func resourceAwsEbsFastSnapshotRestore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEbsFastSnapshotRestoreCreate,
+		Read:   resourceAwsEbsFastSnapshotRestoreRead,
+		Delete: resourceAwsEbsFastSnapshotRestoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.EbsFastSnapshotRestoreEnabledTimeout),
+			Delete: schema.DefaultTimeout(waiter.EbsFastSnapshotRestoreDisabledTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEbsFastSnapshotRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	availabilityZone := d.Get("availability_zone").(string)
+	snapshotID := d.Get("snapshot_id").(string)
+	id := tfec2.EbsFastSnapshotRestoreCreateID(availabilityZone, snapshotID)
+
+	input := &ec2.EnableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice([]string{availabilityZone}),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	}
+
+	log.Printf("[DEBUG] Enabling EBS Fast Snapshot Restore: %s", input)
+	output, err := conn.EnableFastSnapshotRestores(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling EBS Fast Snapshot Restore (%s): %w", id, err)
+	}
+
+	if output != nil {
+		if err := ebsFastSnapshotRestoreEnableErrors(output.Unsuccessful); err != nil {
+			return fmt.Errorf("error enabling EBS Fast Snapshot Restore (%s): %w", id, err)
+		}
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.EbsFastSnapshotRestoreEnabled(conn, availabilityZone, snapshotID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EBS Fast Snapshot Restore (%s) to be enabled: %w", d.Id(), err)
+	}
+
+	return resourceAwsEbsFastSnapshotRestoreRead(d, meta)
+}
+
+func resourceAwsEbsFastSnapshotRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	availabilityZone, snapshotID, err := tfec2.EbsFastSnapshotRestoreParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	fastSnapshotRestore, err := finder.EbsFastSnapshotRestore(conn, availabilityZone, snapshotID)
+
+	if err != nil {
+		return fmt.Errorf("error reading EBS Fast Snapshot Restore (%s): %w", d.Id(), err)
+	}
+
+	if fastSnapshotRestore == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading EBS Fast Snapshot Restore (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] EBS Fast Snapshot Restore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if state := aws.StringValue(fastSnapshotRestore.State); state == ec2.FastSnapshotRestoreStateCodeDisabling || state == ec2.FastSnapshotRestoreStateCodeDisabled {
+		log.Printf("[WARN] EBS Fast Snapshot Restore (%s) in deleted state (%s), removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("availability_zone", fastSnapshotRestore.AvailabilityZone)
+	d.Set("snapshot_id", fastSnapshotRestore.SnapshotId)
+	d.Set("state", fastSnapshotRestore.State)
+
+	return nil
+}
+
+func resourceAwsEbsFastSnapshotRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	availabilityZone, snapshotID, err := tfec2.EbsFastSnapshotRestoreParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Disabling EBS Fast Snapshot Restore: %s", d.Id())
+	output, err := conn.DisableFastSnapshotRestores(&ec2.DisableFastSnapshotRestoresInput{
+		AvailabilityZones: aws.StringSlice([]string{availabilityZone}),
+		SourceSnapshotIds: aws.StringSlice([]string{snapshotID}),
+	})
+
+	if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling EBS Fast Snapshot Restore (%s): %w", d.Id(), err)
+	}
+
+	if output != nil {
+		if err := ebsFastSnapshotRestoreDisableErrors(output.Unsuccessful); err != nil {
+			return fmt.Errorf("error disabling EBS Fast Snapshot Restore (%s): %w", d.Id(), err)
+		}
+	}
+
+	if _, err := waiter.EbsFastSnapshotRestoreDisabled(conn, availabilityZone, snapshotID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EBS Fast Snapshot Restore (%s) to be disabled: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// ebsFastSnapshotRestoreEnableErrors returns the per-Availability Zone errors from an EnableFastSnapshotRestores call.
+func ebsFastSnapshotRestoreEnableErrors(items []*ec2.EnableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		for _, stateError := range item.FastSnapshotRestoreStateErrors {
+			if stateError == nil || stateError.Error == nil {
+				continue
+			}
+
+			errors = multierror.Append(errors, fmt.Errorf("%s (%s): %s: %s", aws.StringValue(item.SnapshotId), aws.StringValue(stateError.AvailabilityZone), aws.StringValue(stateError.Error.Code), aws.StringValue(stateError.Error.Message)))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
+// ebsFastSnapshotRestoreDisableErrors returns the per-Availability Zone errors from a DisableFastSnapshotRestores call.
+func ebsFastSnapshotRestoreDisableErrors(items []*ec2.DisableFastSnapshotRestoreErrorItem) error {
+	var errors *multierror.Error
+
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		for _, stateError := range item.FastSnapshotRestoreStateErrors {
+			if stateError == nil || stateError.Error == nil {
+				continue
+			}
+
+			errors = multierror.Append(errors, fmt.Errorf("%s (%s): %s: %s", aws.StringValue(item.SnapshotId), aws.StringValue(stateError.AvailabilityZone), aws.StringValue(stateError.Error.Code), aws.StringValue(stateError.Error.Message)))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+)
+
+func TestAccAWSEBSFastSnapshotRestore_basic(t *testing.T) {
+	var v ec2.DescribeFastSnapshotRestoreSuccessItem
+	resourceName := "aws_ebs_fast_snapshot_restore.test"
+	availabilityZoneDataSourceName := "data.aws_availability_zones.available"
+	snapshotResourceName := "aws_ebs_snapshot.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEBSFastSnapshotRestoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEBSFastSnapshotRestoreConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEBSFastSnapshotRestoreExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", availabilityZoneDataSourceName, "names.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", snapshotResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEBSFastSnapshotRestore_disappears(t *testing.T) {
+	var v ec2.DescribeFastSnapshotRestoreSuccessItem
+	resourceName := "aws_ebs_fast_snapshot_restore.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEBSFastSnapshotRestoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEBSFastSnapshotRestoreConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEBSFastSnapshotRestoreExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEbsFastSnapshotRestore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEBSFastSnapshotRestoreDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ebs_fast_snapshot_restore" {
+			continue
+		}
+
+		availabilityZone, snapshotID, err := tfec2.EbsFastSnapshotRestoreParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.EbsFastSnapshotRestore(conn, availabilityZone, snapshotID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.State) == ec2.FastSnapshotRestoreStateCodeDisabled {
+			continue
+		}
+
+		return fmt.Errorf("EBS Fast Snapshot Restore %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEBSFastSnapshotRestoreExists(n string, v *ec2.DescribeFastSnapshotRestoreSuccessItem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EBS Fast Snapshot Restore ID is set")
+		}
+
+		availabilityZone, snapshotID, err := tfec2.EbsFastSnapshotRestoreParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.EbsFastSnapshotRestore(conn, availabilityZone, snapshotID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EBS Fast Snapshot Restore %s not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSEBSFastSnapshotRestoreConfig(rName string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_fast_snapshot_restore" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  snapshot_id       = aws_ebs_snapshot.test.id
+}
+`, rName))
+}
@@ -29,6 +29,47 @@ func resourceAwsEc2ClientVpnEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"client_connect_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"lambda_function_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"client_login_banner_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"banner_text": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1400),
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"client_cidr_block": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -40,11 +81,30 @@ func resourceAwsEc2ClientVpnEndpoint() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"self_service_portal": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.SelfServicePortalDisabled,
+				ValidateFunc: validation.StringInSlice(ec2.SelfServicePortal_Values(), false),
+			},
 			"server_certificate_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: validateArn,
 			},
+			"session_timeout_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      24,
+				ValidateFunc: validation.IntInSlice([]int{8, 10, 12, 24}),
+			},
 			"split_tunnel": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -130,6 +190,11 @@ func resourceAwsEc2ClientVpnEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -139,12 +204,30 @@ func resourceAwsEc2ClientVpnEndpointCreate(d *schema.ResourceData, meta interfac
 
 	req := &ec2.CreateClientVpnEndpointInput{
 		ClientCidrBlock:      aws.String(d.Get("client_cidr_block").(string)),
+		SelfServicePortal:    aws.String(d.Get("self_service_portal").(string)),
 		ServerCertificateArn: aws.String(d.Get("server_certificate_arn").(string)),
+		SessionTimeoutHours:  aws.Int64(int64(d.Get("session_timeout_hours").(int))),
 		TransportProtocol:    aws.String(d.Get("transport_protocol").(string)),
 		SplitTunnel:          aws.Bool(d.Get("split_tunnel").(bool)),
 		TagSpecifications:    ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeClientVpnEndpoint),
 	}
 
+	if v, ok := d.GetOk("client_connect_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		req.ClientConnectOptions = expandEc2ClientConnectOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("client_login_banner_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		req.ClientLoginBannerOptions = expandEc2ClientLoginBannerOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		req.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("vpc_id"); ok {
+		req.VpcId = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		req.Description = aws.String(v.(string))
 	}
@@ -237,6 +320,26 @@ func resourceAwsEc2ClientVpnEndpointRead(d *schema.ResourceData, meta interface{
 		d.Set("status", result.ClientVpnEndpoints[0].Status.Code)
 	}
 	d.Set("split_tunnel", result.ClientVpnEndpoints[0].SplitTunnel)
+	d.Set("session_timeout_hours", result.ClientVpnEndpoints[0].SessionTimeoutHours)
+	d.Set("vpc_id", result.ClientVpnEndpoints[0].VpcId)
+
+	if aws.StringValue(result.ClientVpnEndpoints[0].SelfServicePortalUrl) != "" {
+		d.Set("self_service_portal", ec2.SelfServicePortalEnabled)
+	} else {
+		d.Set("self_service_portal", ec2.SelfServicePortalDisabled)
+	}
+
+	if err := d.Set("security_group_ids", aws.StringValueSlice(result.ClientVpnEndpoints[0].SecurityGroupIds)); err != nil {
+		return fmt.Errorf("error setting security_group_ids: %w", err)
+	}
+
+	if err := d.Set("client_connect_options", flattenEc2ClientConnectResponseOptions(result.ClientVpnEndpoints[0].ClientConnectOptions)); err != nil {
+		return fmt.Errorf("error setting client_connect_options: %w", err)
+	}
+
+	if err := d.Set("client_login_banner_options", flattenEc2ClientLoginBannerResponseOptions(result.ClientVpnEndpoints[0].ClientLoginBannerOptions)); err != nil {
+		return fmt.Errorf("error setting client_login_banner_options: %w", err)
+	}
 
 	err = d.Set("authentication_options", flattenAuthOptsConfig(result.ClientVpnEndpoints[0].AuthenticationOptions))
 	if err != nil {
@@ -283,6 +386,26 @@ func resourceAwsEc2ClientVpnEndpointUpdate(d *schema.ResourceData, meta interfac
 		ClientVpnEndpointId: aws.String(d.Id()),
 	}
 
+	if d.HasChange("client_connect_options") {
+		if v, ok := d.GetOk("client_connect_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			req.ClientConnectOptions = expandEc2ClientConnectOptions(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			req.ClientConnectOptions = &ec2.ClientConnectOptions{
+				Enabled: aws.Bool(false),
+			}
+		}
+	}
+
+	if d.HasChange("client_login_banner_options") {
+		if v, ok := d.GetOk("client_login_banner_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			req.ClientLoginBannerOptions = expandEc2ClientLoginBannerOptions(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			req.ClientLoginBannerOptions = &ec2.ClientLoginBannerOptions{
+				Enabled: aws.Bool(false),
+			}
+		}
+	}
+
 	if d.HasChange("description") {
 		req.Description = aws.String(d.Get("description").(string))
 	}
@@ -308,10 +431,29 @@ func resourceAwsEc2ClientVpnEndpointUpdate(d *schema.ResourceData, meta interfac
 		req.ServerCertificateArn = aws.String(d.Get("server_certificate_arn").(string))
 	}
 
+	if d.HasChange("self_service_portal") {
+		req.SelfServicePortal = aws.String(d.Get("self_service_portal").(string))
+	}
+
+	if d.HasChange("session_timeout_hours") {
+		req.SessionTimeoutHours = aws.Int64(int64(d.Get("session_timeout_hours").(int)))
+	}
+
 	if d.HasChange("split_tunnel") {
 		req.SplitTunnel = aws.Bool(d.Get("split_tunnel").(bool))
 	}
 
+	// The VPC and its security groups must be modified together.
+	if d.HasChanges("security_group_ids", "vpc_id") {
+		if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+			req.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("vpc_id"); ok {
+			req.VpcId = aws.String(v.(string))
+		}
+	}
+
 	if d.HasChange("connection_log_options") {
 		if v, ok := d.GetOk("connection_log_options"); ok {
 			connSet := v.([]interface{})
@@ -347,6 +489,78 @@ func resourceAwsEc2ClientVpnEndpointUpdate(d *schema.ResourceData, meta interfac
 	return resourceAwsEc2ClientVpnEndpointRead(d, meta)
 }
 
+func expandEc2ClientConnectOptions(tfMap map[string]interface{}) *ec2.ClientConnectOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.ClientConnectOptions{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["lambda_function_arn"].(string); ok && v != "" {
+		apiObject.LambdaFunctionArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEc2ClientConnectResponseOptions(apiObject *ec2.ClientConnectResponseOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.LambdaFunctionArn; v != nil {
+		tfMap["lambda_function_arn"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandEc2ClientLoginBannerOptions(tfMap map[string]interface{}) *ec2.ClientLoginBannerOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.ClientLoginBannerOptions{}
+
+	if v, ok := tfMap["banner_text"].(string); ok && v != "" {
+		apiObject.BannerText = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenEc2ClientLoginBannerResponseOptions(apiObject *ec2.ClientLoginBannerResponseOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BannerText; v != nil {
+		tfMap["banner_text"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenConnLoggingConfig(lopts *ec2.ConnectionLogResponseOptions) []map[string]interface{} {
 	m := make(map[string]interface{})
 	if lopts.CloudwatchLogGroup != nil {
@@ -93,6 +93,9 @@ func TestAccAwsEc2ClientVpn_serial(t *testing.T) {
 			"withDNSServers":    testAccAwsEc2ClientVpnEndpoint_withDNSServers,
 			"tags":              testAccAwsEc2ClientVpnEndpoint_tags,
 			"splitTunnel":       testAccAwsEc2ClientVpnEndpoint_splitTunnel,
+			"selfServicePortal": testAccAwsEc2ClientVpnEndpoint_selfServicePortal,
+			"sessionOptions":    testAccAwsEc2ClientVpnEndpoint_sessionOptions,
+			"vpcSecurityGroups": testAccAwsEc2ClientVpnEndpoint_vpcSecurityGroups,
 		},
 		"AuthorizationRule": {
 			"basic":      testAccAwsEc2ClientVpnAuthorizationRule_basic,
@@ -146,6 +149,12 @@ func testAccAwsEc2ClientVpnEndpoint_basic(t *testing.T) {
 					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`client-vpn-endpoint/cvpn-endpoint-.+`)),
 					resource.TestCheckResourceAttr(resourceName, "authentication_options.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "authentication_options.0.type", "certificate-authentication"),
+					resource.TestCheckResourceAttr(resourceName, "client_connect_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "client_connect_options.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "client_login_banner_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "client_login_banner_options.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "self_service_portal", "disabled"),
+					resource.TestCheckResourceAttr(resourceName, "session_timeout_hours", "24"),
 					resource.TestCheckResourceAttr(resourceName, "status", ec2.ClientVpnEndpointStatusCodePendingAssociate),
 				),
 			},
@@ -400,6 +409,116 @@ func testAccAwsEc2ClientVpnEndpoint_splitTunnel(t *testing.T) {
 	})
 }
 
+func testAccAwsEc2ClientVpnEndpoint_selfServicePortal(t *testing.T) {
+	var v ec2.ClientVpnEndpoint
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ec2_client_vpn_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckClientVPNSyncronize(t); testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnEndpointConfigSelfServicePortal(rName, "enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "self_service_portal", "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2ClientVpnEndpointConfigSelfServicePortal(rName, "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "self_service_portal", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsEc2ClientVpnEndpoint_sessionOptions(t *testing.T) {
+	var v ec2.ClientVpnEndpoint
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ec2_client_vpn_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckClientVPNSyncronize(t); testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnEndpointConfigSessionOptions(rName, 10, "Authorized users only"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "client_login_banner_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "client_login_banner_options.0.banner_text", "Authorized users only"),
+					resource.TestCheckResourceAttr(resourceName, "client_login_banner_options.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "session_timeout_hours", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2ClientVpnEndpointConfigSessionOptions(rName, 8, "Unauthorized access is prohibited"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "client_login_banner_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "client_login_banner_options.0.banner_text", "Unauthorized access is prohibited"),
+					resource.TestCheckResourceAttr(resourceName, "client_login_banner_options.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "session_timeout_hours", "8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsEc2ClientVpnEndpoint_vpcSecurityGroups(t *testing.T) {
+	var v ec2.ClientVpnEndpoint
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ec2_client_vpn_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckClientVPNSyncronize(t); testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnEndpointConfigVpcSecurityGroups(rName, "aws_security_group.test1.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_ids.*", "aws_security_group.test1", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2ClientVpnEndpointConfigVpcSecurityGroups(rName, "aws_security_group.test1.id, aws_security_group.test2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_ids.*", "aws_security_group.test1", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_ids.*", "aws_security_group.test2", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPreCheckClientVPNSyncronize(t *testing.T) {
 	sync.TestAccPreCheckSyncronize(t, testAccEc2ClientVpnEndpointSemaphore, "Client VPN")
 }
@@ -704,3 +823,92 @@ resource "aws_ec2_client_vpn_endpoint" "test" {
 }
 `, rName, splitTunnel)
 }
+
+func testAccEc2ClientVpnEndpointConfigSelfServicePortal(rName, selfServicePortal string) string {
+	return testAccEc2ClientVpnEndpointConfigAcmCertificateBase() + fmt.Sprintf(`
+resource "aws_iam_saml_provider" "default" {
+  name                   = %[1]q
+  saml_metadata_document = file("./test-fixtures/saml-metadata.xml")
+}
+
+resource "aws_ec2_client_vpn_endpoint" "test" {
+  client_cidr_block      = "10.0.0.0/16"
+  description            = %[1]q
+  self_service_portal    = %[2]q
+  server_certificate_arn = aws_acm_certificate.test.arn
+
+  authentication_options {
+    type              = "federated-authentication"
+    saml_provider_arn = aws_iam_saml_provider.default.arn
+  }
+
+  connection_log_options {
+    enabled = false
+  }
+}
+`, rName, selfServicePortal)
+}
+
+func testAccEc2ClientVpnEndpointConfigSessionOptions(rName string, sessionTimeoutHours int, bannerText string) string {
+	return testAccEc2ClientVpnEndpointConfigAcmCertificateBase() + fmt.Sprintf(`
+resource "aws_ec2_client_vpn_endpoint" "test" {
+  client_cidr_block      = "10.0.0.0/16"
+  description            = %[1]q
+  server_certificate_arn = aws_acm_certificate.test.arn
+  session_timeout_hours  = %[2]d
+
+  authentication_options {
+    type                       = "certificate-authentication"
+    root_certificate_chain_arn = aws_acm_certificate.test.arn
+  }
+
+  client_login_banner_options {
+    banner_text = %[3]q
+    enabled     = true
+  }
+
+  connection_log_options {
+    enabled = false
+  }
+}
+`, rName, sessionTimeoutHours, bannerText)
+}
+
+func testAccEc2ClientVpnEndpointConfigVpcSecurityGroups(rName, securityGroupIDs string) string {
+	return testAccEc2ClientVpnEndpointConfigAcmCertificateBase() + fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test1" {
+  name   = "%[1]s-1"
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_security_group" "test2" {
+  name   = "%[1]s-2"
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_ec2_client_vpn_endpoint" "test" {
+  client_cidr_block      = "10.0.0.0/16"
+  description            = %[1]q
+  security_group_ids     = [%[2]s]
+  server_certificate_arn = aws_acm_certificate.test.arn
+  vpc_id                 = aws_vpc.test.id
+
+  authentication_options {
+    type                       = "certificate-authentication"
+    root_certificate_chain_arn = aws_acm_certificate.test.arn
+  }
+
+  connection_log_options {
+    enabled = false
+  }
+}
+`, rName, securityGroupIDs)
+}
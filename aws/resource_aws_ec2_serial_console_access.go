@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAwsEc2SerialConsoleAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2SerialConsoleAccessCreate,
+		Read:   resourceAwsEc2SerialConsoleAccessRead,
+		Update: resourceAwsEc2SerialConsoleAccessUpdate,
+		Delete: resourceAwsEc2SerialConsoleAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2SerialConsoleAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	enabled := d.Get("enabled").(bool)
+	if err := setEc2SerialConsoleAccess(conn, enabled); err != nil {
+		return fmt.Errorf("error setting EC2 serial console access (%t): %w", enabled, err)
+	}
+
+	// The serial console access setting is scoped to the account and region.
+	d.SetId(meta.(*AWSClient).region)
+
+	return resourceAwsEc2SerialConsoleAccessRead(d, meta)
+}
+
+func resourceAwsEc2SerialConsoleAccessRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	output, err := conn.GetSerialConsoleAccessStatus(&ec2.GetSerialConsoleAccessStatusInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 serial console access: %w", err)
+	}
+
+	d.Set("enabled", aws.BoolValue(output.SerialConsoleAccessEnabled))
+
+	return nil
+}
+
+func resourceAwsEc2SerialConsoleAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	enabled := d.Get("enabled").(bool)
+	if err := setEc2SerialConsoleAccess(conn, enabled); err != nil {
+		return fmt.Errorf("error updating EC2 serial console access (%t): %w", enabled, err)
+	}
+
+	return resourceAwsEc2SerialConsoleAccessRead(d, meta)
+}
+
+func resourceAwsEc2SerialConsoleAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Removing the resource restores the AWS default of serial console access disabled.
+	if err := setEc2SerialConsoleAccess(conn, false); err != nil {
+		return fmt.Errorf("error disabling EC2 serial console access: %w", err)
+	}
+
+	return nil
+}
+
+func setEc2SerialConsoleAccess(conn *ec2.EC2, enabled bool) error {
+	var err error
+
+	if enabled {
+		_, err = conn.EnableSerialConsoleAccess(&ec2.EnableSerialConsoleAccessInput{})
+	} else {
+		_, err = conn.DisableSerialConsoleAccess(&ec2.DisableSerialConsoleAccessInput{})
+	}
+
+	return err
+}
@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSEc2SerialConsoleAccess_basic(t *testing.T) {
+	resourceName := "aws_ec2_serial_console_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2SerialConsoleAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2SerialConsoleAccessConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2SerialConsoleAccess(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2SerialConsoleAccessConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2SerialConsoleAccess(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2SerialConsoleAccessDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	output, err := conn.GetSerialConsoleAccessStatus(&ec2.GetSerialConsoleAccessStatusInput{})
+
+	if err != nil {
+		return err
+	}
+
+	if aws.BoolValue(output.SerialConsoleAccessEnabled) {
+		return fmt.Errorf("EC2 serial console access not disabled on resource removal")
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2SerialConsoleAccess(n string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := conn.GetSerialConsoleAccessStatus(&ec2.GetSerialConsoleAccessStatusInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if aws.BoolValue(output.SerialConsoleAccessEnabled) != enabled {
+			return fmt.Errorf("EC2 serial console access is not in expected state (%t)", enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEc2SerialConsoleAccessConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_serial_console_access" "test" {
+  enabled = %[1]t
+}
+`, enabled)
+}
@@ -3,10 +3,16 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsEc2Tag() *schema.Resource {
@@ -38,16 +44,6 @@ func resourceAwsEc2Tag() *schema.Resource {
 	}
 }
 
-func extractResourceIDAndKeyFromEc2TagID(id string) (string, string, error) {
-	parts := strings.SplitN(id, ",", 2)
-
-	if len(parts) != 2 {
-		return "", "", fmt.Errorf("Invalid resource ID; cannot look up resource: %s", id)
-	}
-
-	return parts[0], parts[1], nil
-}
-
 func resourceAwsEc2TagCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -59,26 +55,50 @@ func resourceAwsEc2TagCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating EC2 Tag (%s) for resource (%s): %w", key, resourceID, err)
 	}
 
-	d.SetId(fmt.Sprintf("%s,%s", resourceID, key))
+	d.SetId(tfec2.TagCreateID(resourceID, key))
 
 	return resourceAwsEc2TagRead(d, meta)
 }
 
 func resourceAwsEc2TagRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	resourceID, key, err := extractResourceIDAndKeyFromEc2TagID(d.Id())
+	resourceID, key, err := tfec2.TagParseID(d.Id())
 
 	if err != nil {
 		return err
 	}
 
-	exists, value, err := keyvaluetags.Ec2GetTag(conn, resourceID, key)
+	var exists bool
+	var value *string
+
+	// Tags are eventually consistent; retry a newly created tag until it is visible.
+	err = resource.Retry(waiter.PropagationTimeout, func() *resource.RetryError {
+		exists, value, err = keyvaluetags.Ec2GetTag(conn, resourceID, key)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if d.IsNewResource() && !exists {
+			return resource.RetryableError(fmt.Errorf("EC2 Tag (%s) for resource (%s) not found", key, resourceID))
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		exists, value, err = keyvaluetags.Ec2GetTag(conn, resourceID, key)
+	}
 
 	if err != nil {
 		return fmt.Errorf("error reading EC2 Tag (%s) for resource (%s): %w", key, resourceID, err)
 	}
 
 	if !exists {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading EC2 Tag (%s) for resource (%s): not found after creation", key, resourceID)
+		}
+
 		log.Printf("[WARN] EC2 Tag (%s) for resource (%s) not found, removing from state", key, resourceID)
 		d.SetId("")
 		return nil
@@ -93,7 +113,7 @@ func resourceAwsEc2TagRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsEc2TagUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	resourceID, key, err := extractResourceIDAndKeyFromEc2TagID(d.Id())
+	resourceID, key, err := tfec2.TagParseID(d.Id())
 
 	if err != nil {
 		return err
@@ -108,13 +128,30 @@ func resourceAwsEc2TagUpdate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsEc2TagDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	resourceID, key, err := extractResourceIDAndKeyFromEc2TagID(d.Id())
+	resourceID, key, err := tfec2.TagParseID(d.Id())
 
 	if err != nil {
 		return err
 	}
 
-	if err := keyvaluetags.Ec2UpdateTags(conn, resourceID, map[string]string{key: d.Get("value").(string)}, nil); err != nil {
+	// Only the key is specified so that the tag is removed regardless of its current value.
+	input := &ec2.DeleteTagsInput{
+		Resources: aws.StringSlice([]string{resourceID}),
+		Tags: []*ec2.Tag{
+			{
+				Key: aws.String(key),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Tag: %s", input)
+	_, err = conn.DeleteTags(input)
+
+	if tfawserr.ErrCodeContains(err, ".NotFound") {
+		return nil
+	}
+
+	if err != nil {
 		return fmt.Errorf("error deleting EC2 Tag (%s) for resource (%s): %w", key, resourceID, err)
 	}
 
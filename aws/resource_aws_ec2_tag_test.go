@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
 )
 
 func TestAccAWSEc2Tag_basic(t *testing.T) {
@@ -99,7 +100,7 @@ func testAccCheckEc2TagDestroy(s *terraform.State) error {
 			continue
 		}
 
-		resourceID, key, err := extractResourceIDAndKeyFromEc2TagID(rs.Primary.ID)
+		resourceID, key, err := tfec2.TagParseID(rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -130,7 +131,7 @@ func testAccCheckEc2TagExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		resourceID, key, err := extractResourceIDAndKeyFromEc2TagID(rs.Primary.ID)
+		resourceID, key, err := tfec2.TagParseID(rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -0,0 +1,317 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+)
+
+func TestAccAWSEc2TransitGatewayConnectPeer_basic(t *testing.T) {
+	var v ec2.TransitGatewayConnectPeer
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	connectResourceName := "aws_ec2_transit_gateway_connect.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectPeerExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`transit-gateway-connect-peer/tgw-connect-peer-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "bgp_asn"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "bgp_configuration.*", map[string]string{
+						"peer_address":            "169.254.200.1",
+						"transit_gateway_address": "169.254.200.2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "bgp_configuration.*", map[string]string{
+						"peer_address":            "169.254.200.1",
+						"transit_gateway_address": "169.254.200.3",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "bgp_configuration.0.transit_gateway_asn", transitGatewayResourceName, "amazon_side_asn"),
+					resource.TestCheckResourceAttrPair(resourceName, "connect_attachment_id", connectResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "inside_cidr_blocks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "inside_cidr_blocks.*", "169.254.200.0/29"),
+					resource.TestCheckResourceAttr(resourceName, "peer_address", "1.1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "transit_gateway_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayConnectPeer_disappears(t *testing.T) {
+	var v ec2.TransitGatewayConnectPeer
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectPeerExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEc2TransitGatewayConnectPeer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayConnectPeer_BgpAsn(t *testing.T) {
+	var v ec2.TransitGatewayConnectPeer
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerConfigBgpAsn(rName, "4200000001"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectPeerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bgp_asn", "4200000001"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_configuration.0.peer_asn", "4200000001"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayConnectPeer_TransitGatewayAddress(t *testing.T) {
+	var v ec2.TransitGatewayConnectPeer
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerConfigTransitGatewayAddress(rName, "10.20.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectPeerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_address", "10.20.0.10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayConnectPeer_Tags(t *testing.T) {
+	var v ec2.TransitGatewayConnectPeer
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectPeerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectPeerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEc2TransitGatewayConnectPeerConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayConnectPeerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TransitGatewayConnectPeerExists(n string, v *ec2.TransitGatewayConnectPeer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Connect Peer ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := finder.TransitGatewayConnectPeerByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 Transit Gateway Connect Peer (%s) not found", rs.Primary.ID)
+		}
+
+		if state := aws.StringValue(output.State); state != ec2.TransitGatewayConnectPeerStateAvailable {
+			return fmt.Errorf("EC2 Transit Gateway Connect Peer (%s) in incorrect state. Expected: %s, got: %s", rs.Primary.ID, ec2.TransitGatewayConnectPeerStateAvailable, state)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TransitGatewayConnectPeerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_connect_peer" {
+			continue
+		}
+
+		output, err := finder.TransitGatewayConnectPeerByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayConnectPeerIDNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			continue
+		}
+
+		if state := aws.StringValue(output.State); state != ec2.TransitGatewayConnectPeerStateDeleted {
+			return fmt.Errorf("EC2 Transit Gateway Connect Peer (%s) still exists in non-deleted (%s) state", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2TransitGatewayConnectPeerConfigBase(rName string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccAWSEc2TransitGatewayConnectPeerConfig(rName string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectPeerConfigBase(rName), `
+resource "aws_ec2_transit_gateway_connect_peer" "test" {
+  connect_attachment_id = aws_ec2_transit_gateway_connect.test.id
+  inside_cidr_blocks    = ["169.254.200.0/29"]
+  peer_address          = "1.1.1.1"
+}
+`)
+}
+
+func testAccAWSEc2TransitGatewayConnectPeerConfigBgpAsn(rName, bgpAsn string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectPeerConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect_peer" "test" {
+  bgp_asn               = %[1]q
+  connect_attachment_id = aws_ec2_transit_gateway_connect.test.id
+  inside_cidr_blocks    = ["169.254.200.0/29"]
+  peer_address          = "1.1.1.1"
+}
+`, bgpAsn))
+}
+
+func testAccAWSEc2TransitGatewayConnectPeerConfigTransitGatewayAddress(rName, transitGatewayAddress string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectPeerConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect_peer" "test" {
+  connect_attachment_id   = aws_ec2_transit_gateway_connect.test.id
+  inside_cidr_blocks      = ["169.254.200.0/29"]
+  peer_address            = "1.1.1.1"
+  transit_gateway_address = %[1]q
+}
+`, transitGatewayAddress))
+}
+
+func testAccAWSEc2TransitGatewayConnectPeerConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectPeerConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect_peer" "test" {
+  connect_attachment_id = aws_ec2_transit_gateway_connect.test.id
+  inside_cidr_blocks    = ["169.254.200.0/29"]
+  peer_address          = "1.1.1.1"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSEc2TransitGatewayConnectPeerConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSEc2TransitGatewayConnectPeerConfigBase(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect_peer" "test" {
+  connect_attachment_id = aws_ec2_transit_gateway_connect.test.id
+  inside_cidr_blocks    = ["169.254.200.0/29"]
+  peer_address          = "1.1.1.1"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
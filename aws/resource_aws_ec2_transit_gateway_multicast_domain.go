@@ -0,0 +1,321 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+)
+
+func resourceAwsEc2TransitGatewayMulticastDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayMulticastDomainCreate,
+		Read:   resourceAwsEc2TransitGatewayMulticastDomainRead,
+		Update: resourceAwsEc2TransitGatewayMulticastDomainUpdate,
+		Delete: resourceAwsEc2TransitGatewayMulticastDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_accept_shared_associations": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.AutoAcceptSharedAssociationsValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.AutoAcceptSharedAssociationsValue_Values(), false),
+			},
+			"igmpv2_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.Igmpv2SupportValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.Igmpv2SupportValue_Values(), false),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"static_sources_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.StaticSourcesSupportValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.StaticSourcesSupportValue_Values(), false),
+			},
+			"tags": tagsSchema(),
+			"transit_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateTransitGatewayMulticastDomainInput{
+		Options: &ec2.CreateTransitGatewayMulticastDomainRequestOptions{
+			AutoAcceptSharedAssociations: aws.String(d.Get("auto_accept_shared_associations").(string)),
+			Igmpv2Support:                aws.String(d.Get("igmpv2_support").(string)),
+			StaticSourcesSupport:         aws.String(d.Get("static_sources_support").(string)),
+		},
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeTransitGatewayMulticastDomain),
+		TransitGatewayId:  aws.String(d.Get("transit_gateway_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Multicast Domain: %s", input)
+	output, err := conn.CreateTransitGatewayMulticastDomain(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Multicast Domain: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayMulticastDomain.TransitGatewayMulticastDomainId))
+
+	if _, err := waiter.TransitGatewayMulticastDomainCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsEc2TransitGatewayMulticastDomainRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	multicastDomain, err := finder.TransitGatewayMulticastDomainByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s): %w", d.Id(), err)
+	}
+
+	if multicastDomain == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if state := aws.StringValue(multicastDomain.State); state == ec2.TransitGatewayMulticastDomainStateDeleting || state == ec2.TransitGatewayMulticastDomainStateDeleted {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain (%s) in deleted state (%s), removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", multicastDomain.TransitGatewayMulticastDomainArn)
+	if multicastDomain.Options != nil {
+		d.Set("auto_accept_shared_associations", multicastDomain.Options.AutoAcceptSharedAssociations)
+		d.Set("igmpv2_support", multicastDomain.Options.Igmpv2Support)
+		d.Set("static_sources_support", multicastDomain.Options.StaticSourcesSupport)
+	}
+	d.Set("owner_id", multicastDomain.OwnerId)
+	d.Set("transit_gateway_id", multicastDomain.TransitGatewayId)
+
+	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(multicastDomain.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Multicast Domain (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2TransitGatewayMulticastDomainRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	// Group members and sources must be deregistered and subnets disassociated before the domain can be deleted.
+	groups, err := finder.TransitGatewayMulticastGroups(conn, &ec2.SearchTransitGatewayMulticastGroupsInput{
+		TransitGatewayMulticastDomainId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s) groups: %w", d.Id(), err)
+	}
+
+	if err := deregisterEc2TransitGatewayMulticastGroups(conn, d.Id(), groups); err != nil {
+		return err
+	}
+
+	associations, err := finder.TransitGatewayMulticastDomainAssociations(conn, &ec2.GetTransitGatewayMulticastDomainAssociationsInput{
+		TransitGatewayMulticastDomainId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s) associations: %w", d.Id(), err)
+	}
+
+	for _, association := range associations {
+		if association.Subnet == nil {
+			continue
+		}
+
+		attachmentID := aws.StringValue(association.TransitGatewayAttachmentId)
+		subnetID := aws.StringValue(association.Subnet.SubnetId)
+
+		switch aws.StringValue(association.Subnet.State) {
+		case ec2.TransitGatewayMulitcastDomainAssociationStateDisassociated:
+			continue
+		case ec2.TransitGatewayMulitcastDomainAssociationStateDisassociating:
+			if _, err := waiter.TransitGatewayMulticastDomainAssociationDeleted(conn, d.Id(), attachmentID, subnetID); err != nil {
+				return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain Association (%s) to be deleted: %w", tfec2.TransitGatewayMulticastDomainAssociationCreateID(d.Id(), attachmentID, subnetID), err)
+			}
+		default:
+			if err := disassociateEc2TransitGatewayMulticastDomainSubnet(conn, d.Id(), attachmentID, subnetID); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Printf("[INFO] Deleting EC2 Transit Gateway Multicast Domain (%s)", d.Id())
+	_, err = conn.DeleteTransitGatewayMulticastDomain(&ec2.DeleteTransitGatewayMulticastDomainInput{
+		TransitGatewayMulticastDomainId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Multicast Domain (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.TransitGatewayMulticastDomainDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// deregisterEc2TransitGatewayMulticastGroups deregisters all static group members and sources in the specified groups.
+// Members and sources added via IGMP cannot be deregistered and are ignored.
+func deregisterEc2TransitGatewayMulticastGroups(conn *ec2.EC2, multicastDomainID string, groups []*ec2.TransitGatewayMulticastGroup) error {
+	for _, group := range groups {
+		groupIPAddress := aws.StringValue(group.GroupIpAddress)
+		networkInterfaceID := aws.StringValue(group.NetworkInterfaceId)
+
+		if aws.BoolValue(group.GroupMember) && aws.StringValue(group.MemberType) == ec2.MembershipTypeStatic {
+			if err := deregisterEc2TransitGatewayMulticastGroupMember(conn, multicastDomainID, groupIPAddress, networkInterfaceID); err != nil {
+				return err
+			}
+		}
+
+		if aws.BoolValue(group.GroupSource) && aws.StringValue(group.SourceType) == ec2.MembershipTypeStatic {
+			if err := deregisterEc2TransitGatewayMulticastGroupSource(conn, multicastDomainID, groupIPAddress, networkInterfaceID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func deregisterEc2TransitGatewayMulticastGroupMember(conn *ec2.EC2, multicastDomainID, groupIPAddress, networkInterfaceID string) error {
+	id := tfec2.TransitGatewayMulticastGroupRegistrationCreateID(multicastDomainID, groupIPAddress, networkInterfaceID)
+
+	log.Printf("[INFO] Deregistering EC2 Transit Gateway Multicast Group Member (%s)", id)
+	_, err := conn.DeregisterTransitGatewayMulticastGroupMembers(&ec2.DeregisterTransitGatewayMulticastGroupMembersInput{
+		GroupIpAddress:                  aws.String(groupIPAddress),
+		NetworkInterfaceIds:             aws.StringSlice([]string{networkInterfaceID}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering EC2 Transit Gateway Multicast Group Member (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func deregisterEc2TransitGatewayMulticastGroupSource(conn *ec2.EC2, multicastDomainID, groupIPAddress, networkInterfaceID string) error {
+	id := tfec2.TransitGatewayMulticastGroupRegistrationCreateID(multicastDomainID, groupIPAddress, networkInterfaceID)
+
+	log.Printf("[INFO] Deregistering EC2 Transit Gateway Multicast Group Source (%s)", id)
+	_, err := conn.DeregisterTransitGatewayMulticastGroupSources(&ec2.DeregisterTransitGatewayMulticastGroupSourcesInput{
+		GroupIpAddress:                  aws.String(groupIPAddress),
+		NetworkInterfaceIds:             aws.StringSlice([]string{networkInterfaceID}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering EC2 Transit Gateway Multicast Group Source (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func disassociateEc2TransitGatewayMulticastDomainSubnet(conn *ec2.EC2, multicastDomainID, attachmentID, subnetID string) error {
+	id := tfec2.TransitGatewayMulticastDomainAssociationCreateID(multicastDomainID, attachmentID, subnetID)
+
+	log.Printf("[INFO] Disassociating EC2 Transit Gateway Multicast Domain Association (%s)", id)
+	_, err := conn.DisassociateTransitGatewayMulticastDomain(&ec2.DisassociateTransitGatewayMulticastDomainInput{
+		SubnetIds:                       aws.StringSlice([]string{subnetID}),
+		TransitGatewayAttachmentId:      aws.String(attachmentID),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating EC2 Transit Gateway Multicast Domain Association (%s): %w", id, err)
+	}
+
+	if _, err := waiter.TransitGatewayMulticastDomainAssociationDeleted(conn, multicastDomainID, attachmentID, subnetID); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain Association (%s) to be deleted: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEc2TransitGatewayMulticastDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayMulticastDomainAssociationCreate,
+		Read:   resourceAwsEc2TransitGatewayMulticastDomainAssociationRead,
+		Delete: resourceAwsEc2TransitGatewayMulticastDomainAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"transit_gateway_attachment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"transit_gateway_multicast_domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID := d.Get("transit_gateway_multicast_domain_id").(string)
+	attachmentID := d.Get("transit_gateway_attachment_id").(string)
+	subnetID := d.Get("subnet_id").(string)
+	id := tfec2.TransitGatewayMulticastDomainAssociationCreateID(multicastDomainID, attachmentID, subnetID)
+
+	input := &ec2.AssociateTransitGatewayMulticastDomainInput{
+		SubnetIds:                       aws.StringSlice([]string{subnetID}),
+		TransitGatewayAttachmentId:      aws.String(attachmentID),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Multicast Domain Association: %s", input)
+	_, err := conn.AssociateTransitGatewayMulticastDomain(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Multicast Domain Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.TransitGatewayMulticastDomainAssociationCreated(conn, multicastDomainID, attachmentID, subnetID); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain Association (%s) to become associated: %w", d.Id(), err)
+	}
+
+	return resourceAwsEc2TransitGatewayMulticastDomainAssociationRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID, attachmentID, subnetID, err := tfec2.TransitGatewayMulticastDomainAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	var association *ec2.TransitGatewayMulticastDomainAssociation
+
+	err = resource.Retry(waiter.PropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		association, err = finder.TransitGatewayMulticastDomainAssociation(conn, multicastDomainID, attachmentID, subnetID)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if d.IsNewResource() && association == nil {
+			return resource.RetryableError(&resource.NotFoundError{})
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		association, err = finder.TransitGatewayMulticastDomainAssociation(conn, multicastDomainID, attachmentID, subnetID)
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain Association (%s): %w", d.Id(), err)
+	}
+
+	if association == nil || association.Subnet == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain Association (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if state := aws.StringValue(association.Subnet.State); state == ec2.TransitGatewayMulitcastDomainAssociationStateDisassociating || state == ec2.TransitGatewayMulitcastDomainAssociationStateDisassociated {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain Association (%s) in deleted state (%s), removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("subnet_id", association.Subnet.SubnetId)
+	d.Set("transit_gateway_attachment_id", association.TransitGatewayAttachmentId)
+	d.Set("transit_gateway_multicast_domain_id", multicastDomainID)
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayMulticastDomainAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID, attachmentID, subnetID, err := tfec2.TransitGatewayMulticastDomainAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// Group members and sources in the subnet must be deregistered before it can be disassociated.
+	groups, err := finder.TransitGatewayMulticastGroups(conn, &ec2.SearchTransitGatewayMulticastGroupsInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"transit-gateway-attachment-id": attachmentID,
+		}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s) groups: %w", multicastDomainID, err)
+	}
+
+	var subnetGroups []*ec2.TransitGatewayMulticastGroup
+
+	for _, group := range groups {
+		if aws.StringValue(group.SubnetId) == subnetID {
+			subnetGroups = append(subnetGroups, group)
+		}
+	}
+
+	if err := deregisterEc2TransitGatewayMulticastGroups(conn, multicastDomainID, subnetGroups); err != nil {
+		return err
+	}
+
+	return disassociateEc2TransitGatewayMulticastDomainSubnet(conn, multicastDomainID, attachmentID, subnetID)
+}
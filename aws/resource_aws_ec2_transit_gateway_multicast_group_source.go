@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEc2TransitGatewayMulticastGroupSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TransitGatewayMulticastGroupSourceCreate,
+		Read:   resourceAwsEc2TransitGatewayMulticastGroupSourceRead,
+		Delete: resourceAwsEc2TransitGatewayMulticastGroupSourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"transit_gateway_multicast_domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TransitGatewayMulticastGroupSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID := d.Get("transit_gateway_multicast_domain_id").(string)
+	groupIPAddress := d.Get("group_ip_address").(string)
+	networkInterfaceID := d.Get("network_interface_id").(string)
+	id := tfec2.TransitGatewayMulticastGroupRegistrationCreateID(multicastDomainID, groupIPAddress, networkInterfaceID)
+
+	input := &ec2.RegisterTransitGatewayMulticastGroupSourcesInput{
+		GroupIpAddress:                  aws.String(groupIPAddress),
+		NetworkInterfaceIds:             aws.StringSlice([]string{networkInterfaceID}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Multicast Group Source: %s", input)
+	_, err := conn.RegisterTransitGatewayMulticastGroupSources(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Multicast Group Source (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsEc2TransitGatewayMulticastGroupSourceRead(d, meta)
+}
+
+func resourceAwsEc2TransitGatewayMulticastGroupSourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID, groupIPAddress, networkInterfaceID, err := tfec2.TransitGatewayMulticastGroupRegistrationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	var source *ec2.TransitGatewayMulticastGroup
+
+	// Registrations are not immediately visible.
+	err = resource.Retry(waiter.PropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		source, err = finder.TransitGatewayMulticastGroupSource(conn, multicastDomainID, groupIPAddress, networkInterfaceID)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if d.IsNewResource() && source == nil {
+			return resource.RetryableError(&resource.NotFoundError{})
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		source, err = finder.TransitGatewayMulticastGroupSource(conn, multicastDomainID, groupIPAddress, networkInterfaceID)
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Group Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Group Source (%s): %w", d.Id(), err)
+	}
+
+	if source == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading EC2 Transit Gateway Multicast Group Source (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Group Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_ip_address", source.GroupIpAddress)
+	d.Set("network_interface_id", source.NetworkInterfaceId)
+	d.Set("transit_gateway_multicast_domain_id", multicastDomainID)
+
+	return nil
+}
+
+func resourceAwsEc2TransitGatewayMulticastGroupSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	multicastDomainID, groupIPAddress, networkInterfaceID, err := tfec2.TransitGatewayMulticastGroupRegistrationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	return deregisterEc2TransitGatewayMulticastGroupSource(conn, multicastDomainID, groupIPAddress, networkInterfaceID)
+}
@@ -7,10 +7,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsEcsCapacityProvider() *schema.Resource {
@@ -36,7 +39,6 @@ func resourceAwsEcsCapacityProvider() *schema.Resource {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"auto_scaling_group_arn": {
@@ -49,7 +51,6 @@ func resourceAwsEcsCapacityProvider() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								ecs.ManagedTerminationProtectionEnabled,
 								ecs.ManagedTerminationProtectionDisabled,
@@ -60,28 +61,30 @@ func resourceAwsEcsCapacityProvider() *schema.Resource {
 							MaxItems: 1,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"instance_warmup_period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      300,
+										ValidateFunc: validation.IntBetween(0, 10000),
+									},
 									"maximum_scaling_step_size": {
 										Type:         schema.TypeInt,
 										Optional:     true,
 										Computed:     true,
-										ForceNew:     true,
 										ValidateFunc: validation.IntBetween(1, 10000),
 									},
 									"minimum_scaling_step_size": {
 										Type:         schema.TypeInt,
 										Optional:     true,
 										Computed:     true,
-										ForceNew:     true,
 										ValidateFunc: validation.IntBetween(1, 10000),
 									},
 									"status": {
 										Type:     schema.TypeString,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 										ValidateFunc: validation.StringInSlice([]string{
 											ecs.ManagedScalingStatusEnabled,
 											ecs.ManagedScalingStatusDisabled,
@@ -90,7 +93,6 @@ func resourceAwsEcsCapacityProvider() *schema.Resource {
 										Type:         schema.TypeInt,
 										Optional:     true,
 										Computed:     true,
-										ForceNew:     true,
 										ValidateFunc: validation.IntBetween(1, 100),
 									},
 								},
@@ -183,6 +185,40 @@ func resourceAwsEcsCapacityProviderRead(d *schema.ResourceData, meta interface{}
 func resourceAwsEcsCapacityProviderUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
+	if d.HasChangesExcept("tags") {
+		input := &ecs.UpdateCapacityProviderInput{
+			AutoScalingGroupProvider: expandAutoScalingGroupProviderUpdate(d.Get("auto_scaling_group_provider")),
+			Name:                     aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating ECS Capacity Provider: %s", input)
+		err := resource.Retry(waiter.CapacityProviderUpdateTimeout, func() *resource.RetryError {
+			_, err := conn.UpdateCapacityProvider(input)
+
+			if tfawserr.ErrCodeEquals(err, ecs.ErrCodeUpdateInProgressException) {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			_, err = conn.UpdateCapacityProvider(input)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error updating ECS Capacity Provider (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.CapacityProviderUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for ECS Capacity Provider (%s) to update: %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		o, n := d.GetChange("tags")
 
@@ -191,7 +227,7 @@ func resourceAwsEcsCapacityProviderUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	return nil
+	return resourceAwsEcsCapacityProviderRead(d, meta)
 }
 
 func resourceAwsEcsCapacityProviderDelete(d *schema.ResourceData, meta interface{}) error {
@@ -244,28 +280,61 @@ func expandAutoScalingGroupProvider(configured interface{}) *ecs.AutoScalingGrou
 		prov.ManagedTerminationProtection = aws.String(mtp)
 	}
 
-	if v := p["managed_scaling"].([]interface{}); len(v) > 0 && v[0].(map[string]interface{}) != nil {
-		ms := v[0].(map[string]interface{})
-		managedScaling := ecs.ManagedScaling{}
+	prov.ManagedScaling = expandEcsCapacityProviderManagedScaling(p["managed_scaling"])
 
-		if val, ok := ms["maximum_scaling_step_size"].(int); ok && val != 0 {
-			managedScaling.MaximumScalingStepSize = aws.Int64(int64(val))
-		}
-		if val, ok := ms["minimum_scaling_step_size"].(int); ok && val != 0 {
-			managedScaling.MinimumScalingStepSize = aws.Int64(int64(val))
-		}
-		if val, ok := ms["status"].(string); ok && len(val) > 0 {
-			managedScaling.Status = aws.String(val)
-		}
-		if val, ok := ms["target_capacity"].(int); ok && val != 0 {
-			managedScaling.TargetCapacity = aws.Int64(int64(val))
-		}
-		prov.ManagedScaling = &managedScaling
+	return &prov
+}
+
+func expandAutoScalingGroupProviderUpdate(configured interface{}) *ecs.AutoScalingGroupProviderUpdate {
+	if configured == nil {
+		return nil
 	}
 
+	if configured.([]interface{}) == nil || len(configured.([]interface{})) == 0 {
+		return nil
+	}
+
+	prov := ecs.AutoScalingGroupProviderUpdate{}
+	p := configured.([]interface{})[0].(map[string]interface{})
+
+	if mtp := p["managed_termination_protection"].(string); len(mtp) > 0 {
+		prov.ManagedTerminationProtection = aws.String(mtp)
+	}
+
+	prov.ManagedScaling = expandEcsCapacityProviderManagedScaling(p["managed_scaling"])
+
 	return &prov
 }
 
+func expandEcsCapacityProviderManagedScaling(configured interface{}) *ecs.ManagedScaling {
+	v, ok := configured.([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	ms := v[0].(map[string]interface{})
+	managedScaling := ecs.ManagedScaling{}
+
+	if val, ok := ms["instance_warmup_period"].(int); ok {
+		managedScaling.InstanceWarmupPeriod = aws.Int64(int64(val))
+	}
+	if val, ok := ms["maximum_scaling_step_size"].(int); ok && val != 0 {
+		managedScaling.MaximumScalingStepSize = aws.Int64(int64(val))
+	}
+	if val, ok := ms["minimum_scaling_step_size"].(int); ok && val != 0 {
+		managedScaling.MinimumScalingStepSize = aws.Int64(int64(val))
+	}
+	if val, ok := ms["status"].(string); ok && len(val) > 0 {
+		managedScaling.Status = aws.String(val)
+	}
+	if val, ok := ms["target_capacity"].(int); ok && val != 0 {
+		managedScaling.TargetCapacity = aws.Int64(int64(val))
+	}
+
+	return &managedScaling
+}
+
 func flattenAutoScalingGroupProvider(provider *ecs.AutoScalingGroupProvider) []map[string]interface{} {
 	if provider == nil {
 		return nil
@@ -279,6 +348,7 @@ func flattenAutoScalingGroupProvider(provider *ecs.AutoScalingGroupProvider) []m
 
 	if provider.ManagedScaling != nil {
 		m := map[string]interface{}{
+			"instance_warmup_period":    aws.Int64Value(provider.ManagedScaling.InstanceWarmupPeriod),
 			"maximum_scaling_step_size": aws.Int64Value(provider.ManagedScaling.MaximumScalingStepSize),
 			"minimum_scaling_step_size": aws.Int64Value(provider.ManagedScaling.MinimumScalingStepSize),
 			"status":                    aws.StringValue(provider.ManagedScaling.Status),
@@ -1,10 +1,12 @@
 package aws
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -56,6 +59,12 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 				}
 				return true
 			}),
+			// Fine-grained access control can be enabled on an existing domain
+			// but cannot be disabled once enabled.
+			customdiff.ForceNewIfChange("advanced_security_options.0.enabled", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+			resourceAwsElasticSearchDomainCustomizeDiffAdvancedSecurityOptions,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -82,7 +91,6 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 						"enabled": {
 							Type:     schema.TypeBool,
 							Required: true,
-							ForceNew: true,
 						},
 						"internal_user_database_enabled": {
 							Type:     schema.TypeBool,
@@ -115,6 +123,65 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 					},
 				},
 			},
+			"auto_tune_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_state": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(elasticsearch.AutoTuneDesiredState_Values(), false),
+						},
+						"maintenance_schedule": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      resourceAwsElasticSearchDomainAutoTuneMaintenanceScheduleHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cron_expression_for_recurrence": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"duration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"unit": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(elasticsearch.TimeUnit_Values(), false),
+												},
+												"value": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+									"start_at": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validation.IsRFC3339Time,
+										DiffSuppressFunc: suppressEquivalentTime,
+									},
+								},
+							},
+						},
+						"rollback_on_disable": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(elasticsearch.RollbackOnDisable_Values(), false),
+						},
+					},
+				},
+			},
 			"domain_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -421,6 +488,22 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 	}
 }
 
+func resourceAwsElasticSearchDomainCustomizeDiffAdvancedSecurityOptions(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("advanced_security_options") || !diff.Get("advanced_security_options.0.enabled").(bool) {
+		return nil
+	}
+
+	if diff.NewValueKnown("node_to_node_encryption") && !diff.Get("node_to_node_encryption.0.enabled").(bool) {
+		return fmt.Errorf("advanced_security_options.0.enabled requires node_to_node_encryption.0.enabled to be true")
+	}
+
+	if diff.NewValueKnown("encrypt_at_rest") && !diff.Get("encrypt_at_rest.0.enabled").(bool) {
+		return fmt.Errorf("advanced_security_options.0.enabled requires encrypt_at_rest.0.enabled to be true")
+	}
+
+	return nil
+}
+
 func resourceAwsElasticSearchDomainImport(
 	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("domain_name", d.Id())
@@ -457,6 +540,10 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoTuneOptions = expandESAutoTuneOptionsInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("ebs_options"); ok {
 		options := v.([]interface{})
 
@@ -649,6 +736,41 @@ func waitForElasticSearchDomainCreation(conn *elasticsearch.ElasticsearchService
 	return nil
 }
 
+// waitForElasticSearchDomainUpdate waits for a configuration change to be
+// processed. Changes such as enabling fine-grained access control trigger a
+// blue/green deployment, during which the domain may briefly report that it
+// is not processing, so the domain must be idle for several consecutive checks.
+func waitForElasticSearchDomainUpdate(conn *elasticsearch.ElasticsearchService, domainName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"true"},
+		Target:  []string{"false"},
+		Refresh: func() (interface{}, string, error) {
+			out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
+				DomainName: aws.String(domainName),
+			})
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			if out == nil || out.DomainStatus == nil {
+				return nil, "", nil
+			}
+
+			processing := aws.BoolValue(out.DomainStatus.Processing) || aws.BoolValue(out.DomainStatus.UpgradeProcessing)
+
+			return out, strconv.FormatBool(processing), nil
+		},
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
 func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).esconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
@@ -722,6 +844,24 @@ func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	// The maintenance schedules and rollback setting are only returned from
+	// DescribeElasticsearchDomainConfig
+	configOutput, err := conn.DescribeElasticsearchDomainConfig(&elasticsearch.DescribeElasticsearchDomainConfigInput{
+		DomainName: aws.String(d.Get("domain_name").(string)),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading ElasticSearch domain (%s) config: %w", d.Id(), err)
+	}
+
+	if configOutput != nil && configOutput.DomainConfig != nil && configOutput.DomainConfig.AutoTuneOptions != nil && configOutput.DomainConfig.AutoTuneOptions.Options != nil {
+		if err := d.Set("auto_tune_options", []interface{}{flattenESAutoTuneOptions(configOutput.DomainConfig.AutoTuneOptions.Options)}); err != nil {
+			return fmt.Errorf("error setting auto_tune_options: %w", err)
+		}
+	} else {
+		d.Set("auto_tune_options", nil)
+	}
+
 	if err := d.Set("snapshot_options", flattenESSnapshotOptions(ds.SnapshotOptions)); err != nil {
 		return fmt.Errorf("error setting snapshot_options: %s", err)
 	}
@@ -810,6 +950,12 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]interface{}))
 	}
 
+	if d.HasChange("auto_tune_options") {
+		if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AutoTuneOptions = expandESAutoTuneOptions(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
 	if d.HasChange("domain_endpoint_options") {
 		input.DomainEndpointOptions = expandESDomainEndpointOptions(d.Get("domain_endpoint_options").([]interface{}))
 	}
@@ -875,34 +1021,8 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	descInput := &elasticsearch.DescribeElasticsearchDomainInput{
-		DomainName: aws.String(d.Get("domain_name").(string)),
-	}
-	var out *elasticsearch.DescribeElasticsearchDomainOutput
-	err = resource.Retry(60*time.Minute, func() *resource.RetryError {
-		out, err = conn.DescribeElasticsearchDomain(descInput)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		if !aws.BoolValue(out.DomainStatus.Processing) {
-			return nil
-		}
-
-		return resource.RetryableError(
-			fmt.Errorf("%q: Timeout while waiting for changes to be processed", d.Id()))
-	})
-	if isResourceTimeoutError(err) {
-		out, err = conn.DescribeElasticsearchDomain(descInput)
-		if err != nil {
-			return fmt.Errorf("Error describing ElasticSearch domain: %s", err)
-		}
-		if !aws.BoolValue(out.DomainStatus.Processing) {
-			return nil
-		}
-	}
-	if err != nil {
-		return fmt.Errorf("Error waiting for ElasticSearch domain changes to be processed: %s", err)
+	if err := waitForElasticSearchDomainUpdate(conn, d.Get("domain_name").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for ElasticSearch domain (%s) changes to be processed: %w", d.Id(), err)
 	}
 
 	if d.HasChange("elasticsearch_version") {
@@ -1059,6 +1179,149 @@ func flattenESNodeToNodeEncryptionOptions(o *elasticsearch.NodeToNodeEncryptionO
 	return []map[string]interface{}{m}
 }
 
+func expandESAutoTuneOptionsInput(tfMap map[string]interface{}) *elasticsearch.AutoTuneOptionsInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	options := expandESAutoTuneOptions(tfMap)
+
+	return &elasticsearch.AutoTuneOptionsInput{
+		DesiredState:         options.DesiredState,
+		MaintenanceSchedules: options.MaintenanceSchedules,
+	}
+}
+
+func expandESAutoTuneOptions(tfMap map[string]interface{}) *elasticsearch.AutoTuneOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	options := &elasticsearch.AutoTuneOptions{}
+
+	if v, ok := tfMap["desired_state"].(string); ok && v != "" {
+		options.DesiredState = aws.String(v)
+	}
+
+	if v, ok := tfMap["maintenance_schedule"].(*schema.Set); ok {
+		options.MaintenanceSchedules = expandESAutoTuneMaintenanceSchedules(v.List())
+	}
+
+	if v, ok := tfMap["rollback_on_disable"].(string); ok && v != "" {
+		options.RollbackOnDisable = aws.String(v)
+	}
+
+	return options
+}
+
+func expandESAutoTuneMaintenanceSchedules(tfList []interface{}) []*elasticsearch.AutoTuneMaintenanceSchedule {
+	// An empty list is sent so that removing all schedules is applied.
+	schedules := make([]*elasticsearch.AutoTuneMaintenanceSchedule, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		schedule := &elasticsearch.AutoTuneMaintenanceSchedule{}
+
+		if v, ok := tfMap["cron_expression_for_recurrence"].(string); ok && v != "" {
+			schedule.CronExpressionForRecurrence = aws.String(v)
+		}
+
+		if v, ok := tfMap["duration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			duration := v[0].(map[string]interface{})
+
+			schedule.Duration = &elasticsearch.Duration{
+				Unit:  aws.String(duration["unit"].(string)),
+				Value: aws.Int64(int64(duration["value"].(int))),
+			}
+		}
+
+		if v, ok := tfMap["start_at"].(string); ok && v != "" {
+			startAt, _ := time.Parse(time.RFC3339, v)
+			schedule.StartAt = aws.Time(startAt)
+		}
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules
+}
+
+func flattenESAutoTuneOptions(options *elasticsearch.AutoTuneOptions) map[string]interface{} {
+	if options == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"desired_state":        aws.StringValue(options.DesiredState),
+		"maintenance_schedule": flattenESAutoTuneMaintenanceSchedules(options.MaintenanceSchedules),
+		"rollback_on_disable":  aws.StringValue(options.RollbackOnDisable),
+	}
+
+	return tfMap
+}
+
+func flattenESAutoTuneMaintenanceSchedules(schedules []*elasticsearch.AutoTuneMaintenanceSchedule) []interface{} {
+	tfList := make([]interface{}, 0, len(schedules))
+
+	for _, schedule := range schedules {
+		if schedule == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"cron_expression_for_recurrence": aws.StringValue(schedule.CronExpressionForRecurrence),
+		}
+
+		if schedule.Duration != nil {
+			tfMap["duration"] = []interface{}{
+				map[string]interface{}{
+					"unit":  aws.StringValue(schedule.Duration.Unit),
+					"value": int(aws.Int64Value(schedule.Duration.Value)),
+				},
+			}
+		}
+
+		if schedule.StartAt != nil {
+			tfMap["start_at"] = aws.TimeValue(schedule.StartAt).UTC().Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// resourceAwsElasticSearchDomainAutoTuneMaintenanceScheduleHash hashes the
+// schedule start time in UTC so that equivalent timestamps written with a
+// different offset do not produce a new set element.
+func resourceAwsElasticSearchDomainAutoTuneMaintenanceScheduleHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m["cron_expression_for_recurrence"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	if v, ok := m["duration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		duration := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("%d-%s-", duration["value"].(int), duration["unit"].(string)))
+	}
+
+	if v, ok := m["start_at"].(string); ok {
+		if startAt, err := time.Parse(time.RFC3339, v); err == nil {
+			v = startAt.UTC().Format(time.RFC3339)
+		}
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	return hashcode.String(buf.String())
+}
+
 func expandESClusterConfig(m map[string]interface{}) *elasticsearch.ElasticsearchClusterConfig {
 	config := elasticsearch.ElasticsearchClusterConfig{}
 
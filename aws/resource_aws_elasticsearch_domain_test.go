@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -615,6 +616,102 @@ func TestAccAWSElasticSearchDomain_AdvancedSecurityOptions_Disabled(t *testing.T
 	})
 }
 
+func TestAccAWSElasticSearchDomain_AdvancedSecurityOptions_Enable(t *testing.T) {
+	var domain1, domain2 elasticsearch.ElasticsearchDomainStatus
+	domainName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "aws_elasticsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckESDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccESDomainConfig_AdvancedSecurityOptionsDisabled(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckESDomainExists(resourceName, &domain1),
+					testAccCheckAdvancedSecurityOptions(false, false, &domain1),
+				),
+			},
+			{
+				Config: testAccESDomainConfig_AdvancedSecurityOptionsUserDb(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckESDomainExists(resourceName, &domain2),
+					testAccCheckAWSESDomainNotRecreated(&domain1, &domain2),
+					testAccCheckAdvancedSecurityOptions(true, true, &domain2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticSearchDomain_AdvancedSecurityOptions_RequiresEncryption(t *testing.T) {
+	domainName := acctest.RandomWithPrefix("tf-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckESDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccESDomainConfig_AdvancedSecurityOptionsEncryption(domainName, false, true),
+				ExpectError: regexp.MustCompile(`requires node_to_node_encryption.0.enabled to be true`),
+			},
+			{
+				Config:      testAccESDomainConfig_AdvancedSecurityOptionsEncryption(domainName, true, false),
+				ExpectError: regexp.MustCompile(`requires encrypt_at_rest.0.enabled to be true`),
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticSearchDomain_AutoTuneOptions(t *testing.T) {
+	var domain elasticsearch.ElasticsearchDomainStatus
+	domainName := acctest.RandomWithPrefix("tf-test")
+	resourceName := "aws_elasticsearch_domain.test"
+	startAt := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckESDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccESDomainConfig_AutoTuneOptions(domainName, startAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckESDomainExists(resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.0.desired_state", elasticsearch.AutoTuneDesiredStateEnabled),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.0.maintenance_schedule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "auto_tune_options.0.maintenance_schedule.*", map[string]string{
+						"start_at":                       startAt,
+						"duration.#":                     "1",
+						"duration.0.value":               "2",
+						"duration.0.unit":                elasticsearch.TimeUnitHours,
+						"cron_expression_for_recurrence": "cron(0 0 ? * 1 *)",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     domainName,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccESDomainConfig_AutoTuneOptionsDisabled(domainName, elasticsearch.RollbackOnDisableDefaultRollback),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckESDomainExists(resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.0.desired_state", elasticsearch.AutoTuneDesiredStateDisabled),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.0.maintenance_schedule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.0.rollback_on_disable", elasticsearch.RollbackOnDisableDefaultRollback),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSElasticSearchDomain_LogPublishingOptions_IndexSlowLogs(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	ri := acctest.RandInt()
@@ -2215,6 +2312,102 @@ resource "aws_elasticsearch_domain" "test" {
 `, domainName)
 }
 
+func testAccESDomainConfig_AdvancedSecurityOptionsEncryption(domainName string, nodeToNodeEncryption, encryptAtRest bool) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[1]q
+  elasticsearch_version = "7.1"
+
+  cluster_config {
+    instance_type = "r5.large.elasticsearch"
+  }
+
+  advanced_security_options {
+    enabled                        = true
+    internal_user_database_enabled = true
+    master_user_options {
+      master_user_name     = "testmasteruser"
+      master_user_password = "Barbarbarbar1!"
+    }
+  }
+
+  encrypt_at_rest {
+    enabled = %[3]t
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = "Policy-Min-TLS-1-2-2019-07"
+  }
+
+  node_to_node_encryption {
+    enabled = %[2]t
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+`, domainName, nodeToNodeEncryption, encryptAtRest)
+}
+
+func testAccESDomainConfig_AutoTuneOptions(domainName, startAt string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[1]q
+  elasticsearch_version = "7.10"
+
+  cluster_config {
+    instance_type = "r5.large.elasticsearch"
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  auto_tune_options {
+    desired_state = "ENABLED"
+
+    maintenance_schedule {
+      start_at = %[2]q
+      duration {
+        value = "2"
+        unit  = "HOURS"
+      }
+      cron_expression_for_recurrence = "cron(0 0 ? * 1 *)"
+    }
+
+    rollback_on_disable = "NO_ROLLBACK"
+  }
+}
+`, domainName, startAt)
+}
+
+func testAccESDomainConfig_AutoTuneOptionsDisabled(domainName, rollbackOnDisable string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name           = %[1]q
+  elasticsearch_version = "7.10"
+
+  cluster_config {
+    instance_type = "r5.large.elasticsearch"
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  auto_tune_options {
+    desired_state       = "DISABLED"
+    rollback_on_disable = %[2]q
+  }
+}
+`, domainName, rollbackOnDisable)
+}
+
 func testAccESDomain_LogPublishingOptions_BaseConfig(randInt int) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {
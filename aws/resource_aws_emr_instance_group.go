@@ -150,6 +150,11 @@ func resourceAwsEMRInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 		if err := json.Unmarshal([]byte(v.(string)), &autoScalingPolicy); err != nil {
 			return fmt.Errorf("[DEBUG] error parsing Auto Scaling Policy %s", err)
 		}
+
+		if err := resourceAwsEMRInstanceGroupCheckManagedScalingPolicy(conn, d.Get("cluster_id").(string)); err != nil {
+			return err
+		}
+
 		groupConfig.AutoScalingPolicy = autoScalingPolicy
 	}
 
@@ -329,6 +334,10 @@ func resourceAwsEMRInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("error parsing EMR Auto Scaling Policy JSON for update: %s", err)
 		}
 
+		if err := resourceAwsEMRInstanceGroupCheckManagedScalingPolicy(conn, d.Get("cluster_id").(string)); err != nil {
+			return err
+		}
+
 		putAutoScalingPolicy := &emr.PutAutoScalingPolicyInput{
 			ClusterId:         aws.String(d.Get("cluster_id").(string)),
 			AutoScalingPolicy: autoScalingPolicy,
@@ -362,6 +371,22 @@ func resourceAwsEMRInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// resourceAwsEMRInstanceGroupCheckManagedScalingPolicy returns an error if the cluster has a
+// managed scaling policy, since automatic scaling policies cannot be attached alongside it.
+func resourceAwsEMRInstanceGroupCheckManagedScalingPolicy(conn *emr.EMR, clusterID string) error {
+	hasManagedScalingPolicy, err := emrClusterHasManagedScalingPolicy(conn, clusterID)
+
+	if err != nil {
+		return fmt.Errorf("error reading EMR Cluster (%s) Managed Scaling Policy: %w", clusterID, err)
+	}
+
+	if hasManagedScalingPolicy {
+		return fmt.Errorf("cannot attach Auto Scaling Policy to EMR Cluster (%s) Instance Group: the cluster has an EMR Managed Scaling Policy, remove it first", clusterID)
+	}
+
+	return nil
+}
+
 func instanceGroupStateRefresh(conn *emr.EMR, clusterID, groupID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		ig, err := fetchEMRInstanceGroup(conn, clusterID, groupID)
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emr"
@@ -67,6 +68,18 @@ func resourceAwsEMRManagedScalingPolicy() *schema.Resource {
 
 func resourceAwsEMRManagedScalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).emrconn
+	clusterID := d.Get("cluster_id").(string)
+
+	// Managed scaling and automatic scaling policies are mutually exclusive.
+	instanceGroupIDs, err := emrClusterAutoScalingPolicyInstanceGroupIDs(conn, clusterID)
+
+	if err != nil {
+		return fmt.Errorf("error reading EMR Cluster (%s) Auto Scaling Policies: %w", clusterID, err)
+	}
+
+	if len(instanceGroupIDs) > 0 {
+		return fmt.Errorf("cannot create EMR Managed Scaling Policy for EMR Cluster (%s): Instance Groups (%s) have Auto Scaling Policies attached, remove them first", clusterID, strings.Join(instanceGroupIDs, ", "))
+	}
 
 	if l := d.Get("compute_limits").(*schema.Set).List(); len(l) > 0 && l[0] != nil {
 		cl := l[0].(map[string]interface{})
@@ -86,7 +99,7 @@ func resourceAwsEMRManagedScalingPolicyCreate(d *schema.ResourceData, meta inter
 		}
 
 		_, err := conn.PutManagedScalingPolicy(&emr.PutManagedScalingPolicyInput{
-			ClusterId:            aws.String(d.Get("cluster_id").(string)),
+			ClusterId:            aws.String(clusterID),
 			ManagedScalingPolicy: managedScalingPolicy,
 		})
 
@@ -96,7 +109,7 @@ func resourceAwsEMRManagedScalingPolicyCreate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	d.SetId(d.Get("cluster_id").(string))
+	d.SetId(clusterID)
 	return nil
 }
 
@@ -192,3 +205,63 @@ func flattenEmrComputeLimits(apiObject *emr.ComputeLimits) []interface{} {
 
 	return []interface{}{tfMap}
 }
+
+// emrClusterAutoScalingPolicyInstanceGroupIDs returns the IDs of the cluster's instance groups
+// that have an automatic scaling policy attached.
+func emrClusterAutoScalingPolicyInstanceGroupIDs(conn *emr.EMR, clusterID string) ([]string, error) {
+	output, err := conn.DescribeCluster(&emr.DescribeClusterInput{
+		ClusterId: aws.String(clusterID),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Automatic scaling policies are only supported by instance group clusters.
+	if output == nil || output.Cluster == nil || aws.StringValue(output.Cluster.InstanceCollectionType) != emr.InstanceCollectionTypeInstanceGroup {
+		return nil, nil
+	}
+
+	var instanceGroupIDs []string
+
+	err = conn.ListInstanceGroupsPages(&emr.ListInstanceGroupsInput{
+		ClusterId: aws.String(clusterID),
+	}, func(page *emr.ListInstanceGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, instanceGroup := range page.InstanceGroups {
+			if instanceGroup == nil || instanceGroup.AutoScalingPolicy == nil {
+				continue
+			}
+
+			if status := instanceGroup.AutoScalingPolicy.Status; status != nil && aws.StringValue(status.State) == emr.AutoScalingPolicyStateDetached {
+				continue
+			}
+
+			instanceGroupIDs = append(instanceGroupIDs, aws.StringValue(instanceGroup.Id))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return instanceGroupIDs, nil
+}
+
+// emrClusterHasManagedScalingPolicy returns whether the cluster has a managed scaling policy attached.
+func emrClusterHasManagedScalingPolicy(conn *emr.EMR, clusterID string) (bool, error) {
+	output, err := conn.GetManagedScalingPolicy(&emr.GetManagedScalingPolicyInput{
+		ClusterId: aws.String(clusterID),
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return output != nil && output.ManagedScalingPolicy != nil, nil
+}
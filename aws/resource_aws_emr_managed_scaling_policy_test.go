@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -104,6 +105,56 @@ func TestAccAwsEmrManagedScalingPolicy_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAwsEmrManagedScalingPolicy_AutoScalingPolicyConflict(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	autoscalingPolicy := `
+{
+  "Constraints": {
+    "MinCapacity": 1,
+    "MaxCapacity": 2
+  },
+  "Rules": [
+    {
+      "Name": "ScaleOutMemoryPercentage",
+      "Description": "Scale out if YARNMemoryAvailablePercentage is less than 15",
+      "Action": {
+        "SimpleScalingPolicyConfiguration": {
+          "AdjustmentType": "CHANGE_IN_CAPACITY",
+          "ScalingAdjustment": 1,
+          "CoolDown": 300
+        }
+      },
+      "Trigger": {
+        "CloudWatchAlarmDefinition": {
+          "ComparisonOperator": "LESS_THAN",
+          "EvaluationPeriods": 1,
+          "MetricName": "YARNMemoryAvailablePercentage",
+          "Namespace": "AWS/ElasticMapReduce",
+          "Period": 300,
+          "Statistic": "AVERAGE",
+          "Threshold": 15.0,
+          "Unit": "PERCENT"
+        }
+      }
+    }
+  ]
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEmrManagedScalingPolicyDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSEmrManagedScalingPolicy_AutoScalingPolicyConflict(rName, autoscalingPolicy),
+				ExpectError: regexp.MustCompile(`have Auto Scaling Policies attached`),
+			},
+		},
+	})
+}
+
 func testAccAWSEmrManagedScalingPolicy_basic(r string) string {
 	return fmt.Sprintf(testAccAWSEmrManagedScalingPolicyBase+`
 resource "aws_emr_managed_scaling_policy" "testpolicy" {
@@ -145,6 +196,21 @@ resource "aws_emr_managed_scaling_policy" "testpolicy" {
 `, r, maximumOndemandCapacityUnits)
 }
 
+func testAccAWSEmrManagedScalingPolicy_AutoScalingPolicyConflict(rName, autoscalingPolicy string) string {
+	return composeConfig(
+		testAccAWSEmrClusterConfigCoreInstanceGroupAutoscalingPolicy(rName, autoscalingPolicy),
+		`
+resource "aws_emr_managed_scaling_policy" "testpolicy" {
+  cluster_id = aws_emr_cluster.test.id
+  compute_limits {
+    unit_type              = "Instances"
+    minimum_capacity_units = 1
+    maximum_capacity_units = 2
+  }
+}
+`)
+}
+
 func testAccCheckAWSEmrManagedScalingPolicyExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
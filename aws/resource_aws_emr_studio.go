@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emr/finder"
+)
+
+func resourceAwsEMRStudio() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEMRStudioCreate,
+		Read:   resourceAwsEMRStudioRead,
+		Update: resourceAwsEMRStudioUpdate,
+		Delete: resourceAwsEMRStudioDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(emr.AuthMode_Values(), false),
+			},
+			"default_s3_location": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"engine_security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"service_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"workspace_security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEMRStudioCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrconn
+
+	name := d.Get("name").(string)
+	input := &emr.CreateStudioInput{
+		AuthMode:                 aws.String(d.Get("auth_mode").(string)),
+		DefaultS3Location:        aws.String(d.Get("default_s3_location").(string)),
+		EngineSecurityGroupId:    aws.String(d.Get("engine_security_group_id").(string)),
+		Name:                     aws.String(name),
+		ServiceRole:              aws.String(d.Get("service_role").(string)),
+		SubnetIds:                expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		VpcId:                    aws.String(d.Get("vpc_id").(string)),
+		WorkspaceSecurityGroupId: aws.String(d.Get("workspace_security_group_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_role"); ok {
+		input.UserRole = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().EmrTags()
+	}
+
+	log.Printf("[DEBUG] Creating EMR Studio: %s", input)
+	output, err := conn.CreateStudio(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EMR Studio (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.StudioId))
+
+	return resourceAwsEMRStudioRead(d, meta)
+}
+
+func resourceAwsEMRStudioRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	studio, err := finder.StudioByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "does not exist") {
+		log.Printf("[WARN] EMR Studio (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EMR Studio (%s): %w", d.Id(), err)
+	}
+
+	if studio == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading EMR Studio (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] EMR Studio (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", studio.StudioArn)
+	d.Set("auth_mode", studio.AuthMode)
+	d.Set("default_s3_location", studio.DefaultS3Location)
+	d.Set("description", studio.Description)
+	d.Set("engine_security_group_id", studio.EngineSecurityGroupId)
+	d.Set("name", studio.Name)
+	d.Set("service_role", studio.ServiceRole)
+	d.Set("url", studio.Url)
+	d.Set("user_role", studio.UserRole)
+	d.Set("vpc_id", studio.VpcId)
+	d.Set("workspace_security_group_id", studio.WorkspaceSecurityGroupId)
+
+	if err := d.Set("subnet_ids", aws.StringValueSlice(studio.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %w", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.EmrKeyValueTags(studio.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEMRStudioUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrconn
+
+	if d.HasChanges("default_s3_location", "description", "name", "subnet_ids") {
+		input := &emr.UpdateStudioInput{
+			StudioId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("default_s3_location") {
+			input.DefaultS3Location = aws.String(d.Get("default_s3_location").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("subnet_ids") {
+			input.SubnetIds = expandStringSet(d.Get("subnet_ids").(*schema.Set))
+		}
+
+		log.Printf("[DEBUG] Updating EMR Studio: %s", input)
+		if _, err := conn.UpdateStudio(input); err != nil {
+			return fmt.Errorf("error updating EMR Studio (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.EmrUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EMR Studio (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEMRStudioRead(d, meta)
+}
+
+func resourceAwsEMRStudioDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrconn
+
+	log.Printf("[DEBUG] Deleting EMR Studio: %s", d.Id())
+	_, err := conn.DeleteStudio(&emr.DeleteStudioInput{
+		StudioId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "does not exist") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EMR Studio (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfemr "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emr/finder"
+)
+
+func resourceAwsEMRStudioSessionMapping() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEMRStudioSessionMappingCreate,
+		Read:   resourceAwsEMRStudioSessionMappingRead,
+		Update: resourceAwsEMRStudioSessionMappingUpdate,
+		Delete: resourceAwsEMRStudioSessionMappingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identity_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"identity_id", "identity_name"},
+			},
+			"identity_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"identity_id", "identity_name"},
+			},
+			"identity_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(emr.IdentityType_Values(), false),
+			},
+			"session_policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"studio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEMRStudioSessionMappingCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrconn
+
+	identityType := d.Get("identity_type").(string)
+	studioID := d.Get("studio_id").(string)
+	input := &emr.CreateStudioSessionMappingInput{
+		IdentityType:     aws.String(identityType),
+		SessionPolicyArn: aws.String(d.Get("session_policy_arn").(string)),
+		StudioId:         aws.String(studioID),
+	}
+
+	var identityID, identityName string
+
+	if v, ok := d.GetOk("identity_id"); ok {
+		identityID = v.(string)
+		input.IdentityId = aws.String(identityID)
+	}
+
+	if v, ok := d.GetOk("identity_name"); ok {
+		identityName = v.(string)
+		input.IdentityName = aws.String(identityName)
+	}
+
+	log.Printf("[DEBUG] Creating EMR Studio Session Mapping: %s", input)
+	_, err := conn.CreateStudioSessionMapping(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EMR Studio (%s) Session Mapping: %w", studioID, err)
+	}
+
+	// The identity ID is used in the resource ID, so resolve it when the mapping was created by name.
+	if identityID == "" {
+		sessionMapping, err := finder.StudioSessionMapping(conn, studioID, identityType, "", identityName)
+
+		if err != nil {
+			return fmt.Errorf("error reading EMR Studio (%s) Session Mapping (%s): %w", studioID, identityName, err)
+		}
+
+		if sessionMapping == nil {
+			return fmt.Errorf("error reading EMR Studio (%s) Session Mapping (%s): not found after creation", studioID, identityName)
+		}
+
+		identityID = aws.StringValue(sessionMapping.IdentityId)
+	}
+
+	d.SetId(tfemr.StudioSessionMappingCreateID(studioID, identityType, identityID))
+
+	return resourceAwsEMRStudioSessionMappingRead(d, meta)
+}
+
+func resourceAwsEMRStudioSessionMappingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrconn
+
+	studioID, identityType, identityID, err := tfemr.StudioSessionMappingParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	sessionMapping, err := finder.StudioSessionMapping(conn, studioID, identityType, identityID, "")
+
+	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "does not exist") {
+		log.Printf("[WARN] EMR Studio Session Mapping (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EMR Studio Session Mapping (%s): %w", d.Id(), err)
+	}
+
+	if sessionMapping == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading EMR Studio Session Mapping (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] EMR Studio Session Mapping (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("identity_id", sessionMapping.IdentityId)
+	d.Set("identity_name", sessionMapping.IdentityName)
+	d.Set("identity_type", sessionMapping.IdentityType)
+	d.Set("session_policy_arn", sessionMapping.SessionPolicyArn)
+	d.Set("studio_id", sessionMapping.StudioId)
+
+	return nil
+}
+
+func resourceAwsEMRStudioSessionMappingUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrconn
+
+	studioID, identityType, identityID, err := tfemr.StudioSessionMappingParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &emr.UpdateStudioSessionMappingInput{
+		IdentityId:       aws.String(identityID),
+		IdentityType:     aws.String(identityType),
+		SessionPolicyArn: aws.String(d.Get("session_policy_arn").(string)),
+		StudioId:         aws.String(studioID),
+	}
+
+	log.Printf("[DEBUG] Updating EMR Studio Session Mapping: %s", input)
+	if _, err := conn.UpdateStudioSessionMapping(input); err != nil {
+		return fmt.Errorf("error updating EMR Studio Session Mapping (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsEMRStudioSessionMappingRead(d, meta)
+}
+
+func resourceAwsEMRStudioSessionMappingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrconn
+
+	studioID, identityType, identityID, err := tfemr.StudioSessionMappingParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting EMR Studio Session Mapping: %s", d.Id())
+	_, err = conn.DeleteStudioSessionMapping(&emr.DeleteStudioSessionMappingInput{
+		IdentityId:   aws.String(identityID),
+		IdentityType: aws.String(identityType),
+		StudioId:     aws.String(studioID),
+	})
+
+	if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "does not exist") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EMR Studio Session Mapping (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
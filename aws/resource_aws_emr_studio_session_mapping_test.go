@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfemr "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emr/finder"
+)
+
+func TestAccAWSEMRStudioSessionMapping_basic(t *testing.T) {
+	var sessionMapping emr.SessionMappingDetail
+	resourceName := "aws_emr_studio_session_mapping.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	groupName := os.Getenv("AWS_EMR_STUDIO_SSO_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(emr.EndpointsID, t)
+			testAccEMRStudioSessionMappingPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRStudioSessionMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRStudioSessionMappingConfig(rName, groupName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRStudioSessionMappingExists(resourceName, &sessionMapping),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_id"),
+					resource.TestCheckResourceAttr(resourceName, "identity_name", groupName),
+					resource.TestCheckResourceAttr(resourceName, "identity_type", emr.IdentityTypeGroup),
+					resource.TestCheckResourceAttrPair(resourceName, "session_policy_arn", "aws_iam_policy.test1", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "studio_id", "aws_emr_studio.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEMRStudioSessionMappingConfig(rName, groupName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRStudioSessionMappingExists(resourceName, &sessionMapping),
+					resource.TestCheckResourceAttrPair(resourceName, "session_policy_arn", "aws_iam_policy.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEMRStudioSessionMapping_disappears(t *testing.T) {
+	var sessionMapping emr.SessionMappingDetail
+	resourceName := "aws_emr_studio_session_mapping.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	groupName := os.Getenv("AWS_EMR_STUDIO_SSO_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(emr.EndpointsID, t)
+			testAccEMRStudioSessionMappingPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRStudioSessionMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRStudioSessionMappingConfig(rName, groupName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRStudioSessionMappingExists(resourceName, &sessionMapping),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEMRStudioSessionMapping(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccEMRStudioSessionMappingPreCheck(t *testing.T) {
+	if os.Getenv("AWS_EMR_STUDIO_SSO_GROUP_NAME") == "" {
+		t.Skip("AWS_EMR_STUDIO_SSO_GROUP_NAME env var must be set for EMR Studio Session Mapping acceptance tests. This requires an AWS SSO group in the account.")
+	}
+}
+
+func testAccCheckAWSEMRStudioSessionMappingExists(resourceName string, sessionMapping *emr.SessionMappingDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR Studio Session Mapping ID is set")
+		}
+
+		studioID, identityType, identityID, err := tfemr.StudioSessionMappingParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).emrconn
+
+		output, err := finder.StudioSessionMapping(conn, studioID, identityType, identityID, "")
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EMR Studio Session Mapping (%s) not found", rs.Primary.ID)
+		}
+
+		*sessionMapping = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEMRStudioSessionMappingDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).emrconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_emr_studio_session_mapping" {
+			continue
+		}
+
+		studioID, identityType, identityID, err := tfemr.StudioSessionMappingParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.StudioSessionMapping(conn, studioID, identityType, identityID, "")
+
+		if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "does not exist") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("EMR Studio Session Mapping (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEMRStudioSessionMappingConfig(rName, groupName, policyName string) string {
+	return composeConfig(
+		testAccAWSEMRStudioConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iam_role" "user" {
+  name = "%[1]s-user"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "elasticmapreduce.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_policy" "test1" {
+  name = "%[1]s-1"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "elasticmapreduce:ListClusters"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_policy" "test2" {
+  name = "%[1]s-2"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "elasticmapreduce:DescribeCluster"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_emr_studio" "test" {
+  auth_mode                   = "SSO"
+  default_s3_location         = "s3://${aws_s3_bucket.test.bucket}/test"
+  engine_security_group_id    = aws_security_group.engine.id
+  name                        = %[1]q
+  service_role                = aws_iam_role.test.arn
+  subnet_ids                  = [aws_subnet.test.id]
+  user_role                   = aws_iam_role.user.arn
+  vpc_id                      = aws_vpc.test.id
+  workspace_security_group_id = aws_security_group.workspace.id
+}
+
+resource "aws_emr_studio_session_mapping" "test" {
+  identity_name      = %[2]q
+  identity_type      = "GROUP"
+  session_policy_arn = aws_iam_policy.%[3]s.arn
+  studio_id          = aws_emr_studio.test.id
+}
+`, rName, groupName, policyName))
+}
@@ -0,0 +1,349 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emr/finder"
+)
+
+func TestAccAWSEMRStudio_basic(t *testing.T) {
+	var studio emr.Studio
+	resourceName := "aws_emr_studio.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(emr.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRStudioDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRStudioConfigName(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRStudioExists(resourceName, &studio),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "elasticmapreduce", regexp.MustCompile(`studio/es-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auth_mode", emr.AuthModeIam),
+					resource.TestCheckResourceAttrSet(resourceName, "default_s3_location"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_security_group_id", "aws_security_group.engine", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "service_role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_security_group_id", "aws_security_group.workspace", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEMRStudio_disappears(t *testing.T) {
+	var studio emr.Studio
+	resourceName := "aws_emr_studio.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(emr.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRStudioDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRStudioConfigName(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRStudioExists(resourceName, &studio),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEMRStudio(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEMRStudio_Update(t *testing.T) {
+	var studio emr.Studio
+	resourceName := "aws_emr_studio.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(emr.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRStudioDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRStudioConfigName(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRStudioExists(resourceName, &studio),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccAWSEMRStudioConfigUpdated(rName, rNameUpdated, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRStudioExists(resourceName, &studio),
+					resource.TestCheckResourceAttr(resourceName, "default_s3_location", fmt.Sprintf("s3://%s/updated", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEMRStudio_Tags(t *testing.T) {
+	var studio emr.Studio
+	resourceName := "aws_emr_studio.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(emr.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRStudioDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRStudioConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRStudioExists(resourceName, &studio),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEMRStudioConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRStudioExists(resourceName, &studio),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEMRStudioConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRStudioExists(resourceName, &studio),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEMRStudioExists(resourceName string, studio *emr.Studio) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR Studio ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).emrconn
+
+		output, err := finder.StudioByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EMR Studio (%s) not found", rs.Primary.ID)
+		}
+
+		*studio = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEMRStudioDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).emrconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_emr_studio" {
+			continue
+		}
+
+		output, err := finder.StudioByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "does not exist") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("EMR Studio (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEMRStudioConfigBase(rName string) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name                                     = %[1]q
+    for-use-with-amazon-emr-managed-policies = true
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name                                     = %[1]q
+    for-use-with-amazon-emr-managed-policies = true
+  }
+}
+
+resource "aws_security_group" "engine" {
+  name   = "%[1]s-engine"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name                                     = %[1]q
+    for-use-with-amazon-emr-managed-policies = true
+  }
+}
+
+resource "aws_security_group" "workspace" {
+  name   = "%[1]s-workspace"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name                                     = %[1]q
+    for-use-with-amazon-emr-managed-policies = true
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "elasticmapreduce.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName))
+}
+
+func testAccAWSEMRStudioConfigName(rName, studioName string) string {
+	return composeConfig(
+		testAccAWSEMRStudioConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_emr_studio" "test" {
+  auth_mode                   = "IAM"
+  default_s3_location         = "s3://${aws_s3_bucket.test.bucket}/test"
+  engine_security_group_id    = aws_security_group.engine.id
+  name                        = %[1]q
+  service_role                = aws_iam_role.test.arn
+  subnet_ids                  = [aws_subnet.test.id]
+  vpc_id                      = aws_vpc.test.id
+  workspace_security_group_id = aws_security_group.workspace.id
+}
+`, studioName))
+}
+
+func testAccAWSEMRStudioConfigUpdated(rName, studioName, description string) string {
+	return composeConfig(
+		testAccAWSEMRStudioConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_emr_studio" "test" {
+  auth_mode                   = "IAM"
+  default_s3_location         = "s3://${aws_s3_bucket.test.bucket}/updated"
+  description                 = %[2]q
+  engine_security_group_id    = aws_security_group.engine.id
+  name                        = %[1]q
+  service_role                = aws_iam_role.test.arn
+  subnet_ids                  = [aws_subnet.test.id]
+  vpc_id                      = aws_vpc.test.id
+  workspace_security_group_id = aws_security_group.workspace.id
+}
+`, studioName, description))
+}
+
+func testAccAWSEMRStudioConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSEMRStudioConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_emr_studio" "test" {
+  auth_mode                   = "IAM"
+  default_s3_location         = "s3://${aws_s3_bucket.test.bucket}/test"
+  engine_security_group_id    = aws_security_group.engine.id
+  name                        = %[1]q
+  service_role                = aws_iam_role.test.arn
+  subnet_ids                  = [aws_subnet.test.id]
+  vpc_id                      = aws_vpc.test.id
+  workspace_security_group_id = aws_security_group.workspace.id
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSEMRStudioConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSEMRStudioConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_emr_studio" "test" {
+  auth_mode                   = "IAM"
+  default_s3_location         = "s3://${aws_s3_bucket.test.bucket}/test"
+  engine_security_group_id    = aws_security_group.engine.id
+  name                        = %[1]q
+  service_role                = aws_iam_role.test.arn
+  subnet_ids                  = [aws_subnet.test.id]
+  vpc_id                      = aws_vpc.test.id
+  workspace_security_group_id = aws_security_group.workspace.id
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -24,6 +24,36 @@ func resourceAwsFlowLog() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"destination_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      ec2.DestinationFileFormatPlainText,
+							ValidateFunc: validation.StringInSlice(ec2.DestinationFileFormat_Values(), false),
+						},
+						"hive_compatible_partitions": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"per_hour_partition": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"iam_role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -48,6 +78,7 @@ func resourceAwsFlowLog() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					ec2.LogDestinationTypeCloudWatchLogs,
 					ec2.LogDestinationTypeS3,
+					ec2.LogDestinationTypeKinesisDataFirehose,
 				}, false),
 			},
 
@@ -149,6 +180,10 @@ func resourceAwsLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
 		TrafficType:        aws.String(d.Get("traffic_type").(string)),
 	}
 
+	if v, ok := d.GetOk("destination_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		opts.DestinationOptions = expandEc2DestinationOptionsRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("iam_role_arn"); ok && v != "" {
 		opts.DeliverLogsPermissionArn = aws.String(v.(string))
 	}
@@ -222,6 +257,15 @@ func resourceAwsLogFlowRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("iam_role_arn", fl.DeliverLogsPermissionArn)
 	d.Set("log_format", fl.LogFormat)
 	d.Set("max_aggregation_interval", fl.MaxAggregationInterval)
+
+	if fl.DestinationOptions != nil {
+		if err := d.Set("destination_options", []interface{}{flattenEc2DestinationOptionsResponse(fl.DestinationOptions)}); err != nil {
+			return fmt.Errorf("error setting destination_options: %w", err)
+		}
+	} else {
+		d.Set("destination_options", nil)
+	}
+
 	var resourceKey string
 	if strings.HasPrefix(*fl.ResourceId, "vpc-") {
 		resourceKey = "vpc_id"
@@ -279,3 +323,47 @@ func resourceAwsLogFlowDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandEc2DestinationOptionsRequest(tfMap map[string]interface{}) *ec2.DestinationOptionsRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.DestinationOptionsRequest{}
+
+	if v, ok := tfMap["file_format"].(string); ok && v != "" {
+		apiObject.FileFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["hive_compatible_partitions"].(bool); ok {
+		apiObject.HiveCompatiblePartitions = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["per_hour_partition"].(bool); ok {
+		apiObject.PerHourPartition = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenEc2DestinationOptionsResponse(apiObject *ec2.DestinationOptionsResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FileFormat; v != nil {
+		tfMap["file_format"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.HiveCompatiblePartitions; v != nil {
+		tfMap["hive_compatible_partitions"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.PerHourPartition; v != nil {
+		tfMap["per_hour_partition"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
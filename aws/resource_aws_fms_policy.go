@@ -0,0 +1,377 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/fms/equivalency"
+)
+
+const fmsPolicyResourceTypeList = "ResourceTypeList"
+
+func resourceAwsFmsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsPolicyCreate,
+		Read:   resourceAwsFmsPolicyRead,
+		Update: resourceAwsFmsPolicyUpdate,
+		Delete: resourceAwsFmsPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"delete_all_policy_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"delete_unused_fm_managed_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"exclude_map": fmsPolicyScopeMapSchema(),
+
+			"exclude_resource_tags": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"include_map": fmsPolicyScopeMapSchema(),
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"policy_update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"remediation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"resource_tags": tagsSchema(),
+
+			"resource_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 128),
+				ConflictsWith: []string{"resource_type_list"},
+			},
+
+			"resource_type_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				ConflictsWith: []string{"resource_type"},
+			},
+
+			"security_service_policy_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_service_data": {
+							Type:     schema.TypeString,
+							Optional: true,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								equal, _ := equivalency.EquivalentFmsManagedServiceDataJSON(old, new)
+
+								return equal
+							},
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(fms.SecurityServiceType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func fmsPolicyScopeMapSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateAwsAccountId,
+					},
+				},
+				"orgunit": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.PutPolicyInput{
+		Policy: resourceAwsFmsPolicyExpandPolicy(d),
+	}
+
+	log.Printf("[DEBUG] Creating FMS Policy: %s", input)
+	output, err := conn.PutPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FMS Policy (%s): %w", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyId))
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	output, err := conn.GetPolicy(&fms.GetPolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FMS Policy (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.Policy == nil {
+		return fmt.Errorf("error reading FMS Policy (%s): empty response", d.Id())
+	}
+
+	policy := output.Policy
+
+	d.Set("arn", output.PolicyArn)
+	d.Set("delete_unused_fm_managed_resources", policy.DeleteUnusedFMManagedResources)
+	d.Set("description", policy.PolicyDescription)
+	d.Set("exclude_resource_tags", policy.ExcludeResourceTags)
+	d.Set("name", policy.PolicyName)
+	d.Set("policy_update_token", policy.PolicyUpdateToken)
+	d.Set("remediation_enabled", policy.RemediationEnabled)
+
+	if err := d.Set("exclude_map", flattenFmsPolicyScopeMap(policy.ExcludeMap)); err != nil {
+		return fmt.Errorf("error setting exclude_map: %w", err)
+	}
+
+	if err := d.Set("include_map", flattenFmsPolicyScopeMap(policy.IncludeMap)); err != nil {
+		return fmt.Errorf("error setting include_map: %w", err)
+	}
+
+	if err := d.Set("resource_tags", keyvaluetags.FmsKeyValueTags(policy.ResourceTags).Map()); err != nil {
+		return fmt.Errorf("error setting resource_tags: %w", err)
+	}
+
+	if aws.StringValue(policy.ResourceType) == fmsPolicyResourceTypeList {
+		d.Set("resource_type", nil)
+	} else {
+		d.Set("resource_type", policy.ResourceType)
+	}
+
+	if err := d.Set("resource_type_list", aws.StringValueSlice(policy.ResourceTypeList)); err != nil {
+		return fmt.Errorf("error setting resource_type_list: %w", err)
+	}
+
+	if err := d.Set("security_service_policy_data", flattenFmsSecurityServicePolicyData(policy.SecurityServicePolicyData, d.Get("security_service_policy_data.0.managed_service_data").(string))); err != nil {
+		return fmt.Errorf("error setting security_service_policy_data: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	if d.HasChangesExcept("delete_all_policy_resources") {
+		policy := resourceAwsFmsPolicyExpandPolicy(d)
+		policy.PolicyId = aws.String(d.Id())
+		policy.PolicyUpdateToken = aws.String(d.Get("policy_update_token").(string))
+
+		input := &fms.PutPolicyInput{
+			Policy: policy,
+		}
+
+		log.Printf("[DEBUG] Updating FMS Policy: %s", input)
+		_, err := conn.PutPolicy(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating FMS Policy (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	log.Printf("[DEBUG] Deleting FMS Policy: %s", d.Id())
+	_, err := conn.DeletePolicy(&fms.DeletePolicyInput{
+		PolicyId:                 aws.String(d.Id()),
+		DeleteAllPolicyResources: aws.Bool(d.Get("delete_all_policy_resources").(bool)),
+	})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FMS Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
+	policy := &fms.Policy{
+		DeleteUnusedFMManagedResources: aws.Bool(d.Get("delete_unused_fm_managed_resources").(bool)),
+		ExcludeMap:                     expandFmsPolicyScopeMap(d.Get("exclude_map").([]interface{})),
+		ExcludeResourceTags:            aws.Bool(d.Get("exclude_resource_tags").(bool)),
+		IncludeMap:                     expandFmsPolicyScopeMap(d.Get("include_map").([]interface{})),
+		PolicyName:                     aws.String(d.Get("name").(string)),
+		RemediationEnabled:             aws.Bool(d.Get("remediation_enabled").(bool)),
+		ResourceTags:                   keyvaluetags.New(d.Get("resource_tags").(map[string]interface{})).FmsTags(),
+		SecurityServicePolicyData:      expandFmsSecurityServicePolicyData(d.Get("security_service_policy_data").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		policy.PolicyDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_type_list"); ok && v.(*schema.Set).Len() > 0 {
+		policy.ResourceType = aws.String(fmsPolicyResourceTypeList)
+		policy.ResourceTypeList = expandStringSet(v.(*schema.Set))
+	} else {
+		policy.ResourceType = aws.String(d.Get("resource_type").(string))
+	}
+
+	return policy
+}
+
+func expandFmsPolicyScopeMap(tfList []interface{}) map[string][]*string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := map[string][]*string{}
+
+	if v, ok := tfMap["account"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject[fms.CustomerPolicyScopeIdTypeAccount] = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["orgunit"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject[fms.CustomerPolicyScopeIdTypeOrgUnit] = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenFmsPolicyScopeMap(apiObject map[string][]*string) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v, ok := apiObject[fms.CustomerPolicyScopeIdTypeAccount]; ok {
+		tfMap["account"] = aws.StringValueSlice(v)
+	}
+
+	if v, ok := apiObject[fms.CustomerPolicyScopeIdTypeOrgUnit]; ok {
+		tfMap["orgunit"] = aws.StringValueSlice(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandFmsSecurityServicePolicyData(tfList []interface{}) *fms.SecurityServicePolicyData {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &fms.SecurityServicePolicyData{
+		Type: aws.String(tfMap["type"].(string)),
+	}
+
+	if v, ok := tfMap["managed_service_data"].(string); ok && v != "" {
+		apiObject.ManagedServiceData = aws.String(v)
+	}
+
+	return apiObject
+}
+
+// flattenFmsSecurityServicePolicyData keeps the previously configured managed_service_data
+// when it is equivalent to the API's re-ordered and defaulted version of the same document.
+func flattenFmsSecurityServicePolicyData(apiObject *fms.SecurityServicePolicyData, oldManagedServiceData string) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	managedServiceData := aws.StringValue(apiObject.ManagedServiceData)
+
+	if oldManagedServiceData != "" {
+		if equal, _ := equivalency.EquivalentFmsManagedServiceDataJSON(oldManagedServiceData, managedServiceData); equal {
+			managedServiceData = oldManagedServiceData
+		}
+	}
+
+	tfMap := map[string]interface{}{
+		"managed_service_data": managedServiceData,
+		"type":                 aws.StringValue(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,346 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/globalaccelerator/finder"
+)
+
+func resourceAwsGlobalAcceleratorCustomRoutingEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlobalAcceleratorCustomRoutingEndpointGroupCreate,
+		Read:   resourceAwsGlobalAcceleratorCustomRoutingEndpointGroupRead,
+		Update: resourceAwsGlobalAcceleratorCustomRoutingEndpointGroupUpdate,
+		Delete: resourceAwsGlobalAcceleratorCustomRoutingEndpointGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"destination_configuration": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+
+						"protocols": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(globalaccelerator.CustomRoutingProtocol_Values(), false),
+							},
+						},
+
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+
+			"endpoint_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"endpoint_group_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"listener_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingEndpointGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+	region := meta.(*AWSClient).region
+
+	opts := &globalaccelerator.CreateCustomRoutingEndpointGroupInput{
+		DestinationConfigurations: expandGlobalAcceleratorCustomRoutingDestinationConfigurations(d.Get("destination_configuration").(*schema.Set).List()),
+		EndpointGroupRegion:       aws.String(region),
+		IdempotencyToken:          aws.String(resource.UniqueId()),
+		ListenerArn:               aws.String(d.Get("listener_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("endpoint_group_region"); ok {
+		opts.EndpointGroupRegion = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Create Global Accelerator custom routing endpoint group: %s", opts)
+
+	resp, err := conn.CreateCustomRoutingEndpointGroup(opts)
+	if err != nil {
+		return fmt.Errorf("error creating Global Accelerator custom routing endpoint group: %w", err)
+	}
+
+	d.SetId(aws.StringValue(resp.EndpointGroup.EndpointGroupArn))
+
+	acceleratorArn, err := resourceAwsGlobalAcceleratorListenerParseAcceleratorArn(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	err = resourceAwsGlobalAcceleratorCustomRoutingAcceleratorWaitForDeployedState(conn, acceleratorArn)
+
+	if err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("endpoint_configuration"); ok && v.(*schema.Set).Len() > 0 {
+		_, err := conn.AddCustomRoutingEndpoints(&globalaccelerator.AddCustomRoutingEndpointsInput{
+			EndpointConfigurations: expandGlobalAcceleratorCustomRoutingEndpointConfigurations(v.(*schema.Set).List()),
+			EndpointGroupArn:       aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error adding Global Accelerator custom routing endpoint group (%s) endpoints: %w", d.Id(), err)
+		}
+
+		err = resourceAwsGlobalAcceleratorCustomRoutingAcceleratorWaitForDeployedState(conn, acceleratorArn)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGlobalAcceleratorCustomRoutingEndpointGroupRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingEndpointGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	endpointGroup, err := finder.CustomRoutingEndpointGroupByARN(conn, d.Id())
+
+	if isAWSErr(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, "") {
+		log.Printf("[WARN] Global Accelerator custom routing endpoint group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator custom routing endpoint group (%s): %w", d.Id(), err)
+	}
+
+	if endpointGroup == nil {
+		log.Printf("[WARN] Global Accelerator custom routing endpoint group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	listenerArn, err := resourceAwsGlobalAcceleratorEndpointGroupParseListenerArn(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", endpointGroup.EndpointGroupArn)
+	if err := d.Set("destination_configuration", flattenGlobalAcceleratorCustomRoutingDestinationDescriptions(endpointGroup.DestinationDescriptions)); err != nil {
+		return fmt.Errorf("error setting destination_configuration: %w", err)
+	}
+	if err := d.Set("endpoint_configuration", flattenGlobalAcceleratorCustomRoutingEndpointDescriptions(endpointGroup.EndpointDescriptions)); err != nil {
+		return fmt.Errorf("error setting endpoint_configuration: %w", err)
+	}
+	d.Set("endpoint_group_region", endpointGroup.EndpointGroupRegion)
+	d.Set("listener_arn", listenerArn)
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingEndpointGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	if d.HasChange("endpoint_configuration") {
+		o, n := d.GetChange("endpoint_configuration")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		acceleratorArn, err := resourceAwsGlobalAcceleratorListenerParseAcceleratorArn(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		if remove := os.Difference(ns).List(); len(remove) > 0 {
+			endpointIds := make([]*string, 0, len(remove))
+
+			for _, configuration := range expandGlobalAcceleratorCustomRoutingEndpointConfigurations(remove) {
+				endpointIds = append(endpointIds, configuration.EndpointId)
+			}
+
+			_, err := conn.RemoveCustomRoutingEndpoints(&globalaccelerator.RemoveCustomRoutingEndpointsInput{
+				EndpointGroupArn: aws.String(d.Id()),
+				EndpointIds:      endpointIds,
+			})
+
+			if err != nil {
+				return fmt.Errorf("error removing Global Accelerator custom routing endpoint group (%s) endpoints: %w", d.Id(), err)
+			}
+
+			err = resourceAwsGlobalAcceleratorCustomRoutingAcceleratorWaitForDeployedState(conn, acceleratorArn)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			_, err := conn.AddCustomRoutingEndpoints(&globalaccelerator.AddCustomRoutingEndpointsInput{
+				EndpointConfigurations: expandGlobalAcceleratorCustomRoutingEndpointConfigurations(add),
+				EndpointGroupArn:       aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error adding Global Accelerator custom routing endpoint group (%s) endpoints: %w", d.Id(), err)
+			}
+
+			err = resourceAwsGlobalAcceleratorCustomRoutingAcceleratorWaitForDeployedState(conn, acceleratorArn)
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsGlobalAcceleratorCustomRoutingEndpointGroupRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingEndpointGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	opts := &globalaccelerator.DeleteCustomRoutingEndpointGroupInput{
+		EndpointGroupArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteCustomRoutingEndpointGroup(opts)
+
+	if isAWSErr(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Global Accelerator custom routing endpoint group (%s): %w", d.Id(), err)
+	}
+
+	acceleratorArn, err := resourceAwsGlobalAcceleratorListenerParseAcceleratorArn(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	err = resourceAwsGlobalAcceleratorCustomRoutingAcceleratorWaitForDeployedState(conn, acceleratorArn)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func expandGlobalAcceleratorCustomRoutingDestinationConfigurations(configurations []interface{}) []*globalaccelerator.CustomRoutingDestinationConfiguration {
+	out := make([]*globalaccelerator.CustomRoutingDestinationConfiguration, len(configurations))
+
+	for i, raw := range configurations {
+		configuration := raw.(map[string]interface{})
+		m := globalaccelerator.CustomRoutingDestinationConfiguration{}
+
+		m.FromPort = aws.Int64(int64(configuration["from_port"].(int)))
+		m.Protocols = expandStringSet(configuration["protocols"].(*schema.Set))
+		m.ToPort = aws.Int64(int64(configuration["to_port"].(int)))
+
+		out[i] = &m
+	}
+
+	return out
+}
+
+func expandGlobalAcceleratorCustomRoutingEndpointConfigurations(configurations []interface{}) []*globalaccelerator.CustomRoutingEndpointConfiguration {
+	out := make([]*globalaccelerator.CustomRoutingEndpointConfiguration, len(configurations))
+
+	for i, raw := range configurations {
+		configuration := raw.(map[string]interface{})
+		m := globalaccelerator.CustomRoutingEndpointConfiguration{}
+
+		m.EndpointId = aws.String(configuration["endpoint_id"].(string))
+
+		out[i] = &m
+	}
+
+	return out
+}
+
+func flattenGlobalAcceleratorCustomRoutingDestinationDescriptions(descriptions []*globalaccelerator.CustomRoutingDestinationDescription) []interface{} {
+	out := make([]interface{}, len(descriptions))
+
+	for i, description := range descriptions {
+		m := make(map[string]interface{})
+
+		m["from_port"] = int(aws.Int64Value(description.FromPort))
+		m["protocols"] = flattenStringSet(description.Protocols)
+		m["to_port"] = int(aws.Int64Value(description.ToPort))
+
+		out[i] = m
+	}
+
+	return out
+}
+
+func flattenGlobalAcceleratorCustomRoutingEndpointDescriptions(descriptions []*globalaccelerator.CustomRoutingEndpointDescription) []interface{} {
+	out := make([]interface{}, len(descriptions))
+
+	for i, description := range descriptions {
+		m := make(map[string]interface{})
+
+		m["endpoint_id"] = aws.StringValue(description.EndpointId)
+
+		out[i] = m
+	}
+
+	return out
+}
@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/globalaccelerator/finder"
+)
+
+func TestAccAwsGlobalAcceleratorCustomRoutingEndpointGroup_basic(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_group.example"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "destination_configuration.*", map[string]string{
+						"from_port":   "443",
+						"to_port":     "8443",
+						"protocols.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_configuration.*.protocols.*", "TCP"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_group_region", testAccGetRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "listener_arn", "aws_globalaccelerator_custom_routing_listener.example", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsGlobalAcceleratorCustomRoutingEndpointGroup_EndpointConfiguration(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_group.example"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigEndpointConfiguration(rName, "aws_subnet.test1.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "endpoint_configuration.*.endpoint_id", "aws_subnet.test1", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigEndpointConfiguration(rName, "aws_subnet.test2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "endpoint_configuration.*.endpoint_id", "aws_subnet.test2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		endpointGroup, err := finder.CustomRoutingEndpointGroupByARN(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if endpointGroup == nil {
+			return fmt.Errorf("Global Accelerator custom routing endpoint group not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_custom_routing_endpoint_group" {
+			continue
+		}
+
+		endpointGroup, err := finder.CustomRoutingEndpointGroupByARN(conn, rs.Primary.ID)
+		if isAWSErr(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if endpointGroup != nil {
+			return fmt.Errorf("Global Accelerator custom routing endpoint group (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_custom_routing_accelerator" "example" {
+  name            = %[1]q
+  ip_address_type = "IPV4"
+  enabled         = false
+}
+
+resource "aws_globalaccelerator_custom_routing_listener" "example" {
+  accelerator_arn = aws_globalaccelerator_custom_routing_accelerator.example.id
+
+  port_range {
+    from_port = 10000
+    to_port   = 30000
+  }
+}
+`, rName)
+}
+
+func testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigBasic(rName string) string {
+	return composeConfig(
+		testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigBase(rName),
+		`
+resource "aws_globalaccelerator_custom_routing_endpoint_group" "example" {
+  listener_arn = aws_globalaccelerator_custom_routing_listener.example.id
+
+  destination_configuration {
+    from_port = 443
+    to_port   = 8443
+    protocols = ["TCP"]
+  }
+}
+`)
+}
+
+func testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigEndpointConfiguration(rName, endpointId string) string {
+	return composeConfig(
+		testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigBase(rName),
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test1" {
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.1.0/24"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test2" {
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[1]
+  cidr_block        = "10.0.2.0/24"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_globalaccelerator_custom_routing_endpoint_group" "example" {
+  listener_arn = aws_globalaccelerator_custom_routing_listener.example.id
+
+  destination_configuration {
+    from_port = 80
+    to_port   = 81
+    protocols = ["TCP", "UDP"]
+  }
+
+  endpoint_configuration {
+    endpoint_id = %[2]s
+  }
+}
+`, rName, endpointId))
+}
@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/globalaccelerator/finder"
+)
+
+func resourceAwsGlobalAcceleratorCustomRoutingEndpointTraffic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlobalAcceleratorCustomRoutingEndpointTrafficCreate,
+		Read:   resourceAwsGlobalAcceleratorCustomRoutingEndpointTrafficRead,
+		Delete: resourceAwsGlobalAcceleratorCustomRoutingEndpointTrafficDelete,
+
+		Schema: map[string]*schema.Schema{
+			"allow_all_traffic_to_endpoint": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"destination_addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+
+			"destination_ports": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IsPortNumber,
+				},
+			},
+
+			"endpoint_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingEndpointTrafficCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+	endpointGroupArn := d.Get("endpoint_group_arn").(string)
+	endpointId := d.Get("endpoint_id").(string)
+
+	opts := &globalaccelerator.AllowCustomRoutingTrafficInput{
+		AllowAllTrafficToEndpoint: aws.Bool(d.Get("allow_all_traffic_to_endpoint").(bool)),
+		EndpointGroupArn:          aws.String(endpointGroupArn),
+		EndpointId:                aws.String(endpointId),
+	}
+
+	if v, ok := d.GetOk("destination_addresses"); ok && v.(*schema.Set).Len() > 0 {
+		opts.DestinationAddresses = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("destination_ports"); ok && v.(*schema.Set).Len() > 0 {
+		opts.DestinationPorts = expandInt64Set(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Allowing Global Accelerator custom routing traffic: %s", opts)
+
+	_, err := conn.AllowCustomRoutingTraffic(opts)
+	if err != nil {
+		return fmt.Errorf("error allowing Global Accelerator custom routing traffic to endpoint (%s): %w", endpointId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", endpointGroupArn, endpointId))
+
+	return resourceAwsGlobalAcceleratorCustomRoutingEndpointTrafficRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingEndpointTrafficRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	endpointGroupArn, endpointId, err := resourceAwsGlobalAcceleratorCustomRoutingEndpointTrafficParseId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	endpointGroup, err := finder.CustomRoutingEndpointGroupByARN(conn, endpointGroupArn)
+
+	if isAWSErr(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, "") {
+		log.Printf("[WARN] Global Accelerator custom routing endpoint group (%s) not found, removing traffic (%s) from state", endpointGroupArn, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator custom routing endpoint group (%s): %w", endpointGroupArn, err)
+	}
+
+	found := false
+	if endpointGroup != nil {
+		for _, description := range endpointGroup.EndpointDescriptions {
+			if aws.StringValue(description.EndpointId) == endpointId {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Global Accelerator custom routing endpoint (%s) not found, removing traffic (%s) from state", endpointId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("endpoint_group_arn", endpointGroupArn)
+	d.Set("endpoint_id", endpointId)
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingEndpointTrafficDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	endpointGroupArn, endpointId, err := resourceAwsGlobalAcceleratorCustomRoutingEndpointTrafficParseId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	opts := &globalaccelerator.DenyCustomRoutingTrafficInput{
+		DenyAllTrafficToEndpoint: aws.Bool(d.Get("allow_all_traffic_to_endpoint").(bool)),
+		EndpointGroupArn:         aws.String(endpointGroupArn),
+		EndpointId:               aws.String(endpointId),
+	}
+
+	if v, ok := d.GetOk("destination_addresses"); ok && v.(*schema.Set).Len() > 0 {
+		opts.DestinationAddresses = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("destination_ports"); ok && v.(*schema.Set).Len() > 0 {
+		opts.DestinationPorts = expandInt64Set(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Denying Global Accelerator custom routing traffic: %s", opts)
+
+	_, err = conn.DenyCustomRoutingTraffic(opts)
+
+	if isAWSErr(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, "") || isAWSErr(err, globalaccelerator.ErrCodeEndpointNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error denying Global Accelerator custom routing traffic to endpoint (%s): %w", endpointId, err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingEndpointTrafficParseId(id string) (string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected ENDPOINT-GROUP-ARN,ENDPOINT-ID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAwsGlobalAcceleratorCustomRoutingEndpointTraffic_basic(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.example"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingEndpointTrafficConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allow_all_traffic_to_endpoint", "false"),
+					resource.TestCheckResourceAttr(resourceName, "destination_addresses.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_addresses.*", "10.0.1.10"),
+					resource.TestCheckResourceAttr(resourceName, "destination_ports.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_ports.*", "80"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_group_arn", "aws_globalaccelerator_custom_routing_endpoint_group.example", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_id", "aws_subnet.test1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsGlobalAcceleratorCustomRoutingEndpointTraffic_AllowAll(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.example"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingEndpointTrafficConfigAllowAll(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allow_all_traffic_to_endpoint", "true"),
+					resource.TestCheckResourceAttr(resourceName, "destination_addresses.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destination_ports.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGlobalAcceleratorCustomRoutingEndpointTrafficConfigBasic(rName string) string {
+	return composeConfig(
+		testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigEndpointConfiguration(rName, "aws_subnet.test1.id"),
+		`
+resource "aws_globalaccelerator_custom_routing_endpoint_traffic" "example" {
+  endpoint_group_arn    = aws_globalaccelerator_custom_routing_endpoint_group.example.id
+  endpoint_id           = aws_subnet.test1.id
+  destination_addresses = ["10.0.1.10"]
+  destination_ports     = [80]
+}
+`)
+}
+
+func testAccGlobalAcceleratorCustomRoutingEndpointTrafficConfigAllowAll(rName string) string {
+	return composeConfig(
+		testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigEndpointConfiguration(rName, "aws_subnet.test1.id"),
+		`
+resource "aws_globalaccelerator_custom_routing_endpoint_traffic" "example" {
+  endpoint_group_arn            = aws_globalaccelerator_custom_routing_endpoint_group.example.id
+  endpoint_id                   = aws_subnet.test1.id
+  allow_all_traffic_to_endpoint = true
+}
+`)
+}
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/globalaccelerator/finder"
+)
+
+func resourceAwsGlobalAcceleratorCustomRoutingListener() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlobalAcceleratorCustomRoutingListenerCreate,
+		Read:   resourceAwsGlobalAcceleratorCustomRoutingListenerRead,
+		Update: resourceAwsGlobalAcceleratorCustomRoutingListenerUpdate,
+		Delete: resourceAwsGlobalAcceleratorCustomRoutingListenerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accelerator_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"port_range": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingListenerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+	acceleratorArn := d.Get("accelerator_arn").(string)
+
+	opts := &globalaccelerator.CreateCustomRoutingListenerInput{
+		AcceleratorArn:   aws.String(acceleratorArn),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		PortRanges:       resourceAwsGlobalAcceleratorListenerExpandPortRanges(d.Get("port_range").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Create Global Accelerator custom routing listener: %s", opts)
+
+	resp, err := conn.CreateCustomRoutingListener(opts)
+	if err != nil {
+		return fmt.Errorf("error creating Global Accelerator custom routing listener: %w", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Listener.ListenerArn))
+
+	// Creating a listener triggers the accelerator to change status to InPending
+	err = resourceAwsGlobalAcceleratorCustomRoutingAcceleratorWaitForDeployedState(conn, acceleratorArn)
+	if err != nil {
+		return err
+	}
+
+	return resourceAwsGlobalAcceleratorCustomRoutingListenerRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingListenerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	listener, err := finder.CustomRoutingListenerByARN(conn, d.Id())
+
+	if isAWSErr(err, globalaccelerator.ErrCodeListenerNotFoundException, "") {
+		log.Printf("[WARN] Global Accelerator custom routing listener (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator custom routing listener (%s): %w", d.Id(), err)
+	}
+
+	if listener == nil {
+		log.Printf("[WARN] Global Accelerator custom routing listener (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	acceleratorArn, err := resourceAwsGlobalAcceleratorListenerParseAcceleratorArn(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("accelerator_arn", acceleratorArn)
+	if err := d.Set("port_range", resourceAwsGlobalAcceleratorListenerFlattenPortRanges(listener.PortRanges)); err != nil {
+		return fmt.Errorf("error setting port_range: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	opts := &globalaccelerator.UpdateCustomRoutingListenerInput{
+		ListenerArn: aws.String(d.Id()),
+		PortRanges:  resourceAwsGlobalAcceleratorListenerExpandPortRanges(d.Get("port_range").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Update Global Accelerator custom routing listener: %s", opts)
+
+	_, err := conn.UpdateCustomRoutingListener(opts)
+	if err != nil {
+		return fmt.Errorf("error updating Global Accelerator custom routing listener (%s): %w", d.Id(), err)
+	}
+
+	err = resourceAwsGlobalAcceleratorCustomRoutingAcceleratorWaitForDeployedState(conn, d.Get("accelerator_arn").(string))
+	if err != nil {
+		return err
+	}
+
+	return resourceAwsGlobalAcceleratorCustomRoutingListenerRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorCustomRoutingListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	opts := &globalaccelerator.DeleteCustomRoutingListenerInput{
+		ListenerArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteCustomRoutingListener(opts)
+
+	if isAWSErr(err, globalaccelerator.ErrCodeListenerNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Global Accelerator custom routing listener (%s): %w", d.Id(), err)
+	}
+
+	// Deleting a listener triggers the accelerator to change status to InPending
+	err = resourceAwsGlobalAcceleratorCustomRoutingAcceleratorWaitForDeployedState(conn, d.Get("accelerator_arn").(string))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
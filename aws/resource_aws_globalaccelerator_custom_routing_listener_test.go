@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/globalaccelerator/finder"
+)
+
+func TestAccAwsGlobalAcceleratorCustomRoutingListener_basic(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_listener.example"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorCustomRoutingListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingListenerConfig(rName, 10000, 30000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingListenerExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "accelerator_arn", "aws_globalaccelerator_custom_routing_accelerator.example", "id"),
+					resource.TestCheckResourceAttr(resourceName, "port_range.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "port_range.*", map[string]string{
+						"from_port": "10000",
+						"to_port":   "30000",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingListenerConfig(rName, 20000, 40000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "port_range.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "port_range.*", map[string]string{
+						"from_port": "20000",
+						"to_port":   "40000",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalAcceleratorCustomRoutingListenerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		listener, err := finder.CustomRoutingListenerByARN(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if listener == nil {
+			return fmt.Errorf("Global Accelerator custom routing listener not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalAcceleratorCustomRoutingListenerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_custom_routing_listener" {
+			continue
+		}
+
+		listener, err := finder.CustomRoutingListenerByARN(conn, rs.Primary.ID)
+		if isAWSErr(err, globalaccelerator.ErrCodeListenerNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if listener != nil {
+			return fmt.Errorf("Global Accelerator custom routing listener (%s) still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGlobalAcceleratorCustomRoutingListenerConfig(rName string, fromPort, toPort int) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_custom_routing_accelerator" "example" {
+  name            = %[1]q
+  ip_address_type = "IPV4"
+  enabled         = false
+}
+
+resource "aws_globalaccelerator_custom_routing_listener" "example" {
+  accelerator_arn = aws_globalaccelerator_custom_routing_accelerator.example.id
+
+  port_range {
+    from_port = %[2]d
+    to_port   = %[3]d
+  }
+}
+`, rName, fromPort, toPort)
+}
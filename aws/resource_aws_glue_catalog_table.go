@@ -9,8 +9,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/waiter"
 )
 
 func resourceAwsGlueCatalogTable() *schema.Resource {
@@ -250,7 +252,6 @@ func resourceAwsGlueCatalogTable() *schema.Resource {
 			"partition_index": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 3,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -272,6 +273,27 @@ func resourceAwsGlueCatalogTable() *schema.Resource {
 					},
 				},
 			},
+			"target_table": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -305,6 +327,14 @@ func resourceAwsGlueCatalogTableCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(fmt.Sprintf("%s:%s:%s", catalogID, dbName, name))
 
+	for _, partitionIndex := range input.PartitionIndexes {
+		indexName := aws.StringValue(partitionIndex.IndexName)
+
+		if _, err := waiter.PartitionIndexCreated(conn, catalogID, dbName, name, indexName); err != nil {
+			return fmt.Errorf("error waiting for Glue Catalog Table (%s) Partition Index (%s) creation: %w", d.Id(), indexName, err)
+		}
+	}
+
 	return resourceAwsGlueCatalogTableRead(d, meta)
 }
 
@@ -367,20 +397,34 @@ func resourceAwsGlueCatalogTableRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("error setting parameters: %w", err)
 	}
 
+	if err := d.Set("target_table", flattenGlueTableTargetTable(table.TargetTable)); err != nil {
+		return fmt.Errorf("error setting target_table: %w", err)
+	}
+
 	partIndexInput := &glue.GetPartitionIndexesInput{
 		CatalogId:    out.Table.CatalogId,
 		TableName:    out.Table.Name,
 		DatabaseName: out.Table.DatabaseName,
 	}
-	partOut, err := conn.GetPartitionIndexes(partIndexInput)
+
+	var partitionIndexes []*glue.PartitionIndexDescriptor
+
+	err = conn.GetPartitionIndexesPages(partIndexInput, func(page *glue.GetPartitionIndexesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		partitionIndexes = append(partitionIndexes, page.PartitionIndexDescriptorList...)
+
+		return !lastPage
+	})
+
 	if err != nil {
 		return fmt.Errorf("error getting Glue Partition Indexes: %w", err)
 	}
 
-	if partOut != nil && len(partOut.PartitionIndexDescriptorList) > 0 {
-		if err := d.Set("partition_index", flattenGluePartitionIndexes(partOut.PartitionIndexDescriptorList)); err != nil {
-			return fmt.Errorf("error setting partition_index: %w", err)
-		}
+	if err := d.Set("partition_index", flattenGluePartitionIndexes(partitionIndexes)); err != nil {
+		return fmt.Errorf("error setting partition_index: %w", err)
 	}
 
 	return nil
@@ -389,19 +433,77 @@ func resourceAwsGlueCatalogTableRead(d *schema.ResourceData, meta interface{}) e
 func resourceAwsGlueCatalogTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).glueconn
 
-	catalogID, dbName, _, err := readAwsGlueTableID(d.Id())
+	catalogID, dbName, name, err := readAwsGlueTableID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	updateTableInput := &glue.UpdateTableInput{
-		CatalogId:    aws.String(catalogID),
-		DatabaseName: aws.String(dbName),
-		TableInput:   expandGlueTableInput(d),
+	if d.HasChangesExcept("partition_index") {
+		updateTableInput := &glue.UpdateTableInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			TableInput:   expandGlueTableInput(d),
+		}
+
+		if _, err := conn.UpdateTable(updateTableInput); err != nil {
+			return fmt.Errorf("Error updating Glue Catalog Table: %w", err)
+		}
 	}
 
-	if _, err := conn.UpdateTable(updateTableInput); err != nil {
-		return fmt.Errorf("Error updating Glue Catalog Table: %w", err)
+	if d.HasChange("partition_index") {
+		o, n := d.GetChange("partition_index")
+		oldIndexes := glueTablePartitionIndexesByName(o.([]interface{}))
+		newIndexes := glueTablePartitionIndexesByName(n.([]interface{}))
+
+		// Partition indexes cannot be modified, so removed or changed indexes are deleted
+		// before added or changed indexes are created to stay within the per-table limit.
+		for indexName, oldIndex := range oldIndexes {
+			if newIndex, ok := newIndexes[indexName]; ok && newIndex["keys"].(*schema.Set).Equal(oldIndex["keys"]) {
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting Glue Catalog Table (%s) Partition Index: %s", d.Id(), indexName)
+			_, err := conn.DeletePartitionIndex(&glue.DeletePartitionIndexInput{
+				CatalogId:    aws.String(catalogID),
+				DatabaseName: aws.String(dbName),
+				IndexName:    aws.String(indexName),
+				TableName:    aws.String(name),
+			})
+
+			if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error deleting Glue Catalog Table (%s) Partition Index (%s): %w", d.Id(), indexName, err)
+			}
+
+			if _, err := waiter.PartitionIndexDeleted(conn, catalogID, dbName, name, indexName); err != nil {
+				return fmt.Errorf("error waiting for Glue Catalog Table (%s) Partition Index (%s) deletion: %w", d.Id(), indexName, err)
+			}
+		}
+
+		for indexName, newIndex := range newIndexes {
+			if oldIndex, ok := oldIndexes[indexName]; ok && oldIndex["keys"].(*schema.Set).Equal(newIndex["keys"]) {
+				continue
+			}
+
+			input := &glue.CreatePartitionIndexInput{
+				CatalogId:      aws.String(catalogID),
+				DatabaseName:   aws.String(dbName),
+				PartitionIndex: expandGlueTablePartitionIndex(newIndex),
+				TableName:      aws.String(name),
+			}
+
+			log.Printf("[DEBUG] Creating Glue Catalog Table (%s) Partition Index: %s", d.Id(), input)
+			if _, err := conn.CreatePartitionIndex(input); err != nil {
+				return fmt.Errorf("error creating Glue Catalog Table (%s) Partition Index (%s): %w", d.Id(), indexName, err)
+			}
+
+			if _, err := waiter.PartitionIndexCreated(conn, catalogID, dbName, name, indexName); err != nil {
+				return fmt.Errorf("error waiting for Glue Catalog Table (%s) Partition Index (%s) creation: %w", d.Id(), indexName, err)
+			}
+		}
 	}
 
 	return resourceAwsGlueCatalogTableRead(d, meta)
@@ -468,9 +570,45 @@ func expandGlueTableInput(d *schema.ResourceData) *glue.TableInput {
 		tableInput.Parameters = stringMapToPointers(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("target_table"); ok {
+		tableInput.TargetTable = expandGlueTableTargetTable(v.([]interface{}))
+	}
+
 	return tableInput
 }
 
+func expandGlueTableTargetTable(l []interface{}) *glue.TableIdentifier {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	tableIdentifier := &glue.TableIdentifier{
+		CatalogId:    aws.String(m["catalog_id"].(string)),
+		DatabaseName: aws.String(m["database_name"].(string)),
+		Name:         aws.String(m["name"].(string)),
+	}
+
+	return tableIdentifier
+}
+
+func glueTablePartitionIndexesByName(l []interface{}) map[string]map[string]interface{} {
+	partitionIndexes := make(map[string]map[string]interface{}, len(l))
+
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		partitionIndexes[m["index_name"].(string)] = m
+	}
+
+	return partitionIndexes
+}
+
 func expandGlueTablePartitionIndexes(a []interface{}) []*glue.PartitionIndex {
 	partitionIndexes := make([]*glue.PartitionIndex, 0, len(a))
 
@@ -744,6 +882,20 @@ func flattenGluePartitionIndex(c *glue.PartitionIndexDescriptor) map[string]inte
 	return partitionIndex
 }
 
+func flattenGlueTableTargetTable(t *glue.TableIdentifier) []map[string]interface{} {
+	if t == nil {
+		return []map[string]interface{}{}
+	}
+
+	tableIdentifier := map[string]interface{}{
+		"catalog_id":    aws.StringValue(t.CatalogId),
+		"database_name": aws.StringValue(t.DatabaseName),
+		"name":          aws.StringValue(t.Name),
+	}
+
+	return []map[string]interface{}{tableIdentifier}
+}
+
 func flattenGlueSerDeInfo(s *glue.SerDeInfo) []map[string]interface{} {
 	if s == nil {
 		serDeInfos := make([]map[string]interface{}, 0)
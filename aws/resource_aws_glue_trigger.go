@@ -8,10 +8,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsGlueTrigger() *schema.Resource {
@@ -25,6 +28,7 @@ func resourceAwsGlueTrigger() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -86,6 +90,26 @@ func resourceAwsGlueTrigger() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"event_batching_condition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"batch_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"batch_window": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      900,
+							ValidateFunc: validation.IntBetween(1, 900),
+						},
+					},
+				},
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -144,6 +168,10 @@ func resourceAwsGlueTrigger() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"start_on_creation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"tags": tagsSchema(),
 			"type": {
 				Type:         schema.TypeString,
@@ -176,6 +204,10 @@ func resourceAwsGlueTriggerCreate(d *schema.ResourceData, meta interface{}) erro
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("event_batching_condition"); ok {
+		input.EventBatchingCondition = expandGlueEventBatchingCondition(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("predicate"); ok {
 		input.Predicate = expandGluePredicate(v.([]interface{}))
 	}
@@ -184,12 +216,8 @@ func resourceAwsGlueTriggerCreate(d *schema.ResourceData, meta interface{}) erro
 		input.Schedule = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("workflow_name"); ok {
-		input.WorkflowName = aws.String(v.(string))
-	}
-
-	if d.Get("enabled").(bool) && triggerType != glue.TriggerTypeOnDemand {
-		input.StartOnCreation = aws.Bool(true)
+	if v, ok := d.GetOk("start_on_creation"); ok {
+		input.StartOnCreation = aws.Bool(v.(bool))
 	}
 
 	if v, ok := d.GetOk("workflow_name"); ok {
@@ -205,13 +233,22 @@ func resourceAwsGlueTriggerCreate(d *schema.ResourceData, meta interface{}) erro
 	d.SetId(name)
 
 	log.Printf("[DEBUG] Waiting for Glue Trigger (%s) to create", d.Id())
-	if _, err := waiter.TriggerCreated(conn, d.Id()); err != nil {
+	output, err := waiter.TriggerCreated(conn, d.Id())
+	if err != nil {
 		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
 		}
 		return fmt.Errorf("error waiting for Glue Trigger (%s) to be Created: %w", d.Id(), err)
 	}
 
+	// Triggers not started by the create call (including all EVENT triggers)
+	// can only be started once they have reached the CREATED state.
+	if d.Get("enabled").(bool) && triggerType != glue.TriggerTypeOnDemand && output != nil && output.Trigger != nil && aws.StringValue(output.Trigger.State) == glue.TriggerStateCreated {
+		if err := startGlueTrigger(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error starting Glue Trigger (%s): %w", d.Id(), err)
+		}
+	}
+
 	return resourceAwsGlueTriggerRead(d, meta)
 }
 
@@ -266,6 +303,10 @@ func resourceAwsGlueTriggerRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("enabled", enabled)
 
+	if err := d.Set("event_batching_condition", flattenGlueEventBatchingCondition(trigger.EventBatchingCondition)); err != nil {
+		return fmt.Errorf("error setting event_batching_condition: %w", err)
+	}
+
 	if err := d.Set("predicate", flattenGluePredicate(trigger.Predicate)); err != nil {
 		return fmt.Errorf("error setting predicate: %w", err)
 	}
@@ -292,7 +333,7 @@ func resourceAwsGlueTriggerRead(d *schema.ResourceData, meta interface{}) error
 func resourceAwsGlueTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).glueconn
 
-	if d.HasChanges("actions", "description", "predicate", "schedule") {
+	if d.HasChanges("actions", "description", "event_batching_condition", "predicate", "schedule") {
 		triggerUpdate := &glue.TriggerUpdate{
 			Actions: expandGlueActions(d.Get("actions").([]interface{})),
 		}
@@ -301,6 +342,10 @@ func resourceAwsGlueTriggerUpdate(d *schema.ResourceData, meta interface{}) erro
 			triggerUpdate.Description = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("event_batching_condition"); ok {
+			triggerUpdate.EventBatchingCondition = expandGlueEventBatchingCondition(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("predicate"); ok {
 			triggerUpdate.Predicate = expandGluePredicate(v.([]interface{}))
 		}
@@ -322,13 +367,7 @@ func resourceAwsGlueTriggerUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	if d.HasChange("enabled") {
 		if d.Get("enabled").(bool) {
-			input := &glue.StartTriggerInput{
-				Name: aws.String(d.Id()),
-			}
-
-			log.Printf("[DEBUG] Starting Glue Trigger: %s", input)
-			_, err := conn.StartTrigger(input)
-			if err != nil {
+			if err := startGlueTrigger(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return fmt.Errorf("error starting Glue Trigger (%s): %w", d.Id(), err)
 			}
 		} else {
@@ -390,6 +429,33 @@ func deleteGlueTrigger(conn *glue.Glue, Name string) error {
 	return nil
 }
 
+func startGlueTrigger(conn *glue.Glue, name string, timeout time.Duration) error {
+	input := &glue.StartTriggerInput{
+		Name: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Starting Glue Trigger: %s", input)
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		_, err := conn.StartTrigger(input)
+
+		if tfawserr.ErrCodeEquals(err, glue.ErrCodeConcurrentModificationException) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.StartTrigger(input)
+	}
+
+	return err
+}
+
 func expandGlueActions(l []interface{}) []*glue.Action {
 	actions := []*glue.Action{}
 
@@ -488,6 +554,24 @@ func expandGluePredicate(l []interface{}) *glue.Predicate {
 	return predicate
 }
 
+func expandGlueEventBatchingCondition(l []interface{}) *glue.EventBatchingCondition {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	condition := &glue.EventBatchingCondition{
+		BatchSize: aws.Int64(int64(m["batch_size"].(int))),
+	}
+
+	if v, ok := m["batch_window"].(int); ok && v > 0 {
+		condition.BatchWindow = aws.Int64(int64(v))
+	}
+
+	return condition
+}
+
 func flattenGlueActions(actions []*glue.Action) []interface{} {
 	l := []interface{}{}
 
@@ -573,3 +657,16 @@ func flattenGlueTriggerNotificationProperty(property *glue.NotificationProperty)
 
 	return []map[string]interface{}{m}
 }
+
+func flattenGlueEventBatchingCondition(condition *glue.EventBatchingCondition) []map[string]interface{} {
+	if condition == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"batch_size":   aws.Int64Value(condition.BatchSize),
+		"batch_window": aws.Int64Value(condition.BatchWindow),
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -255,6 +255,76 @@ func TestAccAWSGlueTrigger_Predicate(t *testing.T) {
 	})
 }
 
+func TestAccAWSGlueTrigger_StartOnCreation(t *testing.T) {
+	var trigger glue.Trigger
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_trigger.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueTriggerConfig_StartOnCreation(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueTriggerExists(resourceName, &trigger),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "start_on_creation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "type", "CONDITIONAL"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_on_creation"},
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueTrigger_EventBatchingCondition(t *testing.T) {
+	var trigger glue.Trigger
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_trigger.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueTriggerConfig_EventBatchingCondition(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueTriggerExists(resourceName, &trigger),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "event_batching_condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_batching_condition.0.batch_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_batching_condition.0.batch_window", "900"),
+					resource.TestCheckResourceAttr(resourceName, "type", "EVENT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueTriggerConfig_EventBatchingCondition(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueTriggerExists(resourceName, &trigger),
+					resource.TestCheckResourceAttr(resourceName, "event_batching_condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_batching_condition.0.batch_size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "type", "EVENT"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSGlueTrigger_Schedule(t *testing.T) {
 	var trigger glue.Trigger
 
@@ -599,6 +669,60 @@ resource "aws_glue_trigger" "test" {
 `, rName, state))
 }
 
+func testAccAWSGlueTriggerConfig_StartOnCreation(rName string, startOnCreation bool) string {
+	return composeConfig(testAccAWSGlueJobConfig_Required(rName), fmt.Sprintf(`
+resource "aws_glue_job" "test2" {
+  name     = "%[1]s2"
+  role_arn = aws_iam_role.test.arn
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+
+resource "aws_glue_trigger" "test" {
+  name              = %[1]q
+  type              = "CONDITIONAL"
+  start_on_creation = %[2]t
+
+  actions {
+    job_name = aws_glue_job.test2.name
+  }
+
+  predicate {
+    conditions {
+      job_name = aws_glue_job.test.name
+      state    = "SUCCEEDED"
+    }
+  }
+}
+`, rName, startOnCreation))
+}
+
+func testAccAWSGlueTriggerConfig_EventBatchingCondition(rName string, batchSize int) string {
+	return composeConfig(testAccAWSGlueJobConfig_Required(rName), fmt.Sprintf(`
+resource "aws_glue_workflow" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_trigger" "test" {
+  name          = %[1]q
+  type          = "EVENT"
+  workflow_name = aws_glue_workflow.test.name
+
+  actions {
+    job_name = aws_glue_job.test.name
+  }
+
+  event_batching_condition {
+    batch_size = %[2]d
+  }
+}
+`, rName, batchSize))
+}
+
 func testAccAWSGlueTriggerConfig_Crawler(rName, state string) string {
 	return composeConfig(testAccGlueCrawlerConfig_S3Target(rName, "s3://test_bucket"), fmt.Sprintf(`
 resource "aws_glue_crawler" "test2" {
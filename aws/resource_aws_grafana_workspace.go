@@ -0,0 +1,355 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/managedgrafana/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/managedgrafana/waiter"
+)
+
+func resourceAwsGrafanaWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGrafanaWorkspaceCreate,
+		Read:   resourceAwsGrafanaWorkspaceRead,
+		Update: resourceAwsGrafanaWorkspaceUpdate,
+		Delete: resourceAwsGrafanaWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_access_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(managedgrafana.AccountAccessType_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_providers": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(managedgrafana.AuthenticationProviderTypes_Values(), false),
+				},
+			},
+			"data_sources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(managedgrafana.DataSourceType_Values(), false),
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grafana_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-._~]+$`), "must only include alphanumeric, hyphen, period, underscore or tilde characters"),
+				),
+			},
+			"notification_destinations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(managedgrafana.NotificationDestinationType_Values(), false),
+				},
+			},
+			"organizational_units": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"permission_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(managedgrafana.PermissionType_Values(), false),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+			"saml_configuration_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stack_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsGrafanaWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).managedgrafanaconn
+
+	input := &managedgrafana.CreateWorkspaceInput{
+		AccountAccessType:       aws.String(d.Get("account_access_type").(string)),
+		AuthenticationProviders: expandStringList(d.Get("authentication_providers").([]interface{})),
+		PermissionType:          aws.String(d.Get("permission_type").(string)),
+	}
+
+	if v, ok := d.GetOk("data_sources"); ok && v.(*schema.Set).Len() > 0 {
+		input.WorkspaceDataSources = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.WorkspaceDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.WorkspaceName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_destinations"); ok && v.(*schema.Set).Len() > 0 {
+		input.WorkspaceNotificationDestinations = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("organizational_units"); ok && v.(*schema.Set).Len() > 0 {
+		input.WorkspaceOrganizationalUnits = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.WorkspaceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("stack_set_name"); ok {
+		input.StackSetName = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().ManagedgrafanaTags()
+	}
+
+	log.Printf("[DEBUG] Creating Grafana Workspace: %s", input)
+	output, err := conn.CreateWorkspace(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Grafana Workspace: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Workspace.Id))
+
+	if _, err := waiter.WorkspaceCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Grafana Workspace (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsGrafanaWorkspaceRead(d, meta)
+}
+
+func resourceAwsGrafanaWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).managedgrafanaconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	workspace, err := finder.WorkspaceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Grafana Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Grafana Workspace (%s): %w", d.Id(), err)
+	}
+
+	if workspace == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Grafana Workspace (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Grafana Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	workspaceArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "grafana",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("/workspaces/%s", d.Id()),
+	}.String()
+
+	d.Set("account_access_type", workspace.AccountAccessType)
+	d.Set("arn", workspaceArn)
+	d.Set("description", workspace.Description)
+	d.Set("endpoint", workspace.Endpoint)
+	d.Set("grafana_version", workspace.GrafanaVersion)
+	d.Set("name", workspace.Name)
+	d.Set("permission_type", workspace.PermissionType)
+	d.Set("role_arn", workspace.WorkspaceRoleArn)
+	d.Set("stack_set_name", workspace.StackSetName)
+
+	if authentication := workspace.Authentication; authentication != nil {
+		if err := d.Set("authentication_providers", aws.StringValueSlice(authentication.Providers)); err != nil {
+			return fmt.Errorf("error setting authentication_providers: %w", err)
+		}
+
+		d.Set("saml_configuration_status", authentication.SamlConfigurationStatus)
+	} else {
+		d.Set("authentication_providers", nil)
+		d.Set("saml_configuration_status", nil)
+	}
+
+	if err := d.Set("data_sources", aws.StringValueSlice(workspace.DataSources)); err != nil {
+		return fmt.Errorf("error setting data_sources: %w", err)
+	}
+
+	if err := d.Set("notification_destinations", aws.StringValueSlice(workspace.NotificationDestinations)); err != nil {
+		return fmt.Errorf("error setting notification_destinations: %w", err)
+	}
+
+	if err := d.Set("organizational_units", aws.StringValueSlice(workspace.OrganizationalUnits)); err != nil {
+		return fmt.Errorf("error setting organizational_units: %w", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.ManagedgrafanaKeyValueTags(workspace.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGrafanaWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).managedgrafanaconn
+
+	if d.HasChanges("account_access_type", "data_sources", "description", "name", "notification_destinations", "organizational_units", "permission_type", "role_arn", "stack_set_name") {
+		input := &managedgrafana.UpdateWorkspaceInput{
+			WorkspaceId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("account_access_type") {
+			input.AccountAccessType = aws.String(d.Get("account_access_type").(string))
+		}
+
+		if d.HasChange("data_sources") {
+			input.WorkspaceDataSources = expandStringSet(d.Get("data_sources").(*schema.Set))
+		}
+
+		if d.HasChange("description") {
+			input.WorkspaceDescription = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.WorkspaceName = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("notification_destinations") {
+			input.WorkspaceNotificationDestinations = expandStringSet(d.Get("notification_destinations").(*schema.Set))
+		}
+
+		if d.HasChange("organizational_units") {
+			input.WorkspaceOrganizationalUnits = expandStringSet(d.Get("organizational_units").(*schema.Set))
+		}
+
+		if d.HasChange("permission_type") {
+			input.PermissionType = aws.String(d.Get("permission_type").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.WorkspaceRoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("stack_set_name") {
+			input.StackSetName = aws.String(d.Get("stack_set_name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Grafana Workspace: %s", input)
+		if _, err := conn.UpdateWorkspace(input); err != nil {
+			return fmt.Errorf("error updating Grafana Workspace (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.WorkspaceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Grafana Workspace (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("authentication_providers") {
+		input := &managedgrafana.UpdateWorkspaceAuthenticationInput{
+			AuthenticationProviders: expandStringList(d.Get("authentication_providers").([]interface{})),
+			WorkspaceId:             aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Grafana Workspace authentication: %s", input)
+		if _, err := conn.UpdateWorkspaceAuthentication(input); err != nil {
+			return fmt.Errorf("error updating Grafana Workspace (%s) authentication: %w", d.Id(), err)
+		}
+
+		if _, err := waiter.WorkspaceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Grafana Workspace (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.ManagedgrafanaUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Grafana Workspace (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGrafanaWorkspaceRead(d, meta)
+}
+
+func resourceAwsGrafanaWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).managedgrafanaconn
+
+	log.Printf("[DEBUG] Deleting Grafana Workspace: %s", d.Id())
+	_, err := conn.DeleteWorkspace(&managedgrafana.DeleteWorkspaceInput{
+		WorkspaceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Grafana Workspace (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.WorkspaceDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Grafana Workspace (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
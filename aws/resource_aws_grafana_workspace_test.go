@@ -0,0 +1,351 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/managedgrafana/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_grafana_workspace", &resource.Sweeper{
+		Name: "aws_grafana_workspace",
+		F:    testSweepGrafanaWorkspaces,
+	})
+}
+
+func testSweepGrafanaWorkspaces(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).managedgrafanaconn
+	input := &managedgrafana.ListWorkspacesInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListWorkspacesPages(input, func(page *managedgrafana.ListWorkspacesOutput, lastPage bool) bool {
+		for _, workspace := range page.Workspaces {
+			id := aws.StringValue(workspace.Id)
+
+			log.Printf("[INFO] Deleting Grafana Workspace: %s", id)
+			_, err := conn.DeleteWorkspace(&managedgrafana.DeleteWorkspaceInput{
+				WorkspaceId: aws.String(id),
+			})
+
+			if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting Grafana Workspace (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Grafana Workspace sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Grafana Workspaces: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSGrafanaWorkspace_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_grafana_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSGrafana(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGrafanaWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGrafanaWorkspaceConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGrafanaWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_access_type", managedgrafana.AccountAccessTypeCurrentAccount),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "grafana", regexp.MustCompile(`/workspaces/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "authentication_providers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_providers.0", managedgrafana.AuthenticationProviderTypesSaml),
+					resource.TestCheckResourceAttr(resourceName, "data_sources.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "grafana_version"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "permission_type", managedgrafana.PermissionTypeServiceManaged),
+					resource.TestCheckResourceAttrSet(resourceName, "saml_configuration_status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGrafanaWorkspace_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_grafana_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSGrafana(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGrafanaWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGrafanaWorkspaceConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGrafanaWorkspaceExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsGrafanaWorkspace(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGrafanaWorkspace_DataSources(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_grafana_workspace.test"
+	iamRoleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSGrafana(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGrafanaWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGrafanaWorkspaceConfigDataSources(rName, "CLOUDWATCH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGrafanaWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data_sources.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "data_sources.*", "CLOUDWATCH"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "permission_type", managedgrafana.PermissionTypeCustomerManaged),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", iamRoleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGrafanaWorkspaceConfigDataSources(rName, "PROMETHEUS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGrafanaWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data_sources.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "data_sources.*", "PROMETHEUS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGrafanaWorkspace_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_grafana_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSGrafana(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGrafanaWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGrafanaWorkspaceConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGrafanaWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGrafanaWorkspaceConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGrafanaWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSGrafanaWorkspaceConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGrafanaWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSGrafana(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).managedgrafanaconn
+
+	input := &managedgrafana.ListWorkspacesInput{
+		MaxResults: aws.Int64(1),
+	}
+
+	_, err := conn.ListWorkspaces(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAWSGrafanaWorkspaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).managedgrafanaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_grafana_workspace" {
+			continue
+		}
+
+		output, err := finder.WorkspaceByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Grafana Workspace (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSGrafanaWorkspaceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).managedgrafanaconn
+
+		output, err := finder.WorkspaceByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Grafana Workspace (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSGrafanaWorkspaceConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_grafana_workspace" "test" {
+  account_access_type      = "CURRENT_ACCOUNT"
+  authentication_providers = ["SAML"]
+  name                     = %[1]q
+  permission_type          = "SERVICE_MANAGED"
+}
+`, rName)
+}
+
+func testAccAWSGrafanaWorkspaceConfigDataSources(rName, dataSource string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "grafana.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_grafana_workspace" "test" {
+  account_access_type      = "CURRENT_ACCOUNT"
+  authentication_providers = ["SAML"]
+  data_sources             = [%[2]q]
+  description              = %[1]q
+  name                     = %[1]q
+  permission_type          = "CUSTOMER_MANAGED"
+  role_arn                 = aws_iam_role.test.arn
+}
+`, rName, dataSource)
+}
+
+func testAccAWSGrafanaWorkspaceConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_grafana_workspace" "test" {
+  account_access_type      = "CURRENT_ACCOUNT"
+  authentication_providers = ["SAML"]
+  name                     = %[1]q
+  permission_type          = "SERVICE_MANAGED"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSGrafanaWorkspaceConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_grafana_workspace" "test" {
+  account_access_type      = "CURRENT_ACCOUNT"
+  authentication_providers = ["SAML"]
+  name                     = %[1]q
+  permission_type          = "SERVICE_MANAGED"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -38,6 +38,85 @@ func resourceAwsGuardDutyDetector() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"datasources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kubernetes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audit_logs": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enable": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"malware_protection": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scan_ec2_instance_with_findings": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ebs_volumes": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"enable": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_logs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			// finding_publishing_frequency is marked as Computed:true since
 			// GuardDuty member accounts inherit setting from master account
 			"finding_publishing_frequency": {
@@ -61,6 +140,10 @@ func resourceAwsGuardDutyDetectorCreate(d *schema.ResourceData, meta interface{}
 		input.FindingPublishingFrequency = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("datasources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataSources = expandGuardDutyDataSourceConfigurations(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
 		input.Tags = keyvaluetags.New(v).IgnoreAws().GuarddutyTags()
 	}
@@ -107,6 +190,14 @@ func resourceAwsGuardDutyDetectorRead(d *schema.ResourceData, meta interface{})
 	d.Set("enable", *gdo.Status == guardduty.DetectorStatusEnabled)
 	d.Set("finding_publishing_frequency", gdo.FindingPublishingFrequency)
 
+	if gdo.DataSources != nil {
+		if err := d.Set("datasources", []interface{}{flattenGuardDutyDataSourceConfigurationsResult(gdo.DataSources)}); err != nil {
+			return fmt.Errorf("error setting datasources: %w", err)
+		}
+	} else {
+		d.Set("datasources", nil)
+	}
+
 	if err := d.Set("tags", keyvaluetags.GuarddutyKeyValueTags(gdo.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
@@ -117,13 +208,19 @@ func resourceAwsGuardDutyDetectorRead(d *schema.ResourceData, meta interface{})
 func resourceAwsGuardDutyDetectorUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).guarddutyconn
 
-	if d.HasChanges("enable", "finding_publishing_frequency") {
+	if d.HasChanges("enable", "finding_publishing_frequency", "datasources") {
 		input := guardduty.UpdateDetectorInput{
 			DetectorId:                 aws.String(d.Id()),
 			Enable:                     aws.Bool(d.Get("enable").(bool)),
 			FindingPublishingFrequency: aws.String(d.Get("finding_publishing_frequency").(string)),
 		}
 
+		if d.HasChange("datasources") {
+			if v, ok := d.GetOk("datasources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DataSources = expandGuardDutyDataSourceConfigurations(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		log.Printf("[DEBUG] Update GuardDuty Detector: %s", input)
 		_, err := conn.UpdateDetector(&input)
 		if err != nil {
@@ -173,3 +270,117 @@ func resourceAwsGuardDutyDetectorDelete(d *schema.ResourceData, meta interface{}
 
 	return nil
 }
+
+func expandGuardDutyDataSourceConfigurations(tfMap map[string]interface{}) *guardduty.DataSourceConfigurations {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &guardduty.DataSourceConfigurations{}
+
+	if v, ok := tfMap["kubernetes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Kubernetes = expandGuardDutyKubernetesConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["malware_protection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MalwareProtection = expandGuardDutyMalwareProtectionConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Logs = expandGuardDutyS3LogsConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandGuardDutyKubernetesConfiguration(tfMap map[string]interface{}) *guardduty.KubernetesConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &guardduty.KubernetesConfiguration{}
+
+	if v, ok := tfMap["audit_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AuditLogs = &guardduty.KubernetesAuditLogsConfiguration{
+			Enable: aws.Bool(v[0].(map[string]interface{})["enable"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandGuardDutyMalwareProtectionConfiguration(tfMap map[string]interface{}) *guardduty.MalwareProtectionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &guardduty.MalwareProtectionConfiguration{}
+
+	if v, ok := tfMap["scan_ec2_instance_with_findings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ScanEc2InstanceWithFindings = &guardduty.ScanEc2InstanceWithFindings{}
+
+		if v, ok := v[0].(map[string]interface{})["ebs_volumes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ScanEc2InstanceWithFindings.EbsVolumes = aws.Bool(v[0].(map[string]interface{})["enable"].(bool))
+		}
+	}
+
+	return apiObject
+}
+
+func expandGuardDutyS3LogsConfiguration(tfMap map[string]interface{}) *guardduty.S3LogsConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &guardduty.S3LogsConfiguration{
+		Enable: aws.Bool(tfMap["enable"].(bool)),
+	}
+}
+
+// flattenGuardDutyDataSourceConfigurationsResult only flattens the data sources that are in the schema,
+// so that data source types added to the API later don't cause a difference.
+func flattenGuardDutyDataSourceConfigurationsResult(apiObject *guardduty.DataSourceConfigurationsResult) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Kubernetes; v != nil && v.AuditLogs != nil {
+		tfMap["kubernetes"] = []interface{}{
+			map[string]interface{}{
+				"audit_logs": []interface{}{
+					map[string]interface{}{
+						"enable": aws.StringValue(v.AuditLogs.Status) == guardduty.DataSourceStatusEnabled,
+					},
+				},
+			},
+		}
+	}
+
+	if v := apiObject.MalwareProtection; v != nil && v.ScanEc2InstanceWithFindings != nil && v.ScanEc2InstanceWithFindings.EbsVolumes != nil {
+		tfMap["malware_protection"] = []interface{}{
+			map[string]interface{}{
+				"scan_ec2_instance_with_findings": []interface{}{
+					map[string]interface{}{
+						"ebs_volumes": []interface{}{
+							map[string]interface{}{
+								"enable": aws.StringValue(v.ScanEc2InstanceWithFindings.EbsVolumes.Status) == guardduty.DataSourceStatusEnabled,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	if v := apiObject.S3Logs; v != nil {
+		tfMap["s3_logs"] = []interface{}{
+			map[string]interface{}{
+				"enable": aws.StringValue(v.Status) == guardduty.DataSourceStatusEnabled,
+			},
+		}
+	}
+
+	return tfMap
+}
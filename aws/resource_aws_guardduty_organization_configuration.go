@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -26,6 +27,85 @@ func resourceAwsGuardDutyOrganizationConfiguration() *schema.Resource {
 				Type:     schema.TypeBool,
 				Required: true,
 			},
+			"datasources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kubernetes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audit_logs": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enable": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"malware_protection": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scan_ec2_instance_with_findings": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ebs_volumes": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"auto_enable": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_logs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_enable": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"detector_id": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -46,8 +126,17 @@ func resourceAwsGuardDutyOrganizationConfigurationUpdate(d *schema.ResourceData,
 		DetectorId: aws.String(detectorID),
 	}
 
+	if v, ok := d.GetOk("datasources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataSources = expandGuardDutyOrganizationDataSourceConfigurations(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.UpdateOrganizationConfiguration(input)
 
+	// BadRequestException: The request is rejected because the current account is not the delegated administrator account.
+	if tfawserr.ErrMessageContains(err, guardduty.ErrCodeBadRequestException, "delegated administrator") {
+		return fmt.Errorf("error updating GuardDuty Organization Configuration (%s): the GuardDuty Organization Configuration must be managed from the delegated administrator account: %w", detectorID, err)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error updating GuardDuty Organization Configuration (%s): %w", detectorID, err)
 	}
@@ -72,6 +161,10 @@ func resourceAwsGuardDutyOrganizationConfigurationRead(d *schema.ResourceData, m
 		return nil
 	}
 
+	if tfawserr.ErrMessageContains(err, guardduty.ErrCodeBadRequestException, "delegated administrator") {
+		return fmt.Errorf("error reading GuardDuty Organization Configuration (%s): the GuardDuty Organization Configuration must be managed from the delegated administrator account: %w", d.Id(), err)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error reading GuardDuty Organization Configuration (%s): %w", d.Id(), err)
 	}
@@ -83,5 +176,101 @@ func resourceAwsGuardDutyOrganizationConfigurationRead(d *schema.ResourceData, m
 	d.Set("detector_id", d.Id())
 	d.Set("auto_enable", output.AutoEnable)
 
+	if output.DataSources != nil {
+		if err := d.Set("datasources", []interface{}{flattenGuardDutyOrganizationDataSourceConfigurationsResult(output.DataSources)}); err != nil {
+			return fmt.Errorf("error setting datasources: %w", err)
+		}
+	} else {
+		d.Set("datasources", nil)
+	}
+
 	return nil
 }
+
+func expandGuardDutyOrganizationDataSourceConfigurations(tfMap map[string]interface{}) *guardduty.OrganizationDataSourceConfigurations {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &guardduty.OrganizationDataSourceConfigurations{}
+
+	if v, ok := tfMap["kubernetes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Kubernetes = &guardduty.OrganizationKubernetesConfiguration{}
+
+		if v, ok := v[0].(map[string]interface{})["audit_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Kubernetes.AuditLogs = &guardduty.OrganizationKubernetesAuditLogsConfiguration{
+				AutoEnable: aws.Bool(v[0].(map[string]interface{})["enable"].(bool)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["malware_protection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MalwareProtection = &guardduty.OrganizationMalwareProtectionConfiguration{}
+
+		if v, ok := v[0].(map[string]interface{})["scan_ec2_instance_with_findings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.MalwareProtection.ScanEc2InstanceWithFindings = &guardduty.OrganizationScanEc2InstanceWithFindings{}
+
+			if v, ok := v[0].(map[string]interface{})["ebs_volumes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.MalwareProtection.ScanEc2InstanceWithFindings.EbsVolumes = &guardduty.OrganizationEbsVolumes{
+					AutoEnable: aws.Bool(v[0].(map[string]interface{})["auto_enable"].(bool)),
+				}
+			}
+		}
+	}
+
+	if v, ok := tfMap["s3_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Logs = &guardduty.OrganizationS3LogsConfiguration{
+			AutoEnable: aws.Bool(v[0].(map[string]interface{})["auto_enable"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+// flattenGuardDutyOrganizationDataSourceConfigurationsResult only flattens the data sources that are in the schema,
+// so that data source types added to the API later don't cause a difference.
+func flattenGuardDutyOrganizationDataSourceConfigurationsResult(apiObject *guardduty.OrganizationDataSourceConfigurationsResult) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Kubernetes; v != nil && v.AuditLogs != nil {
+		tfMap["kubernetes"] = []interface{}{
+			map[string]interface{}{
+				"audit_logs": []interface{}{
+					map[string]interface{}{
+						"enable": aws.BoolValue(v.AuditLogs.AutoEnable),
+					},
+				},
+			},
+		}
+	}
+
+	if v := apiObject.MalwareProtection; v != nil && v.ScanEc2InstanceWithFindings != nil && v.ScanEc2InstanceWithFindings.EbsVolumes != nil {
+		tfMap["malware_protection"] = []interface{}{
+			map[string]interface{}{
+				"scan_ec2_instance_with_findings": []interface{}{
+					map[string]interface{}{
+						"ebs_volumes": []interface{}{
+							map[string]interface{}{
+								"auto_enable": aws.BoolValue(v.ScanEc2InstanceWithFindings.EbsVolumes.AutoEnable),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	if v := apiObject.S3Logs; v != nil {
+		tfMap["s3_logs"] = []interface{}{
+			map[string]interface{}{
+				"auto_enable": aws.BoolValue(v.AutoEnable),
+			},
+		}
+	}
+
+	return tfMap
+}
@@ -8,10 +8,13 @@ import (
 func TestAccAWSGuardDuty_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Detector": {
-			"basic":            testAccAwsGuardDutyDetector_basic,
-			"tags":             testAccAwsGuardDutyDetector_tags,
-			"datasource_basic": testAccAWSGuarddutyDetectorDataSource_basic,
-			"datasource_id":    testAccAWSGuarddutyDetectorDataSource_Id,
+			"basic":                             testAccAwsGuardDutyDetector_basic,
+			"tags":                              testAccAwsGuardDutyDetector_tags,
+			"datasources_s3logs":                testAccAwsGuardDutyDetector_datasources_s3logs,
+			"datasources_kubernetes_audit_logs": testAccAwsGuardDutyDetector_datasources_kubernetes_audit_logs,
+			"datasources_malware_protection":    testAccAwsGuardDutyDetector_datasources_malware_protection,
+			"datasource_basic":                  testAccAWSGuarddutyDetectorDataSource_basic,
+			"datasource_id":                     testAccAWSGuarddutyDetectorDataSource_Id,
 		},
 		"Filter": {
 			"basic":      testAccAwsGuardDutyFilter_basic,
@@ -30,7 +33,10 @@ func TestAccAWSGuardDuty_serial(t *testing.T) {
 			"basic": testAccAwsGuardDutyOrganizationAdminAccount_basic,
 		},
 		"OrganizationConfiguration": {
-			"basic": testAccAwsGuardDutyOrganizationConfiguration_basic,
+			"basic":             testAccAwsGuardDutyOrganizationConfiguration_basic,
+			"s3Logs":            testAccAwsGuardDutyOrganizationConfiguration_s3logs,
+			"kubernetes":        testAccAwsGuardDutyOrganizationConfiguration_kubernetes,
+			"malwareProtection": testAccAwsGuardDutyOrganizationConfiguration_malwareprotection,
 		},
 		"ThreatIntelSet": {
 			"basic": testAccAwsGuardDutyThreatintelset_basic,
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsInspector2DelegatedAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsInspector2DelegatedAdminAccountCreate,
+		Read:   resourceAwsInspector2DelegatedAdminAccountRead,
+		Delete: resourceAwsInspector2DelegatedAdminAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsInspector2DelegatedAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	accountID := d.Get("account_id").(string)
+
+	input := &inspector2.EnableDelegatedAdminAccountInput{
+		ClientToken:             aws.String(resource.UniqueId()),
+		DelegatedAdminAccountId: aws.String(accountID),
+	}
+
+	_, err := conn.EnableDelegatedAdminAccount(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling Inspector V2 Delegated Admin Account (%s): %w", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if _, err := waiter.DelegatedAdminAccountEnabled(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Inspector V2 Delegated Admin Account (%s) to enable: %w", d.Id(), err)
+	}
+
+	return resourceAwsInspector2DelegatedAdminAccountRead(d, meta)
+}
+
+func resourceAwsInspector2DelegatedAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	account, err := finder.DelegatedAdminAccountByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector V2 Delegated Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Inspector V2 Delegated Admin Account (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", account.AccountId)
+	d.Set("relationship_status", account.Status)
+
+	return nil
+}
+
+func resourceAwsInspector2DelegatedAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	log.Printf("[DEBUG] Disabling Inspector V2 Delegated Admin Account: %s", d.Id())
+	_, err := conn.DisableDelegatedAdminAccount(&inspector2.DisableDelegatedAdminAccountInput{
+		DelegatedAdminAccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling Inspector V2 Delegated Admin Account (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.DelegatedAdminAccountDisabled(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Inspector V2 Delegated Admin Account (%s) to disable: %w", d.Id(), err)
+	}
+
+	return nil
+}
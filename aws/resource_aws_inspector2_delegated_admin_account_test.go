@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAWSInspector2DelegatedAdminAccount_basic(t *testing.T) {
+	resourceName := "aws_inspector2_delegated_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSInspector2(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2DelegatedAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSInspector2DelegatedAdminAccountConfigSelf(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2DelegatedAdminAccountExists(resourceName),
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSInspector2DelegatedAdminAccount_disappears(t *testing.T) {
+	resourceName := "aws_inspector2_delegated_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSInspector2(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2DelegatedAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSInspector2DelegatedAdminAccountConfigSelf(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2DelegatedAdminAccountExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsInspector2DelegatedAdminAccount(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsInspector2DelegatedAdminAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_delegated_admin_account" {
+			continue
+		}
+
+		_, err := finder.DelegatedAdminAccountByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Inspector V2 Delegated Admin Account %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsInspector2DelegatedAdminAccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Inspector V2 Delegated Admin Account ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+		_, err := finder.DelegatedAdminAccountByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAWSInspector2DelegatedAdminAccountConfigSelf() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["inspector2.${data.aws_partition.current.dns_suffix}"]
+  feature_set                   = "ALL"
+}
+
+resource "aws_inspector2_delegated_admin_account" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  account_id = data.aws_caller_identity.current.account_id
+}
+`
+}
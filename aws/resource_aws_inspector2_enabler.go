@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfinspector2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsInspector2Enabler() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsInspector2EnablerCreate,
+		Read:   resourceAwsInspector2EnablerRead,
+		Update: resourceAwsInspector2EnablerUpdate,
+		Delete: resourceAwsInspector2EnablerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAwsAccountId,
+				},
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						inspector2.ResourceScanTypeEc2,
+						inspector2.ResourceScanTypeEcr,
+						inspector2.ResourceScanTypeLambda,
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsInspector2EnablerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	accountIDs := expandStringSet(d.Get("account_ids").(*schema.Set))
+	resourceTypes := expandStringSet(d.Get("resource_types").(*schema.Set))
+	id := tfinspector2.EnablerCreateID(aws.StringValueSlice(accountIDs), aws.StringValueSlice(resourceTypes))
+
+	if err := inspector2Enable(conn, accountIDs, resourceTypes, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error enabling Inspector V2 (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsInspector2EnablerRead(d, meta)
+}
+
+func resourceAwsInspector2EnablerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	accountIDs, _, err := tfinspector2.EnablerParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	accounts, err := finder.AccountStatesByIDs(conn, accountIDs)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector V2 Enabler (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Inspector V2 Enabler (%s): %w", d.Id(), err)
+	}
+
+	// Only resource types enabled in every account are reported.
+	var resourceTypes []string
+
+	for _, resourceType := range []string{inspector2.ResourceScanTypeEc2, inspector2.ResourceScanTypeEcr, inspector2.ResourceScanTypeLambda} {
+		enabled := true
+
+		for _, account := range accounts {
+			if state := tfinspector2.ResourceTypeState(account.ResourceState, resourceType); state == nil || aws.StringValue(state.Status) != inspector2.StatusEnabled {
+				enabled = false
+				break
+			}
+		}
+
+		if enabled {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+
+	if !d.IsNewResource() && len(resourceTypes) == 0 {
+		log.Printf("[WARN] Inspector V2 Enabler (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_ids", accountIDs)
+	d.Set("resource_types", resourceTypes)
+
+	return nil
+}
+
+func resourceAwsInspector2EnablerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	if d.HasChange("resource_types") {
+		accountIDs := expandStringSet(d.Get("account_ids").(*schema.Set))
+		o, n := d.GetChange("resource_types")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			if err := inspector2Enable(conn, accountIDs, expandStringSet(add), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error enabling Inspector V2 (%s): %w", d.Id(), err)
+			}
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			if err := inspector2Disable(conn, accountIDs, expandStringSet(del), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error disabling Inspector V2 (%s): %w", d.Id(), err)
+			}
+		}
+
+		d.SetId(tfinspector2.EnablerCreateID(aws.StringValueSlice(accountIDs), aws.StringValueSlice(expandStringSet(ns))))
+	}
+
+	return resourceAwsInspector2EnablerRead(d, meta)
+}
+
+func resourceAwsInspector2EnablerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	accountIDs := expandStringSet(d.Get("account_ids").(*schema.Set))
+	resourceTypes := expandStringSet(d.Get("resource_types").(*schema.Set))
+
+	log.Printf("[DEBUG] Disabling Inspector V2: %s", d.Id())
+	if err := inspector2Disable(conn, accountIDs, resourceTypes, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error disabling Inspector V2 (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func inspector2Enable(conn *inspector2.Inspector2, accountIDs, resourceTypes []*string, timeout time.Duration) error {
+	input := &inspector2.EnableInput{
+		AccountIds:    accountIDs,
+		ClientToken:   aws.String(resource.UniqueId()),
+		ResourceTypes: resourceTypes,
+	}
+
+	log.Printf("[DEBUG] Enabling Inspector V2: %s", input)
+	output, err := conn.Enable(input)
+
+	if err != nil {
+		return err
+	}
+
+	if output != nil {
+		if err := tfinspector2.FailedAccountsError(output.FailedAccounts); err != nil {
+			return err
+		}
+	}
+
+	if _, err := waiter.ResourceTypesEnabled(conn, aws.StringValueSlice(accountIDs), aws.StringValueSlice(resourceTypes), timeout); err != nil {
+		return fmt.Errorf("error waiting for enable: %w", err)
+	}
+
+	return nil
+}
+
+func inspector2Disable(conn *inspector2.Inspector2, accountIDs, resourceTypes []*string, timeout time.Duration) error {
+	input := &inspector2.DisableInput{
+		AccountIds:    accountIDs,
+		ResourceTypes: resourceTypes,
+	}
+
+	output, err := conn.Disable(input)
+
+	if err != nil {
+		return err
+	}
+
+	if output != nil {
+		if err := tfinspector2.FailedAccountsError(output.FailedAccounts); err != nil {
+			return err
+		}
+	}
+
+	if _, err := waiter.ResourceTypesDisabled(conn, aws.StringValueSlice(accountIDs), aws.StringValueSlice(resourceTypes), timeout); err != nil {
+		return fmt.Errorf("error waiting for disable: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfinspector2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2/finder"
+)
+
+func testAccAWSInspector2Enabler_basic(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSInspector2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2EnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSInspector2EnablerConfigResourceTypes(`"EC2"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2EnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "account_ids.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "EC2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSInspector2Enabler_disappears(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSInspector2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2EnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSInspector2EnablerConfigResourceTypes(`"EC2"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2EnablerExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsInspector2Enabler(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSInspector2Enabler_ResourceTypes(t *testing.T) {
+	resourceName := "aws_inspector2_enabler.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSInspector2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsInspector2EnablerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSInspector2EnablerConfigResourceTypes(`"EC2", "ECR"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2EnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "EC2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "ECR"),
+				),
+			},
+			{
+				Config: testAccAWSInspector2EnablerConfigResourceTypes(`"ECR", "LAMBDA"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsInspector2EnablerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "ECR"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "LAMBDA"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsInspector2EnablerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_enabler" {
+			continue
+		}
+
+		accountIDs, resourceTypes, err := tfinspector2.EnablerParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		accounts, err := finder.AccountStatesByIDs(conn, accountIDs)
+
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			for _, resourceType := range resourceTypes {
+				state := tfinspector2.ResourceTypeState(account.ResourceState, resourceType)
+
+				if state != nil && aws.StringValue(state.Status) != inspector2.StatusDisabled {
+					return fmt.Errorf("Inspector V2 %s scanning still %s in account (%s)", resourceType, aws.StringValue(state.Status), aws.StringValue(account.AccountId))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsInspector2EnablerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Inspector V2 Enabler ID is set")
+		}
+
+		accountIDs, resourceTypes, err := tfinspector2.EnablerParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).inspector2conn
+
+		accounts, err := finder.AccountStatesByIDs(conn, accountIDs)
+
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			for _, resourceType := range resourceTypes {
+				state := tfinspector2.ResourceTypeState(account.ResourceState, resourceType)
+
+				if state == nil || aws.StringValue(state.Status) != inspector2.StatusEnabled {
+					return fmt.Errorf("Inspector V2 %s scanning not enabled in account (%s)", resourceType, aws.StringValue(account.AccountId))
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSInspector2EnablerConfigResourceTypes(resourceTypes string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_enabler" "test" {
+  account_ids    = [data.aws_caller_identity.current.account_id]
+  resource_types = [%[1]s]
+}
+`, resourceTypes)
+}
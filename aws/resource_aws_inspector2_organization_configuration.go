@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/inspector2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsInspector2OrganizationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsInspector2OrganizationConfigurationUpdate,
+		Read:   resourceAwsInspector2OrganizationConfigurationRead,
+		Update: resourceAwsInspector2OrganizationConfigurationUpdate,
+		Delete: resourceAwsInspector2OrganizationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_enable": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ec2": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"ecr": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"lambda": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"max_account_limit_reached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsInspector2OrganizationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	input := &inspector2.UpdateOrganizationConfigurationInput{}
+
+	if v, ok := d.GetOk("auto_enable"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoEnable = expandInspector2AutoEnable(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.UpdateOrganizationConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Inspector V2 Organization Configuration: %w", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*AWSClient).accountid)
+	}
+
+	return resourceAwsInspector2OrganizationConfigurationRead(d, meta)
+}
+
+func resourceAwsInspector2OrganizationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	output, err := finder.OrganizationConfiguration(conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector V2 Organization Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Inspector V2 Organization Configuration (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("auto_enable", []interface{}{flattenInspector2AutoEnable(output.AutoEnable)}); err != nil {
+		return fmt.Errorf("error setting auto_enable: %w", err)
+	}
+
+	d.Set("max_account_limit_reached", output.MaxAccountLimitReached)
+
+	return nil
+}
+
+func resourceAwsInspector2OrganizationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).inspector2conn
+
+	// The organization configuration cannot be deleted, so turn off auto-enable instead.
+	input := &inspector2.UpdateOrganizationConfigurationInput{
+		AutoEnable: &inspector2.AutoEnable{
+			Ec2:    aws.Bool(false),
+			Ecr:    aws.Bool(false),
+			Lambda: aws.Bool(false),
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting Inspector V2 Organization Configuration: %s", d.Id())
+	_, err := conn.UpdateOrganizationConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error resetting Inspector V2 Organization Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandInspector2AutoEnable(tfMap map[string]interface{}) *inspector2.AutoEnable {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &inspector2.AutoEnable{}
+
+	if v, ok := tfMap["ec2"].(bool); ok {
+		apiObject.Ec2 = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ecr"].(bool); ok {
+		apiObject.Ecr = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["lambda"].(bool); ok {
+		apiObject.Lambda = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenInspector2AutoEnable(apiObject *inspector2.AutoEnable) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Ec2; v != nil {
+		tfMap["ec2"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Ecr; v != nil {
+		tfMap["ecr"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Lambda; v != nil {
+		tfMap["lambda"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
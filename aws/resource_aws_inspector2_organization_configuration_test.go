@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccAWSInspector2OrganizationConfiguration_basic(t *testing.T) {
+	resourceName := "aws_inspector2_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSInspector2(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		Providers: testAccProviders,
+		// Inspector V2 Organization Configuration cannot be deleted separately.
+		// Ensure the delegated administrator is removed instead.
+		CheckDestroy: testAccCheckAwsInspector2DelegatedAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSInspector2OrganizationConfigurationConfigAutoEnable(true, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ecr", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSInspector2OrganizationConfigurationConfigAutoEnable(false, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ecr", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSInspector2OrganizationConfigurationConfigAutoEnable(ec2, ecr, lambda bool) string {
+	return composeConfig(testAccAWSInspector2DelegatedAdminAccountConfigSelf(), fmt.Sprintf(`
+resource "aws_inspector2_organization_configuration" "test" {
+  depends_on = [aws_inspector2_delegated_admin_account.test]
+
+  auto_enable {
+    ec2    = %[1]t
+    ecr    = %[2]t
+    lambda = %[3]t
+  }
+}
+`, ec2, ecr, lambda))
+}
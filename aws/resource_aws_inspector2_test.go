@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/inspector2"
+)
+
+// Inspector V2 is enabled and configured per account and Region, so all
+// Inspector V2 tests must run serially.
+func TestAccAWSInspector2_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Enabler": {
+			"basic":         testAccAWSInspector2Enabler_basic,
+			"disappears":    testAccAWSInspector2Enabler_disappears,
+			"ResourceTypes": testAccAWSInspector2Enabler_ResourceTypes,
+		},
+		"DelegatedAdminAccount": {
+			"basic":      testAccAWSInspector2DelegatedAdminAccount_basic,
+			"disappears": testAccAWSInspector2DelegatedAdminAccount_disappears,
+		},
+		"OrganizationConfiguration": {
+			"basic": testAccAWSInspector2OrganizationConfiguration_basic,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccPreCheckAWSInspector2(t *testing.T) {
+	testAccPartitionHasServicePreCheck(inspector2.EndpointsID, t)
+}
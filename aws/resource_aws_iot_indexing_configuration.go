@@ -0,0 +1,325 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsIotIndexingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotIndexingConfigurationPut,
+		Read:   resourceAwsIotIndexingConfigurationRead,
+		Update: resourceAwsIotIndexingConfigurationPut,
+		Delete: schema.Noop,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"thing_group_indexing_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_field":  iotIndexingConfigurationFieldSchema(false),
+						"managed_field": iotIndexingConfigurationFieldSchema(true),
+						"thing_group_indexing_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iot.ThingGroupIndexingMode_Values(), false),
+						},
+					},
+				},
+			},
+			"thing_indexing_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_field": iotIndexingConfigurationFieldSchema(false),
+						"device_defender_indexing_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      iot.DeviceDefenderIndexingModeOff,
+							ValidateFunc: validation.StringInSlice(iot.DeviceDefenderIndexingMode_Values(), false),
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"named_shadow_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 64),
+										},
+									},
+								},
+							},
+						},
+						"managed_field": iotIndexingConfigurationFieldSchema(true),
+						"named_shadow_indexing_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      iot.NamedShadowIndexingModeOff,
+							ValidateFunc: validation.StringInSlice(iot.NamedShadowIndexingMode_Values(), false),
+						},
+						"thing_connectivity_indexing_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      iot.ThingConnectivityIndexingModeOff,
+							ValidateFunc: validation.StringInSlice(iot.ThingConnectivityIndexingMode_Values(), false),
+						},
+						"thing_indexing_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iot.ThingIndexingMode_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func iotIndexingConfigurationFieldSchema(computed bool) *schema.Schema {
+	s := &schema.Schema{
+		Type: schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Optional: !computed,
+					Computed: computed,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     !computed,
+					Computed:     computed,
+					ValidateFunc: validation.StringInSlice(iot.FieldType_Values(), false),
+				},
+			},
+		},
+	}
+
+	if computed {
+		s.Computed = true
+	} else {
+		s.Optional = true
+	}
+
+	return s
+}
+
+func resourceAwsIotIndexingConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	input := &iot.UpdateIndexingConfigurationInput{}
+
+	if v, ok := d.GetOk("thing_group_indexing_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ThingGroupIndexingConfiguration = expandIotThingGroupIndexingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("thing_indexing_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ThingIndexingConfiguration = expandIotThingIndexingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating IoT Indexing Configuration: %s", input)
+	_, err := conn.UpdateIndexingConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating IoT Indexing Configuration: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	return resourceAwsIotIndexingConfigurationRead(d, meta)
+}
+
+func resourceAwsIotIndexingConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	output, err := conn.GetIndexingConfiguration(&iot.GetIndexingConfigurationInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Indexing Configuration: %w", err)
+	}
+
+	if output.ThingGroupIndexingConfiguration != nil {
+		if err := d.Set("thing_group_indexing_configuration", []interface{}{flattenIotThingGroupIndexingConfiguration(output.ThingGroupIndexingConfiguration)}); err != nil {
+			return fmt.Errorf("error setting thing_group_indexing_configuration: %w", err)
+		}
+	} else {
+		d.Set("thing_group_indexing_configuration", nil)
+	}
+
+	if output.ThingIndexingConfiguration != nil {
+		if err := d.Set("thing_indexing_configuration", []interface{}{flattenIotThingIndexingConfiguration(output.ThingIndexingConfiguration)}); err != nil {
+			return fmt.Errorf("error setting thing_indexing_configuration: %w", err)
+		}
+	} else {
+		d.Set("thing_indexing_configuration", nil)
+	}
+
+	return nil
+}
+
+func expandIotThingGroupIndexingConfiguration(tfMap map[string]interface{}) *iot.ThingGroupIndexingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.ThingGroupIndexingConfiguration{}
+
+	if v, ok := tfMap["custom_field"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CustomFields = expandIotFields(v.List())
+	}
+
+	if v, ok := tfMap["thing_group_indexing_mode"].(string); ok && v != "" {
+		apiObject.ThingGroupIndexingMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotThingIndexingConfiguration(tfMap map[string]interface{}) *iot.ThingIndexingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.ThingIndexingConfiguration{}
+
+	if v, ok := tfMap["custom_field"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CustomFields = expandIotFields(v.List())
+	}
+
+	if v, ok := tfMap["device_defender_indexing_mode"].(string); ok && v != "" {
+		apiObject.DeviceDefenderIndexingMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandIotIndexingFilter(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["named_shadow_indexing_mode"].(string); ok && v != "" {
+		apiObject.NamedShadowIndexingMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["thing_connectivity_indexing_mode"].(string); ok && v != "" {
+		apiObject.ThingConnectivityIndexingMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["thing_indexing_mode"].(string); ok && v != "" {
+		apiObject.ThingIndexingMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotIndexingFilter(tfMap map[string]interface{}) *iot.IndexingFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.IndexingFilter{}
+
+	if v, ok := tfMap["named_shadow_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NamedShadowNames = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandIotFields(tfList []interface{}) []*iot.Field {
+	var apiObjects []*iot.Field
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iot.Field{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIotThingGroupIndexingConfiguration(apiObject *iot.ThingGroupIndexingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"custom_field":              flattenIotFields(apiObject.CustomFields),
+		"managed_field":             flattenIotFields(apiObject.ManagedFields),
+		"thing_group_indexing_mode": aws.StringValue(apiObject.ThingGroupIndexingMode),
+	}
+
+	return tfMap
+}
+
+func flattenIotThingIndexingConfiguration(apiObject *iot.ThingIndexingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"custom_field":                     flattenIotFields(apiObject.CustomFields),
+		"device_defender_indexing_mode":    aws.StringValue(apiObject.DeviceDefenderIndexingMode),
+		"managed_field":                    flattenIotFields(apiObject.ManagedFields),
+		"named_shadow_indexing_mode":       aws.StringValue(apiObject.NamedShadowIndexingMode),
+		"thing_connectivity_indexing_mode": aws.StringValue(apiObject.ThingConnectivityIndexingMode),
+		"thing_indexing_mode":              aws.StringValue(apiObject.ThingIndexingMode),
+	}
+
+	if v := apiObject.Filter; v != nil && len(v.NamedShadowNames) > 0 {
+		tfMap["filter"] = []interface{}{
+			map[string]interface{}{
+				"named_shadow_names": aws.StringValueSlice(v.NamedShadowNames),
+			},
+		}
+	}
+
+	return tfMap
+}
+
+func flattenIotFields(apiObjects []*iot.Field) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+			"type": aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
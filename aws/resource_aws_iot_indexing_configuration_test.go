@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSIotIndexingConfiguration_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":         testAccAWSIotIndexingConfiguration_basic,
+		"allAttributes": testAccAWSIotIndexingConfiguration_allAttributes,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccAWSIotIndexingConfiguration_basic(t *testing.T) {
+	resourceName := "aws_iot_indexing_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotIndexingConfigurationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.0.custom_field.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.0.thing_group_indexing_mode", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.custom_field.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.device_defender_indexing_mode", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.named_shadow_indexing_mode", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_connectivity_indexing_mode", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_indexing_mode", "OFF"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSIotIndexingConfiguration_allAttributes(t *testing.T) {
+	resourceName := "aws_iot_indexing_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotIndexingConfigurationConfigAllAttributes,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.0.custom_field.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "thing_group_indexing_configuration.0.thing_group_indexing_mode", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.custom_field.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_indexing_configuration.0.custom_field.*", map[string]string{
+						"name": "attributes.version",
+						"type": "Number",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_indexing_configuration.0.custom_field.*", map[string]string{
+						"name": "shadow.name.thing1shadow.desired.DefaultDesired",
+						"type": "String",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_indexing_configuration.0.custom_field.*", map[string]string{
+						"name": "deviceDefender.securityProfile1.NUMBER_VALUE_BEHAVIOR.lastViolationValue.number",
+						"type": "Number",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.device_defender_indexing_mode", "VIOLATIONS"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.*", "thing1shadow"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.named_shadow_indexing_mode", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_connectivity_indexing_mode", "STATUS"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_indexing_mode", "REGISTRY_AND_SHADOW"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccAWSIotIndexingConfigurationConfig = `
+resource "aws_iot_indexing_configuration" "test" {
+  thing_group_indexing_configuration {
+    thing_group_indexing_mode = "OFF"
+  }
+
+  thing_indexing_configuration {
+    thing_indexing_mode = "OFF"
+  }
+}
+`
+
+const testAccAWSIotIndexingConfigurationConfigAllAttributes = `
+resource "aws_iot_indexing_configuration" "test" {
+  thing_group_indexing_configuration {
+    thing_group_indexing_mode = "ON"
+  }
+
+  thing_indexing_configuration {
+    thing_indexing_mode              = "REGISTRY_AND_SHADOW"
+    thing_connectivity_indexing_mode = "STATUS"
+    device_defender_indexing_mode    = "VIOLATIONS"
+    named_shadow_indexing_mode       = "ON"
+
+    filter {
+      named_shadow_names = ["thing1shadow"]
+    }
+
+    custom_field {
+      name = "attributes.version"
+      type = "Number"
+    }
+
+    custom_field {
+      name = "shadow.name.thing1shadow.desired.DefaultDesired"
+      type = "String"
+    }
+
+    custom_field {
+      name = "deviceDefender.securityProfile1.NUMBER_VALUE_BEHAVIOR.lastViolationValue.number"
+      type = "Number"
+    }
+  }
+}
+`
@@ -0,0 +1,374 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+const (
+	iotThingGroupDeleteTimeout = 1 * time.Minute
+)
+
+func resourceAwsIotThingGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotThingGroupCreate,
+		Read:   resourceAwsIotThingGroupRead,
+		Update: resourceAwsIotThingGroupUpdate,
+		Delete: resourceAwsIotThingGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"root_to_parent_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"group_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"parent_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_payload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 2028),
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotThingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	name := d.Get("name").(string)
+	input := &iot.CreateThingGroupInput{
+		ThingGroupName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("parent_group_name"); ok {
+		input.ParentGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ThingGroupProperties = expandIotThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().IotTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Thing Group: %s", input)
+	output, err := conn.CreateThingGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Thing Group (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ThingGroupName))
+
+	return resourceAwsIotThingGroupRead(d, meta)
+}
+
+func resourceAwsIotThingGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.DescribeThingGroup(&iot.DescribeThingGroupInput{
+		ThingGroupName: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] IoT Thing Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Thing Group (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.ThingGroupArn)
+	d.Set("name", output.ThingGroupName)
+
+	if output.ThingGroupMetadata != nil {
+		if err := d.Set("metadata", []interface{}{flattenIotThingGroupMetadata(output.ThingGroupMetadata)}); err != nil {
+			return fmt.Errorf("error setting metadata: %w", err)
+		}
+
+		d.Set("parent_group_name", output.ThingGroupMetadata.ParentGroupName)
+	} else {
+		d.Set("metadata", nil)
+		d.Set("parent_group_name", nil)
+	}
+
+	if err := d.Set("properties", flattenIotThingGroupProperties(output.ThingGroupProperties)); err != nil {
+		return fmt.Errorf("error setting properties: %w", err)
+	}
+
+	d.Set("version", output.Version)
+
+	tags, err := keyvaluetags.IotListTags(conn, aws.StringValue(output.ThingGroupArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Thing Group (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotThingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	if d.HasChange("properties") {
+		input := &iot.UpdateThingGroupInput{
+			ExpectedVersion: aws.Int64(int64(d.Get("version").(int))),
+			ThingGroupName:  aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ThingGroupProperties = expandIotThingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.ThingGroupProperties = &iot.ThingGroupProperties{}
+		}
+
+		// Set empty values to clear any previous configuration.
+		if input.ThingGroupProperties.AttributePayload == nil {
+			input.ThingGroupProperties.AttributePayload = &iot.AttributePayload{
+				Attributes: map[string]*string{},
+			}
+		}
+
+		if input.ThingGroupProperties.ThingGroupDescription == nil {
+			input.ThingGroupProperties.ThingGroupDescription = aws.String("")
+		}
+
+		log.Printf("[DEBUG] Updating IoT Thing Group: %s", input)
+		_, err := conn.UpdateThingGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Thing Group (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.IotUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Thing Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotThingGroupRead(d, meta)
+}
+
+func resourceAwsIotThingGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	input := &iot.DeleteThingGroupInput{
+		ThingGroupName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting IoT Thing Group: %s", d.Id())
+	// A parent group cannot be deleted until all of its child groups have been deleted.
+	err := resource.Retry(iotThingGroupDeleteTimeout, func() *resource.RetryError {
+		_, err := conn.DeleteThingGroup(input)
+
+		if isAWSErr(err, iot.ErrCodeInvalidRequestException, "there are still child groups attached") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteThingGroup(input)
+	}
+
+	if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Thing Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotThingGroupProperties(tfMap map[string]interface{}) *iot.ThingGroupProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.ThingGroupProperties{}
+
+	if v, ok := tfMap["attribute_payload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AttributePayload = expandIotAttributePayload(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.ThingGroupDescription = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotAttributePayload(tfMap map[string]interface{}) *iot.AttributePayload {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.AttributePayload{
+		Attributes: map[string]*string{},
+	}
+
+	if v, ok := tfMap["attributes"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Attributes = stringMapToPointers(v)
+	}
+
+	return apiObject
+}
+
+func flattenIotThingGroupProperties(apiObject *iot.ThingGroupProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AttributePayload; v != nil && len(v.Attributes) > 0 {
+		tfMap["attribute_payload"] = []interface{}{
+			map[string]interface{}{
+				"attributes": aws.StringValueMap(v.Attributes),
+			},
+		}
+	}
+
+	if v := aws.StringValue(apiObject.ThingGroupDescription); v != "" {
+		tfMap["description"] = v
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotThingGroupMetadata(apiObject *iot.ThingGroupMetadata) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"parent_group_name":     aws.StringValue(apiObject.ParentGroupName),
+		"root_to_parent_groups": flattenIotGroupNameAndArns(apiObject.RootToParentThingGroups),
+	}
+
+	if v := apiObject.CreationDate; v != nil {
+		tfMap["creation_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenIotGroupNameAndArns(apiObjects []*iot.GroupNameAndArn) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"group_arn":  aws.StringValue(apiObject.GroupArn),
+			"group_name": aws.StringValue(apiObject.GroupName),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfiot "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iot"
+)
+
+func resourceAwsIotThingGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotThingGroupMembershipCreate,
+		Read:   resourceAwsIotThingGroupMembershipRead,
+		Delete: resourceAwsIotThingGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"override_dynamic_group": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"thing_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"thing_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotThingGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	thingGroupName := d.Get("thing_group_name").(string)
+	thingName := d.Get("thing_name").(string)
+	input := &iot.AddThingToThingGroupInput{
+		ThingGroupName: aws.String(thingGroupName),
+		ThingName:      aws.String(thingName),
+	}
+
+	if v, ok := d.GetOk("override_dynamic_group"); ok {
+		input.OverrideDynamicGroups = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Creating IoT Thing Group Membership: %s", input)
+	_, err := conn.AddThingToThingGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error adding IoT Thing (%s) to IoT Thing Group (%s): %w", thingName, thingGroupName, err)
+	}
+
+	d.SetId(tfiot.ThingGroupMembershipCreateResourceID(thingGroupName, thingName))
+
+	return resourceAwsIotThingGroupMembershipRead(d, meta)
+}
+
+func resourceAwsIotThingGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	thingGroupName, thingName, err := tfiot.ThingGroupMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	found, err := findIotThingGroupMembership(conn, thingGroupName, thingName)
+
+	if !d.IsNewResource() && isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] IoT Thing Group Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Thing Group Membership (%s): %w", d.Id(), err)
+	}
+
+	if !found {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Thing Group Membership (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Thing Group Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("thing_group_name", thingGroupName)
+	d.Set("thing_name", thingName)
+
+	return nil
+}
+
+func resourceAwsIotThingGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotconn
+
+	thingGroupName, thingName, err := tfiot.ThingGroupMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting IoT Thing Group Membership: %s", d.Id())
+	_, err = conn.RemoveThingFromThingGroup(&iot.RemoveThingFromThingGroupInput{
+		ThingGroupName: aws.String(thingGroupName),
+		ThingName:      aws.String(thingName),
+	})
+
+	if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing IoT Thing (%s) from IoT Thing Group (%s): %w", thingName, thingGroupName, err)
+	}
+
+	return nil
+}
+
+// findIotThingGroupMembership reports whether the specified thing is a direct member of the specified thing group.
+func findIotThingGroupMembership(conn *iot.IoT, thingGroupName, thingName string) (bool, error) {
+	input := &iot.ListThingGroupsForThingInput{
+		ThingName: aws.String(thingName),
+	}
+
+	var found bool
+
+	err := conn.ListThingGroupsForThingPages(input, func(page *iot.ListThingGroupsForThingOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, group := range page.ThingGroups {
+			if aws.StringValue(group.GroupName) == thingGroupName {
+				found = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return found, err
+}
@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfiot "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iot"
+)
+
+func TestAccAWSIotThingGroupMembership_basic(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iot_thing_group_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingGroupMembershipConfig(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupMembershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "override_dynamic_group", "false"),
+					resource.TestCheckResourceAttr(resourceName, "thing_group_name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "thing_name", rName2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"override_dynamic_group"},
+			},
+		},
+	})
+}
+
+func TestAccAWSIotThingGroupMembership_disappears(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iot_thing_group_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingGroupMembershipConfig(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupMembershipExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotThingGroupMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotThingGroupMembership_disappears_Thing(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iot_thing_group_membership.test"
+	thingResourceName := "aws_iot_thing.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingGroupMembershipConfig(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupMembershipExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotThing(), thingResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotThingGroupMembershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Thing Group Membership ID is set")
+		}
+
+		thingGroupName, thingName, err := tfiot.ThingGroupMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotconn
+
+		found, err := findIotThingGroupMembership(conn, thingGroupName, thingName)
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("IoT Thing Group Membership (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotThingGroupMembershipDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_thing_group_membership" {
+			continue
+		}
+
+		thingGroupName, thingName, err := tfiot.ThingGroupMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		found, err := findIotThingGroupMembership(conn, thingGroupName, thingName)
+
+		if isAWSErr(err, "ResourceNotFoundException", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("IoT Thing Group Membership %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSIotThingGroupMembershipConfig(rName1, rName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iot_thing" "test" {
+  name = %[2]q
+}
+
+resource "aws_iot_thing_group_membership" "test" {
+  thing_group_name = aws_iot_thing_group.test.name
+  thing_name       = aws_iot_thing.test.name
+}
+`, rName1, rName2)
+}
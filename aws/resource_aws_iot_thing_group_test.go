@@ -0,0 +1,343 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSIotThingGroup_basic(t *testing.T) {
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iot_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingGroupConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName, &thingGroup),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "iot", regexp.MustCompile(fmt.Sprintf("thinggroup/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "metadata.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.parent_group_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.root_to_parent_groups.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parent_group_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotThingGroup_disappears(t *testing.T) {
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iot_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingGroupConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName, &thingGroup),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotThingGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotThingGroup_Tags(t *testing.T) {
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iot_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingGroupConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotThingGroupConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotThingGroupConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotThingGroup_ParentGroup(t *testing.T) {
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iot_thing_group.test"
+	grandparentResourceName := "aws_iot_thing_group.grandparent"
+	parentResourceName := "aws_iot_thing_group.parent"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingGroupConfigParentGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName, &thingGroup),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_group_name", parentResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata.0.parent_group_name", parentResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.root_to_parent_groups.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata.0.root_to_parent_groups.0.group_arn", grandparentResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata.0.root_to_parent_groups.0.group_name", grandparentResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata.0.root_to_parent_groups.1.group_arn", parentResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata.0.root_to_parent_groups.1.group_name", parentResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotThingGroup_Properties(t *testing.T) {
+	var thingGroup iot.DescribeThingGroupOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iot_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotThingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotThingGroupConfigProperties(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.0.attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.0.attributes.Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.0.attributes.Key2", "Value2"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.description", "test description 1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotThingGroupConfigPropertiesUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.attribute_payload.0.attributes.Key3", "Value3"),
+					resource.TestCheckResourceAttr(resourceName, "properties.0.description", "test description 2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				Config: testAccAWSIotThingGroupConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotThingGroupExists(resourceName, &thingGroup),
+					resource.TestCheckResourceAttr(resourceName, "properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotThingGroupExists(n string, v *iot.DescribeThingGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Thing Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotconn
+
+		output, err := conn.DescribeThingGroup(&iot.DescribeThingGroupInput{
+			ThingGroupName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotThingGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_thing_group" {
+			continue
+		}
+
+		_, err := conn.DescribeThingGroup(&iot.DescribeThingGroupInput{
+			ThingGroupName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, iot.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Thing Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotThingGroupConfigName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIotThingGroupConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSIotThingGroupConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAWSIotThingGroupConfigParentGroup(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "grandparent" {
+  name = "%[1]s-grandparent"
+}
+
+resource "aws_iot_thing_group" "parent" {
+  name = "%[1]s-parent"
+
+  parent_group_name = aws_iot_thing_group.grandparent.name
+}
+
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+
+  parent_group_name = aws_iot_thing_group.parent.name
+}
+`, rName)
+}
+
+func testAccAWSIotThingGroupConfigProperties(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+
+  properties {
+    attribute_payload {
+      attributes = {
+        Key1 = "Value1"
+        Key2 = "Value2"
+      }
+    }
+
+    description = "test description 1"
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotThingGroupConfigPropertiesUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+
+  properties {
+    attribute_payload {
+      attributes = {
+        Key3 = "Value3"
+      }
+    }
+
+    description = "test description 2"
+  }
+}
+`, rName)
+}
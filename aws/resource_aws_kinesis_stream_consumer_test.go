@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesis/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSKinesisStreamConsumer_basic(t *testing.T) {
+	var consumer kinesis.ConsumerDescription
+	resourceName := "aws_kinesis_stream_consumer.test"
+	streamName := "aws_kinesis_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisStreamConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisStreamConsumerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisStreamConsumerExists(resourceName, &consumer),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "kinesis", regexp.MustCompile(fmt.Sprintf("stream/%[1]s/consumer/%[1]s", rName))),
+					testAccCheckResourceAttrRfc3339(resourceName, "creation_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "stream_arn", streamName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisStreamConsumer_disappears(t *testing.T) {
+	var consumer kinesis.ConsumerDescription
+	resourceName := "aws_kinesis_stream_consumer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisStreamConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisStreamConsumerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisStreamConsumerExists(resourceName, &consumer),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsKinesisStreamConsumer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisStreamConsumer_MaxConcurrentConsumers(t *testing.T) {
+	var consumer kinesis.ConsumerDescription
+	resourceName := "aws_kinesis_stream_consumer.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisStreamConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test creation of max number (5 according to AWS API docs) of concurrent consumers for a single stream
+				Config: testAccAWSKinesisStreamConsumerConfig_multiple(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisStreamConsumerExists(fmt.Sprintf("%s.0", resourceName), &consumer),
+					testAccCheckAWSKinesisStreamConsumerExists(fmt.Sprintf("%s.1", resourceName), &consumer),
+					testAccCheckAWSKinesisStreamConsumerExists(fmt.Sprintf("%s.2", resourceName), &consumer),
+					testAccCheckAWSKinesisStreamConsumerExists(fmt.Sprintf("%s.3", resourceName), &consumer),
+					testAccCheckAWSKinesisStreamConsumerExists(fmt.Sprintf("%s.4", resourceName), &consumer),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKinesisStreamConsumerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesis_stream_consumer" {
+			continue
+		}
+
+		_, err := finder.StreamConsumerByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kinesis Stream Consumer %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSKinesisStreamConsumerExists(n string, v *kinesis.ConsumerDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kinesis Stream Consumer ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+
+		output, err := finder.StreamConsumerByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSKinesisStreamConsumerBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  name        = %q
+  shard_count = 2
+}
+`, rName)
+}
+
+func testAccAWSKinesisStreamConsumerConfig_basic(rName string) string {
+	return composeConfig(
+		testAccAWSKinesisStreamConsumerBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kinesis_stream_consumer" "test" {
+  name       = %q
+  stream_arn = aws_kinesis_stream.test.arn
+}
+`, rName))
+}
+
+func testAccAWSKinesisStreamConsumerConfig_multiple(rName string, count int) string {
+	return composeConfig(
+		testAccAWSKinesisStreamConsumerBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kinesis_stream_consumer" "test" {
+  count      = %d
+  name       = "%s-${count.index}"
+  stream_arn = aws_kinesis_stream.test.arn
+}
+`, count, rName))
+}
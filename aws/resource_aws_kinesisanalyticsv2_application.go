@@ -37,6 +37,11 @@ func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
 			State: resourceAwsKinesisAnalyticsV2ApplicationImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"application_configuration": {
 				Type:     schema.TypeList,
@@ -266,6 +271,56 @@ func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
 							ConflictsWith: []string{"application_configuration.0.sql_application_configuration"},
 						},
 
+						"run_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_restore_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"application_restore_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.ApplicationRestoreType_Values(), false),
+												},
+
+												"snapshot_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+											},
+										},
+									},
+
+									"flink_run_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"allow_non_restored_state": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+							ConflictsWith: []string{"application_configuration.0.sql_application_configuration"},
+						},
+
 						"sql_application_configuration": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -436,12 +491,16 @@ func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
 
 												"input_starting_position_configuration": {
 													Type:     schema.TypeList,
+													Optional: true,
 													Computed: true,
+													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"input_starting_position": {
-																Type:     schema.TypeString,
-																Computed: true,
+																Type:         schema.TypeString,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.InputStartingPosition_Values(), false),
 															},
 														},
 													},
@@ -729,6 +788,7 @@ func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
 								"application_configuration.0.application_snapshot_configuration",
 								"application_configuration.0.environment_properties",
 								"application_configuration.0.flink_application_configuration",
+								"application_configuration.0.run_configuration",
 								"application_configuration.0.vpc_configuration",
 							},
 						},
@@ -837,6 +897,12 @@ func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
 				ValidateFunc: validateArn,
 			},
 
+			"start_application": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -882,6 +948,12 @@ func resourceAwsKinesisAnalyticsV2ApplicationCreate(d *schema.ResourceData, meta
 
 	d.SetId(aws.StringValue(output.ApplicationDetail.ApplicationARN))
 
+	if d.Get("start_application").(bool) {
+		if err := kinesisAnalyticsV2StartApplication(conn, d, output.ApplicationDetail, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
 }
 
@@ -909,6 +981,7 @@ func resourceAwsKinesisAnalyticsV2ApplicationRead(d *schema.ResourceData, meta i
 	d.Set("name", application.ApplicationName)
 	d.Set("runtime_environment", application.RuntimeEnvironment)
 	d.Set("service_execution_role", application.ServiceExecutionRole)
+	d.Set("start_application", kinesisAnalyticsV2ApplicationIsRunning(application))
 	d.Set("status", application.ApplicationStatus)
 	d.Set("version_id", int(aws.Int64Value(application.ApplicationVersionId)))
 
@@ -935,9 +1008,16 @@ func resourceAwsKinesisAnalyticsV2ApplicationRead(d *schema.ResourceData, meta i
 
 func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	applicationName := d.Get("name").(string)
+
+	// Stop the application before any configuration changes so that they don't have to be applied to a running application.
+	if d.HasChange("start_application") && !d.Get("start_application").(bool) {
+		if err := kinesisAnalyticsV2StopApplication(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
 
 	if d.HasChanges("application_configuration", "cloudwatch_logging_options", "service_execution_role") {
-		applicationName := d.Get("name").(string)
 		currentApplicationVersionId := int64(d.Get("version_id").(int))
 		updateApplication := false
 
@@ -972,6 +1052,13 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 				updateApplication = true
 			}
 
+			if d.HasChange("application_configuration.0.run_configuration") && d.Get("status").(string) == kinesisanalyticsv2.ApplicationStatusRunning && d.Get("start_application").(bool) {
+				// The run configuration can only be updated for a running application.
+				input.RunConfigurationUpdate = expandKinesisAnalyticsV2RunConfigurationUpdate(d.Get("application_configuration.0.run_configuration").([]interface{}))
+
+				updateApplication = true
+			}
+
 			if d.HasChange("application_configuration.0.sql_application_configuration") {
 				sqlApplicationConfigurationUpdate := &kinesisanalyticsv2.SqlApplicationConfigurationUpdate{}
 
@@ -999,6 +1086,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 						output := outputRaw.(*kinesisanalyticsv2.AddApplicationInputOutput)
 
 						currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+						if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+							return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+						}
 					} else if len(n.([]interface{})) == 0 {
 						// The existing input cannot be deleted.
 						// This should be handled by the CustomizeDiff function above.
@@ -1034,6 +1125,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 								output := outputRaw.(*kinesisanalyticsv2.AddApplicationInputProcessingConfigurationOutput)
 
 								currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+								if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+									return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+								}
 							} else if len(n.([]interface{})) == 0 {
 								// Delete existing input processing configuration.
 								input := &kinesisanalyticsv2.DeleteApplicationInputProcessingConfigurationInput{
@@ -1055,6 +1150,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 								output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationInputProcessingConfigurationOutput)
 
 								currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+								if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+									return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+								}
 							}
 						}
 
@@ -1113,6 +1212,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 						output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationOutputOutput)
 
 						currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+						if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+							return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+						}
 					}
 
 					// Add new outputs.
@@ -1136,6 +1239,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 						output := outputRaw.(*kinesisanalyticsv2.AddApplicationOutputOutput)
 
 						currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+						if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+							return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+						}
 					}
 				}
 
@@ -1163,6 +1270,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 						output := outputRaw.(*kinesisanalyticsv2.AddApplicationReferenceDataSourceOutput)
 
 						currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+						if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+							return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+						}
 					} else if len(n.([]interface{})) == 0 {
 						// Delete existing reference data source.
 						mOldReferenceDataSource := o.([]interface{})[0].(map[string]interface{})
@@ -1186,6 +1297,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 						output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationReferenceDataSourceOutput)
 
 						currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+						if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+							return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+						}
 					} else {
 						// Update existing reference data source.
 						referenceDataSourceUpdate := expandKinesisAnalyticsV2ReferenceDataSourceUpdate(n.([]interface{}))
@@ -1223,6 +1338,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 					output := outputRaw.(*kinesisanalyticsv2.AddApplicationVpcConfigurationOutput)
 
 					currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+					}
 				} else if len(n.([]interface{})) == 0 {
 					// Delete existing VPC configuration.
 					mOldVpcConfiguration := o.([]interface{})[0].(map[string]interface{})
@@ -1246,6 +1365,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 					output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationVpcConfigurationOutput)
 
 					currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+					}
 				} else {
 					// Update existing VPC configuration.
 					vpcConfigurationUpdate := expandKinesisAnalyticsV2VpcConfigurationUpdate(n.([]interface{}))
@@ -1287,6 +1410,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 				output := outputRaw.(*kinesisanalyticsv2.AddApplicationCloudWatchLoggingOptionOutput)
 
 				currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+				if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+				}
 			} else if len(n.([]interface{})) == 0 {
 				// Delete existing CloudWatch logging options.
 				mOldCloudWatchLoggingOption := o.([]interface{})[0].(map[string]interface{})
@@ -1310,6 +1437,10 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 				output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationCloudWatchLoggingOptionOutput)
 
 				currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+
+				if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+				}
 			} else {
 				// Update existing CloudWatch logging options.
 				mOldCloudWatchLoggingOption := o.([]interface{})[0].(map[string]interface{})
@@ -1344,6 +1475,23 @@ func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta
 			if err != nil {
 				return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s): %w", d.Id(), err)
 			}
+
+			if _, err := waiter.ApplicationUpdated(conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("start_application") && d.Get("start_application").(bool) {
+		// Refresh the application so that any inputs added above are started.
+		application, err := finder.ApplicationByName(conn, applicationName)
+
+		if err != nil {
+			return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %w", d.Id(), err)
+		}
+
+		if err := kinesisAnalyticsV2StartApplication(conn, d, application, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
 	}
 
@@ -1408,6 +1556,96 @@ func resourceAwsKinesisAnalyticsV2ApplicationImport(d *schema.ResourceData, meta
 	return []*schema.ResourceData{d}, nil
 }
 
+// kinesisAnalyticsV2ApplicationIsRunning returns whether the specified application is running.
+func kinesisAnalyticsV2ApplicationIsRunning(application *kinesisanalyticsv2.ApplicationDetail) bool {
+	switch aws.StringValue(application.ApplicationStatus) {
+	case kinesisanalyticsv2.ApplicationStatusAutoscaling, kinesisanalyticsv2.ApplicationStatusRunning:
+		return true
+	default:
+		return false
+	}
+}
+
+func kinesisAnalyticsV2StartApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, d *schema.ResourceData, application *kinesisanalyticsv2.ApplicationDetail, timeout time.Duration) error {
+	applicationName := aws.StringValue(application.ApplicationName)
+
+	if kinesisAnalyticsV2ApplicationIsRunning(application) {
+		return nil
+	}
+
+	input := &kinesisanalyticsv2.StartApplicationInput{
+		ApplicationName: aws.String(applicationName),
+	}
+
+	if aws.StringValue(application.RuntimeEnvironment) == kinesisanalyticsv2.RuntimeEnvironmentSql10 {
+		// Each SQL application input must be told where to start reading from.
+		if applicationConfigurationDescription := application.ApplicationConfigurationDescription; applicationConfigurationDescription != nil && applicationConfigurationDescription.SqlApplicationConfigurationDescription != nil {
+			runConfiguration := &kinesisanalyticsv2.RunConfiguration{}
+
+			for _, inputDescription := range applicationConfigurationDescription.SqlApplicationConfigurationDescription.InputDescriptions {
+				inputStartingPosition := kinesisanalyticsv2.InputStartingPositionNow
+
+				if v, ok := d.GetOk("application_configuration.0.sql_application_configuration.0.input.0.input_starting_position_configuration.0.input_starting_position"); ok {
+					inputStartingPosition = v.(string)
+				}
+
+				runConfiguration.SqlRunConfigurations = append(runConfiguration.SqlRunConfigurations, &kinesisanalyticsv2.SqlRunConfiguration{
+					InputId: inputDescription.InputId,
+					InputStartingPositionConfiguration: &kinesisanalyticsv2.InputStartingPositionConfiguration{
+						InputStartingPosition: aws.String(inputStartingPosition),
+					},
+				})
+			}
+
+			if len(runConfiguration.SqlRunConfigurations) > 0 {
+				input.RunConfiguration = runConfiguration
+			}
+		}
+	} else {
+		input.RunConfiguration = expandKinesisAnalyticsV2RunConfiguration(d.Get("application_configuration.0.run_configuration").([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Starting Kinesis Analytics v2 Application (%s): %s", applicationName, input)
+
+	if _, err := conn.StartApplication(input); err != nil {
+		return fmt.Errorf("error starting Kinesis Analytics v2 Application (%s): %w", applicationName, err)
+	}
+
+	if _, err := waiter.ApplicationStarted(conn, applicationName, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to start: %w", applicationName, err)
+	}
+
+	return nil
+}
+
+func kinesisAnalyticsV2StopApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName string, timeout time.Duration) error {
+	application, err := finder.ApplicationByName(conn, applicationName)
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %w", applicationName, err)
+	}
+
+	if !kinesisAnalyticsV2ApplicationIsRunning(application) {
+		return nil
+	}
+
+	input := &kinesisanalyticsv2.StopApplicationInput{
+		ApplicationName: aws.String(applicationName),
+	}
+
+	log.Printf("[DEBUG] Stopping Kinesis Analytics v2 Application (%s): %s", applicationName, input)
+
+	if _, err := conn.StopApplication(input); err != nil {
+		return fmt.Errorf("error stopping Kinesis Analytics v2 Application (%s): %w", applicationName, err)
+	}
+
+	if _, err := waiter.ApplicationStopped(conn, applicationName, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to stop: %w", applicationName, err)
+	}
+
+	return nil
+}
+
 func expandKinesisAnalyticsV2ApplicationConfiguration(vApplicationConfiguration []interface{}) *kinesisanalyticsv2.ApplicationConfiguration {
 	if len(vApplicationConfiguration) == 0 || vApplicationConfiguration[0] == nil {
 		return nil
@@ -2199,6 +2437,58 @@ func expandKinesisAnalyticsV2ReferenceDataSourceUpdate(vReferenceDataSource []in
 	return referenceDataSourceUpdate
 }
 
+func expandKinesisAnalyticsV2RunConfiguration(vRunConfiguration []interface{}) *kinesisanalyticsv2.RunConfiguration {
+	if len(vRunConfiguration) == 0 || vRunConfiguration[0] == nil {
+		return nil
+	}
+
+	runConfiguration := &kinesisanalyticsv2.RunConfiguration{}
+
+	mRunConfiguration := vRunConfiguration[0].(map[string]interface{})
+
+	if vApplicationRestoreConfiguration, ok := mRunConfiguration["application_restore_configuration"].([]interface{}); ok && len(vApplicationRestoreConfiguration) > 0 && vApplicationRestoreConfiguration[0] != nil {
+		applicationRestoreConfiguration := &kinesisanalyticsv2.ApplicationRestoreConfiguration{}
+
+		mApplicationRestoreConfiguration := vApplicationRestoreConfiguration[0].(map[string]interface{})
+
+		if vApplicationRestoreType, ok := mApplicationRestoreConfiguration["application_restore_type"].(string); ok && vApplicationRestoreType != "" {
+			applicationRestoreConfiguration.ApplicationRestoreType = aws.String(vApplicationRestoreType)
+		}
+		if vSnapshotName, ok := mApplicationRestoreConfiguration["snapshot_name"].(string); ok && vSnapshotName != "" {
+			applicationRestoreConfiguration.SnapshotName = aws.String(vSnapshotName)
+		}
+
+		runConfiguration.ApplicationRestoreConfiguration = applicationRestoreConfiguration
+	}
+
+	if vFlinkRunConfiguration, ok := mRunConfiguration["flink_run_configuration"].([]interface{}); ok && len(vFlinkRunConfiguration) > 0 && vFlinkRunConfiguration[0] != nil {
+		flinkRunConfiguration := &kinesisanalyticsv2.FlinkRunConfiguration{}
+
+		mFlinkRunConfiguration := vFlinkRunConfiguration[0].(map[string]interface{})
+
+		if vAllowNonRestoredState, ok := mFlinkRunConfiguration["allow_non_restored_state"].(bool); ok {
+			flinkRunConfiguration.AllowNonRestoredState = aws.Bool(vAllowNonRestoredState)
+		}
+
+		runConfiguration.FlinkRunConfiguration = flinkRunConfiguration
+	}
+
+	return runConfiguration
+}
+
+func expandKinesisAnalyticsV2RunConfigurationUpdate(vRunConfiguration []interface{}) *kinesisanalyticsv2.RunConfigurationUpdate {
+	runConfiguration := expandKinesisAnalyticsV2RunConfiguration(vRunConfiguration)
+
+	if runConfiguration == nil {
+		return nil
+	}
+
+	return &kinesisanalyticsv2.RunConfigurationUpdate{
+		ApplicationRestoreConfiguration: runConfiguration.ApplicationRestoreConfiguration,
+		FlinkRunConfiguration:           runConfiguration.FlinkRunConfiguration,
+	}
+}
+
 func expandKinesisAnalyticsV2SourceSchema(vSourceSchema []interface{}) *kinesisanalyticsv2.SourceSchema {
 	if len(vSourceSchema) == 0 || vSourceSchema[0] == nil {
 		return nil
@@ -2367,6 +2657,29 @@ func flattenKinesisAnalyticsV2ApplicationConfigurationDescription(applicationCon
 		mApplicationConfiguration["flink_application_configuration"] = []interface{}{mFlinkApplicationConfiguration}
 	}
 
+	if runConfigurationDescription := applicationConfigurationDescription.RunConfigurationDescription; runConfigurationDescription != nil {
+		mRunConfiguration := map[string]interface{}{}
+
+		if applicationRestoreConfigurationDescription := runConfigurationDescription.ApplicationRestoreConfigurationDescription; applicationRestoreConfigurationDescription != nil {
+			mApplicationRestoreConfiguration := map[string]interface{}{
+				"application_restore_type": aws.StringValue(applicationRestoreConfigurationDescription.ApplicationRestoreType),
+				"snapshot_name":            aws.StringValue(applicationRestoreConfigurationDescription.SnapshotName),
+			}
+
+			mRunConfiguration["application_restore_configuration"] = []interface{}{mApplicationRestoreConfiguration}
+		}
+
+		if flinkRunConfigurationDescription := runConfigurationDescription.FlinkRunConfigurationDescription; flinkRunConfigurationDescription != nil {
+			mFlinkRunConfiguration := map[string]interface{}{
+				"allow_non_restored_state": aws.BoolValue(flinkRunConfigurationDescription.AllowNonRestoredState),
+			}
+
+			mRunConfiguration["flink_run_configuration"] = []interface{}{mFlinkRunConfiguration}
+		}
+
+		mApplicationConfiguration["run_configuration"] = []interface{}{mRunConfiguration}
+	}
+
 	if sqlApplicationConfigurationDescription := applicationConfigurationDescription.SqlApplicationConfigurationDescription; sqlApplicationConfigurationDescription != nil {
 		mSqlApplicationConfiguration := map[string]interface{}{}
 
@@ -2405,6 +2718,14 @@ func flattenKinesisAnalyticsV2ApplicationConfigurationDescription(applicationCon
 				mInput["input_processing_configuration"] = []interface{}{mInputProcessingConfiguration}
 			}
 
+			if inputStartingPositionConfiguration := inputDescription.InputStartingPositionConfiguration; inputStartingPositionConfiguration != nil {
+				mInputStartingPositionConfiguration := map[string]interface{}{
+					"input_starting_position": aws.StringValue(inputStartingPositionConfiguration.InputStartingPosition),
+				}
+
+				mInput["input_starting_position_configuration"] = []interface{}{mInputStartingPositionConfiguration}
+			}
+
 			if kinesisFirehoseInputDescription := inputDescription.KinesisFirehoseInputDescription; kinesisFirehoseInputDescription != nil {
 				mKinesisFirehoseInput := map[string]interface{}{
 					"resource_arn": aws.StringValue(kinesisFirehoseInputDescription.ResourceARN),
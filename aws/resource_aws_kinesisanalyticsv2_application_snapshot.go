@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/waiter"
+)
+
+func resourceAwsKinesisAnalyticsV2ApplicationSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisAnalyticsV2ApplicationSnapshotCreate,
+		Read:   resourceAwsKinesisAnalyticsV2ApplicationSnapshotRead,
+		Delete: resourceAwsKinesisAnalyticsV2ApplicationSnapshotDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+
+			"application_version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"snapshot_creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"snapshot_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+
+	applicationName := d.Get("application_name").(string)
+	snapshotName := d.Get("snapshot_name").(string)
+
+	input := &kinesisanalyticsv2.CreateApplicationSnapshotInput{
+		ApplicationName: aws.String(applicationName),
+		SnapshotName:    aws.String(snapshotName),
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Analytics v2 Application Snapshot: %s", input)
+	_, err := conn.CreateApplicationSnapshot(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Analytics v2 Application Snapshot (%s/%s): %w", applicationName, snapshotName, err)
+	}
+
+	d.SetId(kinesisAnalyticsV2ApplicationSnapshotCreateID(applicationName, snapshotName))
+
+	_, err = waiter.SnapshotCreated(conn, applicationName, snapshotName, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application Snapshot (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationSnapshotRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+
+	applicationName, snapshotName, err := kinesisAnalyticsV2ApplicationSnapshotParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	snapshotDetails, err := finder.SnapshotDetailsByApplicationAndSnapshotNames(conn, applicationName, snapshotName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Kinesis Analytics v2 Application Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application Snapshot (%s): %w", d.Id(), err)
+	}
+
+	d.Set("application_name", applicationName)
+	d.Set("application_version_id", int(aws.Int64Value(snapshotDetails.ApplicationVersionId)))
+	d.Set("snapshot_creation_timestamp", aws.TimeValue(snapshotDetails.SnapshotCreationTimestamp).Format(time.RFC3339))
+	d.Set("snapshot_name", snapshotDetails.SnapshotName)
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+
+	applicationName, snapshotName, err := kinesisAnalyticsV2ApplicationSnapshotParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	snapshotCreationTimestamp, err := time.Parse(time.RFC3339, d.Get("snapshot_creation_timestamp").(string))
+
+	if err != nil {
+		return fmt.Errorf("error parsing snapshot_creation_timestamp: %w", err)
+	}
+
+	log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application Snapshot (%s)", d.Id())
+	_, err = conn.DeleteApplicationSnapshot(&kinesisanalyticsv2.DeleteApplicationSnapshotInput{
+		ApplicationName:           aws.String(applicationName),
+		SnapshotCreationTimestamp: aws.Time(snapshotCreationTimestamp),
+		SnapshotName:              aws.String(snapshotName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Analytics v2 Application Snapshot (%s): %w", d.Id(), err)
+	}
+
+	_, err = waiter.SnapshotDeleted(conn, applicationName, snapshotName, d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application Snapshot (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const kinesisAnalyticsV2ApplicationSnapshotIDSeparator = "/"
+
+func kinesisAnalyticsV2ApplicationSnapshotCreateID(applicationName, snapshotName string) string {
+	return strings.Join([]string{applicationName, snapshotName}, kinesisAnalyticsV2ApplicationSnapshotIDSeparator)
+}
+
+func kinesisAnalyticsV2ApplicationSnapshotParseID(id string) (string, string, error) {
+	parts := strings.Split(id, kinesisAnalyticsV2ApplicationSnapshotIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected <application-name>%s<snapshot-name>", id, kinesisAnalyticsV2ApplicationSnapshotIDSeparator)
+}
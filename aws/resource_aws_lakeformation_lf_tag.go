@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tflakeformation "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLakeFormationLFTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationLFTagCreate,
+		Read:   resourceAwsLakeFormationLFTagRead,
+		Update: resourceAwsLakeFormationLFTagUpdate,
+		Delete: resourceAwsLakeFormationLFTagDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1000,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationLFTagCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	}
+	tagKey := d.Get("key").(string)
+
+	input := &lakeformation.CreateLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(tagKey),
+		TagValues: expandStringSet(d.Get("values").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Creating Lake Formation LF-Tag: %s", input)
+	_, err := conn.CreateLFTag(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lake Formation LF-Tag (%s): %w", tagKey, err)
+	}
+
+	d.SetId(tflakeformation.LFTagCreateResourceID(catalogID, tagKey))
+
+	return resourceAwsLakeFormationLFTagRead(d, meta)
+}
+
+func resourceAwsLakeFormationLFTagRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID, tagKey, err := tflakeformation.LFTagParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.LFTagByKey(conn, catalogID, tagKey)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation LF-Tag (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	d.Set("catalog_id", output.CatalogId)
+	d.Set("key", output.TagKey)
+	d.Set("values", flattenStringSet(output.TagValues))
+
+	return nil
+}
+
+func resourceAwsLakeFormationLFTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID, tagKey, err := tflakeformation.LFTagParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("values")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	if add := ns.Difference(os); add.Len() > 0 {
+		input := &lakeformation.UpdateLFTagInput{
+			CatalogId:      aws.String(catalogID),
+			TagKey:         aws.String(tagKey),
+			TagValuesToAdd: expandStringSet(add),
+		}
+
+		log.Printf("[DEBUG] Updating Lake Formation LF-Tag: %s", input)
+		if _, err := conn.UpdateLFTag(input); err != nil {
+			return fmt.Errorf("error adding values to Lake Formation LF-Tag (%s): %w", d.Id(), err)
+		}
+	}
+
+	// Values are removed one at a time so that a value which cannot be
+	// removed (e.g. because it is still referenced by a grant) is named.
+	for _, v := range os.Difference(ns).List() {
+		value := v.(string)
+		input := &lakeformation.UpdateLFTagInput{
+			CatalogId:         aws.String(catalogID),
+			TagKey:            aws.String(tagKey),
+			TagValuesToDelete: aws.StringSlice([]string{value}),
+		}
+
+		log.Printf("[DEBUG] Updating Lake Formation LF-Tag: %s", input)
+		if _, err := conn.UpdateLFTag(input); err != nil {
+			return fmt.Errorf("error removing value (%s) from Lake Formation LF-Tag (%s): %w", value, d.Id(), err)
+		}
+	}
+
+	return resourceAwsLakeFormationLFTagRead(d, meta)
+}
+
+func resourceAwsLakeFormationLFTagDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID, tagKey, err := tflakeformation.LFTagParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lake Formation LF-Tag: %s", d.Id())
+	_, err = conn.DeleteLFTag(&lakeformation.DeleteLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(tagKey),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
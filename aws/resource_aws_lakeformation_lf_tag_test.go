@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tflakeformation "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAWSLakeFormationLFTag_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_lf_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationLFTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationLFTagConfig_values(rName, []string{"value"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationLFTagExists(resourceName),
+					testAccCheckResourceAttrAccountID(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(resourceName, "key", rName),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationLFTag_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_lf_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationLFTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationLFTagConfig_values(rName, []string{"value"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationLFTagExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLakeFormationLFTag(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationLFTag_values(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_lf_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationLFTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationLFTagConfig_values(rName, []string{"value1", "value2"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationLFTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "value1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLakeFormationLFTagConfig_values(rName, []string{"value1", "value3"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationLFTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "value1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "value3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLakeFormationLFTagDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_lf_tag" {
+			continue
+		}
+
+		catalogID, tagKey, err := tflakeformation.LFTagParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.LFTagByKey(conn, catalogID, tagKey)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lake Formation LF-Tag %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLakeFormationLFTagExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lake Formation LF-Tag ID is set")
+		}
+
+		catalogID, tagKey, err := tflakeformation.LFTagParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+		_, err = finder.LFTagByKey(conn, catalogID, tagKey)
+
+		return err
+	}
+}
+
+func testAccAWSLakeFormationLFTagConfig_values(rName string, values []string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_caller_identity.current.arn]
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["%[2]s"]
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, strings.Join(values, `", "`))
+}
@@ -29,15 +29,17 @@ func resourceAwsLakeFormationPermissions() *schema.Resource {
 				ValidateFunc: validateAwsAccountId,
 			},
 			"catalog_resource": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				ExactlyOneOf: []string{"catalog_resource", "data_location", "database", "table", "table_with_columns"},
 			},
 			"data_location": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"catalog_resource", "data_location", "database", "table", "table_with_columns"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"arn": {
@@ -55,10 +57,11 @@ func resourceAwsLakeFormationPermissions() *schema.Resource {
 				},
 			},
 			"database": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"catalog_resource", "data_location", "database", "table", "table_with_columns"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"catalog_id": {
@@ -101,10 +104,11 @@ func resourceAwsLakeFormationPermissions() *schema.Resource {
 				ValidateFunc: validatePrincipal,
 			},
 			"table": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"catalog_resource", "data_location", "database", "table", "table_with_columns"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"catalog_id": {
@@ -131,10 +135,11 @@ func resourceAwsLakeFormationPermissions() *schema.Resource {
 				},
 			},
 			"table_with_columns": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"catalog_resource", "data_location", "database", "table", "table_with_columns"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"catalog_id": {
@@ -299,40 +304,57 @@ func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interf
 		return fmt.Errorf("error reading Lake Formation permissions: %w", err)
 	}
 
-	if len(principalResourcePermissions) > 1 {
-		return fmt.Errorf("error reading Lake Formation permissions: %s", "multiple permissions found")
+	// ListPermissions filters coarsely (e.g. listing a table also returns column-level grants
+	// on that table), so keep only the grants for this exact principal and resource.
+	principalResourcePermissions = filterLakeFormationPermissions(d, principalResourcePermissions)
+
+	if len(principalResourcePermissions) == 0 {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Lake Formation permissions (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Resource Lake Formation permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 
-	for _, permissions := range principalResourcePermissions {
-		d.Set("principal", permissions.Principal.DataLakePrincipalIdentifier)
-		d.Set("permissions", permissions.Permissions)
-		d.Set("permissions_with_grant_option", permissions.PermissionsWithGrantOption)
+	var permissions, permissionsWithGrantOption []*string
 
-		if permissions.Resource.Catalog != nil {
-			d.Set("catalog_resource", true)
-		}
+	for _, principalResourcePermission := range principalResourcePermissions {
+		permissions = appendLakeFormationPermissions(permissions, principalResourcePermission.Permissions)
+		permissionsWithGrantOption = appendLakeFormationPermissions(permissionsWithGrantOption, principalResourcePermission.PermissionsWithGrantOption)
+	}
 
-		if permissions.Resource.DataLocation != nil {
-			d.Set("data_location", []interface{}{flattenLakeFormationDataLocationResource(permissions.Resource.DataLocation)})
-		} else {
-			d.Set("data_location", nil)
-		}
+	permission := principalResourcePermissions[0]
 
-		if permissions.Resource.Database != nil {
-			d.Set("database", []interface{}{flattenLakeFormationDatabaseResource(permissions.Resource.Database)})
-		} else {
-			d.Set("database", nil)
-		}
+	d.Set("principal", permission.Principal.DataLakePrincipalIdentifier)
+	d.Set("permissions", aws.StringValueSlice(permissions))
+	d.Set("permissions_with_grant_option", aws.StringValueSlice(permissionsWithGrantOption))
 
-		// table with columns permissions will include the table and table with columns
-		if permissions.Resource.TableWithColumns != nil {
-			d.Set("table_with_columns", []interface{}{flattenLakeFormationTableWithColumnsResource(permissions.Resource.TableWithColumns)})
-		} else if permissions.Resource.Table != nil {
-			d.Set("table_with_columns", nil)
-			d.Set("table", []interface{}{flattenLakeFormationTableResource(permissions.Resource.Table)})
-		} else {
-			d.Set("table", nil)
-		}
+	if permission.Resource.Catalog != nil {
+		d.Set("catalog_resource", true)
+	}
+
+	if permission.Resource.DataLocation != nil {
+		d.Set("data_location", []interface{}{flattenLakeFormationDataLocationResource(permission.Resource.DataLocation)})
+	} else {
+		d.Set("data_location", nil)
+	}
+
+	if permission.Resource.Database != nil {
+		d.Set("database", []interface{}{flattenLakeFormationDatabaseResource(permission.Resource.Database)})
+	} else {
+		d.Set("database", nil)
+	}
+
+	// table with columns permissions will include the table and table with columns
+	if permission.Resource.TableWithColumns != nil {
+		d.Set("table_with_columns", []interface{}{flattenLakeFormationTableWithColumnsResource(permission.Resource.TableWithColumns)})
+	} else if permission.Resource.Table != nil {
+		d.Set("table_with_columns", nil)
+		d.Set("table", []interface{}{flattenLakeFormationTableResource(permission.Resource.Table)})
+	} else {
+		d.Set("table", nil)
 	}
 
 	return nil
@@ -385,6 +407,82 @@ func resourceAwsLakeFormationPermissionsDelete(d *schema.ResourceData, meta inte
 	return nil
 }
 
+// filterLakeFormationPermissions returns the permissions granted to the configured principal
+// on exactly the configured resource.
+func filterLakeFormationPermissions(d *schema.ResourceData, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	principal := d.Get("principal").(string)
+	res := expandLakeFormationResource(d, false)
+
+	var filtered []*lakeformation.PrincipalResourcePermissions
+
+	for _, permission := range allPermissions {
+		if permission == nil || permission.Principal == nil || permission.Resource == nil {
+			continue
+		}
+
+		if aws.StringValue(permission.Principal.DataLakePrincipalIdentifier) != principal {
+			continue
+		}
+
+		if !lakeFormationResourceMatches(res, permission.Resource) {
+			continue
+		}
+
+		filtered = append(filtered, permission)
+	}
+
+	return filtered
+}
+
+func lakeFormationResourceMatches(want, got *lakeformation.Resource) bool {
+	switch {
+	case want.Catalog != nil:
+		return got.Catalog != nil
+	case want.DataLocation != nil:
+		return got.DataLocation != nil &&
+			aws.StringValue(got.DataLocation.ResourceArn) == aws.StringValue(want.DataLocation.ResourceArn)
+	case want.Database != nil:
+		return got.Database != nil &&
+			aws.StringValue(got.Database.Name) == aws.StringValue(want.Database.Name)
+	case want.Table != nil:
+		if got.Table == nil || aws.StringValue(got.Table.DatabaseName) != aws.StringValue(want.Table.DatabaseName) {
+			return false
+		}
+
+		if want.Table.TableWildcard != nil {
+			return got.Table.TableWildcard != nil
+		}
+
+		return aws.StringValue(got.Table.Name) == aws.StringValue(want.Table.Name)
+	case want.TableWithColumns != nil:
+		return got.TableWithColumns != nil &&
+			aws.StringValue(got.TableWithColumns.DatabaseName) == aws.StringValue(want.TableWithColumns.DatabaseName) &&
+			aws.StringValue(got.TableWithColumns.Name) == aws.StringValue(want.TableWithColumns.Name)
+	}
+
+	return false
+}
+
+// appendLakeFormationPermissions appends the permissions not already present in the list.
+func appendLakeFormationPermissions(permissions []*string, additional []*string) []*string {
+	for _, v := range additional {
+		found := false
+
+		for _, existing := range permissions {
+			if aws.StringValue(existing) == aws.StringValue(v) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			permissions = append(permissions, v)
+		}
+	}
+
+	return permissions
+}
+
 func expandLakeFormationResource(d *schema.ResourceData, squashTableWithColumns bool) *lakeformation.Resource {
 	res := &lakeformation.Resource{}
 
@@ -0,0 +1,375 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+)
+
+func resourceAwsLakeFormationResourceLFTags() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationResourceLFTagsCreate,
+		Read:   resourceAwsLakeFormationResourceLFTagsRead,
+		Delete: resourceAwsLakeFormationResourceLFTagsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"database": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"database", "table", "table_with_columns"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"lf_tag": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Set:      lakeFormationLFTagPairHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"table": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"database", "table", "table_with_columns"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"table_with_columns": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"database", "table", "table_with_columns"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"column_names": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationResourceLFTagsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.AddLFTagsToResourceInput{
+		LFTags:   expandLakeFormationLFTagPairs(d.Get("lf_tag").(*schema.Set).List()),
+		Resource: expandLakeFormationResourceLFTagsResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Adding Lake Formation LF-Tags to resource: %s", input)
+	output, err := conn.AddLFTagsToResource(input)
+
+	if err != nil {
+		return fmt.Errorf("error adding Lake Formation LF-Tags to resource: %w", err)
+	}
+
+	if err := lakeFormationLFTagErrors("adding", output.Failures); err != nil {
+		return fmt.Errorf("error adding Lake Formation LF-Tags to resource: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(input.String())))
+
+	return resourceAwsLakeFormationResourceLFTagsRead(d, meta)
+}
+
+func resourceAwsLakeFormationResourceLFTagsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.GetResourceLFTagsInput{
+		Resource:           expandLakeFormationResourceLFTagsResource(d),
+		ShowAssignedLFTags: aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	output, err := conn.GetResourceLFTags(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Lake Formation Resource LF-Tags (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Resource LF-Tags (%s): %w", d.Id(), err)
+	}
+
+	var tags []*lakeformation.LFTagPair
+
+	switch {
+	case input.Resource.Database != nil:
+		tags = output.LFTagOnDatabase
+	case input.Resource.Table != nil:
+		tags = output.LFTagsOnTable
+	case input.Resource.TableWithColumns != nil:
+		for _, column := range output.LFTagsOnColumns {
+			if column == nil {
+				continue
+			}
+
+			tags = append(tags, column.LFTags...)
+		}
+	}
+
+	// Only track the keys managed by this resource; other LF-Tags may be
+	// assigned to the same database, table or columns outside of Terraform.
+	keys := make(map[string]bool)
+	for _, tfMapRaw := range d.Get("lf_tag").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			keys[tfMap["key"].(string)] = true
+		}
+	}
+
+	var managed []*lakeformation.LFTagPair
+	for _, tag := range tags {
+		if tag == nil || !keys[aws.StringValue(tag.TagKey)] {
+			continue
+		}
+
+		managed = append(managed, tag)
+	}
+
+	if len(managed) == 0 {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Lake Formation Resource LF-Tags (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Lake Formation Resource LF-Tags (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("lf_tag", flattenLakeFormationLFTagPairs(managed)); err != nil {
+		return fmt.Errorf("error setting lf_tag: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationResourceLFTagsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.RemoveLFTagsFromResourceInput{
+		LFTags:   expandLakeFormationLFTagPairs(d.Get("lf_tag").(*schema.Set).List()),
+		Resource: expandLakeFormationResourceLFTagsResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Removing Lake Formation LF-Tags from resource: %s", input)
+	output, err := conn.RemoveLFTagsFromResource(input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing Lake Formation LF-Tags from resource (%s): %w", d.Id(), err)
+	}
+
+	if err := lakeFormationLFTagErrors("removing", output.Failures); err != nil {
+		return fmt.Errorf("error removing Lake Formation LF-Tags from resource (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLakeFormationResourceLFTagsResource(d *schema.ResourceData) *lakeformation.Resource {
+	res := &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		res.Database = expandLakeFormationDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		res.Table = expandLakeFormationTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		res.TableWithColumns = expandLakeFormationTableWithColumnsResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return res
+}
+
+func lakeFormationLFTagErrors(action string, failures []*lakeformation.LFTagError) error {
+	var errs *multierror.Error
+
+	for _, failure := range failures {
+		if failure == nil || failure.Error == nil {
+			continue
+		}
+
+		var tag string
+		if v := failure.LFTag; v != nil {
+			tag = fmt.Sprintf("%s=%s", aws.StringValue(v.TagKey), strings.Join(aws.StringValueSlice(v.TagValues), ","))
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("%s LF-Tag (%s): %s: %s", action, tag, aws.StringValue(failure.Error.ErrorCode), aws.StringValue(failure.Error.ErrorMessage)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func lakeFormationLFTagPairHash(v interface{}) int {
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return 0
+	}
+
+	return hashcode.String(fmt.Sprintf("%s=%s", m["key"].(string), m["value"].(string)))
+}
+
+func expandLakeFormationLFTagPairs(tfList []interface{}) []*lakeformation.LFTagPair {
+	var apiObjects []*lakeformation.LFTagPair
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lakeformation.LFTagPair{
+			TagKey:    aws.String(tfMap["key"].(string)),
+			TagValues: aws.StringSlice([]string{tfMap["value"].(string)}),
+		}
+
+		if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+			apiObject.CatalogId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLakeFormationLFTagPairs(apiObjects []*lakeformation.LFTagPair) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, value := range apiObject.TagValues {
+			tfList = append(tfList, map[string]interface{}{
+				"catalog_id": aws.StringValue(apiObject.CatalogId),
+				"key":        aws.StringValue(apiObject.TagKey),
+				"value":      aws.StringValue(value),
+			})
+		}
+	}
+
+	return tfList
+}
@@ -0,0 +1,287 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccAWSLakeFormationResourceLFTags_database(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_resource_lf_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationResourceLFTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationResourceLFTagsConfig_database(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						"key":   rName,
+						"value": "value1",
+					}),
+				),
+			},
+			{
+				Config: testAccAWSLakeFormationResourceLFTagsConfig_database(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						"key":   rName,
+						"value": "value2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationResourceLFTags_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_resource_lf_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationResourceLFTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationResourceLFTagsConfig_database(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationResourceLFTagsExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLakeFormationResourceLFTags(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationResourceLFTags_table(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_resource_lf_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationResourceLFTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationResourceLFTagsConfig_table(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.database_name", "aws_glue_catalog_table.test", "database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationResourceLFTags_tableWithColumns(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_resource_lf_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationResourceLFTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationResourceLFTagsConfig_tableWithColumns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.0.column_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.0.column_names.0", "event"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLakeFormationResourceLFTagsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_resource_lf_tags" {
+			continue
+		}
+
+		output, err := conn.GetResourceLFTags(&lakeformation.GetResourceLFTagsInput{
+			Resource:           testAccAWSLakeFormationResourceLFTagsResource(rs),
+			ShowAssignedLFTags: aws.Bool(true),
+		})
+
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.LFTagOnDatabase) > 0 || len(output.LFTagsOnTable) > 0 || len(output.LFTagsOnColumns) > 0 {
+			return fmt.Errorf("Lake Formation Resource LF-Tags %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLakeFormationResourceLFTagsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+		output, err := conn.GetResourceLFTags(&lakeformation.GetResourceLFTagsInput{
+			Resource:           testAccAWSLakeFormationResourceLFTagsResource(rs),
+			ShowAssignedLFTags: aws.Bool(true),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.LFTagOnDatabase) == 0 && len(output.LFTagsOnTable) == 0 && len(output.LFTagsOnColumns) == 0 {
+			return fmt.Errorf("Lake Formation Resource LF-Tags %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSLakeFormationResourceLFTagsResource(rs *terraform.ResourceState) *lakeformation.Resource {
+	attributes := rs.Primary.Attributes
+
+	switch {
+	case attributes["database.#"] == "1":
+		return &lakeformation.Resource{
+			Database: &lakeformation.DatabaseResource{
+				Name: aws.String(attributes["database.0.name"]),
+			},
+		}
+	case attributes["table.#"] == "1":
+		return &lakeformation.Resource{
+			Table: &lakeformation.TableResource{
+				DatabaseName: aws.String(attributes["table.0.database_name"]),
+				Name:         aws.String(attributes["table.0.name"]),
+			},
+		}
+	default:
+		return &lakeformation.Resource{
+			TableWithColumns: &lakeformation.TableWithColumnsResource{
+				ColumnNames:  aws.StringSlice([]string{attributes["table_with_columns.0.column_names.0"]}),
+				DatabaseName: aws.String(attributes["table_with_columns.0.database_name"]),
+				Name:         aws.String(attributes["table_with_columns.0.name"]),
+			},
+		}
+	}
+}
+
+func testAccAWSLakeFormationResourceLFTagsConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_caller_identity.current.arn]
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["value1", "value2"]
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationResourceLFTagsConfigTableBase(rName string) string {
+	return composeConfig(testAccAWSLakeFormationResourceLFTagsConfigBase(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "event"
+      type = "string"
+    }
+
+    columns {
+      name = "timestamp"
+      type = "date"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSLakeFormationResourceLFTagsConfig_database(rName, value string) string {
+	return composeConfig(testAccAWSLakeFormationResourceLFTagsConfigBase(rName), fmt.Sprintf(`
+resource "aws_lakeformation_resource_lf_tags" "test" {
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = %[1]q
+  }
+}
+`, value))
+}
+
+func testAccAWSLakeFormationResourceLFTagsConfig_table(rName string) string {
+	return composeConfig(testAccAWSLakeFormationResourceLFTagsConfigTableBase(rName), `
+resource "aws_lakeformation_resource_lf_tags" "test" {
+  table {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = "value1"
+  }
+}
+`)
+}
+
+func testAccAWSLakeFormationResourceLFTagsConfig_tableWithColumns(rName string) string {
+	return composeConfig(testAccAWSLakeFormationResourceLFTagsConfigTableBase(rName), `
+resource "aws_lakeformation_resource_lf_tags" "test" {
+  table_with_columns {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+    column_names  = ["event"]
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = "value1"
+  }
+}
+`)
+}
@@ -12,9 +12,15 @@ func TestAccAWSLakeFormation_serial(t *testing.T) {
 			"withoutCatalogId": testAccAWSLakeFormationDataLakeSettings_withoutCatalogId,
 			"dataSource":       testAccAWSLakeFormationDataLakeSettingsDataSource_basic,
 		},
+		"LFTag": {
+			"basic":      testAccAWSLakeFormationLFTag_basic,
+			"disappears": testAccAWSLakeFormationLFTag_disappears,
+			"values":     testAccAWSLakeFormationLFTag_values,
+		},
 		"Permissions": {
 			"basic":                      testAccAWSLakeFormationPermissions_basic,
 			"dataLocation":               testAccAWSLakeFormationPermissions_dataLocation,
+			"disappears":                 testAccAWSLakeFormationPermissions_disappears,
 			"database":                   testAccAWSLakeFormationPermissions_database,
 			"table":                      testAccAWSLakeFormationPermissions_table,
 			"tableWithColumns":           testAccAWSLakeFormationPermissions_tableWithColumns,
@@ -24,6 +30,12 @@ func TestAccAWSLakeFormation_serial(t *testing.T) {
 			"tableDataSource":            testAccAWSLakeFormationPermissionsDataSource_table,
 			"tableWithColumnsDataSource": testAccAWSLakeFormationPermissionsDataSource_tableWithColumns,
 		},
+		"ResourceLFTags": {
+			"database":         testAccAWSLakeFormationResourceLFTags_database,
+			"disappears":       testAccAWSLakeFormationResourceLFTags_disappears,
+			"table":            testAccAWSLakeFormationResourceLFTags_table,
+			"tableWithColumns": testAccAWSLakeFormationResourceLFTags_tableWithColumns,
+		},
 	}
 
 	for group, m := range testCases {
@@ -373,6 +373,36 @@ func TestAccAWSLambdaEventSourceMapping_KinesisBatchWindow(t *testing.T) {
 	})
 }
 
+func TestAccAWSLambdaEventSourceMapping_KinesisStreamConsumer(t *testing.T) {
+	var conf lambda.EventSourceMappingConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lambda_event_source_mapping.test"
+	consumerResourceName := "aws_kinesis_stream_consumer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLambdaEventSourceMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLambdaEventSourceMappingConfigKinesisStreamConsumer(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaEventSourceMappingExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "event_source_arn", consumerResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"starting_position",
+				},
+			},
+		},
+	})
+}
+
 func TestAccAWSLambdaEventSourceMapping_ParallelizationFactor(t *testing.T) {
 	var conf lambda.EventSourceMappingConfiguration
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -884,6 +914,47 @@ resource "aws_lambda_event_source_mapping" "test" {
 `, batchWindow)
 }
 
+func testAccAWSLambdaEventSourceMappingConfigKinesisStreamConsumer(rName string) string {
+	return testAccAWSLambdaEventSourceMappingConfigKinesisBase(rName) + fmt.Sprintf(`
+resource "aws_iam_role_policy" "consumer" {
+  role = aws_iam_role.test.name
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "kinesis:DescribeStreamConsumer",
+        "kinesis:DescribeStreamSummary",
+        "kinesis:ListShards",
+        "kinesis:SubscribeToShard"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_kinesis_stream_consumer" "test" {
+  name       = %[1]q
+  stream_arn = aws_kinesis_stream.test.arn
+}
+
+resource "aws_lambda_event_source_mapping" "test" {
+  batch_size        = 100
+  enabled           = true
+  event_source_arn  = aws_kinesis_stream_consumer.test.arn
+  function_name     = aws_lambda_function.test.arn
+  starting_position = "TRIM_HORIZON"
+
+  depends_on = [aws_iam_role_policy.consumer]
+}
+`, rName)
+}
+
 func testAccAWSLambdaEventSourceMappingConfigKinesisParallelizationFactor(rName string, parallelizationFactor int64) string {
 	return testAccAWSLambdaEventSourceMappingConfigKinesisBase(rName) + fmt.Sprintf(`
 resource "aws_lambda_event_source_mapping" "test" {
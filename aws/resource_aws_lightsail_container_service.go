@@ -0,0 +1,453 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lightsail/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lightsail/waiter"
+)
+
+func resourceAwsLightsailContainerService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailContainerServiceCreate,
+		Read:   resourceAwsLightsailContainerServiceRead,
+		Update: resourceAwsLightsailContainerServiceUpdate,
+		Delete: resourceAwsLightsailContainerServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ContainerServiceCreateTimeout),
+			Update: schema.DefaultTimeout(waiter.ContainerServiceUpdateTimeout),
+			Delete: schema.DefaultTimeout(waiter.ContainerServiceDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[a-z0-9]{1,2}|[a-z0-9][a-z0-9-]+[a-z0-9]$`), "must contain only lowercase alphanumeric characters and hyphens, and must not start or end with a hyphen"),
+				),
+			},
+			"power": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(lightsail.ContainerServicePowerName_Values(), false),
+			},
+			"power_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_registry_access": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ecr_image_puller_role": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"is_active": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"principal_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"public_domain_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"certificate_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"domain_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scale": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailContainerServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	serviceName := d.Get("name").(string)
+
+	input := &lightsail.CreateContainerServiceInput{
+		ServiceName: aws.String(serviceName),
+		Power:       aws.String(d.Get("power").(string)),
+		Scale:       aws.Int64(int64(d.Get("scale").(int))),
+	}
+
+	if v, ok := d.GetOk("private_registry_access"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PrivateRegistryAccess = expandLightsailContainerServicePrivateRegistryAccess(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("public_domain_names"); ok {
+		input.PublicDomainNames = expandLightsailContainerServicePublicDomainNames(v.([]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().LightsailTags()
+	}
+
+	_, err := conn.CreateContainerService(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Container Service (%s): %w", serviceName, err)
+	}
+
+	d.SetId(serviceName)
+
+	if _, err := waiter.ContainerServiceCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Container Service (%s) creation: %w", d.Id(), err)
+	}
+
+	// once container service creation and/or deployment successful (now enabled by default), disable it if "is_disabled" is true
+	if v, ok := d.GetOk("is_disabled"); ok && v.(bool) {
+		input := &lightsail.UpdateContainerServiceInput{
+			ServiceName: aws.String(d.Id()),
+			IsDisabled:  aws.Bool(true),
+		}
+
+		_, err := conn.UpdateContainerService(input)
+
+		if err != nil {
+			return fmt.Errorf("error disabling Lightsail Container Service (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.ContainerServiceDisabledModified(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Container Service (%s) to be disabled: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLightsailContainerServiceRead(d, meta)
+}
+
+func resourceAwsLightsailContainerServiceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	cs, err := finder.ContainerServiceByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		log.Printf("[WARN] Lightsail Container Service (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Container Service (%s): %w", d.Id(), err)
+	}
+
+	if cs == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Lightsail Container Service (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Lightsail Container Service (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", cs.ContainerServiceName)
+	d.Set("power", cs.Power)
+	d.Set("scale", cs.Scale)
+	d.Set("is_disabled", cs.IsDisabled)
+
+	if err := d.Set("private_registry_access", flattenLightsailContainerServicePrivateRegistryAccess(cs.PrivateRegistryAccess)); err != nil {
+		return fmt.Errorf("error setting private_registry_access for Lightsail Container Service (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("public_domain_names", flattenLightsailContainerServicePublicDomainNames(cs.PublicDomainNames)); err != nil {
+		return fmt.Errorf("error setting public_domain_names for Lightsail Container Service (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", cs.Arn)
+	if cs.Location != nil {
+		d.Set("availability_zone", cs.Location.AvailabilityZone)
+	}
+	d.Set("created_at", aws.TimeValue(cs.CreatedAt).Format(time.RFC3339))
+	d.Set("power_id", cs.PowerId)
+	d.Set("principal_arn", cs.PrincipalArn)
+	d.Set("private_domain_name", cs.PrivateDomainName)
+	d.Set("resource_type", cs.ResourceType)
+	d.Set("state", cs.State)
+	d.Set("url", cs.Url)
+
+	if err := d.Set("tags", keyvaluetags.LightsailKeyValueTags(cs.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailContainerServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	if d.HasChangesExcept("tags") {
+		publicDomainNames := containerServicePublicDomainNamesChanged(d)
+
+		input := &lightsail.UpdateContainerServiceInput{
+			ServiceName:       aws.String(d.Id()),
+			IsDisabled:        aws.Bool(d.Get("is_disabled").(bool)),
+			Power:             aws.String(d.Get("power").(string)),
+			PublicDomainNames: publicDomainNames,
+			Scale:             aws.Int64(int64(d.Get("scale").(int))),
+		}
+
+		if d.HasChange("private_registry_access") {
+			if v, ok := d.GetOk("private_registry_access"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.PrivateRegistryAccess = expandLightsailContainerServicePrivateRegistryAccess(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		_, err := conn.UpdateContainerService(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Lightsail Container Service (%s): %w", d.Id(), err)
+		}
+
+		if d.HasChange("is_disabled") {
+			if _, err := waiter.ContainerServiceDisabledModified(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Lightsail Container Service (%s) update: %w", d.Id(), err)
+			}
+		} else {
+			if _, err := waiter.ContainerServiceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Lightsail Container Service (%s) update: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.LightsailUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Lightsail Container Service (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLightsailContainerServiceRead(d, meta)
+}
+
+func resourceAwsLightsailContainerServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	input := &lightsail.DeleteContainerServiceInput{
+		ServiceName: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteContainerService(input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Container Service (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ContainerServiceDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Container Service (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLightsailContainerServicePrivateRegistryAccess(tfMap map[string]interface{}) *lightsail.PrivateRegistryAccessRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	request := &lightsail.PrivateRegistryAccessRequest{}
+
+	if v, ok := tfMap["ecr_image_puller_role"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if m, ok := v[0].(map[string]interface{}); ok {
+			request.EcrImagePullerRole = &lightsail.ContainerServiceECRImagePullerRoleRequest{
+				IsActive: aws.Bool(m["is_active"].(bool)),
+			}
+		}
+	}
+
+	return request
+}
+
+func expandLightsailContainerServicePublicDomainNames(rawPublicDomainNames []interface{}) map[string][]*string {
+	if len(rawPublicDomainNames) == 0 || rawPublicDomainNames[0] == nil {
+		return nil
+	}
+
+	resultMap := make(map[string][]*string)
+
+	for _, rpdn := range rawPublicDomainNames {
+		rpdnMap, ok := rpdn.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rawCertificates := rpdnMap["certificate"].(*schema.Set).List()
+
+		for _, rc := range rawCertificates {
+			rcMap, ok := rc.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			var domainNames []*string
+			for _, rawDomainName := range rcMap["domain_names"].([]interface{}) {
+				domainNames = append(domainNames, aws.String(rawDomainName.(string)))
+			}
+
+			certificateName := rcMap["certificate_name"].(string)
+
+			resultMap[certificateName] = domainNames
+		}
+	}
+
+	return resultMap
+}
+
+func flattenLightsailContainerServicePrivateRegistryAccess(apiObject *lightsail.PrivateRegistryAccess) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EcrImagePullerRole; v != nil {
+		tfMap["ecr_image_puller_role"] = []interface{}{map[string]interface{}{
+			"is_active":     aws.BoolValue(v.IsActive),
+			"principal_arn": aws.StringValue(v.PrincipalArn),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLightsailContainerServicePublicDomainNames(domainNames map[string][]*string) []interface{} {
+	if domainNames == nil {
+		return []interface{}{}
+	}
+
+	var rawCertificates []interface{}
+
+	for certName, domains := range domainNames {
+		rawCertificate := map[string]interface{}{
+			"certificate_name": certName,
+			"domain_names":     aws.StringValueSlice(domains),
+		}
+
+		rawCertificates = append(rawCertificates, rawCertificate)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate": rawCertificates,
+		},
+	}
+}
+
+// containerServicePublicDomainNamesChanged returns the public domain names to
+// send in an update. Removing all public domain names requires sending an
+// empty map rather than omitting the field.
+func containerServicePublicDomainNamesChanged(d *schema.ResourceData) map[string][]*string {
+	o, n := d.GetChange("public_domain_names")
+	oldPublicDomainNames := expandLightsailContainerServicePublicDomainNames(o.([]interface{}))
+	newPublicDomainNames := expandLightsailContainerServicePublicDomainNames(n.([]interface{}))
+
+	if len(newPublicDomainNames) == 0 && len(oldPublicDomainNames) > 0 {
+		return map[string][]*string{}
+	}
+
+	return newPublicDomainNames
+}
@@ -0,0 +1,410 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lightsail/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lightsail/waiter"
+)
+
+func resourceAwsLightsailContainerServiceDeploymentVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailContainerServiceDeploymentVersionCreate,
+		Read:   resourceAwsLightsailContainerServiceDeploymentVersionRead,
+		Delete: resourceAwsLightsailContainerServiceDeploymentVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ContainerServiceDeploymentVersionCreateTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"container": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 53,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"container_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 53),
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ports": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lightsail.ContainerServiceProtocol_Values(), false),
+							},
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_endpoint": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"container_port": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"health_check": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"healthy_threshold": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      2,
+										ValidateFunc: validation.IntBetween(2, 10),
+									},
+									"interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      5,
+										ValidateFunc: validation.IntBetween(5, 300),
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										Default:  "/",
+									},
+									"success_codes": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										Default:  "200-499",
+									},
+									"timeout_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      2,
+										ValidateFunc: validation.IntBetween(2, 60),
+									},
+									"unhealthy_threshold": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      2,
+										ValidateFunc: validation.IntBetween(2, 10),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailContainerServiceDeploymentVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	serviceName := d.Get("service_name").(string)
+
+	input := &lightsail.CreateContainerServiceDeploymentInput{
+		ServiceName: aws.String(serviceName),
+	}
+
+	if v, ok := d.GetOk("container"); ok && v.(*schema.Set).Len() > 0 {
+		input.Containers = expandLightsailContainerServiceDeploymentContainers(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("public_endpoint"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PublicEndpoint = expandLightsailContainerServiceDeploymentPublicEndpoint(v.([]interface{}))
+	}
+
+	output, err := conn.CreateContainerServiceDeployment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Container Service (%s) Deployment Version: %w", serviceName, err)
+	}
+
+	if output == nil || output.ContainerService == nil || output.ContainerService.NextDeployment == nil {
+		return fmt.Errorf("error creating Lightsail Container Service (%s) Deployment Version: empty output", serviceName)
+	}
+
+	version := int(aws.Int64Value(output.ContainerService.NextDeployment.Version))
+
+	d.SetId(fmt.Sprintf("%s/%d", serviceName, version))
+
+	if _, err := waiter.ContainerServiceDeploymentVersionActive(conn, serviceName, version, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Container Service (%s) Deployment Version (%d) to become active: %w", serviceName, version, err)
+	}
+
+	if _, err := waiter.ContainerServiceUpdated(conn, serviceName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Container Service (%s) to be running: %w", serviceName, err)
+	}
+
+	return resourceAwsLightsailContainerServiceDeploymentVersionRead(d, meta)
+}
+
+func resourceAwsLightsailContainerServiceDeploymentVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	serviceName, version, err := resourceAwsLightsailContainerServiceDeploymentVersionParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	deployment, err := finder.ContainerServiceDeploymentByVersion(conn, serviceName, version)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		log.Printf("[WARN] Lightsail Container Service (%s) Deployment Version (%d) not found, removing from state", serviceName, version)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Container Service (%s) Deployment Version (%d): %w", serviceName, version, err)
+	}
+
+	if deployment == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Lightsail Container Service (%s) Deployment Version (%d): not found after creation", serviceName, version)
+		}
+
+		log.Printf("[WARN] Lightsail Container Service (%s) Deployment Version (%d) not found, removing from state", serviceName, version)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("created_at", aws.TimeValue(deployment.CreatedAt).Format(time.RFC3339))
+	d.Set("service_name", serviceName)
+	d.Set("state", deployment.State)
+	d.Set("version", deployment.Version)
+
+	if err := d.Set("container", flattenLightsailContainerServiceDeploymentContainers(deployment.Containers)); err != nil {
+		return fmt.Errorf("error setting container for Lightsail Container Service (%s) Deployment Version (%d): %w", serviceName, version, err)
+	}
+
+	if err := d.Set("public_endpoint", flattenLightsailContainerServiceDeploymentPublicEndpoint(deployment.PublicEndpoint)); err != nil {
+		return fmt.Errorf("error setting public_endpoint for Lightsail Container Service (%s) Deployment Version (%d): %w", serviceName, version, err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailContainerServiceDeploymentVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Cannot destroy Lightsail Container Service Deployment Version. Terraform will remove this resource from the state file, however resources may remain.")
+	return nil
+}
+
+func resourceAwsLightsailContainerServiceDeploymentVersionParseID(id string) (string, int, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", 0, fmt.Errorf("unexpected format of ID (%s), expected SERVICE_NAME/VERSION", id)
+	}
+
+	version, err := strconv.Atoi(parts[1])
+
+	if err != nil {
+		return "", 0, fmt.Errorf("error parsing version from ID (%s): %w", id, err)
+	}
+
+	return parts[0], version, nil
+}
+
+func expandLightsailContainerServiceDeploymentContainers(tfList []interface{}) map[string]*lightsail.Container {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	result := make(map[string]*lightsail.Container)
+
+	for _, tfListRaw := range tfList {
+		tfMap, ok := tfListRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		containerName := tfMap["container_name"].(string)
+
+		container := &lightsail.Container{
+			Image: aws.String(tfMap["image"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			container.Command = expandStringList(v)
+		}
+
+		if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+			container.Environment = stringMapToPointers(v)
+		}
+
+		if v, ok := tfMap["ports"].(map[string]interface{}); ok && len(v) > 0 {
+			container.Ports = stringMapToPointers(v)
+		}
+
+		result[containerName] = container
+	}
+
+	return result
+}
+
+func expandLightsailContainerServiceDeploymentPublicEndpoint(tfList []interface{}) *lightsail.EndpointRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	endpoint := &lightsail.EndpointRequest{
+		ContainerName: aws.String(tfMap["container_name"].(string)),
+		ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+	}
+
+	if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		endpoint.HealthCheck = expandLightsailContainerServiceDeploymentHealthCheck(v)
+	}
+
+	return endpoint
+}
+
+func expandLightsailContainerServiceDeploymentHealthCheck(tfList []interface{}) *lightsail.ContainerServiceHealthCheckConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return &lightsail.ContainerServiceHealthCheckConfig{
+		HealthyThreshold:   aws.Int64(int64(tfMap["healthy_threshold"].(int))),
+		IntervalSeconds:    aws.Int64(int64(tfMap["interval_seconds"].(int))),
+		Path:               aws.String(tfMap["path"].(string)),
+		SuccessCodes:       aws.String(tfMap["success_codes"].(string)),
+		TimeoutSeconds:     aws.Int64(int64(tfMap["timeout_seconds"].(int))),
+		UnhealthyThreshold: aws.Int64(int64(tfMap["unhealthy_threshold"].(int))),
+	}
+}
+
+func flattenLightsailContainerServiceDeploymentContainers(containers map[string]*lightsail.Container) []interface{} {
+	if len(containers) == 0 {
+		return nil
+	}
+
+	var rawContainers []interface{}
+	for containerName, container := range containers {
+		if container == nil {
+			continue
+		}
+
+		rawContainer := map[string]interface{}{
+			"command":        aws.StringValueSlice(container.Command),
+			"container_name": containerName,
+			"environment":    aws.StringValueMap(container.Environment),
+			"image":          aws.StringValue(container.Image),
+			"ports":          aws.StringValueMap(container.Ports),
+		}
+
+		rawContainers = append(rawContainers, rawContainer)
+	}
+
+	return rawContainers
+}
+
+func flattenLightsailContainerServiceDeploymentPublicEndpoint(endpoint *lightsail.ContainerServiceEndpoint) []interface{} {
+	if endpoint == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"container_name": aws.StringValue(endpoint.ContainerName),
+			"container_port": int(aws.Int64Value(endpoint.ContainerPort)),
+			"health_check":   flattenLightsailContainerServiceDeploymentHealthCheck(endpoint.HealthCheck),
+		},
+	}
+}
+
+func flattenLightsailContainerServiceDeploymentHealthCheck(healthCheck *lightsail.ContainerServiceHealthCheckConfig) []interface{} {
+	if healthCheck == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"healthy_threshold":   int(aws.Int64Value(healthCheck.HealthyThreshold)),
+			"interval_seconds":    int(aws.Int64Value(healthCheck.IntervalSeconds)),
+			"path":                aws.StringValue(healthCheck.Path),
+			"success_codes":       aws.StringValue(healthCheck.SuccessCodes),
+			"timeout_seconds":     int(aws.Int64Value(healthCheck.TimeoutSeconds)),
+			"unhealthy_threshold": int(aws.Int64Value(healthCheck.UnhealthyThreshold)),
+		},
+	}
+}
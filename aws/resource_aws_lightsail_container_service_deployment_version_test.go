@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lightsail/finder"
+)
+
+func TestAccAWSLightsailContainerServiceDeploymentVersion_Container_Basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	containerName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_container_service_deployment_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailContainerServiceDeploymentVersionConfigContainerBasic(rName, containerName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceDeploymentVersionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "service_name", "aws_lightsail_container_service.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "state", lightsail.ContainerServiceDeploymentStateActive),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "container.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "container.*", map[string]string{
+						"container_name": containerName,
+						"image":          "amazon/amazon-lightsail:hello-world",
+						"command.#":      "0",
+						"environment.%":  "0",
+						"ports.%":        "0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailContainerServiceDeploymentVersion_PublicEndpoint(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	containerName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_container_service_deployment_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailContainerServiceDeploymentVersionConfigPublicEndpoint(rName, containerName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceDeploymentVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", lightsail.ContainerServiceDeploymentStateActive),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "container.*", map[string]string{
+						"container_name": containerName,
+						"environment.%":  "1",
+						"environment.A":  "a",
+						"ports.%":        "1",
+						"ports.80":       lightsail.ContainerServiceProtocolHttp,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.container_name", containerName),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.container_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.health_check.0.healthy_threshold", "2"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.health_check.0.interval_seconds", "5"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.health_check.0.path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.health_check.0.success_codes", "200-499"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.health_check.0.timeout_seconds", "2"),
+					resource.TestCheckResourceAttr(resourceName, "public_endpoint.0.health_check.0.unhealthy_threshold", "2"),
+					resource.TestCheckResourceAttr("aws_lightsail_container_service.test", "state", lightsail.ContainerServiceStateRunning),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailContainerServiceDeploymentVersionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Container Service Deployment Version ID is set")
+		}
+
+		serviceName, version, err := resourceAwsLightsailContainerServiceDeploymentVersionParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		deployment, err := finder.ContainerServiceDeploymentByVersion(conn, serviceName, version)
+
+		if err != nil {
+			return err
+		}
+
+		if deployment == nil {
+			return fmt.Errorf("Lightsail Container Service Deployment Version (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSLightsailContainerServiceDeploymentVersionConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name  = %q
+  power = "nano"
+  scale = 1
+}
+`, rName)
+}
+
+func testAccAWSLightsailContainerServiceDeploymentVersionConfigContainerBasic(rName, containerName string) string {
+	return composeConfig(
+		testAccAWSLightsailContainerServiceDeploymentVersionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_lightsail_container_service_deployment_version" "test" {
+  container {
+    container_name = %q
+    image          = "amazon/amazon-lightsail:hello-world"
+  }
+
+  service_name = aws_lightsail_container_service.test.name
+}
+`, containerName))
+}
+
+func testAccAWSLightsailContainerServiceDeploymentVersionConfigPublicEndpoint(rName, containerName string) string {
+	return composeConfig(
+		testAccAWSLightsailContainerServiceDeploymentVersionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_lightsail_container_service_deployment_version" "test" {
+  container {
+    container_name = %[1]q
+    image          = "amazon/amazon-lightsail:hello-world"
+
+    environment = {
+      A = "a"
+    }
+
+    ports = {
+      80 = "HTTP"
+    }
+  }
+
+  public_endpoint {
+    container_name = %[1]q
+    container_port = 80
+
+    health_check {}
+  }
+
+  service_name = aws_lightsail_container_service.test.name
+}
+`, containerName))
+}
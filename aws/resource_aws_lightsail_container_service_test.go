@@ -0,0 +1,337 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lightsail/finder"
+)
+
+func TestAccAWSLightsailContainerService_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_container_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailContainerServiceConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "power", lightsail.ContainerServicePowerNameNano),
+					resource.TestCheckResourceAttr(resourceName, "scale", "1"),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "false"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "lightsail", regexp.MustCompile(`ContainerService/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zone"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "power_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "principal_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "ContainerService"),
+					resource.TestCheckResourceAttr(resourceName, "state", lightsail.ContainerServiceStateReady),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+					resource.TestCheckResourceAttr(resourceName, "private_registry_access.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "private_registry_access.0.ecr_image_puller_role.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "private_registry_access.0.ecr_image_puller_role.0.is_active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailContainerService_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_container_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailContainerServiceConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLightsailContainerService(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailContainerService_Name(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_container_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailContainerServiceConfigBasic(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName1),
+				),
+			},
+			{
+				Config: testAccAWSLightsailContainerServiceConfigBasic(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailContainerService_IsDisabled(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_container_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailContainerServiceConfigIsDisabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "false"),
+				),
+			},
+			{
+				Config: testAccAWSLightsailContainerServiceConfigIsDisabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_disabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", lightsail.ContainerServiceStateDisabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailContainerService_Power(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_container_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailContainerServiceConfigPowerScale(rName, lightsail.ContainerServicePowerNameNano, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "power", lightsail.ContainerServicePowerNameNano),
+				),
+			},
+			{
+				Config: testAccAWSLightsailContainerServiceConfigPowerScale(rName, lightsail.ContainerServicePowerNameMicro, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "power", lightsail.ContainerServicePowerNameMicro),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailContainerService_Scale(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_container_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailContainerServiceConfigPowerScale(rName, lightsail.ContainerServicePowerNameNano, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale", "1"),
+				),
+			},
+			{
+				Config: testAccAWSLightsailContainerServiceConfigPowerScale(rName, lightsail.ContainerServicePowerNameNano, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailContainerService_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_container_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailContainerServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailContainerServiceConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSLightsailContainerServiceConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSLightsailContainerServiceConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailContainerServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailContainerServiceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_container_service" {
+			continue
+		}
+
+		containerService, err := finder.ContainerServiceByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if containerService != nil {
+			return fmt.Errorf("Lightsail Container Service (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLightsailContainerServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Container Service ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		containerService, err := finder.ContainerServiceByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if containerService == nil {
+			return fmt.Errorf("Lightsail Container Service (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSLightsailContainerServiceConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name  = %q
+  power = "nano"
+  scale = 1
+}
+`, rName)
+}
+
+func testAccAWSLightsailContainerServiceConfigIsDisabled(rName string, isDisabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name        = %q
+  power       = "nano"
+  scale       = 1
+  is_disabled = %t
+}
+`, rName, isDisabled)
+}
+
+func testAccAWSLightsailContainerServiceConfigPowerScale(rName, power string, scale int) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name  = %q
+  power = %q
+  scale = %d
+}
+`, rName, power, scale)
+}
+
+func testAccAWSLightsailContainerServiceConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name  = %[1]q
+  power = "nano"
+  scale = 1
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSLightsailContainerServiceConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name  = %[1]q
+  power = "nano"
+  scale = 1
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
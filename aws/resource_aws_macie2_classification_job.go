@@ -0,0 +1,825 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func resourceAwsMacie2ClassificationJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2ClassificationJobCreate,
+		Read:   resourceAwsMacie2ClassificationJobRead,
+		Update: resourceAwsMacie2ClassificationJobUpdate,
+		Delete: resourceAwsMacie2ClassificationJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_data_identifier_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"initial_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					macie2.JobStatusCancelled,
+					macie2.JobStatusRunning,
+					macie2.JobStatusUserPaused,
+				}, false),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Scheduled jobs are IDLE between runs and one-time jobs
+					// become COMPLETE, neither of which can be requested.
+					return new == macie2.JobStatusRunning && (old == macie2.JobStatusIdle || old == macie2.JobStatusComplete)
+				},
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(macie2.JobType_Values(), false),
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validation.StringLenBetween(0, 500),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validation.StringLenBetween(0, 500-resource.UniqueIDSuffixLength),
+			},
+			"s3_job_definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_definitions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+									"buckets": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"scoping": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"excludes": resourceAwsMacie2ClassificationJobScopingBlockSchema(),
+									"includes": resourceAwsMacie2ClassificationJobScopingBlockSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sampling_percentage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"schedule_frequency": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_schedule": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"schedule_frequency.0.weekly_schedule", "schedule_frequency.0.monthly_schedule"},
+						},
+						"monthly_schedule": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							Computed:      true,
+							ForceNew:      true,
+							ValidateFunc:  validation.IntBetween(1, 31),
+							ConflictsWith: []string{"schedule_frequency.0.daily_schedule", "schedule_frequency.0.weekly_schedule"},
+						},
+						"weekly_schedule": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ForceNew:      true,
+							ValidateFunc:  validation.StringInSlice(macie2.DayOfWeek_Values(), false),
+							ConflictsWith: []string{"schedule_frequency.0.daily_schedule", "schedule_frequency.0.monthly_schedule"},
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+			"user_paused_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_imminent_expiration_health_event_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_paused_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceAwsMacie2ClassificationJobScopingBlockSchema returns the schema of
+// the excludes and includes blocks of a classification job's scoping.
+func resourceAwsMacie2ClassificationJobScopingBlockSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"and": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"simple_scope_term": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"comparator": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											Computed:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.JobComparator_Values(), false),
+										},
+										"key": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.ScopeFilterKey_Values(), false),
+										},
+										"values": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+							"tag_scope_term": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"comparator": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											Computed:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.JobComparator_Values(), false),
+										},
+										"key": {
+											Type:     schema.TypeString,
+											Optional: true,
+											ForceNew: true,
+										},
+										"tag_values": {
+											Type:     schema.TypeList,
+											Optional: true,
+											ForceNew: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"key": {
+														Type:     schema.TypeString,
+														Optional: true,
+														ForceNew: true,
+													},
+													"value": {
+														Type:     schema.TypeString,
+														Optional: true,
+														ForceNew: true,
+													},
+												},
+											},
+										},
+										"target": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											Computed:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.TagTarget_Values(), false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMacie2ClassificationJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &macie2.CreateClassificationJobInput{
+		ClientToken:     aws.String(resource.UniqueId()),
+		JobType:         aws.String(d.Get("job_type").(string)),
+		Name:            aws.String(name),
+		S3JobDefinition: expandMacie2S3JobDefinition(d.Get("s3_job_definition").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("custom_data_identifier_ids"); ok && len(v.([]interface{})) > 0 {
+		input.CustomDataIdentifierIds = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("initial_run"); ok {
+		input.InitialRun = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("sampling_percentage"); ok {
+		input.SamplingPercentage = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("schedule_frequency"); ok && len(v.([]interface{})) > 0 {
+		input.ScheduleFrequency = expandMacie2ScheduleFrequency(v.([]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().Macie2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Macie2 Classification Job: %s", input)
+	output, err := conn.CreateClassificationJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Macie2 Classification Job (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+
+	if v, ok := d.GetOk("job_status"); ok && v.(string) != macie2.JobStatusRunning {
+		if err := macie2ClassificationJobUpdateStatus(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMacie2ClassificationJobRead(d, meta)
+}
+
+func resourceAwsMacie2ClassificationJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.ClassificationJobByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Macie2 Classification Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie2 Classification Job (%s): %w", d.Id(), err)
+	}
+
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("custom_data_identifier_ids", aws.StringValueSlice(output.CustomDataIdentifierIds))
+	d.Set("description", output.Description)
+	d.Set("initial_run", output.InitialRun)
+	d.Set("job_arn", output.JobArn)
+	d.Set("job_id", output.JobId)
+	d.Set("job_status", output.JobStatus)
+	d.Set("job_type", output.JobType)
+	d.Set("name", output.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(output.Name)))
+
+	if err := d.Set("s3_job_definition", flattenMacie2S3JobDefinition(output.S3JobDefinition)); err != nil {
+		return fmt.Errorf("error setting s3_job_definition: %w", err)
+	}
+
+	d.Set("sampling_percentage", output.SamplingPercentage)
+
+	if err := d.Set("schedule_frequency", flattenMacie2ScheduleFrequency(output.ScheduleFrequency)); err != nil {
+		return fmt.Errorf("error setting schedule_frequency: %w", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.Macie2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("user_paused_details", flattenMacie2UserPausedDetails(output.UserPausedDetails)); err != nil {
+		return fmt.Errorf("error setting user_paused_details: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2ClassificationJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if d.HasChange("job_status") {
+		if err := macie2ClassificationJobUpdateStatus(conn, d.Id(), d.Get("job_status").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("job_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Macie2 Classification Job (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMacie2ClassificationJobRead(d, meta)
+}
+
+func resourceAwsMacie2ClassificationJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	// Classification jobs cannot be deleted, only cancelled.
+	output, err := finder.ClassificationJobByID(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie2 Classification Job (%s): %w", d.Id(), err)
+	}
+
+	switch status := aws.StringValue(output.JobStatus); status {
+	case macie2.JobStatusCancelled, macie2.JobStatusComplete:
+		log.Printf("[WARN] Macie2 Classification Job (%s) is %s, removing from state only", d.Id(), status)
+		return nil
+	}
+
+	log.Printf("[WARN] Macie2 Classification Job (%s) cannot be deleted, cancelling it and removing from state", d.Id())
+	err = macie2ClassificationJobUpdateStatus(conn, d.Id(), macie2.JobStatusCancelled)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+func macie2ClassificationJobUpdateStatus(conn *macie2.Macie2, id, status string) error {
+	input := &macie2.UpdateClassificationJobInput{
+		JobId:     aws.String(id),
+		JobStatus: aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Macie2 Classification Job status: %s", input)
+	if _, err := conn.UpdateClassificationJob(input); err != nil {
+		return fmt.Errorf("error updating Macie2 Classification Job (%s) status to %s: %w", id, status, err)
+	}
+
+	return nil
+}
+
+func expandMacie2S3JobDefinition(tfList []interface{}) *macie2.S3JobDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &macie2.S3JobDefinition{}
+
+	if v, ok := tfMap["bucket_definitions"].([]interface{}); ok && len(v) > 0 {
+		apiObject.BucketDefinitions = expandMacie2BucketDefinitions(v)
+	}
+
+	if v, ok := tfMap["scoping"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Scoping = expandMacie2Scoping(v)
+	}
+
+	return apiObject
+}
+
+func expandMacie2BucketDefinitions(tfList []interface{}) []*macie2.S3BucketDefinitionForJob {
+	var apiObjects []*macie2.S3BucketDefinitionForJob
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &macie2.S3BucketDefinitionForJob{
+			AccountId: aws.String(tfMap["account_id"].(string)),
+			Buckets:   expandStringList(tfMap["buckets"].([]interface{})),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandMacie2Scoping(tfList []interface{}) *macie2.Scoping {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &macie2.Scoping{}
+
+	if v, ok := tfMap["excludes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Excludes = expandMacie2JobScopingBlock(v)
+	}
+
+	if v, ok := tfMap["includes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Includes = expandMacie2JobScopingBlock(v)
+	}
+
+	return apiObject
+}
+
+func expandMacie2JobScopingBlock(tfList []interface{}) *macie2.JobScopingBlock {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &macie2.JobScopingBlock{}
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 {
+		apiObject.And = expandMacie2JobScopeTerms(v)
+	}
+
+	return apiObject
+}
+
+func expandMacie2JobScopeTerms(tfList []interface{}) []*macie2.JobScopeTerm {
+	var apiObjects []*macie2.JobScopeTerm
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &macie2.JobScopeTerm{}
+
+		if v, ok := tfMap["simple_scope_term"].([]interface{}); ok && len(v) > 0 {
+			apiObject.SimpleScopeTerm = expandMacie2SimpleScopeTerm(v)
+		}
+
+		if v, ok := tfMap["tag_scope_term"].([]interface{}); ok && len(v) > 0 {
+			apiObject.TagScopeTerm = expandMacie2TagScopeTerm(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMacie2SimpleScopeTerm(tfList []interface{}) *macie2.SimpleScopeTerm {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &macie2.SimpleScopeTerm{}
+
+	if v, ok := tfMap["comparator"].(string); ok && v != "" {
+		apiObject.Comparator = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["values"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Values = expandStringList(v)
+	}
+
+	return apiObject
+}
+
+func expandMacie2TagScopeTerm(tfList []interface{}) *macie2.TagScopeTerm {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &macie2.TagScopeTerm{}
+
+	if v, ok := tfMap["comparator"].(string); ok && v != "" {
+		apiObject.Comparator = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["tag_values"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TagValues = expandMacie2TagValuePairs(v)
+	}
+
+	if v, ok := tfMap["target"].(string); ok && v != "" {
+		apiObject.Target = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMacie2TagValuePairs(tfList []interface{}) []*macie2.TagValuePair {
+	var apiObjects []*macie2.TagValuePair
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &macie2.TagValuePair{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandMacie2ScheduleFrequency(tfList []interface{}) *macie2.JobScheduleFrequency {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &macie2.JobScheduleFrequency{}
+
+	if v, ok := tfMap["daily_schedule"].(bool); ok && v {
+		apiObject.DailySchedule = &macie2.DailySchedule{}
+	}
+
+	if v, ok := tfMap["monthly_schedule"].(int); ok && v > 0 {
+		apiObject.MonthlySchedule = &macie2.MonthlySchedule{
+			DayOfMonth: aws.Int64(int64(v)),
+		}
+	}
+
+	if v, ok := tfMap["weekly_schedule"].(string); ok && v != "" {
+		apiObject.WeeklySchedule = &macie2.WeeklySchedule{
+			DayOfWeek: aws.String(v),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenMacie2S3JobDefinition(apiObject *macie2.S3JobDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_definitions": flattenMacie2BucketDefinitions(apiObject.BucketDefinitions),
+		"scoping":            flattenMacie2Scoping(apiObject.Scoping),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMacie2BucketDefinitions(apiObjects []*macie2.S3BucketDefinitionForJob) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"account_id": aws.StringValue(apiObject.AccountId),
+			"buckets":    aws.StringValueSlice(apiObject.Buckets),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMacie2Scoping(apiObject *macie2.Scoping) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"excludes": flattenMacie2JobScopingBlock(apiObject.Excludes),
+		"includes": flattenMacie2JobScopingBlock(apiObject.Includes),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMacie2JobScopingBlock(apiObject *macie2.JobScopingBlock) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"and": flattenMacie2JobScopeTerms(apiObject.And),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMacie2JobScopeTerms(apiObjects []*macie2.JobScopeTerm) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"simple_scope_term": flattenMacie2SimpleScopeTerm(apiObject.SimpleScopeTerm),
+			"tag_scope_term":    flattenMacie2TagScopeTerm(apiObject.TagScopeTerm),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMacie2SimpleScopeTerm(apiObject *macie2.SimpleScopeTerm) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"comparator": aws.StringValue(apiObject.Comparator),
+		"key":        aws.StringValue(apiObject.Key),
+		"values":     aws.StringValueSlice(apiObject.Values),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMacie2TagScopeTerm(apiObject *macie2.TagScopeTerm) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"comparator": aws.StringValue(apiObject.Comparator),
+		"key":        aws.StringValue(apiObject.Key),
+		"tag_values": flattenMacie2TagValuePairs(apiObject.TagValues),
+		"target":     aws.StringValue(apiObject.Target),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMacie2TagValuePairs(apiObjects []*macie2.TagValuePair) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":   aws.StringValue(apiObject.Key),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMacie2ScheduleFrequency(apiObject *macie2.JobScheduleFrequency) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.DailySchedule != nil {
+		tfMap["daily_schedule"] = true
+	}
+
+	if apiObject.MonthlySchedule != nil {
+		tfMap["monthly_schedule"] = int(aws.Int64Value(apiObject.MonthlySchedule.DayOfMonth))
+	}
+
+	if apiObject.WeeklySchedule != nil {
+		tfMap["weekly_schedule"] = aws.StringValue(apiObject.WeeklySchedule.DayOfWeek)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMacie2UserPausedDetails(apiObject *macie2.UserPausedDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"job_imminent_expiration_health_event_arn": aws.StringValue(apiObject.JobImminentExpirationHealthEventArn),
+	}
+
+	if v := apiObject.JobExpiresAt; v != nil {
+		tfMap["job_expires_at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.JobPausedAt; v != nil {
+		tfMap["job_paused_at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,591 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfmacie2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/macie2/finder"
+)
+
+func TestExpandMacie2Scoping(t *testing.T) {
+	testCases := map[string]struct {
+		Input    []interface{}
+		Expected *macie2.Scoping
+	}{
+		"nil input": {
+			Input:    nil,
+			Expected: nil,
+		},
+		"empty input": {
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		"nil array": {
+			Input:    []interface{}{nil},
+			Expected: nil,
+		},
+		"empty scoping": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"excludes": []interface{}{},
+					"includes": []interface{}{},
+				},
+			},
+			Expected: &macie2.Scoping{},
+		},
+		"simple scope term excludes": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"excludes": []interface{}{
+						map[string]interface{}{
+							"and": []interface{}{
+								map[string]interface{}{
+									"simple_scope_term": []interface{}{
+										map[string]interface{}{
+											"comparator": macie2.JobComparatorEq,
+											"key":        macie2.ScopeFilterKeyObjectExtension,
+											"values":     []interface{}{"test"},
+										},
+									},
+									"tag_scope_term": []interface{}{},
+								},
+							},
+						},
+					},
+					"includes": []interface{}{},
+				},
+			},
+			Expected: &macie2.Scoping{
+				Excludes: &macie2.JobScopingBlock{
+					And: []*macie2.JobScopeTerm{
+						{
+							SimpleScopeTerm: &macie2.SimpleScopeTerm{
+								Comparator: aws.String(macie2.JobComparatorEq),
+								Key:        aws.String(macie2.ScopeFilterKeyObjectExtension),
+								Values:     aws.StringSlice([]string{"test"}),
+							},
+						},
+					},
+				},
+			},
+		},
+		"tag scope term includes": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"excludes": []interface{}{},
+					"includes": []interface{}{
+						map[string]interface{}{
+							"and": []interface{}{
+								map[string]interface{}{
+									"simple_scope_term": []interface{}{},
+									"tag_scope_term": []interface{}{
+										map[string]interface{}{
+											"comparator": macie2.JobComparatorEq,
+											"key":        "TAG",
+											"tag_values": []interface{}{
+												map[string]interface{}{
+													"key":   "Name",
+													"value": "test",
+												},
+											},
+											"target": macie2.TagTargetS3Object,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: &macie2.Scoping{
+				Includes: &macie2.JobScopingBlock{
+					And: []*macie2.JobScopeTerm{
+						{
+							TagScopeTerm: &macie2.TagScopeTerm{
+								Comparator: aws.String(macie2.JobComparatorEq),
+								Key:        aws.String("TAG"),
+								TagValues: []*macie2.TagValuePair{
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test"),
+									},
+								},
+								Target: aws.String(macie2.TagTargetS3Object),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for k, tc := range testCases {
+		value := expandMacie2Scoping(tc.Input)
+
+		// Convert to strings to avoid dealing with pointers
+		valueS := fmt.Sprintf("%v", value)
+		expectedValueS := fmt.Sprintf("%v", tc.Expected)
+
+		if valueS != expectedValueS {
+			t.Errorf("Case %q: Given:\n%s\n\nExpected:\n%s", k, valueS, expectedValueS)
+		}
+	}
+}
+
+func TestFlattenMacie2Scoping(t *testing.T) {
+	testCases := map[string]struct {
+		Input    *macie2.Scoping
+		Expected []interface{}
+	}{
+		"nil input": {
+			Input:    nil,
+			Expected: nil,
+		},
+		"empty scoping": {
+			Input: &macie2.Scoping{},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"excludes": []interface{}(nil),
+					"includes": []interface{}(nil),
+				},
+			},
+		},
+		"simple and tag scope terms": {
+			Input: &macie2.Scoping{
+				Excludes: &macie2.JobScopingBlock{
+					And: []*macie2.JobScopeTerm{
+						{
+							SimpleScopeTerm: &macie2.SimpleScopeTerm{
+								Comparator: aws.String(macie2.JobComparatorStartsWith),
+								Key:        aws.String(macie2.ScopeFilterKeyObjectKey),
+								Values:     aws.StringSlice([]string{"logs/"}),
+							},
+						},
+					},
+				},
+				Includes: &macie2.JobScopingBlock{
+					And: []*macie2.JobScopeTerm{
+						{
+							TagScopeTerm: &macie2.TagScopeTerm{
+								Comparator: aws.String(macie2.JobComparatorEq),
+								Key:        aws.String("TAG"),
+								TagValues: []*macie2.TagValuePair{
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test"),
+									},
+								},
+								Target: aws.String(macie2.TagTargetS3Object),
+							},
+						},
+					},
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"excludes": []interface{}{
+						map[string]interface{}{
+							"and": []interface{}{
+								map[string]interface{}{
+									"simple_scope_term": []interface{}{
+										map[string]interface{}{
+											"comparator": macie2.JobComparatorStartsWith,
+											"key":        macie2.ScopeFilterKeyObjectKey,
+											"values":     []string{"logs/"},
+										},
+									},
+									"tag_scope_term": []interface{}(nil),
+								},
+							},
+						},
+					},
+					"includes": []interface{}{
+						map[string]interface{}{
+							"and": []interface{}{
+								map[string]interface{}{
+									"simple_scope_term": []interface{}(nil),
+									"tag_scope_term": []interface{}{
+										map[string]interface{}{
+											"comparator": macie2.JobComparatorEq,
+											"key":        "TAG",
+											"tag_values": []interface{}{
+												map[string]interface{}{
+													"key":   "Name",
+													"value": "test",
+												},
+											},
+											"target": macie2.TagTargetS3Object,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for k, tc := range testCases {
+		value := flattenMacie2Scoping(tc.Input)
+
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Errorf("Case %q: Given:\n%#v\n\nExpected:\n%#v", k, value, tc.Expected)
+		}
+	}
+}
+
+func testAccAWSMacie2ClassificationJob_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_classification_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2ClassificationJobConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "job_arn", "macie2", regexp.MustCompile(`classification-job/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeScheduled),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_definitions.#", "1"),
+					testAccCheckResourceAttrAccountID(resourceName, "s3_job_definition.0.bucket_definitions.0.account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_job_definition.0.bucket_definitions.0.buckets.0", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "sampling_percentage", "100"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.daily_schedule", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSMacie2ClassificationJob_JobStatus(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_classification_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigJobStatus(rName, macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigJobStatus(rName, macie2.JobStatusUserPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusUserPaused),
+					resource.TestCheckResourceAttr(resourceName, "user_paused_details.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigJobStatus(rName, macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSMacie2ClassificationJob_Scoping(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_classification_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigScoping(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.comparator", macie2.JobComparatorEq),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.key", macie2.ScopeFilterKeyObjectExtension),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.includes.0.and.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.includes.0.and.0.tag_scope_term.0.comparator", macie2.JobComparatorEq),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.includes.0.and.0.tag_scope_term.0.key", "TAG"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.includes.0.and.0.tag_scope_term.0.tag_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.includes.0.and.0.tag_scope_term.0.target", macie2.TagTargetS3Object),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSMacie2ClassificationJob_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_classification_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMacie2ClassificationJobConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2ClassificationJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_classification_job" {
+			continue
+		}
+
+		output, err := finder.ClassificationJobByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, tfmacie2.ErrMessageMacieNotEnabled) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Classification jobs are cancelled rather than deleted.
+		if status := aws.StringValue(output.JobStatus); status != macie2.JobStatusCancelled && status != macie2.JobStatusComplete {
+			return fmt.Errorf("Macie2 Classification Job (%s) still %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMacie2ClassificationJobExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		_, err := finder.ClassificationJobByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAWSMacie2ClassificationJobConfigBase(rName string) string {
+	return composeConfig(testAccAWSMacie2AccountConfig, fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName))
+}
+
+func testAccAWSMacie2ClassificationJobConfig(rName string) string {
+	return composeConfig(testAccAWSMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = "SCHEDULED"
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  schedule_frequency {
+    daily_schedule = true
+  }
+
+  sampling_percentage = 100
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName))
+}
+
+func testAccAWSMacie2ClassificationJobConfigJobStatus(rName, jobStatus string) string {
+	return composeConfig(testAccAWSMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name       = %[1]q
+  job_type   = "SCHEDULED"
+  job_status = %[2]q
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  schedule_frequency {
+    weekly_schedule = "MONDAY"
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, jobStatus))
+}
+
+func testAccAWSMacie2ClassificationJobConfigScoping(rName string) string {
+	return composeConfig(testAccAWSMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = "ONE_TIME"
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+
+    scoping {
+      excludes {
+        and {
+          simple_scope_term {
+            comparator = "EQ"
+            key        = "OBJECT_EXTENSION"
+            values     = ["test", "log"]
+          }
+        }
+      }
+
+      includes {
+        and {
+          tag_scope_term {
+            comparator = "EQ"
+            key        = "TAG"
+            target     = "S3_OBJECT"
+
+            tag_values {
+              key   = "Name"
+              value = "test"
+            }
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName))
+}
+
+func testAccAWSMacie2ClassificationJobConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = "ONE_TIME"
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSMacie2ClassificationJobConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSMacie2ClassificationJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = "ONE_TIME"
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"testing"
+)
+
+// Macie can only be enabled once per account, so all Macie2 tests share the
+// account and must run serially.
+func TestAccAWSMacie2_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Account": {
+			"basic":                      testAccAWSMacie2Account_basic,
+			"disappears":                 testAccAWSMacie2Account_disappears,
+			"FindingPublishingFrequency": testAccAWSMacie2Account_FindingPublishingFrequency,
+			"Status":                     testAccAWSMacie2Account_Status,
+		},
+		"ClassificationJob": {
+			"basic":     testAccAWSMacie2ClassificationJob_basic,
+			"JobStatus": testAccAWSMacie2ClassificationJob_JobStatus,
+			"Scoping":   testAccAWSMacie2ClassificationJob_Scoping,
+			"Tags":      testAccAWSMacie2ClassificationJob_Tags,
+		},
+		"CustomDataIdentifier": {
+			"basic":      testAccAWSMacie2CustomDataIdentifier_basic,
+			"disappears": testAccAWSMacie2CustomDataIdentifier_disappears,
+			"NamePrefix": testAccAWSMacie2CustomDataIdentifier_NamePrefix,
+			"Tags":       testAccAWSMacie2CustomDataIdentifier_Tags,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMemoryDbACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMemoryDbACLCreate,
+		Read:   resourceAwsMemoryDbACLRead,
+		Update: resourceAwsMemoryDbACLUpdate,
+		Delete: resourceAwsMemoryDbACLDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minimum_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDbName,
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDbNamePrefix,
+			},
+			"tags": tagsSchema(),
+			"user_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMemoryDbACLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateACLInput{
+		ACLName: aws.String(name),
+		Tags:    keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().MemorydbTags(),
+	}
+
+	if v, ok := d.GetOk("user_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.UserNames = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB ACL: %s", input)
+	_, err := conn.CreateACL(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB ACL (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.ACLActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB ACL (%s) to be created: %w", d.Id(), err)
+	}
+
+	return resourceAwsMemoryDbACLRead(d, meta)
+}
+
+func resourceAwsMemoryDbACLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	acl, err := finder.ACLByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB ACL (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", acl.ARN)
+	d.Set("minimum_engine_version", acl.MinimumEngineVersion)
+	d.Set("name", acl.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(acl.Name)))
+	d.Set("user_names", flattenStringSet(acl.UserNames))
+
+	tags, err := keyvaluetags.MemorydbListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB ACL (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDbACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChange("user_names") {
+		o, n := d.GetChange("user_names")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		input := &memorydb.UpdateACLInput{
+			ACLName: aws.String(d.Id()),
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.UserNamesToAdd = expandStringSet(add)
+		}
+
+		if remove := os.Difference(ns); remove.Len() > 0 {
+			input.UserNamesToRemove = expandStringSet(remove)
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB ACL: %s", input)
+		_, err := conn.UpdateACL(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MemoryDB ACL (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.ACLActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MemoryDB ACL (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.MemorydbUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB ACL (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDbACLRead(d, meta)
+}
+
+func resourceAwsMemoryDbACLDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	log.Printf("[DEBUG] Deleting MemoryDB ACL: (%s)", d.Id())
+	_, err := conn.DeleteACL(&memorydb.DeleteACLInput{
+		ACLName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeACLNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB ACL (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ACLDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB ACL (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSMemoryDbACL_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	user1 := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(memorydb.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbACLConfig(rName, []string{user1}, []string{user1}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbACLExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "memorydb", "acl/"+rName),
+					resource.TestCheckResourceAttrSet(resourceName, "minimum_engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_names.*", "aws_memorydb_user.test.0", "user_name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDbACL_disappears(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(memorydb.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbACLConfig(rName, nil, nil),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbACLExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMemoryDbACL(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDbACL_update_userNames(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	user1 := "tf-test-" + acctest.RandString(8)
+	user2 := "tf-test-" + acctest.RandString(8)
+	user3 := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(memorydb.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbACLConfig(rName, []string{user1, user2, user3}, []string{user1, user2}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user2),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDbACLConfig(rName, []string{user1, user2, user3}, []string{user2, user3}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user3),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMemoryDbACLConfig(rName, []string{user1, user2, user3}, []string{}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDbACL_tags(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(memorydb.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbACLConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMemoryDbACLConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDbACLConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMemoryDbACLDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_acl" {
+			continue
+		}
+
+		_, err := finder.ACLByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MemoryDB ACL %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMemoryDbACLExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB ACL ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+		_, err := finder.ACLByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAWSMemoryDbACLConfigUsers(userNames []string) string {
+	var userNamesHCL []string
+	for _, userName := range userNames {
+		userNamesHCL = append(userNamesHCL, fmt.Sprintf("%q", userName))
+	}
+
+	return fmt.Sprintf(`
+resource "aws_memorydb_user" "test" {
+  count         = length(local.user_names)
+  access_string = "on ~* &* +@all"
+  user_name     = local.user_names[count.index]
+
+  authentication_mode {
+    type      = "password"
+    passwords = ["aaaaaaaaaaaaaaaa"]
+  }
+}
+
+locals {
+  user_names = [%[1]s]
+}
+`, strings.Join(userNamesHCL, ", "))
+}
+
+func testAccAWSMemoryDbACLConfig(rName string, userNames []string, aclUserNames []string) string {
+	var aclUserNamesHCL []string
+	for _, userName := range aclUserNames {
+		aclUserNamesHCL = append(aclUserNamesHCL, fmt.Sprintf("%q", userName))
+	}
+
+	return composeConfig(testAccAWSMemoryDbACLConfigUsers(userNames), fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  depends_on = [aws_memorydb_user.test]
+
+  name       = %[1]q
+  user_names = [%[2]s]
+}
+`, rName, strings.Join(aclUserNamesHCL, ", ")))
+}
+
+func testAccAWSMemoryDbACLConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSMemoryDbACLConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,615 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	// MemoryDB does not publish these SNS topic status values as enums.
+	memoryDbClusterSnsTopicStatusActive   = "ACTIVE"
+	memoryDbClusterSnsTopicStatusInactive = "INACTIVE"
+)
+
+func resourceAwsMemoryDbCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMemoryDbClusterCreate,
+		Read:   resourceAwsMemoryDbClusterRead,
+		Update: resourceAwsMemoryDbClusterUpdate,
+		Delete: resourceAwsMemoryDbClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateMemoryDbName,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_minor_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"cluster_endpoint": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     memoryDbClusterEndpointSchema(),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Managed by Terraform",
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"engine_patch_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"final_snapshot_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"maintenance_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateOnceAWeekWindowFormat,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDbName,
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDbNamePrefix,
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"num_replicas_per_shard": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 5),
+			},
+			"num_shards": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"parameter_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateMemoryDbName,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"shards": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nodes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"create_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"endpoint": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     memoryDbClusterEndpointSchema(),
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"num_nodes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"slots": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"snapshot_arns": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"snapshot_name"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"snapshot_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_arns"},
+			},
+			"snapshot_retention_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 35),
+			},
+			"snapshot_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateOnceADayWindowFormat,
+			},
+			"sns_topic_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"subnet_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateMemoryDbName,
+			},
+			"tags": tagsSchema(),
+			"tls_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func memoryDbClusterEndpointSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMemoryDbClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateClusterInput{
+		ACLName:                 aws.String(d.Get("acl_name").(string)),
+		AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+		ClusterName:             aws.String(name),
+		Description:             aws.String(d.Get("description").(string)),
+		NodeType:                aws.String(d.Get("node_type").(string)),
+		NumReplicasPerShard:     aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
+		NumShards:               aws.Int64(int64(d.Get("num_shards").(int))),
+		Tags:                    keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().MemorydbTags(),
+		TLSEnabled:              aws.Bool(d.Get("tls_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		input.MaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameter_group_name"); ok {
+		input.ParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		input.Port = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("snapshot_arns"); ok && len(v.([]interface{})) > 0 {
+		input.SnapshotArns = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("snapshot_name"); ok {
+		input.SnapshotName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_retention_limit"); ok {
+		input.SnapshotRetentionLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("snapshot_window"); ok {
+		input.SnapshotWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sns_topic_arn"); ok {
+		input.SnsTopicArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subnet_group_name"); ok {
+		input.SubnetGroupName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Cluster: %s", input)
+	_, err := conn.CreateCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB Cluster (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.ClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB Cluster (%s) to be created: %w", d.Id(), err)
+	}
+
+	return resourceAwsMemoryDbClusterRead(d, meta)
+}
+
+func resourceAwsMemoryDbClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	cluster, err := finder.ClusterByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Cluster (%s): %w", d.Id(), err)
+	}
+
+	d.Set("acl_name", cluster.ACLName)
+	d.Set("arn", cluster.ARN)
+	d.Set("auto_minor_version_upgrade", cluster.AutoMinorVersionUpgrade)
+
+	if v := cluster.ClusterEndpoint; v != nil {
+		if err := d.Set("cluster_endpoint", []interface{}{flattenMemoryDbEndpoint(v)}); err != nil {
+			return fmt.Errorf("error setting cluster_endpoint: %w", err)
+		}
+	} else {
+		d.Set("cluster_endpoint", nil)
+	}
+
+	d.Set("description", cluster.Description)
+	d.Set("engine_patch_version", cluster.EnginePatchVersion)
+	d.Set("engine_version", cluster.EngineVersion)
+	d.Set("kms_key_arn", cluster.KmsKeyId)
+	d.Set("maintenance_window", cluster.MaintenanceWindow)
+	d.Set("name", cluster.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(cluster.Name)))
+	d.Set("node_type", cluster.NodeType)
+
+	numReplicasPerShard, err := memoryDbClusterNumReplicasPerShard(cluster)
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Cluster (%s): %w", d.Id(), err)
+	}
+
+	d.Set("num_replicas_per_shard", numReplicasPerShard)
+	d.Set("num_shards", cluster.NumberOfShards)
+	d.Set("parameter_group_name", cluster.ParameterGroupName)
+
+	if v := cluster.ClusterEndpoint; v != nil {
+		d.Set("port", v.Port)
+	}
+
+	var securityGroupIds []*string
+	for _, securityGroup := range cluster.SecurityGroups {
+		securityGroupIds = append(securityGroupIds, securityGroup.SecurityGroupId)
+	}
+	d.Set("security_group_ids", flattenStringSet(securityGroupIds))
+
+	if err := d.Set("shards", flattenMemoryDbShards(cluster.Shards)); err != nil {
+		return fmt.Errorf("error setting shards: %w", err)
+	}
+
+	d.Set("snapshot_retention_limit", cluster.SnapshotRetentionLimit)
+	d.Set("snapshot_window", cluster.SnapshotWindow)
+
+	if aws.StringValue(cluster.SnsTopicStatus) == memoryDbClusterSnsTopicStatusActive {
+		d.Set("sns_topic_arn", cluster.SnsTopicArn)
+	} else {
+		d.Set("sns_topic_arn", "")
+	}
+
+	d.Set("subnet_group_name", cluster.SubnetGroupName)
+	d.Set("tls_enabled", cluster.TLSEnabled)
+
+	tags, err := keyvaluetags.MemorydbListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Cluster (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDbClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChangesExcept("final_snapshot_name", "tags") {
+		input := &memorydb.UpdateClusterInput{
+			ClusterName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("acl_name") {
+			input.ACLName = aws.String(d.Get("acl_name").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("engine_version") {
+			input.EngineVersion = aws.String(d.Get("engine_version").(string))
+		}
+
+		if d.HasChange("maintenance_window") {
+			input.MaintenanceWindow = aws.String(d.Get("maintenance_window").(string))
+		}
+
+		if d.HasChange("node_type") {
+			input.NodeType = aws.String(d.Get("node_type").(string))
+		}
+
+		if d.HasChange("num_replicas_per_shard") {
+			input.ReplicaConfiguration = &memorydb.ReplicaConfigurationRequest{
+				ReplicaCount: aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
+			}
+		}
+
+		if d.HasChange("num_shards") {
+			input.ShardConfiguration = &memorydb.ShardConfigurationRequest{
+				ShardCount: aws.Int64(int64(d.Get("num_shards").(int))),
+			}
+		}
+
+		if d.HasChange("parameter_group_name") {
+			input.ParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+		}
+
+		if d.HasChange("security_group_ids") {
+			// This should really be a PUT-style operation; an empty list
+			// is not accepted, so only send it when there are values.
+			if v := d.Get("security_group_ids").(*schema.Set); v.Len() > 0 {
+				input.SecurityGroupIds = expandStringSet(v)
+			}
+		}
+
+		if d.HasChange("snapshot_retention_limit") {
+			input.SnapshotRetentionLimit = aws.Int64(int64(d.Get("snapshot_retention_limit").(int)))
+		}
+
+		if d.HasChange("snapshot_window") {
+			input.SnapshotWindow = aws.String(d.Get("snapshot_window").(string))
+		}
+
+		if d.HasChange("sns_topic_arn") {
+			v := d.Get("sns_topic_arn").(string)
+
+			input.SnsTopicArn = aws.String(v)
+
+			if v == "" {
+				input.SnsTopicStatus = aws.String(memoryDbClusterSnsTopicStatusInactive)
+			} else {
+				input.SnsTopicStatus = aws.String(memoryDbClusterSnsTopicStatusActive)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB Cluster: %s", input)
+		_, err := conn.UpdateCluster(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MemoryDB Cluster (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.ClusterAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for MemoryDB Cluster (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.MemorydbUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB Cluster (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDbClusterRead(d, meta)
+}
+
+func resourceAwsMemoryDbClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	input := &memorydb.DeleteClusterInput{
+		ClusterName: aws.String(d.Id()),
+	}
+
+	if v := d.Get("final_snapshot_name"); v != nil && len(v.(string)) > 0 {
+		input.FinalSnapshotName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting MemoryDB Cluster: (%s)", d.Id())
+	_, err := conn.DeleteCluster(input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Cluster (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ClusterDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB Cluster (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// memoryDbClusterNumReplicasPerShard derives the number of replicas per shard
+// from the shard details, as the API does not report it directly.
+func memoryDbClusterNumReplicasPerShard(cluster *memorydb.Cluster) (int64, error) {
+	var numReplicasPerShard int64
+
+	for i, shard := range cluster.Shards {
+		n := aws.Int64Value(shard.NumberOfNodes) - 1
+
+		if i > 0 && n != numReplicasPerShard {
+			return 0, fmt.Errorf("shards have different numbers of replicas (%d and %d)", numReplicasPerShard, n)
+		}
+
+		numReplicasPerShard = n
+	}
+
+	return numReplicasPerShard, nil
+}
+
+func flattenMemoryDbEndpoint(apiObject *memorydb.Endpoint) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"address": aws.StringValue(apiObject.Address),
+		"port":    aws.Int64Value(apiObject.Port),
+	}
+}
+
+func flattenMemoryDbShards(apiObjects []*memorydb.Shard) []interface{} {
+	var tfList []interface{}
+
+	for _, shard := range apiObjects {
+		if shard == nil {
+			continue
+		}
+
+		var nodes []interface{}
+		for _, node := range shard.Nodes {
+			if node == nil {
+				continue
+			}
+
+			tfMap := map[string]interface{}{
+				"availability_zone": aws.StringValue(node.AvailabilityZone),
+				"name":              aws.StringValue(node.Name),
+			}
+
+			if v := node.CreateTime; v != nil {
+				tfMap["create_time"] = aws.TimeValue(v).Format(time.RFC3339)
+			}
+
+			if v := node.Endpoint; v != nil {
+				tfMap["endpoint"] = []interface{}{flattenMemoryDbEndpoint(v)}
+			}
+
+			nodes = append(nodes, tfMap)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":      aws.StringValue(shard.Name),
+			"nodes":     nodes,
+			"num_nodes": aws.Int64Value(shard.NumberOfNodes),
+			"slots":     aws.StringValue(shard.Slots),
+		})
+	}
+
+	return tfList
+}
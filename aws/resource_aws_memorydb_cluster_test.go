@@ -0,0 +1,290 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSMemoryDbCluster_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(memorydb.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "acl_name", "open-access"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "memorydb", "cluster/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_endpoint.0.address"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint.0.port", "6379"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_patch_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "final_snapshot_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_arn", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "maintenance_window"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "node_type", "db.t4g.small"),
+					resource.TestCheckResourceAttr(resourceName, "num_replicas_per_shard", "1"),
+					resource.TestCheckResourceAttr(resourceName, "num_shards", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameter_group_name"),
+					resource.TestCheckResourceAttr(resourceName, "port", "6379"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_ids.*", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "shards.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shards.0.num_nodes", "2"),
+					resource.TestCheckResourceAttr(resourceName, "shards.0.nodes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_limit", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_window"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_group_name", "aws_memorydb_subnet_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tls_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDbCluster_disappears(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(memorydb.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbClusterExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMemoryDbCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDbCluster_update(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(memorydb.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbClusterConfigUpdate(rName, "Description 1", "sun:05:00-sun:06:00", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Description 1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window", "sun:05:00-sun:06:00"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_limit", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMemoryDbClusterConfigUpdate(rName, "Description 2", "mon:05:00-mon:06:00", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Description 2"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window", "mon:05:00-mon:06:00"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_limit", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDbCluster_tags(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(memorydb.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbClusterConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMemoryDbClusterConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMemoryDbClusterConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMemoryDbClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_cluster" {
+			continue
+		}
+
+		_, err := finder.ClusterByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MemoryDB Cluster %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMemoryDbClusterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+		_, err := finder.ClusterByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAWSMemoryDbClusterConfigBase(rName string) string {
+	return composeConfig(testAccAWSMemoryDbConfigBaseNetwork(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_memorydb_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+`, rName))
+}
+
+func testAccAWSMemoryDbClusterConfig(rName string) string {
+	return composeConfig(testAccAWSMemoryDbClusterConfigBase(rName), fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  acl_name           = "open-access"
+  name               = %[1]q
+  node_type          = "db.t4g.small"
+  security_group_ids = [aws_security_group.test.id]
+  subnet_group_name  = aws_memorydb_subnet_group.test.id
+}
+`, rName))
+}
+
+func testAccAWSMemoryDbClusterConfigUpdate(rName, description, maintenanceWindow string, snapshotRetentionLimit int) string {
+	return composeConfig(testAccAWSMemoryDbClusterConfigBase(rName), fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  acl_name                 = "open-access"
+  description              = %[2]q
+  maintenance_window       = %[3]q
+  name                     = %[1]q
+  node_type                = "db.t4g.small"
+  num_replicas_per_shard   = 0
+  security_group_ids       = [aws_security_group.test.id]
+  snapshot_retention_limit = %[4]d
+  subnet_group_name        = aws_memorydb_subnet_group.test.id
+}
+`, rName, description, maintenanceWindow, snapshotRetentionLimit))
+}
+
+func testAccAWSMemoryDbClusterConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSMemoryDbClusterConfigBase(rName), fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  acl_name               = "open-access"
+  name                   = %[1]q
+  node_type              = "db.t4g.small"
+  num_replicas_per_shard = 0
+  security_group_ids     = [aws_security_group.test.id]
+  subnet_group_name      = aws_memorydb_subnet_group.test.id
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSMemoryDbClusterConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSMemoryDbClusterConfigBase(rName), fmt.Sprintf(`
+resource "aws_memorydb_cluster" "test" {
+  acl_name               = "open-access"
+  name                   = %[1]q
+  node_type              = "db.t4g.small"
+  num_replicas_per_shard = 0
+  security_group_ids     = [aws_security_group.test.id]
+  subnet_group_name      = aws_memorydb_subnet_group.test.id
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
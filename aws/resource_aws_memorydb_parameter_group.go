@@ -0,0 +1,329 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMemoryDbParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMemoryDbParameterGroupCreate,
+		Read:   resourceAwsMemoryDbParameterGroupRead,
+		Update: resourceAwsMemoryDbParameterGroupUpdate,
+		Delete: resourceAwsMemoryDbParameterGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Managed by Terraform",
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDbName,
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDbNamePrefix,
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: resourceAwsMemoryDbParameterHash,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsMemoryDbParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateParameterGroupInput{
+		Description:        aws.String(d.Get("description").(string)),
+		Family:             aws.String(d.Get("family").(string)),
+		ParameterGroupName: aws.String(name),
+		Tags:               keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().MemorydbTags(),
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Parameter Group: %s", input)
+	_, err := conn.CreateParameterGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB Parameter Group (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if v, ok := d.GetOk("parameter"); ok && v.(*schema.Set).Len() > 0 {
+		if err := modifyMemoryDbParameterGroupParameters(conn, d.Id(), expandMemoryDbParameters(v.(*schema.Set).List())); err != nil {
+			return fmt.Errorf("error setting MemoryDB Parameter Group (%s) parameters: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDbParameterGroupRead(d, meta)
+}
+
+func resourceAwsMemoryDbParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	group, err := finder.ParameterGroupByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Parameter Group (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.Description)
+	d.Set("family", group.Family)
+	d.Set("name", group.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(group.Name)))
+
+	parameters, err := finder.ParametersByParameterGroupName(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Parameter Group (%s) parameters: %w", d.Id(), err)
+	}
+
+	// The API returns every parameter in the family, so only track the
+	// ones present in the configuration.
+	configured := make(map[string]bool)
+	for _, tfMapRaw := range d.Get("parameter").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			configured[tfMap["name"].(string)] = true
+		}
+	}
+
+	var userDefined []*memorydb.Parameter
+	for _, parameter := range parameters {
+		if configured[aws.StringValue(parameter.Name)] {
+			userDefined = append(userDefined, parameter)
+		}
+	}
+
+	if err := d.Set("parameter", flattenMemoryDbParameters(userDefined)); err != nil {
+		return fmt.Errorf("error setting parameter: %w", err)
+	}
+
+	tags, err := keyvaluetags.MemorydbListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Parameter Group (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDbParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChange("parameter") {
+		o, n := d.GetChange("parameter")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		toAdd := expandMemoryDbParameters(ns.Difference(os).List())
+
+		// Parameters whose value changed are overwritten, only those
+		// removed from the configuration are reset to their defaults.
+		var toReset []*string
+		for _, parameter := range expandMemoryDbParameters(os.Difference(ns).List()) {
+			name := aws.StringValue(parameter.ParameterName)
+			found := false
+
+			for _, added := range toAdd {
+				if aws.StringValue(added.ParameterName) == name {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				toReset = append(toReset, parameter.ParameterName)
+			}
+		}
+
+		if err := resetMemoryDbParameterGroupParameters(conn, d.Id(), toReset); err != nil {
+			return fmt.Errorf("error resetting MemoryDB Parameter Group (%s) parameters: %w", d.Id(), err)
+		}
+
+		if err := modifyMemoryDbParameterGroupParameters(conn, d.Id(), toAdd); err != nil {
+			return fmt.Errorf("error modifying MemoryDB Parameter Group (%s) parameters: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.MemorydbUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB Parameter Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDbParameterGroupRead(d, meta)
+}
+
+func resourceAwsMemoryDbParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	log.Printf("[DEBUG] Deleting MemoryDB Parameter Group: (%s)", d.Id())
+	_, err := conn.DeleteParameterGroup(&memorydb.DeleteParameterGroupInput{
+		ParameterGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Parameter Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// memoryDbParameterGroupMaxParametersPerRequest is the maximum number of
+// parameters that can be modified or reset in a single request.
+const memoryDbParameterGroupMaxParametersPerRequest = 20
+
+func modifyMemoryDbParameterGroupParameters(conn *memorydb.MemoryDB, name string, parameters []*memorydb.ParameterNameValue) error {
+	for len(parameters) > 0 {
+		var chunk []*memorydb.ParameterNameValue
+		if len(parameters) <= memoryDbParameterGroupMaxParametersPerRequest {
+			chunk, parameters = parameters, nil
+		} else {
+			chunk, parameters = parameters[:memoryDbParameterGroupMaxParametersPerRequest], parameters[memoryDbParameterGroupMaxParametersPerRequest:]
+		}
+
+		input := &memorydb.UpdateParameterGroupInput{
+			ParameterGroupName:  aws.String(name),
+			ParameterNameValues: chunk,
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB Parameter Group: %s", input)
+		if _, err := conn.UpdateParameterGroup(input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resetMemoryDbParameterGroupParameters(conn *memorydb.MemoryDB, name string, parameterNames []*string) error {
+	for len(parameterNames) > 0 {
+		var chunk []*string
+		if len(parameterNames) <= memoryDbParameterGroupMaxParametersPerRequest {
+			chunk, parameterNames = parameterNames, nil
+		} else {
+			chunk, parameterNames = parameterNames[:memoryDbParameterGroupMaxParametersPerRequest], parameterNames[memoryDbParameterGroupMaxParametersPerRequest:]
+		}
+
+		input := &memorydb.ResetParameterGroupInput{
+			ParameterGroupName: aws.String(name),
+			ParameterNames:     chunk,
+		}
+
+		log.Printf("[DEBUG] Resetting MemoryDB Parameter Group: %s", input)
+		if _, err := conn.ResetParameterGroup(input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDbParameterHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["value"].(string)))
+	return hashcode.String(buf.String())
+}
+
+func expandMemoryDbParameters(tfList []interface{}) []*memorydb.ParameterNameValue {
+	var apiObjects []*memorydb.ParameterNameValue
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &memorydb.ParameterNameValue{
+			ParameterName:  aws.String(tfMap["name"].(string)),
+			ParameterValue: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenMemoryDbParameters(apiObjects []*memorydb.Parameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.StringValue(apiObject.Name),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
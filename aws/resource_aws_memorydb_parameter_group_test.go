@@ -0,0 +1,194 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSMemoryDbParameterGroup_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(memorydb.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbParameterGroupExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "memorydb", "parametergroup/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "family", "memorydb_redis6"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDbParameterGroup_disappears(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(memorydb.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbParameterGroupExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMemoryDbParameterGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDbParameterGroup_parameters(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(memorydb.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbParameterGroupConfigParameters2(rName, "active-defrag-cycle-max", "70", "active-defrag-cycle-min", "10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "active-defrag-cycle-max",
+						"value": "70",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "active-defrag-cycle-min",
+						"value": "10",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameter"},
+			},
+			{
+				Config: testAccAWSMemoryDbParameterGroupConfigParameters1(rName, "active-defrag-cycle-max", "75"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "active-defrag-cycle-max",
+						"value": "75",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMemoryDbParameterGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_parameter_group" {
+			continue
+		}
+
+		_, err := finder.ParameterGroupByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MemoryDB Parameter Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMemoryDbParameterGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB Parameter Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+		_, err := finder.ParameterGroupByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAWSMemoryDbParameterGroupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+}
+`, rName)
+}
+
+func testAccAWSMemoryDbParameterGroupConfigParameters1(rName, name1, value1 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = %[2]q
+    value = %[3]q
+  }
+}
+`, rName, name1, value1)
+}
+
+func testAccAWSMemoryDbParameterGroupConfigParameters2(rName, name1, value1, name2, value2 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = %[2]q
+    value = %[3]q
+  }
+
+  parameter {
+    name  = %[4]q
+    value = %[5]q
+  }
+}
+`, rName, name1, value1, name2, value2)
+}
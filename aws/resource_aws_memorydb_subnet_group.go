@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMemoryDbSubnetGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMemoryDbSubnetGroupCreate,
+		Read:   resourceAwsMemoryDbSubnetGroupRead,
+		Update: resourceAwsMemoryDbSubnetGroupUpdate,
+		Delete: resourceAwsMemoryDbSubnetGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Managed by Terraform",
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDbName,
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDbNamePrefix,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateMemoryDbName validates the names of MemoryDB clusters, subnet groups,
+// parameter groups, ACLs and users, which share the same naming rules.
+var validateMemoryDbName = validation.All(
+	validation.StringLenBetween(1, 40),
+	validation.StringMatch(regexp.MustCompile(`^[a-z0-9-]*[a-z0-9]$`), "must contain only lowercase alphanumeric characters and hyphens, and must not end with a hyphen"),
+	validation.StringDoesNotMatch(regexp.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+)
+
+// validateMemoryDbNamePrefix leaves room for the unique suffix appended to a name prefix.
+var validateMemoryDbNamePrefix = validation.All(
+	validation.StringLenBetween(1, 40-resource.UniqueIDSuffixLength),
+	validation.StringMatch(regexp.MustCompile(`^[a-z0-9-]+$`), "must contain only lowercase alphanumeric characters and hyphens"),
+)
+
+func resourceAwsMemoryDbSubnetGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateSubnetGroupInput{
+		Description:     aws.String(d.Get("description").(string)),
+		SubnetGroupName: aws.String(name),
+		SubnetIds:       expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		Tags:            keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().MemorydbTags(),
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Subnet Group: %s", input)
+	_, err := conn.CreateSubnetGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB Subnet Group (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsMemoryDbSubnetGroupRead(d, meta)
+}
+
+func resourceAwsMemoryDbSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	group, err := finder.SubnetGroupByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Subnet Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB Subnet Group (%s): %w", d.Id(), err)
+	}
+
+	var subnetIds []*string
+	for _, subnet := range group.Subnets {
+		subnetIds = append(subnetIds, subnet.Identifier)
+	}
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.Description)
+	d.Set("name", group.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(group.Name)))
+	d.Set("subnet_ids", flattenStringSet(subnetIds))
+	d.Set("vpc_id", group.VpcId)
+
+	tags, err := keyvaluetags.MemorydbListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB Subnet Group (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDbSubnetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChanges("description", "subnet_ids") {
+		input := &memorydb.UpdateSubnetGroupInput{
+			Description:     aws.String(d.Get("description").(string)),
+			SubnetGroupName: aws.String(d.Id()),
+			SubnetIds:       expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB Subnet Group: %s", input)
+		_, err := conn.UpdateSubnetGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MemoryDB Subnet Group (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.MemorydbUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB Subnet Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDbSubnetGroupRead(d, meta)
+}
+
+func resourceAwsMemoryDbSubnetGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	log.Printf("[DEBUG] Deleting MemoryDB Subnet Group: (%s)", d.Id())
+	_, err := conn.DeleteSubnetGroup(&memorydb.DeleteSubnetGroupInput{
+		SubnetGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeSubnetGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB Subnet Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMemoryDbUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMemoryDbUserCreate,
+		Read:   resourceAwsMemoryDbUserRead,
+		Update: resourceAwsMemoryDbUserUpdate,
+		Delete: resourceAwsMemoryDbUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_string": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_mode": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"passwords": {
+							Type:      schema.TypeSet,
+							Optional:  true,
+							MinItems:  1,
+							MaxItems:  2,
+							Sensitive: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(16, 128),
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(memorydb.InputAuthenticationType_Values(), false),
+						},
+					},
+				},
+			},
+			"minimum_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateMemoryDbName,
+			},
+		},
+	}
+}
+
+func resourceAwsMemoryDbUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	userName := d.Get("user_name").(string)
+	input := &memorydb.CreateUserInput{
+		AccessString:       aws.String(d.Get("access_string").(string)),
+		AuthenticationMode: expandMemoryDbAuthenticationMode(d.Get("authentication_mode").([]interface{})),
+		Tags:               keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().MemorydbTags(),
+		UserName:           aws.String(userName),
+	}
+
+	// Do not log the input, it contains the user's passwords.
+	log.Printf("[DEBUG] Creating MemoryDB User: %s", userName)
+	_, err := conn.CreateUser(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MemoryDB User (%s): %w", userName, err)
+	}
+
+	d.SetId(userName)
+
+	if _, err := waiter.UserActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB User (%s) to be created: %w", d.Id(), err)
+	}
+
+	return resourceAwsMemoryDbUserRead(d, meta)
+}
+
+func resourceAwsMemoryDbUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	user, err := finder.UserByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MemoryDB User (%s): %w", d.Id(), err)
+	}
+
+	d.Set("access_string", user.AccessString)
+	d.Set("arn", user.ARN)
+
+	if v := user.Authentication; v != nil {
+		// Passwords are never returned by the API, so keep the configured
+		// values to avoid a perpetual diff.
+		authenticationMode := map[string]interface{}{
+			"password_count": aws.Int64Value(v.PasswordCount),
+			"type":           aws.StringValue(v.Type),
+		}
+
+		if v, ok := d.GetOk("authentication_mode.0.passwords"); ok {
+			authenticationMode["passwords"] = v
+		}
+
+		if err := d.Set("authentication_mode", []interface{}{authenticationMode}); err != nil {
+			return fmt.Errorf("error setting authentication_mode: %w", err)
+		}
+	}
+
+	d.Set("minimum_engine_version", user.MinimumEngineVersion)
+	d.Set("user_name", user.Name)
+
+	tags, err := keyvaluetags.MemorydbListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MemoryDB User (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDbUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChangesExcept("tags") {
+		input := &memorydb.UpdateUserInput{
+			UserName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("access_string") {
+			input.AccessString = aws.String(d.Get("access_string").(string))
+		}
+
+		if d.HasChange("authentication_mode") {
+			input.AuthenticationMode = expandMemoryDbAuthenticationMode(d.Get("authentication_mode").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB User: %s", d.Id())
+		_, err := conn.UpdateUser(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MemoryDB User (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.UserActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MemoryDB User (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.MemorydbUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MemoryDB User (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDbUserRead(d, meta)
+}
+
+func resourceAwsMemoryDbUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).memorydbconn
+
+	log.Printf("[DEBUG] Deleting MemoryDB User: (%s)", d.Id())
+	_, err := conn.DeleteUser(&memorydb.DeleteUserInput{
+		UserName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeUserNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MemoryDB User (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.UserDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MemoryDB User (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMemoryDbAuthenticationMode(tfList []interface{}) *memorydb.AuthenticationMode {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &memorydb.AuthenticationMode{}
+
+	if v, ok := tfMap["passwords"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Passwords = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
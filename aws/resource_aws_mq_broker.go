@@ -2,13 +2,16 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -65,14 +68,11 @@ func resourceAwsMqBroker() *schema.Resource {
 				},
 			},
 			"deployment_mode": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  mq.DeploymentModeSingleInstance,
-				ForceNew: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					mq.DeploymentModeSingleInstance,
-					mq.DeploymentModeActiveStandbyMultiAz,
-				}, true),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      mq.DeploymentModeSingleInstance,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(mq.DeploymentMode_Values(), true),
 			},
 			"encryption_options": {
 				Type:             schema.TypeList,
@@ -99,12 +99,10 @@ func resourceAwsMqBroker() *schema.Resource {
 				},
 			},
 			"engine_type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					mq.EngineTypeActivemq,
-				}, true),
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(mq.EngineType_Values(), true),
 			},
 			"engine_version": {
 				Type:     schema.TypeString,
@@ -254,6 +252,57 @@ func resourceAwsMqBroker() *schema.Resource {
 			},
 			"tags": tagsSchema(),
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return validateMqBrokerDeploymentMode(diff.Get("engine_type").(string), diff.Get("deployment_mode").(string), diff.Get("host_instance_type").(string))
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if !strings.EqualFold(diff.Get("engine_type").(string), mq.EngineTypeRabbitmq) {
+					return nil
+				}
+
+				// configuration is Optional+Computed and populated by the API after creation,
+				// so only reject a value that is being configured.
+				if diff.Id() == "" || diff.HasChange("configuration") {
+					if v, ok := diff.GetOk("configuration"); ok && len(v.([]interface{})) > 0 {
+						return fmt.Errorf("configuration is not supported for engine_type %s", mq.EngineTypeRabbitmq)
+					}
+				}
+
+				if v, ok := diff.GetOk("logs"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					if v.([]interface{})[0].(map[string]interface{})["audit"].(bool) {
+						return fmt.Errorf("logs.0.audit is not supported for engine_type %s", mq.EngineTypeRabbitmq)
+					}
+				}
+
+				users := diff.Get("user").(*schema.Set).List()
+
+				if len(users) != 1 {
+					return fmt.Errorf("exactly one user is required for engine_type %s", mq.EngineTypeRabbitmq)
+				}
+
+				user := users[0].(map[string]interface{})
+
+				if user["console_access"].(bool) || user["groups"].(*schema.Set).Len() > 0 {
+					return fmt.Errorf("user console_access and groups are not supported for engine_type %s", mq.EngineTypeRabbitmq)
+				}
+
+				return nil
+			},
+			// RabbitMQ users cannot be managed through the Amazon MQ user APIs after creation.
+			// They are also never read back, so an imported broker has no users in state
+			// and adopting the configured user must not replace it.
+			customdiff.ForceNewIf("user", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				if !strings.EqualFold(diff.Get("engine_type").(string), mq.EngineTypeRabbitmq) {
+					return false
+				}
+
+				o, _ := diff.GetChange("user")
+
+				return o.(*schema.Set).Len() > 0
+			}),
+		),
 	}
 }
 
@@ -273,7 +322,7 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 		PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
 		SecurityGroups:          expandStringSet(d.Get("security_groups").(*schema.Set)),
 		Users:                   expandMqUsers(d.Get("user").(*schema.Set).List()),
-		Logs:                    expandMqLogs(d.Get("logs").([]interface{})),
+		Logs:                    expandMqLogs(d.Get("engine_type").(string), d.Get("logs").([]interface{})),
 	}
 
 	if v, ok := d.GetOk("configuration"); ok {
@@ -380,26 +429,30 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	rawUsers := make([]*mq.User, len(out.Users))
-	for i, u := range out.Users {
-		uOut, err := conn.DescribeUser(&mq.DescribeUserInput{
-			BrokerId: aws.String(d.Id()),
-			Username: u.Username,
-		})
-		if err != nil {
-			return err
-		}
+	// RabbitMQ brokers do not expose their users through the Amazon MQ API,
+	// so the configured user is kept as-is in state.
+	if !strings.EqualFold(aws.StringValue(out.EngineType), mq.EngineTypeRabbitmq) {
+		rawUsers := make([]*mq.User, len(out.Users))
+		for i, u := range out.Users {
+			uOut, err := conn.DescribeUser(&mq.DescribeUserInput{
+				BrokerId: aws.String(d.Id()),
+				Username: u.Username,
+			})
+			if err != nil {
+				return err
+			}
 
-		rawUsers[i] = &mq.User{
-			ConsoleAccess: uOut.ConsoleAccess,
-			Groups:        uOut.Groups,
-			Username:      uOut.Username,
+			rawUsers[i] = &mq.User{
+				ConsoleAccess: uOut.ConsoleAccess,
+				Groups:        uOut.Groups,
+				Username:      uOut.Username,
+			}
 		}
-	}
 
-	users := flattenMqUsers(rawUsers, d.Get("user").(*schema.Set).List())
-	if err = d.Set("user", users); err != nil {
-		return err
+		users := flattenMqUsers(rawUsers, d.Get("user").(*schema.Set).List())
+		if err = d.Set("user", users); err != nil {
+			return err
+		}
 	}
 
 	if err := d.Set("tags", keyvaluetags.MqKeyValueTags(out.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
@@ -428,7 +481,7 @@ func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, err := conn.UpdateBroker(&mq.UpdateBrokerRequest{
 			BrokerId:      aws.String(d.Id()),
 			Configuration: expandMqConfigurationId(d.Get("configuration").([]interface{})),
-			Logs:          expandMqLogs(d.Get("logs").([]interface{})),
+			Logs:          expandMqLogs(d.Get("engine_type").(string), d.Get("logs").([]interface{})),
 		})
 		if err != nil {
 			return fmt.Errorf("error updating MQ Broker (%s) configuration: %s", d.Id(), err)
@@ -436,7 +489,8 @@ func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 		requiresReboot = true
 	}
 
-	if d.HasChange("user") {
+	// RabbitMQ users force a new broker, see CustomizeDiff
+	if d.HasChange("user") && !strings.EqualFold(d.Get("engine_type").(string), mq.EngineTypeRabbitmq) {
 		o, n := d.GetChange("user")
 		var err error
 		// d.HasChange("user") always reports a change when running resourceAwsMqBrokerUpdate
@@ -700,6 +754,22 @@ func flattenMqEncryptionOptions(encryptionOptions *mq.EncryptionOptions) []inter
 	return []interface{}{m}
 }
 
+func validateMqBrokerDeploymentMode(engineType, deploymentMode, hostInstanceType string) error {
+	switch {
+	case strings.EqualFold(deploymentMode, mq.DeploymentModeActiveStandbyMultiAz) && !strings.EqualFold(engineType, mq.EngineTypeActivemq):
+		return fmt.Errorf("deployment_mode %s is only supported for engine_type %s", mq.DeploymentModeActiveStandbyMultiAz, mq.EngineTypeActivemq)
+	case strings.EqualFold(deploymentMode, mq.DeploymentModeClusterMultiAz) && !strings.EqualFold(engineType, mq.EngineTypeRabbitmq):
+		return fmt.Errorf("deployment_mode %s is only supported for engine_type %s", mq.DeploymentModeClusterMultiAz, mq.EngineTypeRabbitmq)
+	}
+
+	// Micro instance types only support single-instance brokers.
+	if strings.HasSuffix(hostInstanceType, ".micro") && deploymentMode != "" && !strings.EqualFold(deploymentMode, mq.DeploymentModeSingleInstance) {
+		return fmt.Errorf("host_instance_type %s only supports deployment_mode %s", hostInstanceType, mq.DeploymentModeSingleInstance)
+	}
+
+	return nil
+}
+
 func validateMqBrokerPassword(v interface{}, k string) (ws []string, errors []error) {
 	min := 12
 	max := 250
@@ -815,6 +815,109 @@ func TestAccAWSMqBroker_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAWSMqBroker_RabbitMQ(t *testing.T) {
+	var broker mq.DescribeBrokerResponse
+	sgName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	brokerName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMq(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMqBrokerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMqBrokerRabbitMqConfigConsoleAccess(sgName, brokerName),
+				ExpectError: regexp.MustCompile(`user console_access and groups are not supported`),
+			},
+			{
+				Config: testAccMqBrokerRabbitMqConfig(sgName, brokerName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMqBrokerExists(resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_mode", mq.DeploymentModeSingleInstance),
+					resource.TestCheckResourceAttr(resourceName, "engine_type", "RabbitMQ"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "3.8.6"),
+					resource.TestCheckResourceAttr(resourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs.0.general", "true"),
+					resource.TestCheckResourceAttr(resourceName, "user.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"console_access": "false",
+						"username":       "Test",
+						"password":       "TestTest1234",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.console_url",
+						regexp.MustCompile(`^https://[a-z0-9-\.]+\.mq\.[a-z0-9-]+\.amazonaws\.com$`)),
+					resource.TestCheckResourceAttr(resourceName, "instances.0.endpoints.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "instances.0.endpoints.0", regexp.MustCompile(`^amqps://[a-z0-9-\.]+:5671$`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "user"},
+			},
+		},
+	})
+}
+
+func TestValidateMqBrokerDeploymentMode(t *testing.T) {
+	cases := []struct {
+		EngineType       string
+		DeploymentMode   string
+		HostInstanceType string
+		ExpectError      bool
+	}{
+		{
+			EngineType:       mq.EngineTypeActivemq,
+			DeploymentMode:   mq.DeploymentModeActiveStandbyMultiAz,
+			HostInstanceType: "mq.m5.large",
+		},
+		{
+			EngineType:       mq.EngineTypeActivemq,
+			DeploymentMode:   mq.DeploymentModeClusterMultiAz,
+			HostInstanceType: "mq.m5.large",
+			ExpectError:      true,
+		},
+		{
+			EngineType:       mq.EngineTypeRabbitmq,
+			DeploymentMode:   mq.DeploymentModeClusterMultiAz,
+			HostInstanceType: "mq.m5.large",
+		},
+		{
+			EngineType:       mq.EngineTypeRabbitmq,
+			DeploymentMode:   mq.DeploymentModeActiveStandbyMultiAz,
+			HostInstanceType: "mq.m5.large",
+			ExpectError:      true,
+		},
+		{
+			EngineType:       mq.EngineTypeRabbitmq,
+			DeploymentMode:   mq.DeploymentModeSingleInstance,
+			HostInstanceType: "mq.t3.micro",
+		},
+		{
+			EngineType:       mq.EngineTypeRabbitmq,
+			DeploymentMode:   mq.DeploymentModeClusterMultiAz,
+			HostInstanceType: "mq.t3.micro",
+			ExpectError:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateMqBrokerDeploymentMode(tc.EngineType, tc.DeploymentMode, tc.HostInstanceType)
+
+		if tc.ExpectError && err == nil {
+			t.Errorf("expected error for %s/%s/%s", tc.EngineType, tc.DeploymentMode, tc.HostInstanceType)
+		}
+
+		if !tc.ExpectError && err != nil {
+			t.Errorf("unexpected error for %s/%s/%s: %s", tc.EngineType, tc.DeploymentMode, tc.HostInstanceType, err)
+		}
+	}
+}
+
 func testAccCheckAwsMqBrokerDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).mqconn
 
@@ -919,6 +1022,53 @@ resource "aws_mq_broker" "test" {
 `, sgName, brokerName)
 }
 
+func testAccMqBrokerRabbitMqConfig(sgName, brokerName string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_mq_broker" "test" {
+  broker_name        = %[2]q
+  engine_type        = "RabbitMQ"
+  engine_version     = "3.8.6"
+  host_instance_type = "mq.t3.micro"
+  security_groups    = [aws_security_group.test.id]
+
+  logs {
+    general = true
+  }
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, sgName, brokerName)
+}
+
+func testAccMqBrokerRabbitMqConfigConsoleAccess(sgName, brokerName string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_mq_broker" "test" {
+  broker_name        = %[2]q
+  engine_type        = "RabbitMQ"
+  engine_version     = "3.8.6"
+  host_instance_type = "mq.t3.micro"
+  security_groups    = [aws_security_group.test.id]
+
+  user {
+    username       = "Test"
+    password       = "TestTest1234"
+    console_access = true
+  }
+}
+`, sgName, brokerName)
+}
+
 func testAccMqBrokerConfig_allFieldsDefaultVpc(sgName, cfgName, cfgBody, brokerName string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "mq1" {
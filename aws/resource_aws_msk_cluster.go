@@ -49,7 +49,6 @@ func resourceAwsMskCluster() *schema.Resource {
 			"broker_node_group_info": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -88,6 +87,25 @@ func resourceAwsMskCluster() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.IntBetween(1, 16384),
 						},
+						"provisioned_throughput": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							DiffSuppressFunc: suppressMissingOptionalConfigurationBlock,
+							MaxItems:         1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"volume_throughput": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(250, 2375),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -355,6 +373,12 @@ func resourceAwsMskCluster() *schema.Resource {
 					},
 				},
 			},
+			"storage_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(kafka.StorageMode_Values(), false),
+			},
 			"tags": tagsSchema(),
 			"zookeeper_connect_string": {
 				Type:     schema.TypeString,
@@ -381,6 +405,10 @@ func resourceAwsMskClusterCreate(d *schema.ResourceData, meta interface{}) error
 		Tags:                 keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().KafkaTags(),
 	}
 
+	if v, ok := d.GetOk("storage_mode"); ok {
+		input.StorageMode = aws.String(v.(string))
+	}
+
 	out, err := conn.CreateCluster(input)
 
 	if err != nil {
@@ -490,6 +518,7 @@ func resourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("kafka_version", aws.StringValue(cluster.CurrentBrokerSoftwareInfo.KafkaVersion))
 	d.Set("number_of_broker_nodes", aws.Int64Value(cluster.NumberOfBrokerNodes))
+	d.Set("storage_mode", cluster.StorageMode)
 
 	if err := d.Set("tags", keyvaluetags.KafkaKeyValueTags(cluster.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
@@ -511,7 +540,7 @@ func resourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsMskClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kafkaconn
 
-	if d.HasChange("broker_node_group_info.0.ebs_volume_size") {
+	if d.HasChanges("broker_node_group_info.0.ebs_volume_size", "broker_node_group_info.0.provisioned_throughput") {
 		input := &kafka.UpdateBrokerStorageInput{
 			ClusterArn:     aws.String(d.Id()),
 			CurrentVersion: aws.String(d.Get("current_version").(string)),
@@ -523,6 +552,10 @@ func resourceAwsMskClusterUpdate(d *schema.ResourceData, meta interface{}) error
 			},
 		}
 
+		if d.HasChange("broker_node_group_info.0.provisioned_throughput") {
+			input.TargetBrokerEBSVolumeInfo[0].ProvisionedThroughput = expandMskClusterProvisionedThroughput(d.Get("broker_node_group_info.0.provisioned_throughput").([]interface{}))
+		}
+
 		output, err := conn.UpdateBrokerStorage(input)
 
 		if err != nil {
@@ -538,6 +571,38 @@ func resourceAwsMskClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		if err := waitForMskClusterOperation(conn, clusterOperationARN); err != nil {
 			return fmt.Errorf("error waiting for MSK Cluster (%s) operation (%s): %s", d.Id(), clusterOperationARN, err)
 		}
+
+		if err := refreshMskClusterCurrentVersion(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("storage_mode") {
+		input := &kafka.UpdateStorageInput{
+			ClusterArn:     aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("current_version").(string)),
+			StorageMode:    aws.String(d.Get("storage_mode").(string)),
+		}
+
+		output, err := conn.UpdateStorage(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MSK Cluster (%s) storage mode: %w", d.Id(), err)
+		}
+
+		if output == nil {
+			return fmt.Errorf("error updating MSK Cluster (%s) storage mode: empty response", d.Id())
+		}
+
+		clusterOperationARN := aws.StringValue(output.ClusterOperationArn)
+
+		if err := waitForMskClusterOperation(conn, clusterOperationARN); err != nil {
+			return fmt.Errorf("error waiting for MSK Cluster (%s) operation (%s): %w", d.Id(), clusterOperationARN, err)
+		}
+
+		if err := refreshMskClusterCurrentVersion(conn, d); err != nil {
+			return err
+		}
 	}
 
 	if d.HasChange("number_of_broker_nodes") {
@@ -562,6 +627,10 @@ func resourceAwsMskClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		if err := waitForMskClusterOperation(conn, clusterOperationARN); err != nil {
 			return fmt.Errorf("error waiting for MSK Cluster (%s) operation (%s): %s", d.Id(), clusterOperationARN, err)
 		}
+
+		if err := refreshMskClusterCurrentVersion(conn, d); err != nil {
+			return err
+		}
 	}
 
 	if d.HasChanges("enhanced_monitoring", "open_monitoring", "logging_info") {
@@ -588,6 +657,10 @@ func resourceAwsMskClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		if err := waitForMskClusterOperation(conn, clusterOperationARN); err != nil {
 			return fmt.Errorf("error waiting for MSK Cluster (%s) operation (%s): %s", d.Id(), clusterOperationARN, err)
 		}
+
+		if err := refreshMskClusterCurrentVersion(conn, d); err != nil {
+			return err
+		}
 	}
 
 	if d.HasChange("configuration_info") && !d.HasChange("kafka_version") {
@@ -612,6 +685,10 @@ func resourceAwsMskClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		if err := waitForMskClusterOperation(conn, clusterOperationARN); err != nil {
 			return fmt.Errorf("error waiting for MSK Cluster (%s) operation (%s): %s", d.Id(), clusterOperationARN, err)
 		}
+
+		if err := refreshMskClusterCurrentVersion(conn, d); err != nil {
+			return err
+		}
 	}
 
 	if d.HasChange("kafka_version") {
@@ -668,7 +745,8 @@ func expandMskClusterBrokerNodeGroupInfo(l []interface{}) *kafka.BrokerNodeGroup
 		SecurityGroups:       expandStringList(m["security_groups"].([]interface{})),
 		StorageInfo: &kafka.StorageInfo{
 			EbsStorageInfo: &kafka.EBSStorageInfo{
-				VolumeSize: aws.Int64(int64(m["ebs_volume_size"].(int))),
+				ProvisionedThroughput: expandMskClusterProvisionedThroughput(m["provisioned_throughput"].([]interface{})),
+				VolumeSize:            aws.Int64(int64(m["ebs_volume_size"].(int))),
 			},
 		},
 	}
@@ -676,6 +754,24 @@ func expandMskClusterBrokerNodeGroupInfo(l []interface{}) *kafka.BrokerNodeGroup
 	return bngi
 }
 
+func expandMskClusterProvisionedThroughput(l []interface{}) *kafka.ProvisionedThroughput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	pt := &kafka.ProvisionedThroughput{
+		Enabled: aws.Bool(m["enabled"].(bool)),
+	}
+
+	if v, ok := m["volume_throughput"].(int); ok && v != 0 {
+		pt.VolumeThroughput = aws.Int64(int64(v))
+	}
+
+	return pt
+}
+
 func expandMskClusterClientAuthentication(l []interface{}) *kafka.ClientAuthentication {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -922,11 +1018,25 @@ func flattenMskBrokerNodeGroupInfo(b *kafka.BrokerNodeGroupInfo) []map[string]in
 	if b.StorageInfo != nil {
 		if b.StorageInfo.EbsStorageInfo != nil {
 			m["ebs_volume_size"] = int(aws.Int64Value(b.StorageInfo.EbsStorageInfo.VolumeSize))
+			m["provisioned_throughput"] = flattenMskProvisionedThroughput(b.StorageInfo.EbsStorageInfo.ProvisionedThroughput)
 		}
 	}
 	return []map[string]interface{}{m}
 }
 
+func flattenMskProvisionedThroughput(pt *kafka.ProvisionedThroughput) []map[string]interface{} {
+	if pt == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled":           aws.BoolValue(pt.Enabled),
+		"volume_throughput": int(aws.Int64Value(pt.VolumeThroughput)),
+	}
+
+	return []map[string]interface{}{m}
+}
+
 func flattenMskClientAuthentication(ca *kafka.ClientAuthentication) []map[string]interface{} {
 	if ca == nil {
 		return []map[string]interface{}{}
@@ -1201,6 +1311,26 @@ func mskClusterOperationRefreshFunc(conn *kafka.Kafka, clusterOperationARN strin
 	}
 }
 
+// refreshMskClusterCurrentVersion stores the cluster's latest version after an operation,
+// since each update API call requires the version the cluster is currently at.
+func refreshMskClusterCurrentVersion(conn *kafka.Kafka, d *schema.ResourceData) error {
+	output, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Cluster (%s) current version: %w", d.Id(), err)
+	}
+
+	if output == nil || output.ClusterInfo == nil {
+		return fmt.Errorf("error reading MSK Cluster (%s) current version: empty response", d.Id())
+	}
+
+	d.Set("current_version", output.ClusterInfo.CurrentVersion)
+
+	return nil
+}
+
 func waitForMskClusterOperation(conn *kafka.Kafka, clusterOperationARN string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"PENDING", "UPDATE_IN_PROGRESS"},
@@ -0,0 +1,668 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mwaa/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSMwaaEnvironment_basic(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttrSet(resourceName, "airflow_version"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "airflow", fmt.Sprintf("environment/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "dag_s3_path", "dags/"),
+					resource.TestCheckResourceAttr(resourceName, "environment_class", "mw1.small"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "last_updated.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.log_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "max_workers", "10"),
+					resource.TestCheckResourceAttr(resourceName, "min_workers", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.subnet_ids.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_role_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_bucket_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", mwaa.EnvironmentStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "webserver_access_mode", mwaa.WebserverAccessModePrivateOnly),
+					resource.TestCheckResourceAttrSet(resourceName, "webserver_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "weekly_maintenance_window_start"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMwaaEnvironment_disappears(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMwaaEnvironment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMwaaEnvironment_AirflowConfigurationOptions(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigAirflowConfigurationOptions(rName, "1", "16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.core.default_task_retries", "1"),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.core.parallelism", "16"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMwaaEnvironmentConfigAirflowConfigurationOptions(rName, "2", "32"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.core.default_task_retries", "2"),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.core.parallelism", "32"),
+				),
+			},
+			{
+				Config: testAccAWSMwaaEnvironmentConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "airflow_configuration_options.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMwaaEnvironment_LoggingConfiguration(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigLoggingConfiguration(rName, "true", mwaa.LoggingLevelCritical),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.log_level", mwaa.LoggingLevelCritical),
+					resource.TestCheckResourceAttrSet(resourceName, "logging_configuration.0.dag_processing_logs.0.cloud_watch_log_group_arn"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.scheduler_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.task_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.webserver_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMwaaEnvironmentConfigLoggingConfiguration(rName, "false", mwaa.LoggingLevelInfo),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.dag_processing_logs.0.log_level", mwaa.LoggingLevelInfo),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMwaaEnvironment_RequirementsS3ObjectVersion(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigRequirements(rName, "boto3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "requirements_s3_path", "requirements.txt"),
+					resource.TestCheckResourceAttrPair(resourceName, "requirements_s3_object_version", "aws_s3_bucket_object.requirements", "version_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMwaaEnvironmentConfigRequirements(rName, "boto3\nrequests"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttrPair(resourceName, "requirements_s3_object_version", "aws_s3_bucket_object.requirements", "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", mwaa.EnvironmentStatusAvailable),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMwaaEnvironment_Tags(t *testing.T) {
+	var environment mwaa.Environment
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mwaa.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMwaaEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMwaaEnvironmentConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMwaaEnvironmentConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMwaaEnvironmentConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMwaaEnvironmentExists(resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMwaaEnvironmentExists(resourceName string, v *mwaa.Environment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MWAA Environment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).mwaaconn
+
+		environment, err := finder.EnvironmentByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *environment
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMwaaEnvironmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).mwaaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mwaa_environment" {
+			continue
+		}
+
+		_, err := finder.EnvironmentByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MWAA Environment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSMwaaEnvironmentConfigBase(rName string) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "public" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = "%[1]s-public-${count.index}"
+  }
+}
+
+resource "aws_subnet" "private" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index + 2)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = "%[1]s-private-${count.index}"
+  }
+}
+
+resource "aws_eip" "test" {
+  count = 2
+
+  vpc = true
+
+  tags = {
+    Name = "%[1]s-${count.index}"
+  }
+}
+
+resource "aws_nat_gateway" "test" {
+  count = 2
+
+  allocation_id = aws_eip.test[count.index].id
+  subnet_id     = aws_subnet.public[count.index].id
+
+  tags = {
+    Name = "%[1]s-${count.index}"
+  }
+}
+
+resource "aws_route_table" "public" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "public" {
+  count = 2
+
+  subnet_id      = aws_subnet.public[count.index].id
+  route_table_id = aws_route_table.public.id
+}
+
+resource "aws_route_table" "private" {
+  count = 2
+
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block     = "0.0.0.0/0"
+    nat_gateway_id = aws_nat_gateway.test[count.index].id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "private" {
+  count = 2
+
+  subnet_id      = aws_subnet.private[count.index].id
+  route_table_id = aws_route_table.private[count.index].id
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+  name   = %[1]q
+
+  ingress {
+    from_port = 0
+    to_port   = 0
+    protocol  = "-1"
+    self      = true
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+  acl    = "private"
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+resource "aws_s3_bucket_object" "dags" {
+  bucket       = aws_s3_bucket.test.id
+  acl          = "private"
+  key          = "dags/"
+  content_type = "application/x-directory"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "airflow.${data.aws_partition.current.dns_suffix}",
+          "airflow-env.${data.aws_partition.current.dns_suffix}"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+# Additional permissions are required for the environment to run Airflow tasks,
+# but creation only needs access to the source bucket
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": [
+        "${aws_s3_bucket.test.arn}",
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    }
+  ]
+}
+POLICY
+}
+`, rName))
+}
+
+func testAccAWSMwaaEnvironmentConfigBasic(rName string) string {
+	return composeConfig(
+		testAccAWSMwaaEnvironmentConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+}
+`, rName))
+}
+
+func testAccAWSMwaaEnvironmentConfigAirflowConfigurationOptions(rName, retries, parallelism string) string {
+	return composeConfig(
+		testAccAWSMwaaEnvironmentConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  airflow_configuration_options = {
+    "core.default_task_retries" = %[2]q
+    "core.parallelism"          = %[3]q
+  }
+
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+}
+`, rName, retries, parallelism))
+}
+
+func testAccAWSMwaaEnvironmentConfigLoggingConfiguration(rName, enabled, logLevel string) string {
+	return composeConfig(
+		testAccAWSMwaaEnvironmentConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+
+  logging_configuration {
+    dag_processing_logs {
+      enabled   = %[2]s
+      log_level = %[3]q
+    }
+
+    scheduler_logs {
+      enabled   = %[2]s
+      log_level = %[3]q
+    }
+
+    task_logs {
+      enabled   = %[2]s
+      log_level = %[3]q
+    }
+
+    webserver_logs {
+      enabled   = %[2]s
+      log_level = %[3]q
+    }
+
+    worker_logs {
+      enabled   = %[2]s
+      log_level = %[3]q
+    }
+  }
+
+  name = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+}
+`, rName, enabled, logLevel))
+}
+
+func testAccAWSMwaaEnvironmentConfigRequirements(rName, requirements string) string {
+	return composeConfig(
+		testAccAWSMwaaEnvironmentConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket_object" "requirements" {
+  bucket  = aws_s3_bucket.test.id
+  acl     = "private"
+  key     = "requirements.txt"
+  content = %[2]q
+}
+
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  requirements_s3_object_version = aws_s3_bucket_object.requirements.version_id
+  requirements_s3_path           = aws_s3_bucket_object.requirements.key
+  source_bucket_arn              = aws_s3_bucket.test.arn
+}
+`, rName, requirements))
+}
+
+func testAccAWSMwaaEnvironmentConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSMwaaEnvironmentConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSMwaaEnvironmentConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSMwaaEnvironmentConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_bucket_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -24,13 +26,23 @@ func resourceAwsNatGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsNatGatewayCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"allocation_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 
+			"connectivity_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.ConnectivityTypePublic,
+				ValidateFunc: validation.StringInSlice(ec2.ConnectivityType_Values(), false),
+			},
+
 			"subnet_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -62,11 +74,18 @@ func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error
 
 	// Create the NAT Gateway
 	createOpts := &ec2.CreateNatGatewayInput{
-		AllocationId:      aws.String(d.Get("allocation_id").(string)),
 		SubnetId:          aws.String(d.Get("subnet_id").(string)),
 		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeNatgateway),
 	}
 
+	if v, ok := d.GetOk("allocation_id"); ok {
+		createOpts.AllocationId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("connectivity_type"); ok {
+		createOpts.ConnectivityType = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] Create NAT Gateway: %s", *createOpts)
 	natResp, err := conn.CreateNatGateway(createOpts)
 	if err != nil {
@@ -118,6 +137,7 @@ func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Set NAT Gateway attributes
 	ng := ngRaw.(*ec2.NatGateway)
+	d.Set("connectivity_type", ng.ConnectivityType)
 	d.Set("subnet_id", ng.SubnetId)
 
 	// Address
@@ -186,6 +206,17 @@ func resourceAwsNatGatewayDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceAwsNatGatewayCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	switch connectivityType := diff.Get("connectivity_type").(string); connectivityType {
+	case ec2.ConnectivityTypePrivate:
+		if _, ok := diff.GetOk("allocation_id"); ok {
+			return fmt.Errorf(`allocation_id is not supported with connectivity_type = "%s"`, connectivityType)
+		}
+	}
+
+	return nil
+}
+
 // NGStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // a NAT Gateway.
 func NGStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
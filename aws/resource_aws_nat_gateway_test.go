@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -70,6 +71,11 @@ func TestAccAWSNatGateway_basic(t *testing.T) {
 				Config: testAccNatGatewayConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNatGatewayExists(resourceName, &natGateway),
+					resource.TestCheckResourceAttrPair(resourceName, "allocation_id", "aws_eip.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "connectivity_type", "public"),
+					resource.TestCheckResourceAttrSet(resourceName, "network_interface_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", "aws_eip.test", "public_ip"),
 					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
 				),
 			},
@@ -82,6 +88,49 @@ func TestAccAWSNatGateway_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSNatGateway_ConnectivityType_private(t *testing.T) {
+	var natGateway ec2.NatGateway
+	resourceName := "aws_nat_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatGatewayConfigConnectivityTypePrivate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNatGatewayExists(resourceName, &natGateway),
+					resource.TestCheckResourceAttr(resourceName, "allocation_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "connectivity_type", "private"),
+					resource.TestCheckResourceAttrSet(resourceName, "network_interface_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip"),
+					resource.TestCheckResourceAttr(resourceName, "public_ip", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNatGateway_ConnectivityType_privateWithAllocationId(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNatGatewayConfigConnectivityTypePrivateWithAllocationId,
+				ExpectError: regexp.MustCompile(`allocation_id is not supported with connectivity_type = "private"`),
+			},
+		},
+	})
+}
+
 func TestAccAWSNatGateway_tags(t *testing.T) {
 	var natGateway ec2.NatGateway
 	resourceName := "aws_nat_gateway.test"
@@ -238,6 +287,21 @@ resource "aws_nat_gateway" "test" {
 }
 `
 
+const testAccNatGatewayConfigConnectivityTypePrivate = testAccNatGatewayConfigBase + `
+resource "aws_nat_gateway" "test" {
+  connectivity_type = "private"
+  subnet_id         = aws_subnet.private.id
+}
+`
+
+const testAccNatGatewayConfigConnectivityTypePrivateWithAllocationId = testAccNatGatewayConfigBase + `
+resource "aws_nat_gateway" "test" {
+  allocation_id     = aws_eip.test.id
+  connectivity_type = "private"
+  subnet_id         = aws_subnet.private.id
+}
+`
+
 func testAccNatGatewayConfigTags1(tagKey1, tagValue1 string) string {
 	return testAccNatGatewayConfigBase + fmt.Sprintf(`
 resource "aws_nat_gateway" "test" {
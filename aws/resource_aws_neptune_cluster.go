@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,6 +25,10 @@ const (
 	CloudwatchLogsExportsAudit = "audit"
 
 	neptuneDefaultPort = 8182
+
+	// Neptune Serverless requires engine version 1.2.0.1 or later
+	// https://docs.aws.amazon.com/neptune/latest/userguide/neptune-serverless.html
+	neptuneServerlessMinimumEngineVersion = "1.2"
 )
 
 func resourceAwsNeptuneCluster() *schema.Resource {
@@ -230,6 +236,28 @@ func resourceAwsNeptuneCluster() *schema.Resource {
 				Optional: true,
 			},
 
+			"serverless_v2_scaling_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      128.0,
+							ValidateFunc: validation.FloatBetween(1.0, 128.0),
+						},
+						"min_capacity": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      2.5,
+							ValidateFunc: validation.FloatBetween(1.0, 128.0),
+						},
+					},
+				},
+			},
+
 			"storage_encrypted": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -262,9 +290,48 @@ func resourceAwsNeptuneCluster() *schema.Resource {
 				Optional: true,
 			},
 		},
+
+		CustomizeDiff: resourceAwsNeptuneClusterCustomizeDiff,
 	}
 }
 
+func resourceAwsNeptuneClusterCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("serverless_v2_scaling_configuration")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+
+	if minCapacity, maxCapacity := tfMap["min_capacity"].(float64), tfMap["max_capacity"].(float64); minCapacity > maxCapacity {
+		return fmt.Errorf("serverless_v2_scaling_configuration min_capacity (%g) must not be greater than max_capacity (%g)", minCapacity, maxCapacity)
+	}
+
+	// The engine version is only known at plan time when it is configured.
+	if !diff.NewValueKnown("engine_version") {
+		return nil
+	}
+
+	engineVersion := diff.Get("engine_version").(string)
+
+	if engineVersion == "" {
+		return nil
+	}
+
+	version, err := gversion.NewVersion(engineVersion)
+
+	if err != nil {
+		return fmt.Errorf("error parsing engine_version (%s): %w", engineVersion, err)
+	}
+
+	if version.LessThan(gversion.Must(gversion.NewVersion(neptuneServerlessMinimumEngineVersion))) {
+		return fmt.Errorf("serverless_v2_scaling_configuration requires engine_version %s or later, got %s", neptuneServerlessMinimumEngineVersion, engineVersion)
+	}
+
+	return nil
+}
+
 func resourceAwsNeptuneClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).neptuneconn
 	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().NeptuneTags()
@@ -358,6 +425,11 @@ func resourceAwsNeptuneClusterCreate(d *schema.ResourceData, meta interface{}) e
 		createDbClusterInput.ReplicationSourceIdentifier = aws.String(attr.(string))
 	}
 
+	if attr, ok := d.GetOk("serverless_v2_scaling_configuration"); ok && len(attr.([]interface{})) > 0 && attr.([]interface{})[0] != nil {
+		createDbClusterInput.ServerlessV2ScalingConfiguration = expandNeptuneServerlessV2ScalingConfiguration(attr.([]interface{})[0].(map[string]interface{}))
+		restoreDBClusterFromSnapshotInput.ServerlessV2ScalingConfiguration = expandNeptuneServerlessV2ScalingConfiguration(attr.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
 		createDbClusterInput.VpcSecurityGroupIds = expandStringList(attr.List())
 		if restoreDBClusterFromSnapshot {
@@ -500,6 +572,10 @@ func flattenAwsNeptuneClusterResource(d *schema.ResourceData, meta interface{},
 	d.Set("storage_encrypted", dbc.StorageEncrypted)
 	d.Set("deletion_protection", dbc.DeletionProtection)
 
+	if err := d.Set("serverless_v2_scaling_configuration", flattenNeptuneServerlessV2ScalingConfigurationInfo(dbc.ServerlessV2ScalingConfiguration)); err != nil {
+		return fmt.Errorf("error setting serverless_v2_scaling_configuration: %w", err)
+	}
+
 	var sg []string
 	for _, g := range dbc.VpcSecurityGroups {
 		sg = append(sg, aws.StringValue(g.VpcSecurityGroupId))
@@ -609,6 +685,13 @@ func resourceAwsNeptuneClusterUpdate(d *schema.ResourceData, meta interface{}) e
 		requestUpdate = true
 	}
 
+	if d.HasChange("serverless_v2_scaling_configuration") {
+		if v, ok := d.GetOk("serverless_v2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			req.ServerlessV2ScalingConfiguration = expandNeptuneServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			requestUpdate = true
+		}
+	}
+
 	if requestUpdate {
 		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 			_, err := conn.ModifyDBCluster(req)
@@ -822,3 +905,39 @@ var resourceAwsNeptuneClusterDeletePendingStates = []string{
 	"backing-up",
 	"modifying",
 }
+
+func expandNeptuneServerlessV2ScalingConfiguration(tfMap map[string]interface{}) *neptune.ServerlessV2ScalingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &neptune.ServerlessV2ScalingConfiguration{}
+
+	if v, ok := tfMap["max_capacity"].(float64); ok && v != 0.0 {
+		apiObject.MaxCapacity = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["min_capacity"].(float64); ok && v != 0.0 {
+		apiObject.MinCapacity = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func flattenNeptuneServerlessV2ScalingConfigurationInfo(apiObject *neptune.ServerlessV2ScalingConfigurationInfo) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaxCapacity; v != nil {
+		tfMap["max_capacity"] = aws.Float64Value(v)
+	}
+
+	if v := apiObject.MinCapacity; v != nil {
+		tfMap["min_capacity"] = aws.Float64Value(v)
+	}
+
+	return []interface{}{tfMap}
+}
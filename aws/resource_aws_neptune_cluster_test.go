@@ -447,6 +447,65 @@ func TestAccAWSNeptuneCluster_deleteProtection(t *testing.T) {
 	})
 }
 
+func TestAccAWSNeptuneCluster_serverlessConfiguration(t *testing.T) {
+	var dbCluster neptune.DBCluster
+	rName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_neptune_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterConfigServerlessConfiguration(rName, 1.0, 8.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.0.min_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.0.max_capacity", "8"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"cluster_identifier_prefix",
+					"final_snapshot_identifier",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccAWSNeptuneClusterConfigServerlessConfiguration(rName, 4.5, 12.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.0.min_capacity", "4.5"),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.0.max_capacity", "12.5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNeptuneCluster_serverlessConfigurationEngineVersion(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSNeptuneClusterConfigServerlessConfigurationEngineVersion(rName, "1.1.1.0"),
+				ExpectError: regexp.MustCompile(`requires engine_version 1.2 or later`),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSNeptuneClusterDestroy(s *terraform.State) error {
 	return testAccCheckAWSNeptuneClusterDestroyWithProvider(s, testAccProvider)
 }
@@ -977,3 +1036,41 @@ resource "aws_neptune_cluster" "test" {
 }
 `, rName))
 }
+
+func testAccAWSNeptuneClusterConfigServerlessConfiguration(rName string, minCapacity, maxCapacity float64) string {
+	return composeConfig(testAccAWSNeptuneClusterConfigBase(), fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier                   = %[1]q
+  availability_zones                   = local.availability_zone_names
+  engine                               = "neptune"
+  engine_version                       = "1.2.0.1"
+  neptune_cluster_parameter_group_name = "default.neptune1.2"
+  skip_final_snapshot                  = true
+  apply_immediately                    = true
+
+  serverless_v2_scaling_configuration {
+    min_capacity = %[2]g
+    max_capacity = %[3]g
+  }
+}
+
+resource "aws_neptune_cluster_instance" "test" {
+  cluster_identifier = aws_neptune_cluster.test.id
+  instance_class     = "db.serverless"
+}
+`, rName, minCapacity, maxCapacity))
+}
+
+func testAccAWSNeptuneClusterConfigServerlessConfigurationEngineVersion(rName, engineVersion string) string {
+	return composeConfig(testAccAWSNeptuneClusterConfigBase(), fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier  = %[1]q
+  availability_zones  = local.availability_zone_names
+  engine              = "neptune"
+  engine_version      = %[2]q
+  skip_final_snapshot = true
+
+  serverless_v2_scaling_configuration {}
+}
+`, rName, engineVersion))
+}
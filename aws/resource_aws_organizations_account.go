@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,10 +9,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/organizations/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsOrganizationsAccount() *schema.Resource {
@@ -21,7 +25,11 @@ func resourceAwsOrganizationsAccount() *schema.Resource {
 		Update: resourceAwsOrganizationsAccountUpdate,
 		Delete: resourceAwsOrganizationsAccountDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Set non API attributes to their Default settings in the schema
+				d.Set("close_on_deletion", false)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -29,6 +37,11 @@ func resourceAwsOrganizationsAccount() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"close_on_deletion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"joined_method": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -259,6 +272,10 @@ func resourceAwsOrganizationsAccountUpdate(d *schema.ResourceData, meta interfac
 func resourceAwsOrganizationsAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).organizationsconn
 
+	if d.Get("close_on_deletion").(bool) {
+		return resourceAwsOrganizationsAccountClose(conn, d.Id())
+	}
+
 	input := &organizations.RemoveAccountFromOrganizationInput{
 		AccountId: aws.String(d.Id()),
 	}
@@ -273,6 +290,62 @@ func resourceAwsOrganizationsAccountDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+// resourceAwsOrganizationsAccountClose closes a member account and waits for it
+// to be suspended. Closed accounts remain in the organization until AWS removes them.
+func resourceAwsOrganizationsAccountClose(conn *organizations.Organizations, id string) error {
+	input := &organizations.CloseAccountInput{
+		AccountId: aws.String(id),
+	}
+
+	log.Printf("[DEBUG] Closing AWS Organizations Account: %s", input)
+	err := resource.Retry(waiter.AccountClosedTimeout, func() *resource.RetryError {
+		_, err := conn.CloseAccount(input)
+
+		// Only a limited number of close requests may be in flight at once.
+		if isOrganizationsConstraintViolation(err, organizations.ConstraintViolationExceptionReasonCloseAccountRequestsLimitExceeded) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.CloseAccount(input)
+	}
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException) {
+		return nil
+	}
+
+	if isOrganizationsConstraintViolation(err, organizations.ConstraintViolationExceptionReasonCloseAccountQuotaExceeded) {
+		return fmt.Errorf("error closing AWS Organizations Account (%s): the close account quota has been reached, retry later or remove the account manually: %w", id, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error closing AWS Organizations Account (%s): %w", id, err)
+	}
+
+	if _, err := waiter.AccountClosed(conn, id); err != nil {
+		return fmt.Errorf("error waiting for AWS Organizations Account (%s) to close: %w", id, err)
+	}
+
+	return nil
+}
+
+func isOrganizationsConstraintViolation(err error, reason string) bool {
+	var constraintViolation *organizations.ConstraintViolationException
+
+	if errors.As(err, &constraintViolation) {
+		return aws.StringValue(constraintViolation.Reason) == reason
+	}
+
+	return false
+}
+
 // resourceAwsOrganizationsAccountStateRefreshFunc returns a resource.StateRefreshFunc
 // that is used to watch a CreateAccount request
 func resourceAwsOrganizationsAccountStateRefreshFunc(conn *organizations.Organizations, id string) resource.StateRefreshFunc {
@@ -5,10 +5,13 @@ import (
 	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/organizations/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func testAccAwsOrganizationsAccount_basic(t *testing.T) {
@@ -54,6 +57,45 @@ func testAccAwsOrganizationsAccount_basic(t *testing.T) {
 	})
 }
 
+func testAccAwsOrganizationsAccount_CloseOnDeletion(t *testing.T) {
+	TestAccSkip(t, "AWS Organizations Account testing is not currently automated due to the close account quota.")
+
+	var account organizations.Account
+
+	orgsEmailDomain, ok := os.LookupEnv("TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN")
+
+	if !ok {
+		TestAccSkip(t, "'TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN' not set, skipping test.")
+	}
+
+	rInt := acctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, orgsEmailDomain)
+	resourceName := "aws_organizations_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsAccountClosed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsAccountConfigCloseOnDeletion(name, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsAccountExists(resourceName, &account),
+					resource.TestCheckResourceAttr(resourceName, "close_on_deletion", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", organizations.AccountStatusActive),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"close_on_deletion"},
+			},
+		},
+	})
+}
+
 func testAccAwsOrganizationsAccount_ParentId(t *testing.T) {
 	TestAccSkip(t, "AWS Organizations Account testing is not currently automated due to manual account deletion steps.")
 
@@ -185,6 +227,34 @@ func testAccCheckAwsOrganizationsAccountDestroy(s *terraform.State) error {
 
 }
 
+// testAccCheckAwsOrganizationsAccountClosed verifies that closed accounts are suspended,
+// as they remain visible in the organization for up to 90 days after closure.
+func testAccCheckAwsOrganizationsAccountClosed(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_account" {
+			continue
+		}
+
+		account, err := finder.AccountByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(account.Status); status != organizations.AccountStatusSuspended {
+			return fmt.Errorf("AWS Organizations Account (%s) status is %s, expected %s", rs.Primary.ID, status, organizations.AccountStatusSuspended)
+		}
+	}
+
+	return nil
+}
+
 func testAccCheckAwsOrganizationsAccountExists(n string, a *organizations.Account) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -222,6 +292,16 @@ resource "aws_organizations_account" "test" {
 `, name, email)
 }
 
+func testAccAwsOrganizationsAccountConfigCloseOnDeletion(name, email string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_account" "test" {
+  name              = %[1]q
+  email             = %[2]q
+  close_on_deletion = true
+}
+`, name, email)
+}
+
 func testAccAwsOrganizationsAccountConfigParentId1(name, email string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {}
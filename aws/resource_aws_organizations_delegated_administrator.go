@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAwsOrganizationsDelegatedAdministrator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsDelegatedAdministratorCreate,
+		Read:   resourceAwsOrganizationsDelegatedAdministratorRead,
+		Delete: resourceAwsOrganizationsDelegatedAdministratorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delegation_enabled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"joined_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID := d.Get("account_id").(string)
+	servicePrincipal := d.Get("service_principal").(string)
+
+	input := &organizations.RegisterDelegatedAdministratorInput{
+		AccountId:        aws.String(accountID),
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	log.Printf("[DEBUG] Registering Organizations Delegated Administrator: %s", input)
+	_, err := conn.RegisterDelegatedAdministrator(input)
+
+	// Registration requires trusted access to be enabled for the service principal.
+	if isOrganizationsConstraintViolation(err, organizations.ConstraintViolationExceptionReasonServiceAccessNotEnabled) {
+		return fmt.Errorf("error registering Organizations Delegated Administrator (%s) for service principal (%s): ensure trusted access is enabled for the service principal, e.g. by adding it to aws_service_access_principals of the aws_organizations_organization resource: %w", accountID, servicePrincipal, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error registering Organizations Delegated Administrator (%s) for service principal (%s): %w", accountID, servicePrincipal, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, servicePrincipal))
+
+	return resourceAwsOrganizationsDelegatedAdministratorRead(d, meta)
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID, servicePrincipal, err := decodeAwsOrganizationsDelegatedAdministratorID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	delegatedAdministrator, err := getOrganizationsDelegatedAdministrator(conn, accountID, servicePrincipal)
+
+	if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
+		log.Printf("[WARN] Organizations Delegated Administrator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Organizations Delegated Administrator (%s): %w", d.Id(), err)
+	}
+
+	if delegatedAdministrator == nil {
+		log.Printf("[WARN] Organizations Delegated Administrator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", delegatedAdministrator.Arn)
+	d.Set("delegation_enabled_date", aws.TimeValue(delegatedAdministrator.DelegationEnabledDate).Format(time.RFC3339))
+	d.Set("email", delegatedAdministrator.Email)
+	d.Set("joined_method", delegatedAdministrator.JoinedMethod)
+	d.Set("joined_timestamp", aws.TimeValue(delegatedAdministrator.JoinedTimestamp).Format(time.RFC3339))
+	d.Set("name", delegatedAdministrator.Name)
+	d.Set("service_principal", servicePrincipal)
+	d.Set("status", delegatedAdministrator.Status)
+
+	return nil
+}
+
+func resourceAwsOrganizationsDelegatedAdministratorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID, servicePrincipal, err := decodeAwsOrganizationsDelegatedAdministratorID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &organizations.DeregisterDelegatedAdministratorInput{
+		AccountId:        aws.String(accountID),
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	log.Printf("[DEBUG] Deregistering Organizations Delegated Administrator: %s", d.Id())
+	_, err = conn.DeregisterDelegatedAdministrator(input)
+
+	if isAWSErr(err, organizations.ErrCodeAccountNotRegisteredException, "") {
+		return nil
+	}
+
+	if isAWSErr(err, organizations.ErrCodeAccountNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering Organizations Delegated Administrator (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getOrganizationsDelegatedAdministrator(conn *organizations.Organizations, accountID, servicePrincipal string) (*organizations.DelegatedAdministrator, error) {
+	input := &organizations.ListDelegatedAdministratorsInput{
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+	var result *organizations.DelegatedAdministrator
+
+	err := conn.ListDelegatedAdministratorsPages(input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, delegatedAdministrator := range page.DelegatedAdministrators {
+			if delegatedAdministrator == nil {
+				continue
+			}
+
+			if aws.StringValue(delegatedAdministrator.Id) == accountID {
+				result = delegatedAdministrator
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func decodeAwsOrganizationsDelegatedAdministratorID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in format of ACCOUNTID/SERVICEPRINCIPAL, received: %s", id)
+	}
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccAwsOrganizationsDelegatedAdministrator_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var delegatedAdministrator organizations.DelegatedAdministrator
+	resourceName := "aws_organizations_delegated_administrator.test"
+	servicePrincipal := "config-multiaccountsetup.amazonaws.com"
+	dataSourceIdentity := "data.aws_caller_identity.delegated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccOrganizationsEnabledPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsOrganizationsDelegatedAdministratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsDelegatedAdministratorConfig(servicePrincipal),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsDelegatedAdministratorExists(resourceName, &delegatedAdministrator),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceIdentity, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "service_principal", servicePrincipal),
+					testAccCheckResourceAttrRfc3339(resourceName, "delegation_enabled_date"),
+					testAccCheckResourceAttrRfc3339(resourceName, "joined_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "status", organizations.AccountStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsOrganizationsDelegatedAdministrator_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	var delegatedAdministrator organizations.DelegatedAdministrator
+	resourceName := "aws_organizations_delegated_administrator.test"
+	servicePrincipal := "config-multiaccountsetup.amazonaws.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+			testAccOrganizationsEnabledPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsOrganizationsDelegatedAdministratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsDelegatedAdministratorConfig(servicePrincipal),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsDelegatedAdministratorExists(resourceName, &delegatedAdministrator),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsOrganizationsDelegatedAdministrator(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsDelegatedAdministratorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_delegated_administrator" {
+			continue
+		}
+
+		accountID, servicePrincipal, err := decodeAwsOrganizationsDelegatedAdministratorID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		delegatedAdministrator, err := getOrganizationsDelegatedAdministrator(conn, accountID, servicePrincipal)
+
+		if err != nil {
+			return err
+		}
+
+		if delegatedAdministrator == nil {
+			continue
+		}
+
+		return fmt.Errorf("expected Organizations Delegated Administrator (%s) to be removed", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsDelegatedAdministratorExists(resourceName string, delegatedAdministrator *organizations.DelegatedAdministrator) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+		accountID, servicePrincipal, err := decodeAwsOrganizationsDelegatedAdministratorID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := getOrganizationsDelegatedAdministrator(conn, accountID, servicePrincipal)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Organizations Delegated Administrator (%s) not found", rs.Primary.ID)
+		}
+
+		*delegatedAdministrator = *output
+
+		return nil
+	}
+}
+
+func testAccAwsOrganizationsDelegatedAdministratorConfig(servicePrincipal string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "delegated" {
+  provider = "awsalternate"
+}
+
+resource "aws_organizations_delegated_administrator" "test" {
+  account_id        = data.aws_caller_identity.delegated.account_id
+  service_principal = %[1]q
+}
+`, servicePrincipal)
+}
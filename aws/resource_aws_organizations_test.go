@@ -16,9 +16,15 @@ func TestAccAWSOrganizations_serial(t *testing.T) {
 			"DataSource":                 testAccDataSourceAwsOrganizationsOrganization_basic,
 		},
 		"Account": {
-			"basic":    testAccAwsOrganizationsAccount_basic,
-			"ParentId": testAccAwsOrganizationsAccount_ParentId,
-			"Tags":     testAccAwsOrganizationsAccount_Tags,
+			"basic":           testAccAwsOrganizationsAccount_basic,
+			"CloseOnDeletion": testAccAwsOrganizationsAccount_CloseOnDeletion,
+			"ParentId":        testAccAwsOrganizationsAccount_ParentId,
+			"Tags":            testAccAwsOrganizationsAccount_Tags,
+		},
+		"DelegatedAdministrator": {
+			"basic":      testAccAwsOrganizationsDelegatedAdministrator_basic,
+			"disappears": testAccAwsOrganizationsDelegatedAdministrator_disappears,
+			"DataSource": testAccDataSourceAwsOrganizationsDelegatedAdministrators_basic,
 		},
 		"OrganizationalUnit": {
 			"basic": testAccAwsOrganizationsOrganizationalUnit_basic,
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -24,6 +25,8 @@ func resourceAwsPlacementGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsPlacementGroupCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -34,6 +37,13 @@ func resourceAwsPlacementGroup() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"partition_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 7),
+			},
 			"strategy": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -48,6 +58,13 @@ func resourceAwsPlacementGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"spread_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.SpreadLevel_Values(), false),
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -62,6 +79,15 @@ func resourceAwsPlacementGroupCreate(d *schema.ResourceData, meta interface{}) e
 		Strategy:          aws.String(d.Get("strategy").(string)),
 		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypePlacementGroup),
 	}
+
+	if v, ok := d.GetOk("partition_count"); ok {
+		input.PartitionCount = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("spread_level"); ok {
+		input.SpreadLevel = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] Creating EC2 Placement group: %s", input)
 	_, err := conn.CreatePlacementGroup(&input)
 	if err != nil {
@@ -130,8 +156,10 @@ func resourceAwsPlacementGroupRead(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[DEBUG] Received EC2 Placement Group: %s", pg)
 
 	d.Set("name", pg.GroupName)
+	d.Set("partition_count", pg.PartitionCount)
 	d.Set("strategy", pg.Strategy)
 	d.Set("placement_group_id", pg.GroupId)
+	d.Set("spread_level", pg.SpreadLevel)
 	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(pg.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
@@ -209,3 +237,23 @@ func resourceAwsPlacementGroupDelete(d *schema.ResourceData, meta interface{}) e
 	_, err = wait.WaitForState()
 	return err
 }
+
+func resourceAwsPlacementGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	// Only validate on creation, as the API populates partition_count and
+	// spread_level for existing placement groups.
+	if diff.Id() != "" {
+		return nil
+	}
+
+	strategy := diff.Get("strategy").(string)
+
+	if v, ok := diff.GetOk("partition_count"); ok && v.(int) > 0 && strategy != ec2.PlacementStrategyPartition {
+		return fmt.Errorf("partition_count can only be set when strategy is %q", ec2.PlacementStrategyPartition)
+	}
+
+	if v, ok := diff.GetOk("spread_level"); ok && v.(string) != "" && strategy != ec2.PlacementStrategySpread {
+		return fmt.Errorf("spread_level can only be set when strategy is %q", ec2.PlacementStrategySpread)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -104,6 +105,94 @@ func TestAccAWSPlacementGroup_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAWSPlacementGroup_PartitionCount(t *testing.T) {
+	var pg ec2.PlacementGroup
+	resourceName := "aws_placement_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPlacementGroupConfigPartitionCount(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPlacementGroupExists(resourceName, &pg),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "partition_count", "7"),
+					resource.TestCheckResourceAttr(resourceName, "strategy", "partition"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPlacementGroup_PartitionCount_InvalidStrategy(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSPlacementGroupConfigPartitionCountInvalidStrategy(rName),
+				ExpectError: regexp.MustCompile(`partition_count can only be set when strategy is "partition"`),
+			},
+		},
+	})
+}
+
+func TestAccAWSPlacementGroup_SpreadLevel(t *testing.T) {
+	var pg ec2.PlacementGroup
+	resourceName := "aws_placement_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSOutpostsOutposts(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPlacementGroupConfigSpreadLevel(rName, "host"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPlacementGroupExists(resourceName, &pg),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "spread_level", "host"),
+					resource.TestCheckResourceAttr(resourceName, "strategy", "spread"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPlacementGroup_SpreadLevel_InvalidStrategy(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSPlacementGroupConfigSpreadLevelInvalidStrategy(rName),
+				ExpectError: regexp.MustCompile(`spread_level can only be set when strategy is "spread"`),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSPlacementGroupDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -190,3 +279,43 @@ resource "aws_placement_group" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccAWSPlacementGroupConfigPartitionCount(rName string, partitionCount int) string {
+	return fmt.Sprintf(`
+resource "aws_placement_group" "test" {
+  name            = %[1]q
+  partition_count = %[2]d
+  strategy        = "partition"
+}
+`, rName, partitionCount)
+}
+
+func testAccAWSPlacementGroupConfigPartitionCountInvalidStrategy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_placement_group" "test" {
+  name            = %[1]q
+  partition_count = 2
+  strategy        = "cluster"
+}
+`, rName)
+}
+
+func testAccAWSPlacementGroupConfigSpreadLevel(rName, spreadLevel string) string {
+	return fmt.Sprintf(`
+resource "aws_placement_group" "test" {
+  name         = %[1]q
+  spread_level = %[2]q
+  strategy     = "spread"
+}
+`, rName, spreadLevel)
+}
+
+func testAccAWSPlacementGroupConfigSpreadLevelInvalidStrategy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_placement_group" "test" {
+  name         = %[1]q
+  spread_level = "rack"
+  strategy     = "partition"
+}
+`, rName)
+}
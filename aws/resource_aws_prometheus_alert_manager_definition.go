@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice/waiter"
+)
+
+func resourceAwsPrometheusAlertManagerDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPrometheusAlertManagerDefinitionCreate,
+		Read:   resourceAwsPrometheusAlertManagerDefinitionRead,
+		Update: resourceAwsPrometheusAlertManagerDefinitionUpdate,
+		Delete: resourceAwsPrometheusAlertManagerDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsPrometheusAlertManagerDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+
+	workspaceID := d.Get("workspace_id").(string)
+	input := &prometheusservice.CreateAlertManagerDefinitionInput{
+		Data:        []byte(d.Get("definition").(string)),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	log.Printf("[DEBUG] Creating Prometheus Alert Manager Definition: %s", input)
+	_, err := conn.CreateAlertManagerDefinition(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Prometheus Alert Manager Definition (%s): %w", workspaceID, err)
+	}
+
+	d.SetId(workspaceID)
+
+	if _, err := waiter.AlertManagerDefinitionCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Prometheus Alert Manager Definition (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsPrometheusAlertManagerDefinitionRead(d, meta)
+}
+
+func resourceAwsPrometheusAlertManagerDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+
+	alertManagerDefinition, err := finder.AlertManagerDefinitionByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Prometheus Alert Manager Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Prometheus Alert Manager Definition (%s): %w", d.Id(), err)
+	}
+
+	if alertManagerDefinition == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Prometheus Alert Manager Definition (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Prometheus Alert Manager Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("definition", string(alertManagerDefinition.Data))
+	d.Set("workspace_id", d.Id())
+
+	return nil
+}
+
+func resourceAwsPrometheusAlertManagerDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+
+	input := &prometheusservice.PutAlertManagerDefinitionInput{
+		Data:        []byte(d.Get("definition").(string)),
+		WorkspaceId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Updating Prometheus Alert Manager Definition: %s", input)
+	if _, err := conn.PutAlertManagerDefinition(input); err != nil {
+		return fmt.Errorf("error updating Prometheus Alert Manager Definition (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.AlertManagerDefinitionUpdated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Prometheus Alert Manager Definition (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceAwsPrometheusAlertManagerDefinitionRead(d, meta)
+}
+
+func resourceAwsPrometheusAlertManagerDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+
+	log.Printf("[DEBUG] Deleting Prometheus Alert Manager Definition: %s", d.Id())
+	_, err := conn.DeleteAlertManagerDefinition(&prometheusservice.DeleteAlertManagerDefinitionInput{
+		WorkspaceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Prometheus Alert Manager Definition (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.AlertManagerDefinitionDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Prometheus Alert Manager Definition (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
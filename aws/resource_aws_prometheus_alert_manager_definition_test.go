@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice/finder"
+)
+
+func TestAccAWSPrometheusAlertManagerDefinition_basic(t *testing.T) {
+	resourceName := "aws_prometheus_alert_manager_definition.test"
+	workspaceResourceName := "aws_prometheus_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSPrometheus(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPrometheusAlertManagerDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPrometheusAlertManagerDefinitionConfig(testAccAWSPrometheusAlertManagerDefinitionDefault),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusAlertManagerDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition", testAccAWSPrometheusAlertManagerDefinitionDefault),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", workspaceResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSPrometheusAlertManagerDefinitionConfig(testAccAWSPrometheusAlertManagerDefinitionUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusAlertManagerDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition", testAccAWSPrometheusAlertManagerDefinitionUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSPrometheusAlertManagerDefinition_disappears(t *testing.T) {
+	resourceName := "aws_prometheus_alert_manager_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSPrometheus(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPrometheusAlertManagerDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPrometheusAlertManagerDefinitionConfig(testAccAWSPrometheusAlertManagerDefinitionDefault),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusAlertManagerDefinitionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsPrometheusAlertManagerDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPrometheusAlertManagerDefinitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).prometheusserviceconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_prometheus_alert_manager_definition" {
+			continue
+		}
+
+		output, err := finder.AlertManagerDefinitionByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Prometheus Alert Manager Definition (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSPrometheusAlertManagerDefinitionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).prometheusserviceconn
+
+		output, err := finder.AlertManagerDefinitionByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Prometheus Alert Manager Definition (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccAWSPrometheusAlertManagerDefinitionDefault = `alertmanager_config: |
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`
+
+const testAccAWSPrometheusAlertManagerDefinitionUpdated = `alertmanager_config: |
+  route:
+    receiver: 'default'
+    group_wait: 30s
+  receivers:
+    - name: 'default'
+`
+
+func testAccAWSPrometheusAlertManagerDefinitionConfig(definition string) string {
+	return fmt.Sprintf(`
+resource "aws_prometheus_workspace" "test" {}
+
+resource "aws_prometheus_alert_manager_definition" "test" {
+  workspace_id = aws_prometheus_workspace.test.id
+  definition   = <<EOT
+%[1]sEOT
+}
+`, definition)
+}
@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfprometheusservice "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice/waiter"
+)
+
+func resourceAwsPrometheusRuleGroupNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPrometheusRuleGroupNamespaceCreate,
+		Read:   resourceAwsPrometheusRuleGroupNamespaceRead,
+		Update: resourceAwsPrometheusRuleGroupNamespaceUpdate,
+		Delete: resourceAwsPrometheusRuleGroupNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsPrometheusRuleGroupNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+
+	workspaceID := d.Get("workspace_id").(string)
+	name := d.Get("name").(string)
+	input := &prometheusservice.CreateRuleGroupsNamespaceInput{
+		Data:        []byte(d.Get("data").(string)),
+		Name:        aws.String(name),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	log.Printf("[DEBUG] Creating Prometheus Rule Group Namespace: %s", input)
+	_, err := conn.CreateRuleGroupsNamespace(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Prometheus Workspace (%s) Rule Group Namespace (%s): %w", workspaceID, name, err)
+	}
+
+	d.SetId(tfprometheusservice.RuleGroupNamespaceCreateID(workspaceID, name))
+
+	if _, err := waiter.RuleGroupNamespaceCreated(conn, workspaceID, name); err != nil {
+		return fmt.Errorf("error waiting for Prometheus Rule Group Namespace (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsPrometheusRuleGroupNamespaceRead(d, meta)
+}
+
+func resourceAwsPrometheusRuleGroupNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+
+	workspaceID, name, err := tfprometheusservice.RuleGroupNamespaceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	ruleGroupNamespace, err := finder.RuleGroupNamespaceByName(conn, workspaceID, name)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Prometheus Rule Group Namespace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Prometheus Rule Group Namespace (%s): %w", d.Id(), err)
+	}
+
+	if ruleGroupNamespace == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Prometheus Rule Group Namespace (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Prometheus Rule Group Namespace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", ruleGroupNamespace.Arn)
+	d.Set("data", string(ruleGroupNamespace.Data))
+	d.Set("name", ruleGroupNamespace.Name)
+	d.Set("workspace_id", workspaceID)
+
+	return nil
+}
+
+func resourceAwsPrometheusRuleGroupNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+
+	workspaceID, name, err := tfprometheusservice.RuleGroupNamespaceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &prometheusservice.PutRuleGroupsNamespaceInput{
+		Data:        []byte(d.Get("data").(string)),
+		Name:        aws.String(name),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	log.Printf("[DEBUG] Updating Prometheus Rule Group Namespace: %s", input)
+	if _, err := conn.PutRuleGroupsNamespace(input); err != nil {
+		return fmt.Errorf("error updating Prometheus Rule Group Namespace (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.RuleGroupNamespaceUpdated(conn, workspaceID, name); err != nil {
+		return fmt.Errorf("error waiting for Prometheus Rule Group Namespace (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceAwsPrometheusRuleGroupNamespaceRead(d, meta)
+}
+
+func resourceAwsPrometheusRuleGroupNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+
+	workspaceID, name, err := tfprometheusservice.RuleGroupNamespaceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Prometheus Rule Group Namespace: %s", d.Id())
+	_, err = conn.DeleteRuleGroupsNamespace(&prometheusservice.DeleteRuleGroupsNamespaceInput{
+		Name:        aws.String(name),
+		WorkspaceId: aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Prometheus Rule Group Namespace (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.RuleGroupNamespaceDeleted(conn, workspaceID, name); err != nil {
+		return fmt.Errorf("error waiting for Prometheus Rule Group Namespace (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
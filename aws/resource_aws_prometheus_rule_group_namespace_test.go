@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfprometheusservice "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice/finder"
+)
+
+func TestAccAWSPrometheusRuleGroupNamespace_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_prometheus_rule_group_namespace.test"
+	workspaceResourceName := "aws_prometheus_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSPrometheus(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPrometheusRuleGroupNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPrometheusRuleGroupNamespaceConfig(rName, testAccAWSPrometheusRuleGroupNamespaceDefault),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusRuleGroupNamespaceExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "aps", regexp.MustCompile(fmt.Sprintf(`rulegroupsnamespace/.+/%s`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "data", testAccAWSPrometheusRuleGroupNamespaceDefault),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", workspaceResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSPrometheusRuleGroupNamespaceConfig(rName, testAccAWSPrometheusRuleGroupNamespaceUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusRuleGroupNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data", testAccAWSPrometheusRuleGroupNamespaceUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSPrometheusRuleGroupNamespace_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_prometheus_rule_group_namespace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSPrometheus(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPrometheusRuleGroupNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPrometheusRuleGroupNamespaceConfig(rName, testAccAWSPrometheusRuleGroupNamespaceDefault),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusRuleGroupNamespaceExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsPrometheusRuleGroupNamespace(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPrometheusRuleGroupNamespace_InvalidData(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSPrometheus(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPrometheusRuleGroupNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSPrometheusRuleGroupNamespaceConfig(rName, testAccAWSPrometheusRuleGroupNamespaceInvalid),
+				ExpectError: regexp.MustCompile(`error (creating|waiting for) Prometheus`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPrometheusRuleGroupNamespaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).prometheusserviceconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_prometheus_rule_group_namespace" {
+			continue
+		}
+
+		workspaceID, name, err := tfprometheusservice.RuleGroupNamespaceParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.RuleGroupNamespaceByName(conn, workspaceID, name)
+
+		if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Prometheus Rule Group Namespace (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSPrometheusRuleGroupNamespaceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		workspaceID, name, err := tfprometheusservice.RuleGroupNamespaceParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).prometheusserviceconn
+
+		output, err := finder.RuleGroupNamespaceByName(conn, workspaceID, name)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Prometheus Rule Group Namespace (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccAWSPrometheusRuleGroupNamespaceDefault = `groups:
+  - name: test
+    rules:
+      - record: metric:recording_rule
+        expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+`
+
+const testAccAWSPrometheusRuleGroupNamespaceUpdated = `groups:
+  - name: test
+    rules:
+      - record: metric:recording_rule
+        expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+  - name: test2
+    rules:
+      - record: metric:recording_rule
+        expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+`
+
+const testAccAWSPrometheusRuleGroupNamespaceInvalid = `groups:
+  - name: test
+    rules:
+      - record: metric:recording_rule
+        expr: avg(rate(
+`
+
+func testAccAWSPrometheusRuleGroupNamespaceConfig(rName, data string) string {
+	return fmt.Sprintf(`
+resource "aws_prometheus_workspace" "test" {}
+
+resource "aws_prometheus_rule_group_namespace" "test" {
+  name         = %[1]q
+  workspace_id = aws_prometheus_workspace.test.id
+  data         = <<EOT
+%[2]sEOT
+}
+`, rName, data)
+}
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice/waiter"
+)
+
+func resourceAwsPrometheusWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPrometheusWorkspaceCreate,
+		Read:   resourceAwsPrometheusWorkspaceRead,
+		Update: resourceAwsPrometheusWorkspaceUpdate,
+		Delete: resourceAwsPrometheusWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"prometheus_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsPrometheusWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+
+	input := &prometheusservice.CreateWorkspaceInput{}
+
+	if v, ok := d.GetOk("alias"); ok {
+		input.Alias = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().PrometheusserviceTags()
+	}
+
+	log.Printf("[DEBUG] Creating Prometheus Workspace: %s", input)
+	output, err := conn.CreateWorkspace(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Prometheus Workspace: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.WorkspaceId))
+
+	if _, err := waiter.WorkspaceCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Prometheus Workspace (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsPrometheusWorkspaceRead(d, meta)
+}
+
+func resourceAwsPrometheusWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	workspace, err := finder.WorkspaceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Prometheus Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Prometheus Workspace (%s): %w", d.Id(), err)
+	}
+
+	if workspace == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Prometheus Workspace (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Prometheus Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("alias", workspace.Alias)
+	d.Set("arn", workspace.Arn)
+	d.Set("prometheus_endpoint", workspace.PrometheusEndpoint)
+
+	if err := d.Set("tags", keyvaluetags.PrometheusserviceKeyValueTags(workspace.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsPrometheusWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+
+	if d.HasChange("alias") {
+		input := &prometheusservice.UpdateWorkspaceAliasInput{
+			WorkspaceId: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("alias"); ok {
+			input.Alias = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Prometheus Workspace: %s", input)
+		if _, err := conn.UpdateWorkspaceAlias(input); err != nil {
+			return fmt.Errorf("error updating Prometheus Workspace (%s) alias: %w", d.Id(), err)
+		}
+
+		if _, err := waiter.WorkspaceUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Prometheus Workspace (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.PrometheusserviceUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Prometheus Workspace (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsPrometheusWorkspaceRead(d, meta)
+}
+
+func resourceAwsPrometheusWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).prometheusserviceconn
+
+	log.Printf("[DEBUG] Deleting Prometheus Workspace: %s", d.Id())
+	_, err := conn.DeleteWorkspace(&prometheusservice.DeleteWorkspaceInput{
+		WorkspaceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Prometheus Workspace (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.WorkspaceDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Prometheus Workspace (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
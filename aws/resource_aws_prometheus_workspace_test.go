@@ -0,0 +1,312 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/prometheusservice/waiter"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_prometheus_workspace", &resource.Sweeper{
+		Name: "aws_prometheus_workspace",
+		F:    testSweepPrometheusWorkspaces,
+	})
+}
+
+func testSweepPrometheusWorkspaces(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).prometheusserviceconn
+	input := &prometheusservice.ListWorkspacesInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListWorkspacesPages(input, func(page *prometheusservice.ListWorkspacesOutput, lastPage bool) bool {
+		for _, workspace := range page.Workspaces {
+			id := aws.StringValue(workspace.WorkspaceId)
+
+			log.Printf("[INFO] Deleting Prometheus Workspace: %s", id)
+			_, err := conn.DeleteWorkspace(&prometheusservice.DeleteWorkspaceInput{
+				WorkspaceId: aws.String(id),
+			})
+
+			if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting Prometheus Workspace (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+
+			if _, err := waiter.WorkspaceDeleted(conn, id); err != nil {
+				sweeperErr := fmt.Errorf("error waiting for Prometheus Workspace (%s) deletion: %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Prometheus Workspace sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Prometheus Workspaces: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSPrometheusWorkspace_basic(t *testing.T) {
+	resourceName := "aws_prometheus_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSPrometheus(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPrometheusWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPrometheusWorkspaceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alias", ""),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "aps", regexp.MustCompile(`workspace/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "prometheus_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPrometheusWorkspace_disappears(t *testing.T) {
+	resourceName := "aws_prometheus_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSPrometheus(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPrometheusWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPrometheusWorkspaceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusWorkspaceExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsPrometheusWorkspace(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPrometheusWorkspace_Alias(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_prometheus_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSPrometheus(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPrometheusWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPrometheusWorkspaceConfigAlias(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alias", rName1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSPrometheusWorkspaceConfigAlias(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alias", rName2),
+				),
+			},
+			{
+				Config: testAccAWSPrometheusWorkspaceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alias", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSPrometheusWorkspace_Tags(t *testing.T) {
+	resourceName := "aws_prometheus_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSPrometheus(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPrometheusWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPrometheusWorkspaceConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSPrometheusWorkspaceConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSPrometheusWorkspaceConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPrometheusWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSPrometheus(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).prometheusserviceconn
+
+	input := &prometheusservice.ListWorkspacesInput{
+		MaxResults: aws.Int64(1),
+	}
+
+	_, err := conn.ListWorkspaces(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAWSPrometheusWorkspaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).prometheusserviceconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_prometheus_workspace" {
+			continue
+		}
+
+		output, err := finder.WorkspaceByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Prometheus Workspace (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSPrometheusWorkspaceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).prometheusserviceconn
+
+		output, err := finder.WorkspaceByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Prometheus Workspace (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSPrometheusWorkspaceConfigBasic() string {
+	return `
+resource "aws_prometheus_workspace" "test" {}
+`
+}
+
+func testAccAWSPrometheusWorkspaceConfigAlias(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_prometheus_workspace" "test" {
+  alias = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSPrometheusWorkspaceConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_prometheus_workspace" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSPrometheusWorkspaceConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_prometheus_workspace" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
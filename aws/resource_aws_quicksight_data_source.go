@@ -0,0 +1,1209 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/waiter"
+)
+
+// quickSightDataSourceParameterKeys lists the mutually exclusive engine
+// specific blocks within the parameters configuration block.
+var quickSightDataSourceParameterKeys = []string{
+	"parameters.0.amazon_elasticsearch",
+	"parameters.0.athena",
+	"parameters.0.aurora",
+	"parameters.0.aurora_postgresql",
+	"parameters.0.aws_iot_analytics",
+	"parameters.0.jira",
+	"parameters.0.maria_db",
+	"parameters.0.mysql",
+	"parameters.0.postgresql",
+	"parameters.0.presto",
+	"parameters.0.rds",
+	"parameters.0.redshift",
+	"parameters.0.s3",
+	"parameters.0.service_now",
+	"parameters.0.snowflake",
+	"parameters.0.spark",
+	"parameters.0.sql_server",
+	"parameters.0.teradata",
+	"parameters.0.twitter",
+}
+
+func resourceAwsQuickSightDataSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsQuickSightDataSourceCreate,
+		Read:   resourceAwsQuickSightDataSourceRead,
+		Update: resourceAwsQuickSightDataSourceUpdate,
+		Delete: resourceAwsQuickSightDataSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+
+			"credentials": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"copy_source_arn": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ValidateFunc:  validateArn,
+							ConflictsWith: []string{"credentials.0.credential_pair"},
+						},
+						"credential_pair": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+										ValidateFunc: validation.All(
+											validation.NoZeroValues,
+											validation.StringLenBetween(1, 1024),
+										),
+									},
+									"username": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+										ValidateFunc: validation.All(
+											validation.NoZeroValues,
+											validation.StringLenBetween(1, 64),
+										),
+									},
+								},
+							},
+							ConflictsWith: []string{"credentials.0.copy_source_arn"},
+						},
+					},
+				},
+			},
+
+			"data_source_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amazon_elasticsearch": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"domain": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"athena": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"work_group": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"aurora":            quickSightDataSourceDatabaseHostPortSchema(),
+						"aurora_postgresql": quickSightDataSourceDatabaseHostPortSchema(),
+						"aws_iot_analytics": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_set_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"jira": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"site_base_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"maria_db":   quickSightDataSourceDatabaseHostPortSchema(),
+						"mysql":      quickSightDataSourceDatabaseHostPortSchema(),
+						"postgresql": quickSightDataSourceDatabaseHostPortSchema(),
+						"presto": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 128),
+									},
+									"host": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"rds": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"instance_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"redshift": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cluster_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"database": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"host": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"s3": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"manifest_file_location": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.NoZeroValues,
+												},
+												"key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.NoZeroValues,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_now": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"site_base_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"snowflake": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"host": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"warehouse": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"spark": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"sql_server": quickSightDataSourceDatabaseHostPortSchema(),
+						"teradata":   quickSightDataSourceDatabaseHostPortSchema(),
+						"twitter": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: quickSightDataSourceParameterKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_rows": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 64,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 16,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"principal": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+
+			"ssl_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disable_ssl": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.DataSourceType_Values(), false),
+			},
+
+			"vpc_connection_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_connection_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// quickSightDataSourceDatabaseHostPortSchema returns the schema shared by the
+// engines that are addressed by a database, host and port.
+func quickSightDataSourceDatabaseHostPortSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: quickSightDataSourceParameterKeys,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"host": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightDataSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	dataSourceID := d.Get("data_source_id").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	input := &quicksight.CreateDataSourceInput{
+		AwsAccountId:         aws.String(awsAccountID),
+		DataSourceId:         aws.String(dataSourceID),
+		DataSourceParameters: expandQuickSightDataSourceParameters(d.Get("parameters").([]interface{})),
+		Name:                 aws.String(d.Get("name").(string)),
+		Type:                 aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("credentials"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Credentials = expandQuickSightDataSourceCredentials(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("ssl_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SslProperties = expandQuickSightDataSourceSslProperties(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().QuicksightTags()
+	}
+
+	if v, ok := d.GetOk("vpc_connection_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VpcConnectionProperties = expandQuickSightDataSourceVpcConnectionProperties(v.([]interface{}))
+	}
+
+	_, err := conn.CreateDataSource(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating QuickSight Data Source (%s): %w", dataSourceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", awsAccountID, dataSourceID))
+
+	if _, err := waiter.DataSourceCreated(conn, awsAccountID, dataSourceID); err != nil {
+		return fmt.Errorf("error waiting for QuickSight Data Source (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsQuickSightDataSourceRead(d, meta)
+}
+
+func resourceAwsQuickSightDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeDataSource(&quicksight.DescribeDataSourceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	})
+
+	if !d.IsNewResource() && isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] QuickSight Data Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error describing QuickSight Data Source (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.DataSource == nil {
+		return fmt.Errorf("error describing QuickSight Data Source (%s): empty output", d.Id())
+	}
+
+	dataSource := output.DataSource
+
+	d.Set("arn", dataSource.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("data_source_id", dataSource.DataSourceId)
+	d.Set("name", dataSource.Name)
+	d.Set("type", dataSource.Type)
+
+	// Credentials are never returned by the API, so the configured
+	// credentials block is intentionally left untouched.
+
+	if err := d.Set("parameters", flattenQuickSightDataSourceParameters(dataSource.DataSourceParameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %w", err)
+	}
+
+	if err := d.Set("ssl_properties", flattenQuickSightDataSourceSslProperties(dataSource.SslProperties)); err != nil {
+		return fmt.Errorf("error setting ssl_properties: %w", err)
+	}
+
+	if err := d.Set("vpc_connection_properties", flattenQuickSightDataSourceVpcConnectionProperties(dataSource.VpcConnectionProperties)); err != nil {
+		return fmt.Errorf("error setting vpc_connection_properties: %w", err)
+	}
+
+	tags, err := keyvaluetags.QuicksightListTags(conn, aws.StringValue(dataSource.Arn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for QuickSight Data Source (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	permsOutput, err := conn.DescribeDataSourcePermissions(&quicksight.DescribeDataSourcePermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error describing QuickSight Data Source (%s) Permissions: %w", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permsOutput.Permissions)); err != nil {
+		return fmt.Errorf("error setting permission: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDataSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChangesExcept("permission", "tags") {
+		input := &quicksight.UpdateDataSourceInput{
+			AwsAccountId:         aws.String(awsAccountID),
+			DataSourceId:         aws.String(dataSourceID),
+			DataSourceParameters: expandQuickSightDataSourceParameters(d.Get("parameters").([]interface{})),
+			Name:                 aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("credentials"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Credentials = expandQuickSightDataSourceCredentials(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("ssl_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SslProperties = expandQuickSightDataSourceSslProperties(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("vpc_connection_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.VpcConnectionProperties = expandQuickSightDataSourceVpcConnectionProperties(v.([]interface{}))
+		}
+
+		_, err = conn.UpdateDataSource(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating QuickSight Data Source (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.DataSourceUpdated(conn, awsAccountID, dataSourceID); err != nil {
+			return fmt.Errorf("error waiting for QuickSight Data Source (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		toGrant, toRevoke := diffQuickSightPermissionsToGrantAndRevoke(os.List(), ns.List())
+
+		input := &quicksight.UpdateDataSourcePermissionsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSourceId: aws.String(dataSourceID),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateDataSourcePermissions(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating QuickSight Data Source (%s) permissions: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.QuicksightUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating QuickSight Data Source (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsQuickSightDataSourceRead(d, meta)
+}
+
+func resourceAwsQuickSightDataSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, err := resourceAwsQuickSightDataSourceParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.DeleteDataSource(&quicksight.DeleteDataSourceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	})
+
+	if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting QuickSight Data Source (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDataSourceParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DATA_SOURCE_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func expandQuickSightDataSourceCredentials(tfList []interface{}) *quicksight.DataSourceCredentials {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	credentials := &quicksight.DataSourceCredentials{}
+
+	if v, ok := tfMap["copy_source_arn"].(string); ok && v != "" {
+		credentials.CopySourceArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["credential_pair"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if m, ok := v[0].(map[string]interface{}); ok {
+			credentials.CredentialPair = &quicksight.CredentialPair{
+				Password: aws.String(m["password"].(string)),
+				Username: aws.String(m["username"].(string)),
+			}
+		}
+	}
+
+	return credentials
+}
+
+func expandQuickSightDataSourceParameters(tfList []interface{}) *quicksight.DataSourceParameters {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	params := &quicksight.DataSourceParameters{}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "amazon_elasticsearch"); m != nil {
+		params.AmazonElasticsearchParameters = &quicksight.AmazonElasticsearchParameters{
+			Domain: aws.String(m["domain"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "athena"); m != nil {
+		ps := &quicksight.AthenaParameters{}
+		if v, ok := m["work_group"].(string); ok && v != "" {
+			ps.WorkGroup = aws.String(v)
+		}
+		params.AthenaParameters = ps
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "aurora"); m != nil {
+		params.AuroraParameters = &quicksight.AuroraParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "aurora_postgresql"); m != nil {
+		params.AuroraPostgreSqlParameters = &quicksight.AuroraPostgreSqlParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "aws_iot_analytics"); m != nil {
+		params.AwsIotAnalyticsParameters = &quicksight.AwsIotAnalyticsParameters{
+			DataSetName: aws.String(m["data_set_name"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "jira"); m != nil {
+		params.JiraParameters = &quicksight.JiraParameters{
+			SiteBaseUrl: aws.String(m["site_base_url"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "maria_db"); m != nil {
+		params.MariaDbParameters = &quicksight.MariaDbParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "mysql"); m != nil {
+		params.MySqlParameters = &quicksight.MySqlParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "postgresql"); m != nil {
+		params.PostgreSqlParameters = &quicksight.PostgreSqlParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "presto"); m != nil {
+		params.PrestoParameters = &quicksight.PrestoParameters{
+			Catalog: aws.String(m["catalog"].(string)),
+			Host:    aws.String(m["host"].(string)),
+			Port:    aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "rds"); m != nil {
+		params.RdsParameters = &quicksight.RdsParameters{
+			Database:   aws.String(m["database"].(string)),
+			InstanceId: aws.String(m["instance_id"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "redshift"); m != nil {
+		ps := &quicksight.RedshiftParameters{
+			Database: aws.String(m["database"].(string)),
+		}
+		if v, ok := m["cluster_id"].(string); ok && v != "" {
+			ps.ClusterId = aws.String(v)
+		}
+		if v, ok := m["host"].(string); ok && v != "" {
+			ps.Host = aws.String(v)
+		}
+		if v, ok := m["port"].(int); ok && v != 0 {
+			ps.Port = aws.Int64(int64(v))
+		}
+		params.RedshiftParameters = ps
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "s3"); m != nil {
+		ps := &quicksight.S3Parameters{}
+		if v, ok := m["manifest_file_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if loc, ok := v[0].(map[string]interface{}); ok {
+				ps.ManifestFileLocation = &quicksight.ManifestFileLocation{
+					Bucket: aws.String(loc["bucket"].(string)),
+					Key:    aws.String(loc["key"].(string)),
+				}
+			}
+		}
+		params.S3Parameters = ps
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "service_now"); m != nil {
+		params.ServiceNowParameters = &quicksight.ServiceNowParameters{
+			SiteBaseUrl: aws.String(m["site_base_url"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "snowflake"); m != nil {
+		params.SnowflakeParameters = &quicksight.SnowflakeParameters{
+			Database:  aws.String(m["database"].(string)),
+			Host:      aws.String(m["host"].(string)),
+			Warehouse: aws.String(m["warehouse"].(string)),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "spark"); m != nil {
+		params.SparkParameters = &quicksight.SparkParameters{
+			Host: aws.String(m["host"].(string)),
+			Port: aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "sql_server"); m != nil {
+		params.SqlServerParameters = &quicksight.SqlServerParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "teradata"); m != nil {
+		params.TeradataParameters = &quicksight.TeradataParameters{
+			Database: aws.String(m["database"].(string)),
+			Host:     aws.String(m["host"].(string)),
+			Port:     aws.Int64(int64(m["port"].(int))),
+		}
+	}
+
+	if m := quickSightDataSourceParameterBlock(tfMap, "twitter"); m != nil {
+		params.TwitterParameters = &quicksight.TwitterParameters{
+			MaxRows: aws.Int64(int64(m["max_rows"].(int))),
+			Query:   aws.String(m["query"].(string)),
+		}
+	}
+
+	return params
+}
+
+// quickSightDataSourceParameterBlock returns the configured engine block with
+// the given key, or nil if it is not set.
+func quickSightDataSourceParameterBlock(tfMap map[string]interface{}, key string) map[string]interface{} {
+	v, ok := tfMap[key].([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	m, ok := v[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	return m
+}
+
+func expandQuickSightDataSourcePermissions(tfList []interface{}) []*quicksight.ResourcePermission {
+	permissions := make([]*quicksight.ResourcePermission, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		permission := &quicksight.ResourcePermission{
+			Actions:   expandStringSet(tfMap["actions"].(*schema.Set)),
+			Principal: aws.String(tfMap["principal"].(string)),
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	return permissions
+}
+
+func expandQuickSightDataSourceSslProperties(tfList []interface{}) *quicksight.SslProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return &quicksight.SslProperties{
+		DisableSsl: aws.Bool(tfMap["disable_ssl"].(bool)),
+	}
+}
+
+func expandQuickSightDataSourceVpcConnectionProperties(tfList []interface{}) *quicksight.VpcConnectionProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return &quicksight.VpcConnectionProperties{
+		VpcConnectionArn: aws.String(tfMap["vpc_connection_arn"].(string)),
+	}
+}
+
+func flattenQuickSightDataSourceParameters(params *quicksight.DataSourceParameters) []interface{} {
+	if params == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if ps := params.AmazonElasticsearchParameters; ps != nil {
+		tfMap["amazon_elasticsearch"] = []interface{}{map[string]interface{}{
+			"domain": aws.StringValue(ps.Domain),
+		}}
+	}
+
+	if ps := params.AthenaParameters; ps != nil {
+		tfMap["athena"] = []interface{}{map[string]interface{}{
+			"work_group": aws.StringValue(ps.WorkGroup),
+		}}
+	}
+
+	if ps := params.AuroraParameters; ps != nil {
+		tfMap["aurora"] = flattenQuickSightDataSourceDatabaseHostPort(ps.Database, ps.Host, ps.Port)
+	}
+
+	if ps := params.AuroraPostgreSqlParameters; ps != nil {
+		tfMap["aurora_postgresql"] = flattenQuickSightDataSourceDatabaseHostPort(ps.Database, ps.Host, ps.Port)
+	}
+
+	if ps := params.AwsIotAnalyticsParameters; ps != nil {
+		tfMap["aws_iot_analytics"] = []interface{}{map[string]interface{}{
+			"data_set_name": aws.StringValue(ps.DataSetName),
+		}}
+	}
+
+	if ps := params.JiraParameters; ps != nil {
+		tfMap["jira"] = []interface{}{map[string]interface{}{
+			"site_base_url": aws.StringValue(ps.SiteBaseUrl),
+		}}
+	}
+
+	if ps := params.MariaDbParameters; ps != nil {
+		tfMap["maria_db"] = flattenQuickSightDataSourceDatabaseHostPort(ps.Database, ps.Host, ps.Port)
+	}
+
+	if ps := params.MySqlParameters; ps != nil {
+		tfMap["mysql"] = flattenQuickSightDataSourceDatabaseHostPort(ps.Database, ps.Host, ps.Port)
+	}
+
+	if ps := params.PostgreSqlParameters; ps != nil {
+		tfMap["postgresql"] = flattenQuickSightDataSourceDatabaseHostPort(ps.Database, ps.Host, ps.Port)
+	}
+
+	if ps := params.PrestoParameters; ps != nil {
+		tfMap["presto"] = []interface{}{map[string]interface{}{
+			"catalog": aws.StringValue(ps.Catalog),
+			"host":    aws.StringValue(ps.Host),
+			"port":    aws.Int64Value(ps.Port),
+		}}
+	}
+
+	if ps := params.RdsParameters; ps != nil {
+		tfMap["rds"] = []interface{}{map[string]interface{}{
+			"database":    aws.StringValue(ps.Database),
+			"instance_id": aws.StringValue(ps.InstanceId),
+		}}
+	}
+
+	if ps := params.RedshiftParameters; ps != nil {
+		tfMap["redshift"] = []interface{}{map[string]interface{}{
+			"cluster_id": aws.StringValue(ps.ClusterId),
+			"database":   aws.StringValue(ps.Database),
+			"host":       aws.StringValue(ps.Host),
+			"port":       aws.Int64Value(ps.Port),
+		}}
+	}
+
+	if ps := params.S3Parameters; ps != nil {
+		m := map[string]interface{}{}
+
+		if loc := ps.ManifestFileLocation; loc != nil {
+			m["manifest_file_location"] = []interface{}{map[string]interface{}{
+				"bucket": aws.StringValue(loc.Bucket),
+				"key":    aws.StringValue(loc.Key),
+			}}
+		}
+
+		tfMap["s3"] = []interface{}{m}
+	}
+
+	if ps := params.ServiceNowParameters; ps != nil {
+		tfMap["service_now"] = []interface{}{map[string]interface{}{
+			"site_base_url": aws.StringValue(ps.SiteBaseUrl),
+		}}
+	}
+
+	if ps := params.SnowflakeParameters; ps != nil {
+		tfMap["snowflake"] = []interface{}{map[string]interface{}{
+			"database":  aws.StringValue(ps.Database),
+			"host":      aws.StringValue(ps.Host),
+			"warehouse": aws.StringValue(ps.Warehouse),
+		}}
+	}
+
+	if ps := params.SparkParameters; ps != nil {
+		tfMap["spark"] = []interface{}{map[string]interface{}{
+			"host": aws.StringValue(ps.Host),
+			"port": aws.Int64Value(ps.Port),
+		}}
+	}
+
+	if ps := params.SqlServerParameters; ps != nil {
+		tfMap["sql_server"] = flattenQuickSightDataSourceDatabaseHostPort(ps.Database, ps.Host, ps.Port)
+	}
+
+	if ps := params.TeradataParameters; ps != nil {
+		tfMap["teradata"] = flattenQuickSightDataSourceDatabaseHostPort(ps.Database, ps.Host, ps.Port)
+	}
+
+	if ps := params.TwitterParameters; ps != nil {
+		tfMap["twitter"] = []interface{}{map[string]interface{}{
+			"max_rows": aws.Int64Value(ps.MaxRows),
+			"query":    aws.StringValue(ps.Query),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenQuickSightDataSourceDatabaseHostPort(database, host *string, port *int64) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"database": aws.StringValue(database),
+		"host":     aws.StringValue(host),
+		"port":     aws.Int64Value(port),
+	}}
+}
+
+func flattenQuickSightPermissions(perms []*quicksight.ResourcePermission) []interface{} {
+	if len(perms) == 0 {
+		return []interface{}{}
+	}
+
+	values := make([]interface{}, 0, len(perms))
+
+	for _, p := range perms {
+		if p == nil {
+			continue
+		}
+
+		values = append(values, map[string]interface{}{
+			"actions":   flattenStringSet(p.Actions),
+			"principal": aws.StringValue(p.Principal),
+		})
+	}
+
+	return values
+}
+
+func flattenQuickSightDataSourceSslProperties(props *quicksight.SslProperties) []interface{} {
+	if props == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"disable_ssl": aws.BoolValue(props.DisableSsl),
+	}}
+}
+
+func flattenQuickSightDataSourceVpcConnectionProperties(props *quicksight.VpcConnectionProperties) []interface{} {
+	if props == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"vpc_connection_arn": aws.StringValue(props.VpcConnectionArn),
+	}}
+}
+
+// diffQuickSightPermissionsToGrantAndRevoke compares the old and new
+// permission sets and returns the permissions to grant and to revoke.
+// Actions removed from a principal that is still present are revoked.
+func diffQuickSightPermissionsToGrantAndRevoke(oldPerms, newPerms []interface{}) ([]*quicksight.ResourcePermission, []*quicksight.ResourcePermission) {
+	oldActions := quickSightPermissionActionsByPrincipal(oldPerms)
+	newActions := quickSightPermissionActionsByPrincipal(newPerms)
+
+	var toGrant, toRevoke []*quicksight.ResourcePermission
+
+	for principal, actions := range newActions {
+		if old, ok := oldActions[principal]; ok && old.Equal(actions) {
+			continue
+		}
+
+		toGrant = append(toGrant, &quicksight.ResourcePermission{
+			Actions:   expandStringSet(actions),
+			Principal: aws.String(principal),
+		})
+	}
+
+	for principal, actions := range oldActions {
+		revoke := actions
+
+		if newPrincipalActions, ok := newActions[principal]; ok {
+			revoke = actions.Difference(newPrincipalActions)
+		}
+
+		if revoke.Len() == 0 {
+			continue
+		}
+
+		toRevoke = append(toRevoke, &quicksight.ResourcePermission{
+			Actions:   expandStringSet(revoke),
+			Principal: aws.String(principal),
+		})
+	}
+
+	return toGrant, toRevoke
+}
+
+func quickSightPermissionActionsByPrincipal(perms []interface{}) map[string]*schema.Set {
+	actionsByPrincipal := make(map[string]*schema.Set, len(perms))
+
+	for _, tfMapRaw := range perms {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		actionsByPrincipal[tfMap["principal"].(string)] = tfMap["actions"].(*schema.Set)
+	}
+
+	return actionsByPrincipal
+}
@@ -0,0 +1,470 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSQuickSightDataSource_basic(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.default"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rId := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("datasource/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "data_source_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.s3.0.manifest_file_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "parameters.0.s3.0.manifest_file_location.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "parameters.0.s3.0.manifest_file_location.0.key", "aws_s3_bucket_object.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeS3),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_disappears(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.default"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rId := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsQuickSightDataSource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_Permissions(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.default"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rId := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfig_Permissions(rId, rName, `"quicksight:DescribeDataSource", "quicksight:DescribeDataSourcePermissions", "quicksight:PassDataSource"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "permission.*.principal", "aws_quicksight_user.test", "arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						"actions.#": "3",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfig_Permissions(rId, rName, `"quicksight:DescribeDataSource", "quicksight:DescribeDataSourcePermissions", "quicksight:PassDataSource", "quicksight:UpdateDataSource", "quicksight:DeleteDataSource", "quicksight:UpdateDataSourcePermissions"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						"actions.#": "6",
+					}),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_Tags(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.default"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rId := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfigTags1(rId, rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfigTags2(rId, rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfigTags1(rId, rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_MultipleParameters(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rId := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSQuickSightDataSourceConfig_MultipleParameters(rId, rName),
+				ExpectError: regexp.MustCompile(`only one of .* can be specified`),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_UpdateName(t *testing.T) {
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.default"
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	rId := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rId, rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "name", rName1),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rId, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickSightDataSourceExists(resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuickSightDataSourceExists(resourceName string, dataSource *quicksight.DataSource) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		awsAccountID, dataSourceId, err := resourceAwsQuickSightDataSourceParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		input := &quicksight.DescribeDataSourceInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSourceId: aws.String(dataSourceId),
+		}
+
+		output, err := conn.DescribeDataSource(input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.DataSource == nil {
+			return fmt.Errorf("QuickSight Data Source (%s) not found", rs.Primary.ID)
+		}
+
+		*dataSource = *output.DataSource
+
+		return nil
+	}
+}
+
+func testAccCheckQuickSightDataSourceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_data_source" {
+			continue
+		}
+
+		awsAccountID, dataSourceId, err := resourceAwsQuickSightDataSourceParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.DescribeDataSource(&quicksight.DescribeDataSourceInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSourceId: aws.String(dataSourceId),
+		})
+
+		if isAWSErr(err, quicksight.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.DataSource != nil {
+			return fmt.Errorf("QuickSight Data Source (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSQuickSightDataSourceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "public-read"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test_data" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s-test-data"
+  content = <<EOT
+[
+  {
+    "Column1": "aaa",
+    "Column2": 1
+  },
+  {
+    "Column1": "bbb",
+    "Column2": 1
+  }
+]
+EOT
+  acl     = "public-read"
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s-test-manifest.json"
+  content = <<EOF
+{
+  "fileLocations": [
+      {
+          "URIs": [
+              "https://${aws_s3_bucket.test.bucket}.s3.${data.aws_partition.current.dns_suffix}/%[1]s-test-data"
+          ]
+      }
+  ],
+  "globalUploadSettings": {
+      "format": "JSON"
+  }
+}
+EOF
+  acl     = "public-read"
+}
+`, rName)
+}
+
+func testAccAWSQuickSightDataSourceConfig(rId, rName string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_source" "default" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_bucket_object.test.key
+      }
+    }
+  }
+
+  type = "S3"
+}
+`, rId, rName))
+}
+
+func testAccAWSQuickSightDataSourceConfig_MultipleParameters(rId, rName string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_source" "default" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  parameters {
+    athena {
+      work_group = "primary"
+    }
+
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_bucket_object.test.key
+      }
+    }
+  }
+
+  type = "S3"
+}
+`, rId, rName))
+}
+
+func testAccAWSQuickSightDataSourceConfig_Permissions(rId, rName, actions string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfigBase(rName),
+		fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_quicksight_user" "test" {
+  aws_account_id = data.aws_caller_identity.current.account_id
+  user_name      = %[2]q
+  email          = "fakeemail@example.com"
+  identity_type  = "QUICKSIGHT"
+  user_role      = "AUTHOR"
+}
+
+resource "aws_quicksight_data_source" "default" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_bucket_object.test.key
+      }
+    }
+  }
+
+  permission {
+    actions   = [%[3]s]
+    principal = aws_quicksight_user.test.arn
+  }
+
+  type = "S3"
+}
+`, rId, rName, actions))
+}
+
+func testAccAWSQuickSightDataSourceConfigTags1(rId, rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_source" "default" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_bucket_object.test.key
+      }
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+
+  type = "S3"
+}
+`, rId, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSQuickSightDataSourceConfigTags2(rId, rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_source" "default" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_bucket_object.test.key
+      }
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+
+  type = "S3"
+}
+`, rId, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
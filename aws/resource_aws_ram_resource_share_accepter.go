@@ -10,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ram/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ram/waiter"
 )
 
 func resourceAwsRamResourceShareAccepter() *schema.Resource {
@@ -94,6 +96,13 @@ func resourceAwsRamResourceShareAccepterCreate(d *schema.ResourceData, meta inte
 			shareARN)
 	}
 
+	// Resources are only listed for the invitation while it is pending
+	pendingResources, err := finder.PendingInvitationResources(conn, aws.StringValue(invitation.ResourceShareInvitationArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing RAM Resource Share (%s) invitation resources: %w", shareARN, err)
+	}
+
 	input := &ram.AcceptResourceShareInvitationInput{
 		ClientToken:                aws.String(resource.UniqueId()),
 		ResourceShareInvitationArn: invitation.ResourceShareInvitationArn,
@@ -123,6 +132,16 @@ func resourceAwsRamResourceShareAccepterCreate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error waiting for RAM resource share (%s) state: %s", d.Id(), err)
 	}
 
+	var resourceARNs []string
+	for _, resource := range pendingResources {
+		resourceARNs = append(resourceARNs, aws.StringValue(resource.Arn))
+	}
+
+	// Shared resources, e.g. subnets, are not usable until associated with the receiving account
+	if _, err := waiter.ResourceShareResourcesAvailable(conn, d.Id(), resourceARNs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for RAM Resource Share (%s) resources to become available: %w", d.Id(), err)
+	}
+
 	return resourceAwsRamResourceShareAccepterRead(d, meta)
 }
 
@@ -143,49 +162,35 @@ func resourceAwsRamResourceShareAccepterRead(d *schema.ResourceData, meta interf
 		d.Set("receiver_account_id", accountID)
 	}
 
-	listResourceSharesInput := &ram.GetResourceSharesInput{
-		ResourceOwner:     aws.String(ram.ResourceOwnerOtherAccounts),
-		ResourceShareArns: aws.StringSlice([]string{d.Id()}),
-	}
+	resourceShare, err := finder.ResourceShareOwnerOtherAccountsByArn(conn, d.Id())
 
-	shares, err := conn.GetResourceShares(listResourceSharesInput)
 	if err != nil {
 		return fmt.Errorf("error retrieving resource shares: %w", err)
 	}
 
-	if len(shares.ResourceShares) != 1 {
+	if resourceShare == nil {
 		log.Printf("[WARN] No RAM resource share with ARN (%s) found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	resourceShare := shares.ResourceShares[0]
-
 	d.Set("status", resourceShare.Status)
 	d.Set("sender_account_id", resourceShare.OwningAccountId)
 	d.Set("share_arn", resourceShare.ResourceShareArn)
 	d.Set("share_id", resourceAwsRamResourceShareGetIDFromARN(d.Id()))
 	d.Set("share_name", resourceShare.Name)
 
-	listInput := &ram.ListResourcesInput{
-		MaxResults:        aws.Int64(int64(500)),
-		ResourceOwner:     aws.String(ram.ResourceOwnerOtherAccounts),
-		ResourceShareArns: aws.StringSlice([]string{d.Id()}),
-	}
-
-	var resourceARNs []*string
-	err = conn.ListResourcesPages(listInput, func(page *ram.ListResourcesOutput, lastPage bool) bool {
-		for _, resource := range page.Resources {
-			resourceARNs = append(resourceARNs, resource.Arn)
-		}
-
-		return !lastPage
-	})
+	resources, err := finder.ResourcesByShareArn(conn, ram.ResourceOwnerOtherAccounts, d.Id())
 
 	if err != nil {
 		return fmt.Errorf("Error reading RAM resource share resources %s: %s", d.Id(), err)
 	}
 
+	var resourceARNs []*string
+	for _, resource := range resources {
+		resourceARNs = append(resourceARNs, resource.Arn)
+	}
+
 	if err := d.Set("resources", flattenStringList(resourceARNs)); err != nil {
 		return fmt.Errorf("unable to set resources: %s", err)
 	}
@@ -250,10 +255,6 @@ func resourceAwsRamResourceShareGetInvitation(conn *ram.RAM, resourceShareARN, s
 		return !lastPage
 	})
 
-	if invitation == nil {
-		return nil, nil
-	}
-
 	if err != nil {
 		return nil, fmt.Errorf("Error reading RAM resource share invitation %s: %s", resourceShareARN, err)
 	}
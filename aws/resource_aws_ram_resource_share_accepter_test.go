@@ -52,6 +52,40 @@ func TestAccAwsRamResourceShareAccepter_basic(t *testing.T) {
 	})
 }
 
+func TestAccAwsRamResourceShareAccepter_ResourceAssociation(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_ram_resource_share_accepter.test"
+	subnetResourceName := "aws_subnet.test"
+
+	shareName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsRamResourceShareAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareAccepterResourceAssociation(shareName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareAccepterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", ram.ResourceShareStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resources.0", subnetResourceName, "arn"),
+				),
+			},
+			{
+				Config:            testAccAwsRamResourceShareAccepterResourceAssociation(shareName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAwsRamResourceShareAccepterDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ramconn
 
@@ -132,3 +166,65 @@ resource "aws_ram_principal_association" "test" {
 data "aws_caller_identity" "receiver" {}
 `, shareName)
 }
+
+func testAccAwsRamResourceShareAccepterResourceAssociation(shareName string) string {
+	return composeConfig(
+		testAccAlternateAccountProviderConfig(),
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_ram_resource_share_accepter" "test" {
+  share_arn = aws_ram_principal_association.test.resource_share_arn
+
+  depends_on = [aws_ram_resource_association.test]
+}
+
+resource "aws_ram_resource_share" "test" {
+  provider = "awsalternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "awsalternate"
+
+  principal          = data.aws_caller_identity.receiver.account_id
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+data "aws_caller_identity" "receiver" {}
+
+resource "aws_vpc" "test" {
+  provider = "awsalternate"
+
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  provider = "awsalternate"
+
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.1.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ram_resource_association" "test" {
+  provider = "awsalternate"
+
+  resource_arn       = aws_subnet.test.arn
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+`, shareName))
+}
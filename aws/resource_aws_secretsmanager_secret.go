@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/secretsmanager/waiter"
@@ -71,6 +73,32 @@ func resourceAwsSecretsManagerSecret() *schema.Resource {
 					validation.IntInSlice([]int{0}),
 				),
 			},
+			"replica": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceAwsSecretsManagerSecretReplicaHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"rotation_enabled": {
 				Deprecated: "Use the aws_secretsmanager_secret_rotation resource instead",
 				Type:       schema.TypeBool,
@@ -127,6 +155,10 @@ func resourceAwsSecretsManagerSecretCreate(d *schema.ResourceData, meta interfac
 		input.KmsKeyId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("replica"); ok && v.(*schema.Set).Len() > 0 {
+		input.AddReplicaRegions = expandSecretsManagerReplicaRegionTypes(v.(*schema.Set).List())
+	}
+
 	log.Printf("[DEBUG] Creating Secrets Manager Secret: %s", input)
 
 	// Retry for secret recreation after deletion
@@ -154,6 +186,12 @@ func resourceAwsSecretsManagerSecretCreate(d *schema.ResourceData, meta interfac
 
 	d.SetId(aws.StringValue(output.ARN))
 
+	if len(input.AddReplicaRegions) > 0 {
+		if _, err := waiter.SecretReplicationInSync(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Secrets Manager Secret (%s) replication: %w", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk("policy"); ok && v.(string) != "" {
 		input := &secretsmanager.PutResourcePolicyInput{
 			ResourcePolicy: aws.String(v.(string)),
@@ -234,6 +272,10 @@ func resourceAwsSecretsManagerSecretRead(d *schema.ResourceData, meta interface{
 	d.Set("kms_key_id", output.KmsKeyId)
 	d.Set("name", output.Name)
 
+	if err := d.Set("replica", flattenSecretsManagerReplicationStatusTypes(output.ReplicationStatus)); err != nil {
+		return fmt.Errorf("error setting replica: %w", err)
+	}
+
 	pIn := &secretsmanager.GetResourcePolicyInput{
 		SecretId: aws.String(d.Id()),
 	}
@@ -290,6 +332,75 @@ func resourceAwsSecretsManagerSecretUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if d.HasChange("replica") {
+		o, n := d.GetChange("replica")
+		// Replicas are keyed by region; a KMS key change requires
+		// removing and re-adding the replica for that region.
+		var removeRegions []*string
+		var addReplicas []interface{}
+
+		oldReplicas := make(map[string]map[string]interface{})
+		for _, tfMapRaw := range o.(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			oldReplicas[tfMap["region"].(string)] = tfMap
+		}
+
+		newRegions := make(map[string]bool)
+		for _, tfMapRaw := range n.(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			region := tfMap["region"].(string)
+			newRegions[region] = true
+
+			oldMap, ok := oldReplicas[region]
+
+			if !ok {
+				addReplicas = append(addReplicas, tfMap)
+				continue
+			}
+
+			if kmsKeyID := tfMap["kms_key_id"].(string); kmsKeyID != "" && kmsKeyID != oldMap["kms_key_id"].(string) {
+				removeRegions = append(removeRegions, aws.String(region))
+				addReplicas = append(addReplicas, tfMap)
+			}
+		}
+
+		for region := range oldReplicas {
+			if !newRegions[region] {
+				removeRegions = append(removeRegions, aws.String(region))
+			}
+		}
+
+		if len(removeRegions) > 0 {
+			input := &secretsmanager.RemoveRegionsFromReplicationInput{
+				RemoveReplicaRegions: removeRegions,
+				SecretId:             aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Removing Secrets Manager Secret replicas: %s", input)
+			_, err := conn.RemoveRegionsFromReplication(input)
+			if err != nil {
+				return fmt.Errorf("error removing Secrets Manager Secret %q replicas: %w", d.Id(), err)
+			}
+		}
+
+		if len(addReplicas) > 0 {
+			input := &secretsmanager.ReplicateSecretToRegionsInput{
+				AddReplicaRegions: expandSecretsManagerReplicaRegionTypes(addReplicas),
+				SecretId:          aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Adding Secrets Manager Secret replicas: %s", input)
+			_, err := conn.ReplicateSecretToRegions(input)
+			if err != nil {
+				return fmt.Errorf("error adding Secrets Manager Secret %q replicas: %w", d.Id(), err)
+			}
+
+			if _, err := waiter.SecretReplicationInSync(conn, d.Id()); err != nil {
+				return fmt.Errorf("error waiting for Secrets Manager Secret (%s) replication: %w", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("policy") {
 		if v, ok := d.GetOk("policy"); ok && v.(string) != "" {
 			policy, err := structure.NormalizeJsonString(v.(string))
@@ -385,6 +496,30 @@ func resourceAwsSecretsManagerSecretUpdate(d *schema.ResourceData, meta interfac
 func resourceAwsSecretsManagerSecretDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).secretsmanagerconn
 
+	// A primary Secret cannot be deleted while it still has replicas
+	if v, ok := d.GetOk("replica"); ok && v.(*schema.Set).Len() > 0 {
+		var regions []*string
+
+		for _, tfMapRaw := range v.(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			regions = append(regions, aws.String(tfMap["region"].(string)))
+		}
+
+		input := &secretsmanager.RemoveRegionsFromReplicationInput{
+			RemoveReplicaRegions: regions,
+			SecretId:             aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Removing Secrets Manager Secret replicas: %s", input)
+		_, err := conn.RemoveRegionsFromReplication(input)
+		if err != nil {
+			if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
+			return fmt.Errorf("error removing Secrets Manager Secret %q replicas: %w", d.Id(), err)
+		}
+	}
+
 	input := &secretsmanager.DeleteSecretInput{
 		SecretId: aws.String(d.Id()),
 	}
@@ -407,3 +542,58 @@ func resourceAwsSecretsManagerSecretDelete(d *schema.ResourceData, meta interfac
 
 	return nil
 }
+
+func resourceAwsSecretsManagerSecretReplicaHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	m := v.(map[string]interface{})
+
+	buf.WriteString(fmt.Sprintf("%s-", m["region"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
+func expandSecretsManagerReplicaRegionTypes(l []interface{}) []*secretsmanager.ReplicaRegionType {
+	var replicaRegionTypes []*secretsmanager.ReplicaRegionType
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		replicaRegionType := &secretsmanager.ReplicaRegionType{
+			Region: aws.String(tfMap["region"].(string)),
+		}
+
+		if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+			replicaRegionType.KmsKeyId = aws.String(v)
+		}
+
+		replicaRegionTypes = append(replicaRegionTypes, replicaRegionType)
+	}
+
+	return replicaRegionTypes
+}
+
+func flattenSecretsManagerReplicationStatusTypes(replicationStatusTypes []*secretsmanager.ReplicationStatusType) []interface{} {
+	var l []interface{}
+
+	for _, replicationStatusType := range replicationStatusTypes {
+		if replicationStatusType == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"kms_key_id":     aws.StringValue(replicationStatusType.KmsKeyId),
+			"region":         aws.StringValue(replicationStatusType.Region),
+			"status":         aws.StringValue(replicationStatusType.Status),
+			"status_message": aws.StringValue(replicationStatusType.StatusMessage),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -3,12 +3,14 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAwsSecretsManagerSecretRotation() *schema.Resource {
@@ -27,6 +29,11 @@ func resourceAwsSecretsManagerSecretRotation() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"rotate_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"rotation_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -42,8 +49,21 @@ func resourceAwsSecretsManagerSecretRotation() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"automatically_after_days": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ExactlyOneOf: []string{"rotation_rules.0.automatically_after_days", "rotation_rules.0.schedule_expression"},
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+h$`), "must be a number of hours, e.g. 3h"),
+						},
+						"schedule_expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"rotation_rules.0.automatically_after_days", "rotation_rules.0.schedule_expression"},
+							ValidateFunc: validation.StringLenBetween(1, 256),
 						},
 					},
 				},
@@ -59,6 +79,7 @@ func resourceAwsSecretsManagerSecretRotationCreate(d *schema.ResourceData, meta
 
 	if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
 		input := &secretsmanager.RotateSecretInput{
+			RotateImmediately: aws.Bool(d.Get("rotate_immediately").(bool)),
 			RotationLambdaARN: aws.String(v.(string)),
 			RotationRules:     expandSecretsManagerRotationRules(d.Get("rotation_rules").([]interface{})),
 			SecretId:          aws.String(secretID),
@@ -115,7 +136,20 @@ func resourceAwsSecretsManagerSecretRotationRead(d *schema.ResourceData, meta in
 
 	if aws.BoolValue(output.RotationEnabled) {
 		d.Set("rotation_lambda_arn", output.RotationLambdaARN)
-		if err := d.Set("rotation_rules", flattenSecretsManagerRotationRules(output.RotationRules)); err != nil {
+		rules := flattenSecretsManagerSecretRotationRules(output.RotationRules)
+
+		// When rotation is configured by days, the API may also report the
+		// equivalent rate() schedule expression. Keep the configured form.
+		if len(rules) > 0 && d.Get("rotation_rules.0.automatically_after_days").(int) > 0 {
+			tfMap := rules[0].(map[string]interface{})
+
+			if _, ok := tfMap["automatically_after_days"]; !ok && output.RotationRules.AutomaticallyAfterDays != nil {
+				tfMap["automatically_after_days"] = int(aws.Int64Value(output.RotationRules.AutomaticallyAfterDays))
+				delete(tfMap, "schedule_expression")
+			}
+		}
+
+		if err := d.Set("rotation_rules", rules); err != nil {
 			return fmt.Errorf("error setting rotation_rules: %s", err)
 		}
 	} else {
@@ -133,6 +167,7 @@ func resourceAwsSecretsManagerSecretRotationUpdate(d *schema.ResourceData, meta
 	if d.HasChanges("rotation_lambda_arn", "rotation_rules") {
 		if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
 			input := &secretsmanager.RotateSecretInput{
+				RotateImmediately: aws.Bool(d.Get("rotate_immediately").(bool)),
 				RotationLambdaARN: aws.String(v.(string)),
 				RotationRules:     expandSecretsManagerRotationRules(d.Get("rotation_rules").([]interface{})),
 				SecretId:          aws.String(secretID),
@@ -198,8 +233,18 @@ func expandSecretsManagerRotationRules(l []interface{}) *secretsmanager.Rotation
 
 	m := l[0].(map[string]interface{})
 
-	rules := &secretsmanager.RotationRulesType{
-		AutomaticallyAfterDays: aws.Int64(int64(m["automatically_after_days"].(int))),
+	rules := &secretsmanager.RotationRulesType{}
+
+	if v, ok := m["automatically_after_days"].(int); ok && v != 0 {
+		rules.AutomaticallyAfterDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["duration"].(string); ok && v != "" {
+		rules.Duration = aws.String(v)
+	}
+
+	if v, ok := m["schedule_expression"].(string); ok && v != "" {
+		rules.ScheduleExpression = aws.String(v)
 	}
 
 	return rules
@@ -216,3 +261,29 @@ func flattenSecretsManagerRotationRules(rules *secretsmanager.RotationRulesType)
 
 	return []interface{}{m}
 }
+
+// flattenSecretsManagerSecretRotationRules is used by the rotation resource only,
+// as its rotation_rules schema additionally supports duration and schedules.
+func flattenSecretsManagerSecretRotationRules(rules *secretsmanager.RotationRulesType) []interface{} {
+	if rules == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	// The API derives the day count from rate() expressions, so it is only
+	// reported when no schedule expression is in use.
+	if v := rules.AutomaticallyAfterDays; v != nil && rules.ScheduleExpression == nil {
+		m["automatically_after_days"] = int(aws.Int64Value(v))
+	}
+
+	if v := rules.Duration; v != nil {
+		m["duration"] = aws.StringValue(v)
+	}
+
+	if v := rules.ScheduleExpression; v != nil {
+		m["schedule_expression"] = aws.StringValue(v)
+	}
+
+	return []interface{}{m}
+}
@@ -48,9 +48,53 @@ func TestAccAwsSecretsManagerSecretRotation_basic(t *testing.T) {
 			*/
 			// Test importing secret rotation
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate_immediately"},
+			},
+		},
+	})
+}
+
+func TestAccAwsSecretsManagerSecretRotation_scheduleExpression(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_secretsmanager_secret_rotation.test"
+	lambdaFunctionResourceName := "aws_lambda_function.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSecretsManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSecretsManagerSecretRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretRotationConfigScheduleExpression(rName, "rate(10 days)", "3h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretRotationExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "rotate_immediately", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "rotation_lambda_arn", lambdaFunctionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.automatically_after_days", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.duration", "3h"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.schedule_expression", "rate(10 days)"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate_immediately"},
+			},
+			{
+				Config: testAccAwsSecretsManagerSecretRotationConfigScheduleExpression(rName, "cron(0 16 1,15 * ? *)", "4h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretRotationExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.duration", "4h"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.schedule_expression", "cron(0 16 1,15 * ? *)"),
+				),
 			},
 		},
 	})
@@ -117,7 +161,7 @@ func testAccCheckAwsSecretsManagerSecretRotationExists(resourceName string, secr
 	}
 }
 
-func testAccAwsSecretsManagerSecretRotationConfig(rName string, automaticallyAfterDays int) string {
+func testAccAwsSecretsManagerSecretRotationConfigBase(rName string) string {
 	return baseAccAWSLambdaConfig(rName, rName, rName) + fmt.Sprintf(`
 # Not a real rotation function
 resource "aws_lambda_function" "test1" {
@@ -154,16 +198,37 @@ resource "aws_lambda_permission" "test2" {
 resource "aws_secretsmanager_secret" "test" {
   name = "%[1]s"
 }
+`, rName)
+}
+
+func testAccAwsSecretsManagerSecretRotationConfig(rName string, automaticallyAfterDays int) string {
+	return testAccAwsSecretsManagerSecretRotationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id           = aws_secretsmanager_secret.test.id
+  rotation_lambda_arn = aws_lambda_function.test1.arn
+
+  rotation_rules {
+    automatically_after_days = %[1]d
+  }
+
+  depends_on = [aws_lambda_permission.test1]
+}
+`, automaticallyAfterDays)
+}
 
+func testAccAwsSecretsManagerSecretRotationConfigScheduleExpression(rName, scheduleExpression, duration string) string {
+	return testAccAwsSecretsManagerSecretRotationConfigBase(rName) + fmt.Sprintf(`
 resource "aws_secretsmanager_secret_rotation" "test" {
   secret_id           = aws_secretsmanager_secret.test.id
+  rotate_immediately  = false
   rotation_lambda_arn = aws_lambda_function.test1.arn
 
   rotation_rules {
-    automatically_after_days = %[2]d
+    schedule_expression = %[1]q
+    duration            = %[2]q
   }
 
   depends_on = [aws_lambda_permission.test1]
 }
-`, rName, automaticallyAfterDays)
+`, scheduleExpression, duration)
 }
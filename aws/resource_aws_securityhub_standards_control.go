@@ -0,0 +1,230 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/securityhub/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	// Maximum amount of time to wait for the controls of a newly subscribed standard to become available
+	securityHubStandardsControlPropagationTimeout = 2 * time.Minute
+)
+
+func resourceAwsSecurityHubStandardsControl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubStandardsControlCreate,
+		Read:   resourceAwsSecurityHubStandardsControlRead,
+		Update: resourceAwsSecurityHubStandardsControlUpdate,
+		Delete: resourceAwsSecurityHubStandardsControlDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"control_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"control_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(securityhub.ControlStatus_Values(), false),
+			},
+			"control_status_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"related_requirements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"remediation_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"severity_rating": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"standards_control_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubStandardsControlCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("standards_control_arn").(string))
+
+	return resourceAwsSecurityHubStandardsControlUpdate(d, meta)
+}
+
+func resourceAwsSecurityHubStandardsControlRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	standardsSubscriptionARN, err := securityHubStandardsControlARNToStandardsSubscriptionARN(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	var control *securityhub.StandardsControl
+
+	// The list of controls is briefly empty right after a standard is subscribed to.
+	err = resource.Retry(securityHubStandardsControlPropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		control, err = finder.StandardsControlByStandardsSubscriptionARNAndStandardsControlARN(conn, standardsSubscriptionARN, d.Id())
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if d.IsNewResource() && control == nil {
+			return resource.RetryableError(&resource.NotFoundError{})
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		control, err = finder.StandardsControlByStandardsSubscriptionARNAndStandardsControlARN(conn, standardsSubscriptionARN, d.Id())
+	}
+
+	if !d.IsNewResource() && isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Security Hub Standards Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Standards Control (%s): %w", d.Id(), err)
+	}
+
+	if control == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Security Hub Standards Control (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Security Hub Standards Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("control_id", control.ControlId)
+	d.Set("control_status", control.ControlStatus)
+	if control.ControlStatusUpdatedAt != nil {
+		d.Set("control_status_updated_at", aws.TimeValue(control.ControlStatusUpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("control_status_updated_at", nil)
+	}
+	d.Set("description", control.Description)
+	d.Set("disabled_reason", control.DisabledReason)
+	d.Set("related_requirements", aws.StringValueSlice(control.RelatedRequirements))
+	d.Set("remediation_url", control.RemediationUrl)
+	d.Set("severity_rating", control.SeverityRating)
+	d.Set("standards_control_arn", control.StandardsControlArn)
+	d.Set("title", control.Title)
+
+	return nil
+}
+
+func resourceAwsSecurityHubStandardsControlUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	controlStatus := d.Get("control_status").(string)
+	input := &securityhub.UpdateStandardsControlInput{
+		ControlStatus:       aws.String(controlStatus),
+		StandardsControlArn: aws.String(d.Id()),
+	}
+
+	if controlStatus == securityhub.ControlStatusDisabled {
+		v, ok := d.GetOk("disabled_reason")
+
+		if !ok {
+			return fmt.Errorf("error updating Security Hub Standards Control (%s): disabled_reason must be specified when control_status is %s", d.Id(), controlStatus)
+		}
+
+		input.DisabledReason = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub Standards Control: %s", input)
+	_, err := conn.UpdateStandardsControl(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Security Hub Standards Control (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsSecurityHubStandardsControlRead(d, meta)
+}
+
+func resourceAwsSecurityHubStandardsControlDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	// Standards controls cannot be deleted, so re-enable the control instead.
+	log.Printf("[DEBUG] Enabling Security Hub Standards Control (%s)", d.Id())
+	_, err := conn.UpdateStandardsControl(&securityhub.UpdateStandardsControlInput{
+		ControlStatus:       aws.String(securityhub.ControlStatusEnabled),
+		StandardsControlArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error enabling Security Hub Standards Control (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// securityHubStandardsControlARNToStandardsSubscriptionARN converts a standards control ARN
+// (e.g. arn:aws:securityhub:us-east-1:123456789012:control/cis-aws-foundations-benchmark/v/1.2.0/1.14)
+// to the ARN of the standards subscription containing it
+// (e.g. arn:aws:securityhub:us-east-1:123456789012:subscription/cis-aws-foundations-benchmark/v/1.2.0).
+func securityHubStandardsControlARNToStandardsSubscriptionARN(standardsControlARN string) (string, error) {
+	parsedARN, err := arn.Parse(standardsControlARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing Security Hub Standards Control ARN (%s): %w", standardsControlARN, err)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) < 3 || parts[0] != "control" {
+		return "", fmt.Errorf("unexpected format for Security Hub Standards Control ARN (%s)", standardsControlARN)
+	}
+
+	parsedARN.Resource = strings.Join(append([]string{"subscription"}, parts[1:len(parts)-1]...), "/")
+
+	return parsedARN.String(), nil
+}
@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/securityhub/finder"
+)
+
+func TestSecurityHubStandardsControlARNToStandardsSubscriptionARN(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputARN      string
+		ExpectedError *regexp.Regexp
+		ExpectedARN   string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputARN:      "",
+			ExpectedError: regexp.MustCompile(`error parsing Security Hub Standards Control ARN`),
+		},
+		{
+			TestName:      "unparsable ARN",
+			InputARN:      "test",
+			ExpectedError: regexp.MustCompile(`error parsing Security Hub Standards Control ARN`),
+		},
+		{
+			TestName:      "invalid ARN resource",
+			InputARN:      "arn:aws:securityhub:us-west-2:1234567890:subscription/cis-aws-foundations-benchmark/v/1.2.0",
+			ExpectedError: regexp.MustCompile(`unexpected format for Security Hub Standards Control ARN`),
+		},
+		{
+			TestName:    "valid CIS ARN",
+			InputARN:    "arn:aws:securityhub:us-west-2:1234567890:control/cis-aws-foundations-benchmark/v/1.2.0/1.14",
+			ExpectedARN: "arn:aws:securityhub:us-west-2:1234567890:subscription/cis-aws-foundations-benchmark/v/1.2.0",
+		},
+		{
+			TestName:    "valid AWS Foundational Security Best Practices ARN",
+			InputARN:    "arn:aws:securityhub:us-west-2:1234567890:control/aws-foundational-security-best-practices/v/1.0.0/ACM.1",
+			ExpectedARN: "arn:aws:securityhub:us-west-2:1234567890:subscription/aws-foundational-security-best-practices/v/1.0.0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := securityHubStandardsControlARNToStandardsSubscriptionARN(testCase.InputARN)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedARN {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedARN)
+			}
+		})
+	}
+}
+
+func testAccAWSSecurityHubStandardsControl_basic(t *testing.T) {
+	var standardsControl securityhub.StandardsControl
+	resourceName := "aws_securityhub_standards_control.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil, //lintignore:AT001
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubStandardsControlConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubStandardsControlExists(resourceName, &standardsControl),
+					resource.TestCheckResourceAttr(resourceName, "control_id", "CIS.1.10"),
+					resource.TestCheckResourceAttr(resourceName, "control_status", "ENABLED"),
+					resource.TestCheckResourceAttrSet(resourceName, "control_status_updated_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", "IAM password policies can prevent the reuse of a given password by the same user. It is recommended that the password policy prevent the reuse of passwords."),
+					resource.TestCheckResourceAttr(resourceName, "disabled_reason", ""),
+					resource.TestCheckResourceAttr(resourceName, "related_requirements.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "related_requirements.0", "CIS AWS Foundations 1.10"),
+					resource.TestCheckResourceAttrSet(resourceName, "remediation_url"),
+					resource.TestCheckResourceAttr(resourceName, "severity_rating", "LOW"),
+					resource.TestCheckResourceAttr(resourceName, "title", "Ensure IAM password policy prevents password reuse"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityHubStandardsControl_disabledControlStatus(t *testing.T) {
+	var standardsControl securityhub.StandardsControl
+	resourceName := "aws_securityhub_standards_control.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil, //lintignore:AT001
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubStandardsControlConfig_disabledControlStatus(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubStandardsControlExists(resourceName, &standardsControl),
+					resource.TestCheckResourceAttr(resourceName, "control_status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "disabled_reason", "Password policies are managed by an external identity provider"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityHubStandardsControl_disabledControlStatusWithoutDisabledReason(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil, //lintignore:AT001
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSecurityHubStandardsControlConfig_disabledControlStatusWithoutDisabledReason(),
+				ExpectError: regexp.MustCompile("disabled_reason must be specified"),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityHubStandardsControlExists(n string, control *securityhub.StandardsControl) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Hub Standards Control ID is set")
+		}
+
+		standardsSubscriptionARN, err := securityHubStandardsControlARNToStandardsSubscriptionARN(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		output, err := finder.StandardsControlByStandardsSubscriptionARNAndStandardsControlARN(conn, standardsSubscriptionARN, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Security Hub Standards Control (%s) not found", rs.Primary.ID)
+		}
+
+		*control = *output
+
+		return nil
+	}
+}
+
+func testAccAWSSecurityHubStandardsControlConfigBase() string {
+	return `
+resource "aws_securityhub_account" "test" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_securityhub_standards_subscription" "test" {
+  standards_arn = "arn:${data.aws_partition.current.partition}:securityhub:::ruleset/cis-aws-foundations-benchmark/v/1.2.0"
+  depends_on    = [aws_securityhub_account.test]
+}
+`
+}
+
+func testAccAWSSecurityHubStandardsControlConfig_basic() string {
+	return composeConfig(
+		testAccAWSSecurityHubStandardsControlConfigBase(),
+		`
+resource "aws_securityhub_standards_control" "test" {
+  standards_control_arn = format("%s/1.10", replace(aws_securityhub_standards_subscription.test.id, "subscription", "control"))
+  control_status        = "ENABLED"
+}
+`)
+}
+
+func testAccAWSSecurityHubStandardsControlConfig_disabledControlStatus() string {
+	return composeConfig(
+		testAccAWSSecurityHubStandardsControlConfigBase(),
+		`
+resource "aws_securityhub_standards_control" "test" {
+  standards_control_arn = format("%s/1.11", replace(aws_securityhub_standards_subscription.test.id, "subscription", "control"))
+  control_status        = "DISABLED"
+  disabled_reason       = "Password policies are managed by an external identity provider"
+}
+`)
+}
+
+func testAccAWSSecurityHubStandardsControlConfig_disabledControlStatusWithoutDisabledReason() string {
+	return composeConfig(
+		testAccAWSSecurityHubStandardsControlConfigBase(),
+		`
+resource "aws_securityhub_standards_control" "test" {
+  standards_control_arn = format("%s/1.12", replace(aws_securityhub_standards_subscription.test.id, "subscription", "control"))
+  control_status        = "DISABLED"
+}
+`)
+}
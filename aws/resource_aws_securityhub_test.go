@@ -22,6 +22,11 @@ func TestAccAWSSecurityHub_serial(t *testing.T) {
 		"ProductSubscription": {
 			"basic": testAccAWSSecurityHubProductSubscription_basic,
 		},
+		"StandardsControl": {
+			"basic":                              testAccAWSSecurityHubStandardsControl_basic,
+			"DisabledControlStatus":              testAccAWSSecurityHubStandardsControl_disabledControlStatus,
+			"DisabledControlStatusWithoutReason": testAccAWSSecurityHubStandardsControl_disabledControlStatusWithoutDisabledReason,
+		},
 		"StandardsSubscription": {
 			"basic": testAccAWSSecurityHubStandardsSubscription_basic,
 		},
@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/waiter"
+)
+
+func resourceAwsServiceCatalogPortfolioShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPortfolioShareCreate,
+		Read:   resourceAwsServiceCatalogPortfolioShareRead,
+		Update: resourceAwsServiceCatalogPortfolioShareUpdate,
+		Delete: resourceAwsServiceCatalogPortfolioShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "en",
+				ValidateFunc: validation.StringInSlice([]string{"en", "jp", "zh"}, false),
+			},
+			"accepted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"share_tag_options": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(servicecatalog.DescribePortfolioShareType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPortfolioShareCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID := d.Get("portfolio_id").(string)
+	principalID := d.Get("principal_id").(string)
+	shareType := d.Get("type").(string)
+	input := &servicecatalog.CreatePortfolioShareInput{
+		AcceptLanguage:  aws.String(d.Get("accept_language").(string)),
+		PortfolioId:     aws.String(portfolioID),
+		ShareTagOptions: aws.Bool(d.Get("share_tag_options").(bool)),
+	}
+
+	if shareType == servicecatalog.DescribePortfolioShareTypeAccount {
+		input.AccountId = aws.String(principalID)
+	} else {
+		input.OrganizationNode = expandServiceCatalogOrganizationNode(shareType, principalID)
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Portfolio Share: %s", input)
+	output, err := conn.CreatePortfolioShare(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Portfolio Share (%s): %w", portfolioID, err)
+	}
+
+	d.SetId(tfservicecatalog.PortfolioShareCreateResourceID(portfolioID, shareType, principalID))
+
+	// Only organization node shares are performed asynchronously
+	if output != nil && aws.StringValue(output.PortfolioShareToken) != "" {
+		if _, err := waiter.PortfolioShareReady(conn, aws.StringValue(output.PortfolioShareToken)); err != nil {
+			return fmt.Errorf("error waiting for Service Catalog Portfolio Share (%s) create: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServiceCatalogPortfolioShareRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPortfolioShareRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.PortfolioShare(conn, portfolioID, shareType, principalID)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Service Catalog Portfolio Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Portfolio Share (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Service Catalog Portfolio Share (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Service Catalog Portfolio Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("accepted", output.Accepted)
+	d.Set("portfolio_id", portfolioID)
+	d.Set("principal_id", output.PrincipalId)
+	d.Set("share_tag_options", output.ShareTagOptions)
+	d.Set("type", output.Type)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPortfolioShareUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	if d.HasChange("share_tag_options") {
+		portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &servicecatalog.UpdatePortfolioShareInput{
+			AcceptLanguage:  aws.String(d.Get("accept_language").(string)),
+			PortfolioId:     aws.String(portfolioID),
+			ShareTagOptions: aws.Bool(d.Get("share_tag_options").(bool)),
+		}
+
+		if shareType == servicecatalog.DescribePortfolioShareTypeAccount {
+			input.AccountId = aws.String(principalID)
+		} else {
+			input.OrganizationNode = expandServiceCatalogOrganizationNode(shareType, principalID)
+		}
+
+		log.Printf("[DEBUG] Updating Service Catalog Portfolio Share: %s", input)
+		output, err := conn.UpdatePortfolioShare(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Service Catalog Portfolio Share (%s): %w", d.Id(), err)
+		}
+
+		if output != nil && aws.StringValue(output.PortfolioShareToken) != "" {
+			if _, err := waiter.PortfolioShareReady(conn, aws.StringValue(output.PortfolioShareToken)); err != nil {
+				return fmt.Errorf("error waiting for Service Catalog Portfolio Share (%s) update: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsServiceCatalogPortfolioShareRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPortfolioShareDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.DeletePortfolioShareInput{
+		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	if shareType == servicecatalog.DescribePortfolioShareTypeAccount {
+		input.AccountId = aws.String(principalID)
+	} else {
+		input.OrganizationNode = expandServiceCatalogOrganizationNode(shareType, principalID)
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Portfolio Share: %s", d.Id())
+	output, err := conn.DeletePortfolioShare(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Portfolio Share (%s): %w", d.Id(), err)
+	}
+
+	if output != nil && aws.StringValue(output.PortfolioShareToken) != "" {
+		if _, err := waiter.PortfolioShareReady(conn, aws.StringValue(output.PortfolioShareToken)); err != nil {
+			return fmt.Errorf("error waiting for Service Catalog Portfolio Share (%s) delete: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// expandServiceCatalogOrganizationNode converts a share type and principal into
+// an organization node. Member account shares use the ACCOUNT node type.
+func expandServiceCatalogOrganizationNode(shareType, principalID string) *servicecatalog.OrganizationNode {
+	nodeType := shareType
+
+	if shareType == servicecatalog.DescribePortfolioShareTypeOrganizationMemberAccount {
+		nodeType = servicecatalog.OrganizationNodeTypeAccount
+	}
+
+	return &servicecatalog.OrganizationNode{
+		Type:  aws.String(nodeType),
+		Value: aws.String(principalID),
+	}
+}
@@ -0,0 +1,638 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsServiceCatalogProvisionedProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProvisionedProductCreate,
+		Read:   resourceAwsServiceCatalogProvisionedProductRead,
+		Update: resourceAwsServiceCatalogProvisionedProductUpdate,
+		Delete: resourceAwsServiceCatalogProvisionedProductDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ProvisionedProductCreatedDefaultTimeout),
+			Update: schema.DefaultTimeout(waiter.ProvisionedProductUpdatedDefaultTimeout),
+			Delete: schema.DefaultTimeout(waiter.ProvisionedProductDeletedDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "en",
+				ValidateFunc: validation.StringInSlice([]string{"en", "jp", "zh"}, false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_dashboard_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ignore_errors": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"last_record_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"launch_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"notification_arns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"path_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"path_name"},
+			},
+			"path_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"path_id"},
+			},
+			"product_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"product_id", "product_name"},
+			},
+			"product_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"product_id", "product_name"},
+			},
+			"provisioning_artifact_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"provisioning_artifact_id", "provisioning_artifact_name"},
+			},
+			"provisioning_artifact_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"provisioning_artifact_id", "provisioning_artifact_name"},
+			},
+			"provisioning_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"use_previous_value": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"retain_physical_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"stack_set_provisioning_preferences": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAwsAccountId,
+							},
+						},
+						"failure_tolerance_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntAtLeast(0),
+							ConflictsWith: []string{"stack_set_provisioning_preferences.0.failure_tolerance_percentage"},
+						},
+						"failure_tolerance_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(0, 100),
+							ConflictsWith: []string{"stack_set_provisioning_preferences.0.failure_tolerance_count"},
+						},
+						"max_concurrency_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntAtLeast(1),
+							ConflictsWith: []string{"stack_set_provisioning_preferences.0.max_concurrency_percentage"},
+						},
+						"max_concurrency_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 100),
+							ConflictsWith: []string{"stack_set_provisioning_preferences.0.max_concurrency_count"},
+						},
+						"regions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProvisionedProductCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+	name := d.Get("name").(string)
+	input := &servicecatalog.ProvisionProductInput{
+		AcceptLanguage:         aws.String(acceptLanguage),
+		ProvisionToken:         aws.String(resource.UniqueId()),
+		ProvisionedProductName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("notification_arns"); ok && len(v.([]interface{})) > 0 {
+		input.NotificationArns = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("path_name"); ok {
+		input.PathName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("product_id"); ok {
+		input.ProductId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("product_name"); ok {
+		input.ProductName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_artifact_id"); ok {
+		input.ProvisioningArtifactId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_artifact_name"); ok {
+		input.ProvisioningArtifactName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.ProvisioningParameters = expandServiceCatalogProvisioningParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("stack_set_provisioning_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ProvisioningPreferences = expandServiceCatalogProvisioningPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().ServicecatalogTags()
+	}
+
+	log.Printf("[DEBUG] Provisioning Service Catalog Product: %s", input)
+	var output *servicecatalog.ProvisionProductOutput
+	err := resource.Retry(waiter.PropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		output, err = conn.ProvisionProduct(input)
+
+		// Newly created launch paths and principal associations can take a moment to propagate
+		if tfawserr.ErrMessageContains(err, servicecatalog.ErrCodeInvalidParametersException, "No launch paths found") {
+			return resource.RetryableError(err)
+		}
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = conn.ProvisionProduct(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error provisioning Service Catalog Product (%s): %w", name, err)
+	}
+
+	if output == nil || output.RecordDetail == nil {
+		return fmt.Errorf("error provisioning Service Catalog Product (%s): empty response", name)
+	}
+
+	d.SetId(aws.StringValue(output.RecordDetail.ProvisionedProductId))
+
+	if _, err := waiter.RecordReady(conn, acceptLanguage, aws.StringValue(output.RecordDetail.RecordId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisionedProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisionedProductRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	acceptLanguage := d.Get("accept_language").(string)
+	output, err := finder.ProvisionedProductByID(conn, acceptLanguage, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Service Catalog Provisioned Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioned Product (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.ProvisionedProductDetail == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Service Catalog Provisioned Product (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Service Catalog Provisioned Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	detail := output.ProvisionedProductDetail
+
+	d.Set("arn", detail.Arn)
+
+	var dashboardNames []string
+	for _, dashboard := range output.CloudWatchDashboards {
+		if dashboard != nil {
+			dashboardNames = append(dashboardNames, aws.StringValue(dashboard.Name))
+		}
+	}
+
+	if err := d.Set("cloudwatch_dashboard_names", dashboardNames); err != nil {
+		return fmt.Errorf("error setting cloudwatch_dashboard_names: %w", err)
+	}
+
+	if detail.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(detail.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+
+	d.Set("last_record_id", detail.LastRecordId)
+	d.Set("launch_role_arn", detail.LaunchRoleArn)
+	d.Set("name", detail.Name)
+	d.Set("product_id", detail.ProductId)
+	d.Set("provisioning_artifact_id", detail.ProvisioningArtifactId)
+	d.Set("status", detail.Status)
+	d.Set("status_message", detail.StatusMessage)
+	d.Set("type", detail.Type)
+
+	// Outputs, tags and the launch path are only available from the last successful provisioning record
+	recordID := aws.StringValue(detail.LastSuccessfulProvisioningRecordId)
+
+	if recordID == "" {
+		recordID = aws.StringValue(detail.LastProvisioningRecordId)
+	}
+
+	if recordID == "" {
+		return nil
+	}
+
+	record, err := finder.RecordByID(conn, acceptLanguage, recordID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioned Product (%s) record (%s): %w", d.Id(), recordID, err)
+	}
+
+	if record == nil || record.RecordDetail == nil {
+		return fmt.Errorf("error reading Service Catalog Provisioned Product (%s) record (%s): empty response", d.Id(), recordID)
+	}
+
+	if err := d.Set("outputs", flattenServiceCatalogRecordOutputs(record.RecordOutputs)); err != nil {
+		return fmt.Errorf("error setting outputs: %w", err)
+	}
+
+	d.Set("path_id", record.RecordDetail.PathId)
+
+	tags := make(map[string]interface{})
+	for _, recordTag := range record.RecordDetail.RecordTags {
+		if recordTag != nil {
+			tags[aws.StringValue(recordTag.Key)] = aws.StringValue(recordTag.Value)
+		}
+	}
+
+	if err := d.Set("tags", keyvaluetags.New(tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisionedProductUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+	input := &servicecatalog.UpdateProvisionedProductInput{
+		AcceptLanguage:       aws.String(acceptLanguage),
+		ProvisionedProductId: aws.String(d.Id()),
+		UpdateToken:          aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("path_name"); ok {
+		input.PathName = aws.String(v.(string))
+	}
+
+	// Prefer the configured names so that the latest matching artifact is used
+	if v, ok := d.GetOk("product_name"); ok {
+		input.ProductName = aws.String(v.(string))
+	} else if v, ok := d.GetOk("product_id"); ok {
+		input.ProductId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_artifact_name"); ok {
+		input.ProvisioningArtifactName = aws.String(v.(string))
+	} else if v, ok := d.GetOk("provisioning_artifact_id"); ok {
+		input.ProvisioningArtifactId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.ProvisioningParameters = expandServiceCatalogUpdateProvisioningParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("stack_set_provisioning_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ProvisioningPreferences = expandServiceCatalogUpdateProvisioningPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if d.HasChange("tags") {
+		input.Tags = keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().ServicecatalogTags()
+
+		// An empty list leaves the existing tags in place
+		if input.Tags == nil {
+			input.Tags = []*servicecatalog.Tag{}
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Provisioned Product: %s", input)
+	output, err := conn.UpdateProvisionedProduct(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Service Catalog Provisioned Product (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.RecordDetail == nil {
+		return fmt.Errorf("error updating Service Catalog Provisioned Product (%s): empty response", d.Id())
+	}
+
+	if _, err := waiter.RecordReady(conn, acceptLanguage, aws.StringValue(output.RecordDetail.RecordId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisionedProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisionedProductDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	acceptLanguage := d.Get("accept_language").(string)
+	input := &servicecatalog.TerminateProvisionedProductInput{
+		AcceptLanguage:          aws.String(acceptLanguage),
+		IgnoreErrors:            aws.Bool(d.Get("ignore_errors").(bool)),
+		ProvisionedProductId:    aws.String(d.Id()),
+		RetainPhysicalResources: aws.Bool(d.Get("retain_physical_resources").(bool)),
+		TerminateToken:          aws.String(resource.UniqueId()),
+	}
+
+	log.Printf("[DEBUG] Terminating Service Catalog Provisioned Product: %s", d.Id())
+	output, err := conn.TerminateProvisionedProduct(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error terminating Service Catalog Provisioned Product (%s): %w", d.Id(), err)
+	}
+
+	if output != nil && output.RecordDetail != nil {
+		_, err := waiter.RecordReady(conn, acceptLanguage, aws.StringValue(output.RecordDetail.RecordId), d.Timeout(schema.TimeoutDelete))
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) termination: %w", d.Id(), err)
+		}
+	}
+
+	if err := waiter.ProvisionedProductTerminated(conn, acceptLanguage, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) termination: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServiceCatalogProvisioningParameters(tfList []interface{}) []*servicecatalog.ProvisioningParameter {
+	var apiObjects []*servicecatalog.ProvisioningParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &servicecatalog.ProvisioningParameter{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandServiceCatalogUpdateProvisioningParameters(tfList []interface{}) []*servicecatalog.UpdateProvisioningParameter {
+	var apiObjects []*servicecatalog.UpdateProvisioningParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &servicecatalog.UpdateProvisioningParameter{
+			Key: aws.String(tfMap["key"].(string)),
+		}
+
+		if v, ok := tfMap["use_previous_value"].(bool); ok && v {
+			apiObject.UsePreviousValue = aws.Bool(v)
+		} else {
+			apiObject.Value = aws.String(tfMap["value"].(string))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandServiceCatalogProvisioningPreferences(tfMap map[string]interface{}) *servicecatalog.ProvisioningPreferences {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &servicecatalog.ProvisioningPreferences{}
+
+	if v, ok := tfMap["accounts"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StackSetAccounts = expandStringList(v)
+	}
+
+	if v, ok := tfMap["failure_tolerance_count"].(int); ok && v != 0 {
+		apiObject.StackSetFailureToleranceCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["failure_tolerance_percentage"].(int); ok && v != 0 {
+		apiObject.StackSetFailureTolerancePercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_concurrency_count"].(int); ok && v != 0 {
+		apiObject.StackSetMaxConcurrencyCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_concurrency_percentage"].(int); ok && v != 0 {
+		apiObject.StackSetMaxConcurrencyPercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["regions"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StackSetRegions = expandStringList(v)
+	}
+
+	return apiObject
+}
+
+func expandServiceCatalogUpdateProvisioningPreferences(tfMap map[string]interface{}) *servicecatalog.UpdateProvisioningPreferences {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &servicecatalog.UpdateProvisioningPreferences{}
+
+	if v, ok := tfMap["accounts"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StackSetAccounts = expandStringList(v)
+	}
+
+	if v, ok := tfMap["failure_tolerance_count"].(int); ok && v != 0 {
+		apiObject.StackSetFailureToleranceCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["failure_tolerance_percentage"].(int); ok && v != 0 {
+		apiObject.StackSetFailureTolerancePercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_concurrency_count"].(int); ok && v != 0 {
+		apiObject.StackSetMaxConcurrencyCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_concurrency_percentage"].(int); ok && v != 0 {
+		apiObject.StackSetMaxConcurrencyPercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["regions"].([]interface{}); ok && len(v) > 0 {
+		apiObject.StackSetRegions = expandStringList(v)
+	}
+
+	return apiObject
+}
+
+func flattenServiceCatalogRecordOutputs(apiObjects []*servicecatalog.RecordOutput) map[string]interface{} {
+	tfMap := make(map[string]interface{})
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap[aws.StringValue(apiObject.OutputKey)] = aws.StringValue(apiObject.OutputValue)
+	}
+
+	return tfMap
+}
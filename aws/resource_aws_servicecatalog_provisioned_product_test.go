@@ -0,0 +1,295 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+)
+
+func TestAccAWSServiceCatalogProvisionedProduct_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accept_language", "en"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "servicecatalog", regexp.MustCompile(`stack/.+/pp-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.Message", "hello"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_cloudformation_stack.test", "outputs.ProductId"),
+					resource.TestCheckResourceAttrSet(resourceName, "provisioning_artifact_id"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.ProvisionedProductStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "CFN_STACK"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"accept_language",
+					"ignore_errors",
+					"provisioning_artifact_name",
+					"provisioning_parameters",
+					"retain_physical_resources",
+				},
+			},
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, "goodbye"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.Message", "goodbye"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.ProvisionedProductStatusAvailable),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProvisionedProduct_disappears(t *testing.T) {
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogProvisionedProduct(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProvisionedProduct_tags(t *testing.T) {
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigTags1(rName, "key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceCatalogProvisionedProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_provisioned_product" {
+			continue
+		}
+
+		output, err := finder.ProvisionedProductByID(conn, rs.Primary.Attributes["accept_language"], rs.Primary.ID)
+
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Service Catalog Provisioned Product (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil && output.ProvisionedProductDetail != nil {
+			return fmt.Errorf("Service Catalog Provisioned Product (%s) still exists with status %s", rs.Primary.ID, aws.StringValue(output.ProvisionedProductDetail.Status))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Provisioned Product ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		_, err := finder.ProvisionedProductByID(conn, rs.Primary.Attributes["accept_language"], rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Service Catalog Provisioned Product (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+// testAccAWSServiceCatalogProvisionedProductConfigBase creates a portfolio and a
+// product via CloudFormation, as there is no product resource yet.
+func testAccAWSServiceCatalogProvisionedProductConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "%[1]s.json"
+
+  content = jsonencode({
+    AWSTemplateFormatVersion = "2010-09-09"
+
+    Parameters = {
+      Message = {
+        Type = "String"
+      }
+    }
+
+    Resources = {
+      WaitHandle = {
+        Type = "AWS::CloudFormation::WaitConditionHandle"
+      }
+    }
+
+    Outputs = {
+      Message = {
+        Value = { Ref = "Message" }
+      }
+    }
+  })
+}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = %[1]q
+  description   = "test"
+  provider_name = "test"
+}
+
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  template_body = jsonencode({
+    AWSTemplateFormatVersion = "2010-09-09"
+
+    Resources = {
+      Product = {
+        Type = "AWS::ServiceCatalog::CloudFormationProduct"
+        Properties = {
+          Name  = %[1]q
+          Owner = "test"
+          ProvisioningArtifactParameters = [{
+            Name = "v1"
+            Info = {
+              LoadTemplateFromURL = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+            }
+          }]
+        }
+      }
+
+      ProductAssociation = {
+        Type = "AWS::ServiceCatalog::PortfolioProductAssociation"
+        Properties = {
+          PortfolioId = aws_servicecatalog_portfolio.test.id
+          ProductId   = { Ref = "Product" }
+        }
+      }
+
+      PrincipalAssociation = {
+        Type = "AWS::ServiceCatalog::PortfolioPrincipalAssociation"
+        Properties = {
+          PortfolioId   = aws_servicecatalog_portfolio.test.id
+          PrincipalARN  = data.aws_caller_identity.current.arn
+          PrincipalType = "IAM"
+        }
+      }
+    }
+
+    Outputs = {
+      ProductId = {
+        Value = { Ref = "Product" }
+      }
+    }
+  })
+}
+`, rName)
+}
+
+func testAccAWSServiceCatalogProvisionedProductConfigBasic(rName, message string) string {
+	return composeConfig(
+		testAccAWSServiceCatalogProvisionedProductConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_id                 = aws_cloudformation_stack.test.outputs["ProductId"]
+  provisioning_artifact_name = "v1"
+
+  provisioning_parameters {
+    key   = "Message"
+    value = %[2]q
+  }
+}
+`, rName, message))
+}
+
+func testAccAWSServiceCatalogProvisionedProductConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSServiceCatalogProvisionedProductConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_id                 = aws_cloudformation_stack.test.outputs["ProductId"]
+  provisioning_artifact_name = "v1"
+
+  provisioning_parameters {
+    key   = "Message"
+    value = "hello"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
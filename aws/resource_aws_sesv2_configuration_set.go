@@ -0,0 +1,655 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sesv2/finder"
+)
+
+func resourceAwsSesV2ConfigurationSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2ConfigurationSetCreate,
+		Read:   resourceAwsSesV2ConfigurationSetRead,
+		Update: resourceAwsSesV2ConfigurationSetUpdate,
+		Delete: resourceAwsSesV2ConfigurationSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"delivery_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sending_pool_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tls_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      sesv2.TlsPolicyOptional,
+							ValidateFunc: validation.StringInSlice(sesv2.TlsPolicy_Values(), false),
+						},
+					},
+				},
+			},
+			"reputation_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_fresh_start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reputation_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"sending_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sending_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"suppression_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suppressed_reasons": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(sesv2.SuppressionListReason_Values(), false),
+							},
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+			"tracking_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_redirect_domain": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"vdm_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dashboard_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"engagement_metrics": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"guardian_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"optimized_shared_delivery": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsSesV2ConfigurationSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	name := d.Get("configuration_set_name").(string)
+	input := &sesv2.CreateConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("delivery_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeliveryOptions = expandSesV2DeliveryOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("reputation_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ReputationOptions = expandSesV2ReputationOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sending_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SendingOptions = expandSesV2SendingOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("suppression_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SuppressionOptions = expandSesV2SuppressionOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().Sesv2Tags()
+	}
+
+	if v, ok := d.GetOk("tracking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TrackingOptions = expandSesV2TrackingOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vdm_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VdmOptions = expandSesV2VdmOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Configuration Set: %s", input)
+	_, err := conn.CreateConfigurationSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Configuration Set (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSesV2ConfigurationSetRead(d, meta)
+}
+
+func resourceAwsSesV2ConfigurationSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.ConfigurationSetByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		log.Printf("[WARN] SESv2 Configuration Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Configuration Set (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		AccountID: meta.(*AWSClient).accountid,
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("configuration-set/%s", d.Id()),
+		Service:   "ses",
+	}.String()
+
+	d.Set("arn", arn)
+	d.Set("configuration_set_name", output.ConfigurationSetName)
+
+	if output.DeliveryOptions != nil {
+		if err := d.Set("delivery_options", []interface{}{flattenSesV2DeliveryOptions(output.DeliveryOptions)}); err != nil {
+			return fmt.Errorf("error setting delivery_options: %w", err)
+		}
+	} else {
+		d.Set("delivery_options", nil)
+	}
+
+	if output.ReputationOptions != nil {
+		if err := d.Set("reputation_options", []interface{}{flattenSesV2ReputationOptions(output.ReputationOptions)}); err != nil {
+			return fmt.Errorf("error setting reputation_options: %w", err)
+		}
+	} else {
+		d.Set("reputation_options", nil)
+	}
+
+	if output.SendingOptions != nil {
+		if err := d.Set("sending_options", []interface{}{flattenSesV2SendingOptions(output.SendingOptions)}); err != nil {
+			return fmt.Errorf("error setting sending_options: %w", err)
+		}
+	} else {
+		d.Set("sending_options", nil)
+	}
+
+	if output.SuppressionOptions != nil && len(output.SuppressionOptions.SuppressedReasons) > 0 {
+		if err := d.Set("suppression_options", []interface{}{flattenSesV2SuppressionOptions(output.SuppressionOptions)}); err != nil {
+			return fmt.Errorf("error setting suppression_options: %w", err)
+		}
+	} else {
+		d.Set("suppression_options", nil)
+	}
+
+	if output.TrackingOptions != nil {
+		if err := d.Set("tracking_options", []interface{}{flattenSesV2TrackingOptions(output.TrackingOptions)}); err != nil {
+			return fmt.Errorf("error setting tracking_options: %w", err)
+		}
+	} else {
+		d.Set("tracking_options", nil)
+	}
+
+	if output.VdmOptions != nil {
+		if err := d.Set("vdm_options", []interface{}{flattenSesV2VdmOptions(output.VdmOptions)}); err != nil {
+			return fmt.Errorf("error setting vdm_options: %w", err)
+		}
+	} else {
+		d.Set("vdm_options", nil)
+	}
+
+	tags, err := keyvaluetags.Sesv2ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SESv2 Configuration Set (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2ConfigurationSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	if d.HasChange("delivery_options") {
+		input := &sesv2.PutConfigurationSetDeliveryOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("delivery_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["sending_pool_name"].(string); ok && v != "" {
+				input.SendingPoolName = aws.String(v)
+			}
+
+			if v, ok := tfMap["tls_policy"].(string); ok && v != "" {
+				input.TlsPolicy = aws.String(v)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set delivery options: %s", input)
+		if _, err := conn.PutConfigurationSetDeliveryOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) delivery options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("reputation_options") {
+		input := &sesv2.PutConfigurationSetReputationOptionsInput{
+			ConfigurationSetName:     aws.String(d.Id()),
+			ReputationMetricsEnabled: aws.Bool(false),
+		}
+
+		if v, ok := d.GetOk("reputation_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["reputation_metrics_enabled"].(bool); ok {
+				input.ReputationMetricsEnabled = aws.Bool(v)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set reputation options: %s", input)
+		if _, err := conn.PutConfigurationSetReputationOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) reputation options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("sending_options") {
+		input := &sesv2.PutConfigurationSetSendingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			SendingEnabled:       aws.Bool(true),
+		}
+
+		if v, ok := d.GetOk("sending_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["sending_enabled"].(bool); ok {
+				input.SendingEnabled = aws.Bool(v)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set sending options: %s", input)
+		if _, err := conn.PutConfigurationSetSendingOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) sending options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("suppression_options") {
+		input := &sesv2.PutConfigurationSetSuppressionOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("suppression_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["suppressed_reasons"].(*schema.Set); ok && v.Len() > 0 {
+				input.SuppressedReasons = expandStringSet(v)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set suppression options: %s", input)
+		if _, err := conn.PutConfigurationSetSuppressionOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) suppression options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tracking_options") {
+		input := &sesv2.PutConfigurationSetTrackingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("tracking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["custom_redirect_domain"].(string); ok && v != "" {
+				input.CustomRedirectDomain = aws.String(v)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set tracking options: %s", input)
+		if _, err := conn.PutConfigurationSetTrackingOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) tracking options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("vdm_options") {
+		input := &sesv2.PutConfigurationSetVdmOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("vdm_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.VdmOptions = expandSesV2VdmOptions(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Configuration Set VDM options: %s", input)
+		if _, err := conn.PutConfigurationSetVdmOptions(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) VDM options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Sesv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SESv2 Configuration Set (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSesV2ConfigurationSetRead(d, meta)
+}
+
+func resourceAwsSesV2ConfigurationSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	log.Printf("[DEBUG] Deleting SESv2 Configuration Set: %s", d.Id())
+	_, err := conn.DeleteConfigurationSet(&sesv2.DeleteConfigurationSetInput{
+		ConfigurationSetName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Configuration Set (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSesV2DeliveryOptions(tfMap map[string]interface{}) *sesv2.DeliveryOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.DeliveryOptions{}
+
+	if v, ok := tfMap["sending_pool_name"].(string); ok && v != "" {
+		apiObject.SendingPoolName = aws.String(v)
+	}
+
+	if v, ok := tfMap["tls_policy"].(string); ok && v != "" {
+		apiObject.TlsPolicy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSesV2ReputationOptions(tfMap map[string]interface{}) *sesv2.ReputationOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.ReputationOptions{}
+
+	if v, ok := tfMap["reputation_metrics_enabled"].(bool); ok {
+		apiObject.ReputationMetricsEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandSesV2SendingOptions(tfMap map[string]interface{}) *sesv2.SendingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.SendingOptions{}
+
+	if v, ok := tfMap["sending_enabled"].(bool); ok {
+		apiObject.SendingEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandSesV2SuppressionOptions(tfMap map[string]interface{}) *sesv2.SuppressionOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.SuppressionOptions{}
+
+	if v, ok := tfMap["suppressed_reasons"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SuppressedReasons = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandSesV2TrackingOptions(tfMap map[string]interface{}) *sesv2.TrackingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.TrackingOptions{}
+
+	if v, ok := tfMap["custom_redirect_domain"].(string); ok && v != "" {
+		apiObject.CustomRedirectDomain = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSesV2VdmOptions(tfMap map[string]interface{}) *sesv2.VdmOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.VdmOptions{}
+
+	if v, ok := tfMap["dashboard_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		dashboardOptions := &sesv2.DashboardOptions{}
+
+		if v, ok := v[0].(map[string]interface{})["engagement_metrics"].(string); ok && v != "" {
+			dashboardOptions.EngagementMetrics = aws.String(v)
+		}
+
+		apiObject.DashboardOptions = dashboardOptions
+	}
+
+	if v, ok := tfMap["guardian_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		guardianOptions := &sesv2.GuardianOptions{}
+
+		if v, ok := v[0].(map[string]interface{})["optimized_shared_delivery"].(string); ok && v != "" {
+			guardianOptions.OptimizedSharedDelivery = aws.String(v)
+		}
+
+		apiObject.GuardianOptions = guardianOptions
+	}
+
+	return apiObject
+}
+
+func flattenSesV2DeliveryOptions(apiObject *sesv2.DeliveryOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SendingPoolName; v != nil {
+		tfMap["sending_pool_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TlsPolicy; v != nil {
+		tfMap["tls_policy"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenSesV2ReputationOptions(apiObject *sesv2.ReputationOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LastFreshStart; v != nil {
+		tfMap["last_fresh_start"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.ReputationMetricsEnabled; v != nil {
+		tfMap["reputation_metrics_enabled"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenSesV2SendingOptions(apiObject *sesv2.SendingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SendingEnabled; v != nil {
+		tfMap["sending_enabled"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenSesV2SuppressionOptions(apiObject *sesv2.SuppressionOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SuppressedReasons; v != nil {
+		tfMap["suppressed_reasons"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenSesV2TrackingOptions(apiObject *sesv2.TrackingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomRedirectDomain; v != nil {
+		tfMap["custom_redirect_domain"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenSesV2VdmOptions(apiObject *sesv2.VdmOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DashboardOptions; v != nil {
+		tfMap["dashboard_options"] = []interface{}{map[string]interface{}{
+			"engagement_metrics": aws.StringValue(v.EngagementMetrics),
+		}}
+	}
+
+	if v := apiObject.GuardianOptions; v != nil {
+		tfMap["guardian_options"] = []interface{}{map[string]interface{}{
+			"optimized_shared_delivery": aws.StringValue(v.OptimizedSharedDelivery),
+		}}
+	}
+
+	return tfMap
+}
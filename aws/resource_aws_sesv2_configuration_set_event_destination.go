@@ -0,0 +1,412 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfsesv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sesv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sesv2/finder"
+)
+
+func resourceAwsSesV2ConfigurationSetEventDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2ConfigurationSetEventDestinationCreate,
+		Read:   resourceAwsSesV2ConfigurationSetEventDestinationRead,
+		Update: resourceAwsSesV2ConfigurationSetEventDestinationUpdate,
+		Delete: resourceAwsSesV2ConfigurationSetEventDestinationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"event_destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_watch_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"default_dimension_value": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.All(
+														validation.StringLenBetween(1, 256),
+														validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.@-]+$`), "must contain only alphanumeric characters, underscores, hyphens, periods and at signs"),
+													),
+												},
+												"dimension_name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.All(
+														validation.StringLenBetween(1, 256),
+														validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_:-]+$`), "must contain only alphanumeric characters, underscores, hyphens and colons"),
+													),
+												},
+												"dimension_value_source": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(sesv2.DimensionValueSource_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"kinesis_firehose_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_stream_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"iam_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"matching_event_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(sesv2.EventType_Values(), false),
+							},
+						},
+						"pinpoint_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+						"sns_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+							ExactlyOneOf: []string{
+								"event_destination.0.cloud_watch_destination",
+								"event_destination.0.kinesis_firehose_destination",
+								"event_destination.0.pinpoint_destination",
+								"event_destination.0.sns_destination",
+							},
+						},
+					},
+				},
+			},
+			"event_destination_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+		},
+	}
+}
+
+func resourceAwsSesV2ConfigurationSetEventDestinationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	configurationSetName := d.Get("configuration_set_name").(string)
+	eventDestinationName := d.Get("event_destination_name").(string)
+	id := tfsesv2.ConfigurationSetEventDestinationCreateID(configurationSetName, eventDestinationName)
+
+	input := &sesv2.CreateConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestination:     expandSesV2EventDestinationDefinition(d.Get("event_destination").([]interface{})[0].(map[string]interface{})),
+		EventDestinationName: aws.String(eventDestinationName),
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Configuration Set Event Destination: %s", input)
+	_, err := conn.CreateConfigurationSetEventDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Configuration Set Event Destination (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsSesV2ConfigurationSetEventDestinationRead(d, meta)
+}
+
+func resourceAwsSesV2ConfigurationSetEventDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	configurationSetName, eventDestinationName, err := tfsesv2.ConfigurationSetEventDestinationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	eventDestination, err := finder.ConfigurationSetEventDestinationByTwoPartKey(conn, configurationSetName, eventDestinationName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		log.Printf("[WARN] SESv2 Configuration Set Event Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Configuration Set Event Destination (%s): %w", d.Id(), err)
+	}
+
+	if eventDestination == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading SESv2 Configuration Set Event Destination (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] SESv2 Configuration Set Event Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("configuration_set_name", configurationSetName)
+	d.Set("event_destination_name", eventDestination.Name)
+
+	if err := d.Set("event_destination", []interface{}{flattenSesV2EventDestination(eventDestination)}); err != nil {
+		return fmt.Errorf("error setting event_destination: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2ConfigurationSetEventDestinationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	configurationSetName, eventDestinationName, err := tfsesv2.ConfigurationSetEventDestinationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &sesv2.UpdateConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestination:     expandSesV2EventDestinationDefinition(d.Get("event_destination").([]interface{})[0].(map[string]interface{})),
+		EventDestinationName: aws.String(eventDestinationName),
+	}
+
+	log.Printf("[DEBUG] Updating SESv2 Configuration Set Event Destination: %s", input)
+	_, err = conn.UpdateConfigurationSetEventDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating SESv2 Configuration Set Event Destination (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsSesV2ConfigurationSetEventDestinationRead(d, meta)
+}
+
+func resourceAwsSesV2ConfigurationSetEventDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	configurationSetName, eventDestinationName, err := tfsesv2.ConfigurationSetEventDestinationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting SESv2 Configuration Set Event Destination: %s", d.Id())
+	_, err = conn.DeleteConfigurationSetEventDestination(&sesv2.DeleteConfigurationSetEventDestinationInput{
+		ConfigurationSetName: aws.String(configurationSetName),
+		EventDestinationName: aws.String(eventDestinationName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Configuration Set Event Destination (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSesV2EventDestinationDefinition(tfMap map[string]interface{}) *sesv2.EventDestinationDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.EventDestinationDefinition{}
+
+	if v, ok := tfMap["cloud_watch_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchDestination = expandSesV2CloudWatchDestination(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["kinesis_firehose_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.KinesisFirehoseDestination = &sesv2.KinesisFirehoseDestination{
+			DeliveryStreamArn: aws.String(tfMap["delivery_stream_arn"].(string)),
+			IamRoleArn:        aws.String(tfMap["iam_role_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["matching_event_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchingEventTypes = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["pinpoint_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PinpointDestination = &sesv2.PinpointDestination{
+			ApplicationArn: aws.String(v[0].(map[string]interface{})["application_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sns_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SnsDestination = &sesv2.SnsDestination{
+			TopicArn: aws.String(v[0].(map[string]interface{})["topic_arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandSesV2CloudWatchDestination(tfMap map[string]interface{}) *sesv2.CloudWatchDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.CloudWatchDestination{}
+
+	for _, tfMapRaw := range tfMap["dimension_configuration"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.DimensionConfigurations = append(apiObject.DimensionConfigurations, &sesv2.CloudWatchDimensionConfiguration{
+			DefaultDimensionValue: aws.String(tfMap["default_dimension_value"].(string)),
+			DimensionName:         aws.String(tfMap["dimension_name"].(string)),
+			DimensionValueSource:  aws.String(tfMap["dimension_value_source"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenSesV2EventDestination(apiObject *sesv2.EventDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":              aws.BoolValue(apiObject.Enabled),
+		"matching_event_types": aws.StringValueSlice(apiObject.MatchingEventTypes),
+	}
+
+	if v := apiObject.CloudWatchDestination; v != nil {
+		var tfList []interface{}
+
+		for _, dimensionConfiguration := range v.DimensionConfigurations {
+			if dimensionConfiguration == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"default_dimension_value": aws.StringValue(dimensionConfiguration.DefaultDimensionValue),
+				"dimension_name":          aws.StringValue(dimensionConfiguration.DimensionName),
+				"dimension_value_source":  aws.StringValue(dimensionConfiguration.DimensionValueSource),
+			})
+		}
+
+		tfMap["cloud_watch_destination"] = []interface{}{map[string]interface{}{
+			"dimension_configuration": tfList,
+		}}
+	}
+
+	if v := apiObject.KinesisFirehoseDestination; v != nil {
+		tfMap["kinesis_firehose_destination"] = []interface{}{map[string]interface{}{
+			"delivery_stream_arn": aws.StringValue(v.DeliveryStreamArn),
+			"iam_role_arn":        aws.StringValue(v.IamRoleArn),
+		}}
+	}
+
+	if v := apiObject.PinpointDestination; v != nil {
+		tfMap["pinpoint_destination"] = []interface{}{map[string]interface{}{
+			"application_arn": aws.StringValue(v.ApplicationArn),
+		}}
+	}
+
+	if v := apiObject.SnsDestination; v != nil {
+		tfMap["sns_destination"] = []interface{}{map[string]interface{}{
+			"topic_arn": aws.StringValue(v.TopicArn),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfsesv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sesv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sesv2/finder"
+)
+
+func TestAccAWSSESV2ConfigurationSetEventDestination_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set_event_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetEventDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetEventDestinationConfigCloudWatchDestination(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetEventDestinationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_set_name", "aws_sesv2_configuration_set.test", "configuration_set_name"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.0.default_dimension_value", "test1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.0.dimension_name", "test1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.0.dimension_value_source", sesv2.DimensionValueSourceMessageTag),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.matching_event_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "event_destination.0.matching_event_types.*", sesv2.EventTypeSend),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2ConfigurationSetEventDestinationConfigCloudWatchDestination(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetEventDestinationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.0.default_dimension_value", "test2"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.0.dimension_name", "test2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2ConfigurationSetEventDestination_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set_event_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetEventDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetEventDestinationConfigCloudWatchDestination(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetEventDestinationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSesV2ConfigurationSetEventDestination(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2ConfigurationSetEventDestination_SnsDestination(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set_event_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetEventDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetEventDestinationConfigSnsDestination(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetEventDestinationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.sns_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_destination.0.sns_destination.0.topic_arn", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSesV2ConfigurationSetEventDestinationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_configuration_set_event_destination" {
+			continue
+		}
+
+		configurationSetName, eventDestinationName, err := tfsesv2.ConfigurationSetEventDestinationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.ConfigurationSetEventDestinationByTwoPartKey(conn, configurationSetName, eventDestinationName)
+
+		if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("SESv2 Configuration Set Event Destination (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSesV2ConfigurationSetEventDestinationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		configurationSetName, eventDestinationName, err := tfsesv2.ConfigurationSetEventDestinationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		output, err := finder.ConfigurationSetEventDestinationByTwoPartKey(conn, configurationSetName, eventDestinationName)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("SESv2 Configuration Set Event Destination (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSESV2ConfigurationSetEventDestinationConfigCloudWatchDestination(rName, dimension string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+}
+
+resource "aws_sesv2_configuration_set_event_destination" "test" {
+  configuration_set_name = aws_sesv2_configuration_set.test.configuration_set_name
+  event_destination_name = %[1]q
+
+  event_destination {
+    cloud_watch_destination {
+      dimension_configuration {
+        default_dimension_value = %[2]q
+        dimension_name          = %[2]q
+        dimension_value_source  = "MESSAGE_TAG"
+      }
+    }
+
+    matching_event_types = ["SEND"]
+  }
+}
+`, rName, dimension)
+}
+
+func testAccAWSSESV2ConfigurationSetEventDestinationConfigSnsDestination(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+}
+
+resource "aws_sesv2_configuration_set_event_destination" "test" {
+  configuration_set_name = aws_sesv2_configuration_set.test.configuration_set_name
+  event_destination_name = %[1]q
+
+  event_destination {
+    enabled = true
+
+    sns_destination {
+      topic_arn = aws_sns_topic.test.arn
+    }
+
+    matching_event_types = ["BOUNCE", "COMPLAINT"]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,557 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sesv2/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sesv2_configuration_set", &resource.Sweeper{
+		Name: "aws_sesv2_configuration_set",
+		F:    testSweepSesV2ConfigurationSets,
+	})
+}
+
+func testSweepSesV2ConfigurationSets(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).sesv2conn
+	input := &sesv2.ListConfigurationSetsInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListConfigurationSetsPages(input, func(page *sesv2.ListConfigurationSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, name := range page.ConfigurationSets {
+			name := aws.StringValue(name)
+
+			log.Printf("[INFO] Deleting SESv2 Configuration Set: %s", name)
+			_, err := conn.DeleteConfigurationSet(&sesv2.DeleteConfigurationSetInput{
+				ConfigurationSetName: aws.String(name),
+			})
+
+			if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting SESv2 Configuration Set (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping SESv2 Configuration Set sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing SESv2 Configuration Sets: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSSESV2ConfigurationSet_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("configuration-set/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.0.sending_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2ConfigurationSet_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSesV2ConfigurationSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2ConfigurationSet_DeliveryOptions(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigDeliveryOptions(rName, sesv2.TlsPolicyRequire),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "delivery_options.0.sending_pool_name", "aws_sesv2_dedicated_ip_pool.test", "pool_name"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", sesv2.TlsPolicyRequire),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigDeliveryOptions(rName, sesv2.TlsPolicyOptional),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", sesv2.TlsPolicyOptional),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2ConfigurationSet_ReputationOptions(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigReputationOptions(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "reputation_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reputation_options.0.reputation_metrics_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigReputationOptions(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "reputation_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reputation_options.0.reputation_metrics_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2ConfigurationSet_SendingOptions(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigSendingOptions(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.0.sending_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigSendingOptions(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.0.sending_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2ConfigurationSet_SuppressionOptions(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigSuppressionOptions(rName, sesv2.SuppressionListReasonBounce),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.0.suppressed_reasons.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "suppression_options.0.suppressed_reasons.*", sesv2.SuppressionListReasonBounce),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigSuppressionOptions(rName, sesv2.SuppressionListReasonComplaint),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.0.suppressed_reasons.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "suppression_options.0.suppressed_reasons.*", sesv2.SuppressionListReasonComplaint),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2ConfigurationSet_TrackingOptions(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigTrackingOptions(rName, "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.0.custom_redirect_domain", "example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigTrackingOptions(rName, "example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.0.custom_redirect_domain", "example.net"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2ConfigurationSet_VdmOptions(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigVdmOptions(rName, sesv2.FeatureStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.0.dashboard_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.0.dashboard_options.0.engagement_metrics", sesv2.FeatureStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.0.guardian_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.0.guardian_options.0.optimized_shared_delivery", sesv2.FeatureStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigVdmOptions(rName, sesv2.FeatureStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.0.dashboard_options.0.engagement_metrics", sesv2.FeatureStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "vdm_options.0.guardian_options.0.optimized_shared_delivery", sesv2.FeatureStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2ConfigurationSet_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2ConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSSESV2ConfigurationSetConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2ConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSesV2ConfigurationSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_configuration_set" {
+			continue
+		}
+
+		_, err := finder.ConfigurationSetByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SESv2 Configuration Set (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSesV2ConfigurationSetExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		_, err := finder.ConfigurationSetByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPreCheckAWSSESV2(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	input := &sesv2.ListConfigurationSetsInput{
+		PageSize: aws.Int64(1),
+	}
+
+	_, err := conn.ListConfigurationSets(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccAWSSESV2ConfigurationSetConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSSESV2ConfigurationSetConfigDeliveryOptions(rName, tlsPolicy string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test" {
+  pool_name = %[1]q
+}
+
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  delivery_options {
+    sending_pool_name = aws_sesv2_dedicated_ip_pool.test.pool_name
+    tls_policy        = %[2]q
+  }
+}
+`, rName, tlsPolicy)
+}
+
+func testAccAWSSESV2ConfigurationSetConfigReputationOptions(rName string, reputationMetricsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  reputation_options {
+    reputation_metrics_enabled = %[2]t
+  }
+}
+`, rName, reputationMetricsEnabled)
+}
+
+func testAccAWSSESV2ConfigurationSetConfigSendingOptions(rName string, sendingEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  sending_options {
+    sending_enabled = %[2]t
+  }
+}
+`, rName, sendingEnabled)
+}
+
+func testAccAWSSESV2ConfigurationSetConfigSuppressionOptions(rName, suppressedReason string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  suppression_options {
+    suppressed_reasons = [%[2]q]
+  }
+}
+`, rName, suppressedReason)
+}
+
+func testAccAWSSESV2ConfigurationSetConfigTrackingOptions(rName, customRedirectDomain string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  tracking_options {
+    custom_redirect_domain = %[2]q
+  }
+}
+`, rName, customRedirectDomain)
+}
+
+func testAccAWSSESV2ConfigurationSetConfigVdmOptions(rName, featureStatus string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  vdm_options {
+    dashboard_options {
+      engagement_metrics = %[2]q
+    }
+
+    guardian_options {
+      optimized_shared_delivery = %[2]q
+    }
+  }
+}
+`, rName, featureStatus)
+}
+
+func testAccAWSSESV2ConfigurationSetConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSSESV2ConfigurationSetConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
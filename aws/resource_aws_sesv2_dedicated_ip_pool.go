@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sesv2/finder"
+)
+
+func resourceAwsSesV2DedicatedIpPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSesV2DedicatedIpPoolCreate,
+		Read:   resourceAwsSesV2DedicatedIpPoolRead,
+		Update: resourceAwsSesV2DedicatedIpPoolUpdate,
+		Delete: resourceAwsSesV2DedicatedIpPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pool_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"scaling_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sesv2.ScalingModeStandard,
+				ValidateFunc: validation.StringInSlice(sesv2.ScalingMode_Values(), false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSesV2DedicatedIpPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	name := d.Get("pool_name").(string)
+	input := &sesv2.CreateDedicatedIpPoolInput{
+		PoolName:    aws.String(name),
+		ScalingMode: aws.String(d.Get("scaling_mode").(string)),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().Sesv2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Dedicated IP Pool: %s", input)
+	_, err := conn.CreateDedicatedIpPool(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Dedicated IP Pool (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSesV2DedicatedIpPoolRead(d, meta)
+}
+
+func resourceAwsSesV2DedicatedIpPoolRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	pool, err := finder.DedicatedIPPoolByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		log.Printf("[WARN] SESv2 Dedicated IP Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Dedicated IP Pool (%s): %w", d.Id(), err)
+	}
+
+	if pool == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading SESv2 Dedicated IP Pool (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] SESv2 Dedicated IP Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := arn.ARN{
+		AccountID: meta.(*AWSClient).accountid,
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("dedicated-ip-pool/%s", d.Id()),
+		Service:   "ses",
+	}.String()
+
+	d.Set("arn", arn)
+	d.Set("pool_name", pool.PoolName)
+	d.Set("scaling_mode", pool.ScalingMode)
+
+	tags, err := keyvaluetags.Sesv2ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SESv2 Dedicated IP Pool (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSesV2DedicatedIpPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	if d.HasChange("scaling_mode") {
+		input := &sesv2.PutDedicatedIpPoolScalingAttributesInput{
+			PoolName:    aws.String(d.Id()),
+			ScalingMode: aws.String(d.Get("scaling_mode").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Dedicated IP Pool scaling attributes: %s", input)
+		if _, err := conn.PutDedicatedIpPoolScalingAttributes(input); err != nil {
+			return fmt.Errorf("error updating SESv2 Dedicated IP Pool (%s) scaling mode: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Sesv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SESv2 Dedicated IP Pool (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSesV2DedicatedIpPoolRead(d, meta)
+}
+
+func resourceAwsSesV2DedicatedIpPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesv2conn
+
+	log.Printf("[DEBUG] Deleting SESv2 Dedicated IP Pool: %s", d.Id())
+	_, err := conn.DeleteDedicatedIpPool(&sesv2.DeleteDedicatedIpPoolInput{
+		PoolName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Dedicated IP Pool (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
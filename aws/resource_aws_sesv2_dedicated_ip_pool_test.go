@@ -0,0 +1,294 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sesv2/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sesv2_dedicated_ip_pool", &resource.Sweeper{
+		Name: "aws_sesv2_dedicated_ip_pool",
+		F:    testSweepSesV2DedicatedIpPools,
+		Dependencies: []string{
+			"aws_sesv2_configuration_set",
+		},
+	})
+}
+
+func testSweepSesV2DedicatedIpPools(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).sesv2conn
+	input := &sesv2.ListDedicatedIpPoolsInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListDedicatedIpPoolsPages(input, func(page *sesv2.ListDedicatedIpPoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, name := range page.DedicatedIpPools {
+			name := aws.StringValue(name)
+
+			log.Printf("[INFO] Deleting SESv2 Dedicated IP Pool: %s", name)
+			_, err := conn.DeleteDedicatedIpPool(&sesv2.DeleteDedicatedIpPoolInput{
+				PoolName: aws.String(name),
+			})
+
+			if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting SESv2 Dedicated IP Pool (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping SESv2 Dedicated IP Pool sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing SESv2 Dedicated IP Pools: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSSESV2DedicatedIpPool_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_dedicated_ip_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2DedicatedIpPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2DedicatedIpPoolExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("dedicated-ip-pool/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "pool_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_mode", sesv2.ScalingModeStandard),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2DedicatedIpPool_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_dedicated_ip_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2DedicatedIpPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2DedicatedIpPoolExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSesV2DedicatedIpPool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2DedicatedIpPool_ScalingMode(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_dedicated_ip_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2DedicatedIpPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfigScalingMode(rName, sesv2.ScalingModeStandard),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2DedicatedIpPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_mode", sesv2.ScalingModeStandard),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfigScalingMode(rName, sesv2.ScalingModeManaged),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2DedicatedIpPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_mode", sesv2.ScalingModeManaged),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESV2DedicatedIpPool_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sesv2_dedicated_ip_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSESV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSesV2DedicatedIpPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2DedicatedIpPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2DedicatedIpPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSSESV2DedicatedIpPoolConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSesV2DedicatedIpPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSesV2DedicatedIpPoolDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_dedicated_ip_pool" {
+			continue
+		}
+
+		output, err := finder.DedicatedIPPoolByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("SESv2 Dedicated IP Pool (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSesV2DedicatedIpPoolExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sesv2conn
+
+		output, err := finder.DedicatedIPPoolByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("SESv2 Dedicated IP Pool (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSESV2DedicatedIpPoolConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test" {
+  pool_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSSESV2DedicatedIpPoolConfigScalingMode(rName, scalingMode string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test" {
+  pool_name    = %[1]q
+  scaling_mode = %[2]q
+}
+`, rName, scalingMode)
+}
+
+func testAccAWSSESV2DedicatedIpPoolConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test" {
+  pool_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSSESV2DedicatedIpPoolConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test" {
+  pool_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -31,6 +31,31 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
 			},
 
+			"logging_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include_execution_data": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(sfn.LogLevel_Values(), false),
+						},
+						"log_destination": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -54,6 +79,30 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tagsSchema(),
+
+			"tracing_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      sfn.StateMachineTypeStandard,
+				ValidateFunc: validation.StringInSlice(sfn.StateMachineType_Values(), false),
+			},
+
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -71,6 +120,15 @@ func resourceAwsSfnStateMachineCreate(d *schema.ResourceData, meta interface{})
 		Name:       aws.String(d.Get("name").(string)),
 		RoleArn:    aws.String(d.Get("role_arn").(string)),
 		Tags:       keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().SfnTags(),
+		Type:       aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("logging_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		params.LoggingConfiguration = expandSfnLoggingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("tracing_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		params.TracingConfiguration = expandSfnTracingConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
 	var stateMachine *sfn.CreateStateMachineOutput
@@ -133,6 +191,23 @@ func resourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", sm.Name)
 	d.Set("role_arn", sm.RoleArn)
 	d.Set("status", sm.Status)
+	d.Set("type", sm.Type)
+
+	if sm.LoggingConfiguration != nil {
+		if err := d.Set("logging_configuration", []interface{}{flattenSfnLoggingConfiguration(sm.LoggingConfiguration)}); err != nil {
+			return fmt.Errorf("error setting logging_configuration: %w", err)
+		}
+	} else {
+		d.Set("logging_configuration", nil)
+	}
+
+	if sm.TracingConfiguration != nil {
+		if err := d.Set("tracing_configuration", []interface{}{flattenSfnTracingConfiguration(sm.TracingConfiguration)}); err != nil {
+			return fmt.Errorf("error setting tracing_configuration: %w", err)
+		}
+	} else {
+		d.Set("tracing_configuration", nil)
+	}
 
 	if err := d.Set("creation_date", sm.CreationDate.Format(time.RFC3339)); err != nil {
 		log.Printf("[DEBUG] Error setting creation_date: %s", err)
@@ -154,13 +229,25 @@ func resourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) er
 func resourceAwsSfnStateMachineUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sfnconn
 
-	if d.HasChanges("definition", "role_arn") {
+	if d.HasChanges("definition", "logging_configuration", "role_arn", "tracing_configuration") {
 		params := &sfn.UpdateStateMachineInput{
 			StateMachineArn: aws.String(d.Id()),
 			Definition:      aws.String(d.Get("definition").(string)),
 			RoleArn:         aws.String(d.Get("role_arn").(string)),
 		}
 
+		if d.HasChange("logging_configuration") {
+			if v, ok := d.GetOk("logging_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				params.LoggingConfiguration = expandSfnLoggingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("tracing_configuration") {
+			if v, ok := d.GetOk("tracing_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				params.TracingConfiguration = expandSfnTracingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		_, err := conn.UpdateStateMachine(params)
 
 		log.Printf("[DEBUG] Updating Step Function State Machine: %#v", params)
@@ -206,3 +293,74 @@ func resourceAwsSfnStateMachineDelete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
+
+func expandSfnLoggingConfiguration(tfMap map[string]interface{}) *sfn.LoggingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sfn.LoggingConfiguration{}
+
+	if v, ok := tfMap["include_execution_data"].(bool); ok {
+		apiObject.IncludeExecutionData = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["level"].(string); ok && v != "" {
+		apiObject.Level = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_destination"].(string); ok && v != "" {
+		apiObject.Destinations = []*sfn.LogDestination{
+			{
+				CloudWatchLogsLogGroup: &sfn.CloudWatchLogsLogGroup{
+					LogGroupArn: aws.String(v),
+				},
+			},
+		}
+	}
+
+	return apiObject
+}
+
+func flattenSfnLoggingConfiguration(apiObject *sfn.LoggingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"include_execution_data": aws.BoolValue(apiObject.IncludeExecutionData),
+		"level":                  aws.StringValue(apiObject.Level),
+	}
+
+	if v := apiObject.Destinations; len(v) > 0 && v[0] != nil && v[0].CloudWatchLogsLogGroup != nil {
+		tfMap["log_destination"] = aws.StringValue(v[0].CloudWatchLogsLogGroup.LogGroupArn)
+	}
+
+	return tfMap
+}
+
+func expandSfnTracingConfiguration(tfMap map[string]interface{}) *sfn.TracingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sfn.TracingConfiguration{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenSfnTracingConfiguration(apiObject *sfn.TracingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled": aws.BoolValue(apiObject.Enabled),
+	}
+
+	return tfMap
+}
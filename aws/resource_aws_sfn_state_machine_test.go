@@ -123,6 +123,117 @@ func TestAccAWSSfnStateMachine_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAWSSfnStateMachine_expressUpdate(t *testing.T) {
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSfnStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSfnStateMachineConfigTypedExpress(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSfnExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "status", sfn.StateMachineStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "type", sfn.StateMachineTypeExpress),
+					resource.TestMatchResourceAttr(resourceName, "definition", regexp.MustCompile(`.*\"MaxAttempts\": 5.*`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSfnStateMachineConfigTypedExpress(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSfnExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "status", sfn.StateMachineStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "type", sfn.StateMachineTypeExpress),
+					resource.TestMatchResourceAttr(resourceName, "definition", regexp.MustCompile(`.*\"MaxAttempts\": 10.*`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSfnStateMachine_LoggingConfiguration(t *testing.T) {
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSfnStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSfnStateMachineConfigLoggingConfiguration(rName, sfn.LogLevelError, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSfnExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "type", sfn.StateMachineTypeExpress),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.include_execution_data", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.level", sfn.LogLevelError),
+					testAccMatchResourceAttrRegionalARN(resourceName, "logging_configuration.0.log_destination", "logs", regexp.MustCompile(`log-group:.+:\*`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSfnStateMachineConfigLoggingConfiguration(rName, sfn.LogLevelAll, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSfnExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.include_execution_data", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.level", sfn.LogLevelAll),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSfnStateMachine_TracingConfiguration(t *testing.T) {
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSfnStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSfnStateMachineConfigTracingConfiguration(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSfnExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSfnStateMachineConfigTracingConfiguration(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSfnExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSSfnExists(n string, sm *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -359,3 +470,127 @@ EOF
 }
 `, rName, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func testAccAWSSfnStateMachineConfigTypedExpress(rName string, rMaxAttempts int) string {
+	return testAccAWSSfnStateMachineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.iam_for_sfn.arn
+  type     = "EXPRESS"
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "Retry": [
+        {
+          "ErrorEquals": [
+            "States.ALL"
+          ],
+          "IntervalSeconds": 5,
+          "MaxAttempts": %[2]d,
+          "BackoffRate": 8
+        }
+      ],
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName, rMaxAttempts)
+}
+
+func testAccAWSSfnStateMachineConfigLoggingConfiguration(rName, level string, includeExecutionData bool) string {
+	return testAccAWSSfnStateMachineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role_policy" "logging" {
+  name = "%[1]s-logging"
+  role = aws_iam_role.iam_for_sfn.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "logs:CreateLogDelivery",
+        "logs:GetLogDelivery",
+        "logs:UpdateLogDelivery",
+        "logs:DeleteLogDelivery",
+        "logs:ListLogDeliveries",
+        "logs:PutResourcePolicy",
+        "logs:DescribeResourcePolicies",
+        "logs:DescribeLogGroups"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.iam_for_sfn.arn
+  type     = "EXPRESS"
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "End": true
+    }
+  }
+}
+EOF
+
+  logging_configuration {
+    log_destination        = "${aws_cloudwatch_log_group.test.arn}:*"
+    include_execution_data = %[3]t
+    level                  = %[2]q
+  }
+
+  depends_on = [aws_iam_role_policy.logging]
+}
+`, rName, level, includeExecutionData)
+}
+
+func testAccAWSSfnStateMachineConfigTracingConfiguration(rName string, enabled bool) string {
+	return testAccAWSSfnStateMachineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.iam_for_sfn.arn
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "End": true
+    }
+  }
+}
+EOF
+
+  tracing_configuration {
+    enabled = %[2]t
+  }
+}
+`, rName, enabled)
+}
@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsShieldProactiveEngagement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldProactiveEngagementCreate,
+		Read:   resourceAwsShieldProactiveEngagementRead,
+		Update: resourceAwsShieldProactiveEngagementUpdate,
+		Delete: resourceAwsShieldProactiveEngagementDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emergency_contact": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_notes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 150),
+						},
+						"phone_number": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 16),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsShieldProactiveEngagementCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAwsShieldProactiveEngagementPut(d, meta); err != nil {
+		return err
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsShieldProactiveEngagementRead(d, meta)
+}
+
+func resourceAwsShieldProactiveEngagementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	status, err := shieldProactiveEngagementStatus(conn)
+
+	if !d.IsNewResource() && isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Shield Proactive Engagement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Proactive Engagement (%s) status: %w", d.Id(), err)
+	}
+
+	if status == "" {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Shield Proactive Engagement (%s): not initialized after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Shield Proactive Engagement (%s) not initialized, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	output, err := conn.DescribeEmergencyContactSettings(&shield.DescribeEmergencyContactSettingsInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Proactive Engagement (%s) emergency contacts: %w", d.Id(), err)
+	}
+
+	if err := d.Set("emergency_contact", flattenShieldEmergencyContacts(output.EmergencyContactList)); err != nil {
+		return fmt.Errorf("error setting emergency_contact: %w", err)
+	}
+
+	d.Set("enabled", status == shield.ProactiveEngagementStatusEnabled)
+
+	return nil
+}
+
+func resourceAwsShieldProactiveEngagementUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAwsShieldProactiveEngagementPut(d, meta); err != nil {
+		return err
+	}
+
+	return resourceAwsShieldProactiveEngagementRead(d, meta)
+}
+
+func resourceAwsShieldProactiveEngagementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	status, err := shieldProactiveEngagementStatus(conn)
+
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Proactive Engagement (%s) status: %w", d.Id(), err)
+	}
+
+	if status == shield.ProactiveEngagementStatusEnabled || status == shield.ProactiveEngagementStatusPending {
+		log.Printf("[DEBUG] Disabling Shield Proactive Engagement (%s)", d.Id())
+		_, err := conn.DisableProactiveEngagement(&shield.DisableProactiveEngagementInput{})
+
+		if err != nil {
+			return fmt.Errorf("error disabling Shield Proactive Engagement (%s): %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Removing Shield Proactive Engagement (%s) emergency contacts", d.Id())
+	_, err = conn.UpdateEmergencyContactSettings(&shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	})
+
+	if err != nil {
+		return fmt.Errorf("error removing Shield Proactive Engagement (%s) emergency contacts: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsShieldProactiveEngagementPut hides the asymmetry of the Shield API:
+// proactive engagement must be initialized once with AssociateProactiveEngagementDetails,
+// after which it is toggled with EnableProactiveEngagement and DisableProactiveEngagement
+// and the emergency contacts are managed with UpdateEmergencyContactSettings.
+func resourceAwsShieldProactiveEngagementPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	contacts := expandShieldEmergencyContacts(d.Get("emergency_contact").([]interface{}))
+	enabled := d.Get("enabled").(bool)
+
+	status, err := shieldProactiveEngagementStatus(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Proactive Engagement status: %w", err)
+	}
+
+	if status == "" {
+		log.Printf("[DEBUG] Associating Shield Proactive Engagement details")
+		_, err := conn.AssociateProactiveEngagementDetails(&shield.AssociateProactiveEngagementDetailsInput{
+			EmergencyContactList: contacts,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error associating Shield Proactive Engagement details: %w", err)
+		}
+
+		// Association enables proactive engagement.
+		status = shield.ProactiveEngagementStatusEnabled
+	} else if d.Id() == "" || d.HasChange("emergency_contact") {
+		log.Printf("[DEBUG] Updating Shield emergency contact settings")
+		_, err := conn.UpdateEmergencyContactSettings(&shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: contacts,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error updating Shield emergency contact settings: %w", err)
+		}
+	}
+
+	switch {
+	case enabled && status == shield.ProactiveEngagementStatusDisabled:
+		log.Printf("[DEBUG] Enabling Shield Proactive Engagement")
+		_, err := conn.EnableProactiveEngagement(&shield.EnableProactiveEngagementInput{})
+
+		if err != nil {
+			return fmt.Errorf("error enabling Shield Proactive Engagement: %w", err)
+		}
+	case !enabled && status != shield.ProactiveEngagementStatusDisabled:
+		log.Printf("[DEBUG] Disabling Shield Proactive Engagement")
+		_, err := conn.DisableProactiveEngagement(&shield.DisableProactiveEngagementInput{})
+
+		if err != nil {
+			return fmt.Errorf("error disabling Shield Proactive Engagement: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// shieldProactiveEngagementStatus returns the proactive engagement status of the
+// account's Shield Advanced subscription. An empty status means proactive engagement
+// has never been initialized.
+func shieldProactiveEngagementStatus(conn *shield.Shield) (string, error) {
+	output, err := conn.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Subscription == nil {
+		return "", nil
+	}
+
+	return aws.StringValue(output.Subscription.ProactiveEngagementStatus), nil
+}
+
+func expandShieldEmergencyContacts(tfList []interface{}) []*shield.EmergencyContact {
+	apiObjects := make([]*shield.EmergencyContact, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &shield.EmergencyContact{
+			EmailAddress: aws.String(tfMap["email_address"].(string)),
+		}
+
+		if v, ok := tfMap["contact_notes"].(string); ok && v != "" {
+			apiObject.ContactNotes = aws.String(v)
+		}
+
+		if v, ok := tfMap["phone_number"].(string); ok && v != "" {
+			apiObject.PhoneNumber = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenShieldEmergencyContacts(apiObjects []*shield.EmergencyContact) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"contact_notes": aws.StringValue(apiObject.ContactNotes),
+			"email_address": aws.StringValue(apiObject.EmailAddress),
+			"phone_number":  aws.StringValue(apiObject.PhoneNumber),
+		})
+	}
+
+	return tfList
+}
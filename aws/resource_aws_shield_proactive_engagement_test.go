@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Proactive engagement is an account-wide setting, so these tests cannot run in parallel.
+func TestAccAWSShieldProactiveEngagement_basic(t *testing.T) {
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(shield.EndpointsID, t)
+			testAccPreCheckAWSShield(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProactiveEngagementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldProactiveEngagementConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProactiveEngagementStatus(shield.ProactiveEngagementStatusEnabled),
+					testAccCheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.contact_notes", "Notes"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", "test@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.phone_number", "+12358132134"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccShieldProactiveEngagementConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProactiveEngagementStatus(shield.ProactiveEngagementStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccShieldProactiveEngagementConfigMultipleContacts(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProactiveEngagementStatus(shield.ProactiveEngagementStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.1.email_address", "test2@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSShieldProactiveEngagementDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_proactive_engagement" {
+			continue
+		}
+
+		status, err := shieldProactiveEngagementStatus(conn)
+
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status == shield.ProactiveEngagementStatusEnabled || status == shield.ProactiveEngagementStatusPending {
+			return fmt.Errorf("Shield Proactive Engagement (%s) still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSShieldProactiveEngagementStatus(expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+		status, err := shieldProactiveEngagementStatus(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if status != expected {
+			return fmt.Errorf("expected Shield Proactive Engagement status %q, got %q", expected, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccShieldProactiveEngagementConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = %[1]t
+
+  emergency_contact {
+    contact_notes = "Notes"
+    email_address = "test@example.com"
+    phone_number  = "+12358132134"
+  }
+}
+`, enabled)
+}
+
+func testAccShieldProactiveEngagementConfigMultipleContacts() string {
+	return `
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = true
+
+  emergency_contact {
+    contact_notes = "Notes"
+    email_address = "test@example.com"
+    phone_number  = "+12358132134"
+  }
+
+  emergency_contact {
+    email_address = "test2@example.com"
+    phone_number  = "+12358132135"
+  }
+}
+`
+}
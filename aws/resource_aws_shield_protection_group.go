@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsShieldProtectionGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldProtectionGroupCreate,
+		Read:   resourceAwsShieldProtectionGroupRead,
+		Update: resourceAwsShieldProtectionGroupUpdate,
+		Delete: resourceAwsShieldProtectionGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(shield.ProtectionGroupAggregation_Values(), false),
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10000,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+				ConflictsWith: []string{"resource_type"},
+			},
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(shield.ProtectionGroupPattern_Values(), false),
+			},
+			"protection_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protection_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+			"resource_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(shield.ProtectedResourceType_Values(), false),
+				ConflictsWith: []string{"members"},
+			},
+		},
+	}
+}
+
+func resourceAwsShieldProtectionGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	protectionGroupID := d.Get("protection_group_id").(string)
+	input := &shield.CreateProtectionGroupInput{
+		Aggregation:       aws.String(d.Get("aggregation").(string)),
+		Pattern:           aws.String(d.Get("pattern").(string)),
+		ProtectionGroupId: aws.String(protectionGroupID),
+	}
+
+	if v, ok := d.GetOk("members"); ok {
+		input.Members = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Shield Protection Group: %s", input)
+	_, err := conn.CreateProtectionGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Shield Protection Group (%s): %w", protectionGroupID, err)
+	}
+
+	d.SetId(protectionGroupID)
+
+	return resourceAwsShieldProtectionGroupRead(d, meta)
+}
+
+func resourceAwsShieldProtectionGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	input := &shield.DescribeProtectionGroupInput{
+		ProtectionGroupId: aws.String(d.Id()),
+	}
+
+	resp, err := conn.DescribeProtectionGroup(input)
+
+	if !d.IsNewResource() && isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Shield Protection Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Protection Group (%s): %w", d.Id(), err)
+	}
+
+	if resp == nil || resp.ProtectionGroup == nil {
+		return fmt.Errorf("error reading Shield Protection Group (%s): empty response", d.Id())
+	}
+
+	protectionGroup := resp.ProtectionGroup
+
+	d.Set("aggregation", protectionGroup.Aggregation)
+	d.Set("pattern", protectionGroup.Pattern)
+	d.Set("protection_group_arn", protectionGroup.ProtectionGroupArn)
+	d.Set("protection_group_id", protectionGroup.ProtectionGroupId)
+	d.Set("resource_type", protectionGroup.ResourceType)
+
+	// Members are only meaningful for the ARBITRARY pattern; for the other
+	// patterns Shield reports the currently matching protected resources.
+	if aws.StringValue(protectionGroup.Pattern) == shield.ProtectionGroupPatternArbitrary {
+		if err := d.Set("members", flattenStringList(protectionGroup.Members)); err != nil {
+			return fmt.Errorf("error setting members: %w", err)
+		}
+	} else {
+		d.Set("members", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsShieldProtectionGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	input := &shield.UpdateProtectionGroupInput{
+		Aggregation:       aws.String(d.Get("aggregation").(string)),
+		Pattern:           aws.String(d.Get("pattern").(string)),
+		ProtectionGroupId: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("members"); ok {
+		input.Members = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Shield Protection Group: %s", input)
+	_, err := conn.UpdateProtectionGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Shield Protection Group (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsShieldProtectionGroupRead(d, meta)
+}
+
+func resourceAwsShieldProtectionGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	input := &shield.DeleteProtectionGroupInput{
+		ProtectionGroupId: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteProtectionGroup(input)
+
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Shield Protection Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
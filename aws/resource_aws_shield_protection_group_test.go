@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSShieldProtectionGroup_basic(t *testing.T) {
+	resourceName := "aws_shield_protection_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(shield.EndpointsID, t)
+			testAccPreCheckAWSShield(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProtectionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldProtectionGroupConfig(rName, shield.ProtectionGroupAggregationSum),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aggregation", "SUM"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "ALL"),
+					testAccMatchResourceAttrGlobalARN(resourceName, "protection_group_arn", "shield", regexp.MustCompile(`protection-group/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "protection_group_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccShieldProtectionGroupConfig(rName, shield.ProtectionGroupAggregationMean),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aggregation", "MEAN"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSShieldProtectionGroup_disappears(t *testing.T) {
+	resourceName := "aws_shield_protection_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(shield.EndpointsID, t)
+			testAccPreCheckAWSShield(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProtectionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldProtectionGroupConfig(rName, shield.ProtectionGroupAggregationSum),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionGroupExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsShieldProtectionGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSShieldProtectionGroup_ResourceType(t *testing.T) {
+	resourceName := "aws_shield_protection_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(shield.EndpointsID, t)
+			testAccPreCheckAWSShield(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProtectionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldProtectionGroupConfigResourceType(rName, shield.ProtectedResourceTypeElasticIpAllocation),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "BY_RESOURCE_TYPE"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "ELASTIC_IP_ALLOCATION"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccShieldProtectionGroupConfigResourceType(rName, shield.ProtectedResourceTypeApplicationLoadBalancer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "APPLICATION_LOAD_BALANCER"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSShieldProtectionGroup_Members(t *testing.T) {
+	resourceName := "aws_shield_protection_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(shield.EndpointsID, t)
+			testAccPreCheckAWSShield(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProtectionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldProtectionGroupConfigMembers(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "ARBITRARY"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "members.0", "aws_shield_protection.test", "resource_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSShieldProtectionGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_protection_group" {
+			continue
+		}
+
+		input := &shield.DescribeProtectionGroupInput{
+			ProtectionGroupId: aws.String(rs.Primary.ID),
+		}
+
+		resp, err := conn.DescribeProtectionGroup(input)
+
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if resp != nil && resp.ProtectionGroup != nil && aws.StringValue(resp.ProtectionGroup.ProtectionGroupId) == rs.Primary.ID {
+			return fmt.Errorf("Shield Protection Group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSShieldProtectionGroupExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Protection Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+		input := &shield.DescribeProtectionGroupInput{
+			ProtectionGroupId: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.DescribeProtectionGroup(input)
+
+		return err
+	}
+}
+
+func testAccShieldProtectionGroupConfig(rName, aggregation string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_protection_group" "test" {
+  protection_group_id = %[1]q
+  aggregation         = %[2]q
+  pattern             = "ALL"
+}
+`, rName, aggregation)
+}
+
+func testAccShieldProtectionGroupConfigResourceType(rName, resourceType string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_protection_group" "test" {
+  protection_group_id = %[1]q
+  aggregation         = "MAX"
+  pattern             = "BY_RESOURCE_TYPE"
+  resource_type       = %[2]q
+}
+`, rName, resourceType)
+}
+
+func testAccShieldProtectionGroupConfigMembers(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_eip" "test" {
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_shield_protection" "test" {
+  name         = %[1]q
+  resource_arn = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:eip-allocation/${aws_eip.test.id}"
+}
+
+resource "aws_shield_protection_group" "test" {
+  protection_group_id = %[1]q
+  aggregation         = "MAX"
+  pattern             = "ARBITRARY"
+  members             = [aws_shield_protection.test.resource_arn]
+}
+`, rName)
+}
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssm/waiter"
 )
 
 func resourceAwsSsmAssociation() *schema.Resource {
@@ -26,6 +28,11 @@ func resourceAwsSsmAssociation() *schema.Resource {
 		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
+			"apply_only_at_cron_interval": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"association_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -120,6 +127,60 @@ func resourceAwsSsmAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sync_compliance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ssm.AssociationSyncCompliance_Values(), false),
+			},
+			"target_locations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"execution_role_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_location_max_concurrency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"target_location_max_errors": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+					},
+				},
+			},
+			"wait_for_success_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 	}
 }
@@ -177,6 +238,22 @@ func resourceAwsSsmAssociationCreate(d *schema.ResourceData, meta interface{}) e
 		associationInput.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("apply_only_at_cron_interval"); ok {
+		associationInput.ApplyOnlyAtCronInterval = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("sync_compliance"); ok {
+		associationInput.SyncCompliance = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandSsmAssociationTargetLocations(v.([]interface{}))
+	}
+
 	resp, err := ssmconn.CreateAssociation(associationInput)
 	if err != nil {
 		return fmt.Errorf("Error creating SSM association: %s", err)
@@ -189,6 +266,14 @@ func resourceAwsSsmAssociationCreate(d *schema.ResourceData, meta interface{}) e
 	d.SetId(aws.StringValue(resp.AssociationDescription.AssociationId))
 	d.Set("association_id", resp.AssociationDescription.AssociationId)
 
+	if v, ok := d.GetOk("wait_for_success_timeout_seconds"); ok {
+		timeout := time.Duration(v.(int)) * time.Second
+
+		if _, err := waiter.AssociationSuccess(ssmconn, d.Id(), timeout); err != nil {
+			return fmt.Errorf("error waiting for SSM Association (%s) success: %w", d.Id(), err)
+		}
+	}
+
 	return resourceAwsSsmAssociationRead(d, meta)
 }
 
@@ -225,6 +310,16 @@ func resourceAwsSsmAssociationRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("max_concurrency", association.MaxConcurrency)
 	d.Set("max_errors", association.MaxErrors)
 	d.Set("automation_target_parameter_name", association.AutomationTargetParameterName)
+	d.Set("apply_only_at_cron_interval", association.ApplyOnlyAtCronInterval)
+	d.Set("sync_compliance", association.SyncCompliance)
+
+	if err := d.Set("calendar_names", aws.StringValueSlice(association.CalendarNames)); err != nil {
+		return fmt.Errorf("error setting calendar_names: %w", err)
+	}
+
+	if err := d.Set("target_locations", flattenSsmAssociationTargetLocations(association.TargetLocations)); err != nil {
+		return fmt.Errorf("error setting target_locations: %w", err)
+	}
 
 	if err := d.Set("parameters", flattenAwsSsmParameters(association.Parameters)); err != nil {
 		return err
@@ -244,6 +339,11 @@ func resourceAwsSsmAssociationRead(d *schema.ResourceData, meta interface{}) err
 func resourceAwsSsmAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
+	// wait_for_success_timeout_seconds only applies to creation and is not sent to AWS.
+	if !d.HasChangesExcept("wait_for_success_timeout_seconds") {
+		return resourceAwsSsmAssociationRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] SSM Association update: %s", d.Id())
 
 	associationInput := &ssm.UpdateAssociationInput{
@@ -291,6 +391,24 @@ func resourceAwsSsmAssociationUpdate(d *schema.ResourceData, meta interface{}) e
 		associationInput.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	associationInput.ApplyOnlyAtCronInterval = aws.Bool(d.Get("apply_only_at_cron_interval").(bool))
+
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = expandStringSet(v.(*schema.Set))
+	} else if d.HasChange("calendar_names") {
+		associationInput.CalendarNames = []*string{}
+	}
+
+	if v, ok := d.GetOk("sync_compliance"); ok {
+		associationInput.SyncCompliance = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandSsmAssociationTargetLocations(v.([]interface{}))
+	} else if d.HasChange("target_locations") {
+		associationInput.TargetLocations = []*ssm.TargetLocation{}
+	}
+
 	_, err := ssmconn.UpdateAssociation(associationInput)
 	if err != nil {
 		return fmt.Errorf("Error updating SSM association: %s", err)
@@ -367,3 +485,61 @@ func flattenAwsSsmAssociationOutoutLocation(location *ssm.InstanceAssociationOut
 
 	return result
 }
+
+func expandSsmAssociationTargetLocations(tfList []interface{}) []*ssm.TargetLocation {
+	var apiObjects []*ssm.TargetLocation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssm.TargetLocation{}
+
+		if v, ok := tfMap["accounts"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Accounts = expandStringSet(v)
+		}
+
+		if v, ok := tfMap["execution_role_name"].(string); ok && v != "" {
+			apiObject.ExecutionRoleName = aws.String(v)
+		}
+
+		if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Regions = expandStringSet(v)
+		}
+
+		if v, ok := tfMap["target_location_max_concurrency"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxConcurrency = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_location_max_errors"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxErrors = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSsmAssociationTargetLocations(apiObjects []*ssm.TargetLocation) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"accounts":                        aws.StringValueSlice(apiObject.Accounts),
+			"execution_role_name":             aws.StringValue(apiObject.ExecutionRoleName),
+			"regions":                         aws.StringValueSlice(apiObject.Regions),
+			"target_location_max_concurrency": aws.StringValue(apiObject.TargetLocationMaxConcurrency),
+			"target_location_max_errors":      aws.StringValue(apiObject.TargetLocationMaxErrors),
+		})
+	}
+
+	return tfList
+}
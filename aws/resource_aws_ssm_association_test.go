@@ -385,6 +385,70 @@ func TestAccAWSSSMAssociation_withScheduleExpression(t *testing.T) {
 	})
 }
 
+func TestAccAWSSSMAssociation_applyOnlyAtCronInterval(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMAssociationConfigApplyOnlyAtCronInterval(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "apply_only_at_cron_interval", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSSMAssociationConfigApplyOnlyAtCronInterval(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "apply_only_at_cron_interval", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMAssociation_syncCompliance(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMAssociationConfigSyncCompliance(rName, ssm.AssociationSyncComplianceManual),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sync_compliance", ssm.AssociationSyncComplianceManual),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSSMAssociationConfigSyncCompliance(rName, ssm.AssociationSyncComplianceAuto),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sync_compliance", ssm.AssociationSyncComplianceAuto),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSSMAssociation_withComplianceSeverity(t *testing.T) {
 	assocName := acctest.RandString(10)
 	rName := acctest.RandString(10)
@@ -863,6 +927,65 @@ resource "aws_ssm_association" "test" {
 `, rName)
 }
 
+func testAccAWSSSMAssociationConfigDocumentBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+
+}
+`, rName)
+}
+
+func testAccAWSSSMAssociationConfigApplyOnlyAtCronInterval(rName string, applyOnlyAtCronInterval bool) string {
+	return testAccAWSSSMAssociationConfigDocumentBase(rName) + fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  name                        = aws_ssm_document.test.name
+  schedule_expression         = "cron(0 16 ? * TUE *)"
+  apply_only_at_cron_interval = %[1]t
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, applyOnlyAtCronInterval)
+}
+
+func testAccAWSSSMAssociationConfigSyncCompliance(rName, syncCompliance string) string {
+	return testAccAWSSSMAssociationConfigDocumentBase(rName) + fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  name            = aws_ssm_document.test.name
+  sync_compliance = %[1]q
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, syncCompliance)
+}
+
 func testAccAWSSSMAssociationBasicConfigWithDocumentVersion(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
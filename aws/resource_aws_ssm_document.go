@@ -1,9 +1,11 @@
 package aws
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
-	"regexp"
+	"reflect"
 	"strings"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -68,8 +71,9 @@ func resourceAwsSsmDocument() *schema.Resource {
 				},
 			},
 			"content": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentSsmDocumentContentDiffs,
 			},
 			"document_format": {
 				Type:     schema.TypeString,
@@ -172,10 +176,42 @@ func resourceAwsSsmDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"version_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
+
+		CustomizeDiff: resourceAwsSsmDocumentCustomizeDiff,
 	}
 }
 
+func resourceAwsSsmDocumentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("content") || !diff.NewValueKnown("content") {
+		return nil
+	}
+
+	// The schema version of an existing document cannot be changed, so the document must be replaced.
+	oldSchemaVersion := diff.Get("schema_version").(string)
+	newSchemaVersion, err := ssmDocumentContentSchemaVersion(diff.Get("content").(string), diff.Get("document_format").(string))
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSM Document (%s) content: %w", diff.Id(), err)
+	}
+
+	if oldSchemaVersion != "" && newSchemaVersion != "" && oldSchemaVersion != newSchemaVersion {
+		return diff.ForceNew("content")
+	}
+
+	for _, k := range []string{"default_version", "document_version", "hash", "latest_version"} {
+		if err := diff.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsSsmDocumentCreate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
@@ -208,6 +244,10 @@ func resourceAwsSsmDocumentCreate(d *schema.ResourceData, meta interface{}) erro
 		docInput.TargetType = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("version_name"); ok {
+		docInput.VersionName = aws.String(v.(string))
+	}
+
 	resp, err := ssmconn.CreateDocument(docInput)
 
 	if err != nil {
@@ -339,6 +379,8 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting target type: %s", err)
 	}
 
+	d.Set("version_name", doc.VersionName)
+
 	return nil
 }
 
@@ -369,15 +411,10 @@ func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Not setting document permissions on %q", d.Id())
 	}
 
-	// update for schema version 1.x is not allowed
-	isSchemaVersion1, _ := regexp.MatchString("^1[.][0-9]$", d.Get("schema_version").(string))
-
-	if !d.HasChange("content") && isSchemaVersion1 {
-		return nil
-	}
-
-	if err := updateAwsSSMDocument(d, meta); err != nil {
-		return err
+	if d.HasChanges("attachments_source", "content", "document_format", "target_type", "version_name") {
+		if err := updateAwsSSMDocument(d, meta); err != nil {
+			return err
+		}
 	}
 
 	return resourceAwsSsmDocumentRead(d, meta)
@@ -641,18 +678,28 @@ func updateAwsSSMDocument(d *schema.ResourceData, meta interface{}) error {
 		Name:            aws.String(name),
 		Content:         aws.String(d.Get("content").(string)),
 		DocumentFormat:  aws.String(d.Get("document_format").(string)),
-		DocumentVersion: aws.String(d.Get("default_version").(string)),
+		DocumentVersion: aws.String("$LATEST"),
 	}
 
 	if v, ok := d.GetOk("target_type"); ok {
 		updateDocInput.TargetType = aws.String(v.(string))
 	}
 
+	// Version names must be unique, so only send a new one.
+	if d.HasChange("version_name") {
+		if v, ok := d.GetOk("version_name"); ok {
+			updateDocInput.VersionName = aws.String(v.(string))
+		}
+	}
+
 	if d.HasChange("attachments_source") {
 		updateDocInput.Attachments = expandSsmAttachmentsSources(d.Get("attachments_source").([]interface{}))
 	}
 
-	newDefaultVersion := d.Get("default_version").(string)
+	// Version attributes are marked as computed during planning, so use the prior state values.
+	oldDefaultVersion, _ := d.GetChange("default_version")
+	oldLatestVersion, _ := d.GetChange("latest_version")
+	newDefaultVersion := oldDefaultVersion.(string)
 
 	ssmconn := meta.(*AWSClient).ssmconn
 	updated, err := ssmconn.UpdateDocument(updateDocInput)
@@ -661,7 +708,7 @@ func updateAwsSSMDocument(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Content is a duplicate of the latest version so update is not necessary: %s", d.Id())
 		log.Printf("[INFO] Updating the default version to the latest version %s: %s", newDefaultVersion, d.Id())
 
-		newDefaultVersion = d.Get("latest_version").(string)
+		newDefaultVersion = oldLatestVersion.(string)
 	} else if err != nil {
 		return fmt.Errorf("Error updating SSM document: %s", err)
 	} else {
@@ -682,6 +729,62 @@ func updateAwsSSMDocument(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// suppressEquivalentSsmDocumentContentDiffs compares document content according to the configured document_format.
+func suppressEquivalentSsmDocumentContentDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("document_format").(string) != ssm.DocumentFormatYaml {
+		return suppressEquivalentJsonDiffs(k, old, new, d)
+	}
+
+	var o, n interface{}
+
+	if err := yaml.Unmarshal([]byte(old), &o); err != nil {
+		return false
+	}
+
+	if err := yaml.Unmarshal([]byte(new), &n); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(o, n)
+}
+
+// ssmDocumentContentSchemaVersion returns the schemaVersion declared in the document content.
+// The value is returned as written, so that e.g. an unquoted YAML 1.0 is not read as the number 1.
+func ssmDocumentContentSchemaVersion(content, format string) (string, error) {
+	if format == ssm.DocumentFormatYaml {
+		var doc struct {
+			SchemaVersion string `yaml:"schemaVersion"`
+		}
+
+		if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+			return "", err
+		}
+
+		return doc.SchemaVersion, nil
+	}
+
+	var doc struct {
+		SchemaVersion json.RawMessage `json:"schemaVersion"`
+	}
+
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return "", err
+	}
+
+	if len(doc.SchemaVersion) == 0 || string(doc.SchemaVersion) == "null" {
+		return "", nil
+	}
+
+	var v string
+
+	if err := json.Unmarshal(doc.SchemaVersion, &v); err != nil {
+		// Not a JSON string, e.g. a number
+		return string(doc.SchemaVersion), nil
+	}
+
+	return v, nil
+}
+
 //Validates that type and account_ids are defined
 func validateSSMDocumentPermissions(v map[string]interface{}) (errors []error) {
 	k := "permissions"
@@ -427,6 +427,79 @@ mainSteps:
 	})
 }
 
+func TestAccAWSSSMDocument_VersionName(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMDocumentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMDocumentConfigVersionName(rName, "release-1.0.0", "Get-Process"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "release-1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSSMDocumentConfigVersionName(rName, "release-1.1.0", "Get-Date"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "release-1.1.0"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMDocument_SchemaVersionChange(t *testing.T) {
+	rName := acctest.RandString(10)
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMDocumentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMDocument20Config(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schema_version", "2.0"),
+				),
+			},
+			{
+				Config: testAccAWSSSMDocumentConfig_DocumentFormat_YAML(rName, `
+---
+schemaVersion: '2.2'
+description: Sample document
+mainSteps:
+- action: aws:runPowerShellScript
+  name: runPowerShellScript
+  inputs:
+    runCommand:
+      - Get-Process
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schema_version", "2.2"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSSMDocument_Tags(t *testing.T) {
 	rName := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
@@ -510,6 +583,56 @@ func TestValidateSSMDocumentPermissions(t *testing.T) {
 	}
 }
 
+func TestSsmDocumentContentSchemaVersion(t *testing.T) {
+	testCases := []struct {
+		Content  string
+		Format   string
+		Expected string
+	}{
+		{
+			Content:  `{"schemaVersion": "2.2", "mainSteps": []}`,
+			Format:   ssm.DocumentFormatJson,
+			Expected: "2.2",
+		},
+		{
+			Content:  "schemaVersion: '0.3'\nmainSteps: []\n",
+			Format:   ssm.DocumentFormatYaml,
+			Expected: "0.3",
+		},
+		{
+			Content:  "schemaVersion: 1.0\nruntimeConfig: {}\n",
+			Format:   ssm.DocumentFormatYaml,
+			Expected: "1.0",
+		},
+		{
+			Content:  `{"schemaVersion": 1.0, "runtimeConfig": {}}`,
+			Format:   ssm.DocumentFormatJson,
+			Expected: "1.0",
+		},
+		{
+			Content:  `{"mainSteps": []}`,
+			Format:   ssm.DocumentFormatJson,
+			Expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		got, err := ssmDocumentContentSchemaVersion(tc.Content, tc.Format)
+
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", tc.Content, err)
+		}
+
+		if got != tc.Expected {
+			t.Errorf("got %q, expected %q for %q", got, tc.Expected, tc.Content)
+		}
+	}
+
+	if _, err := ssmDocumentContentSchemaVersion(`{`, ssm.DocumentFormatJson); err == nil {
+		t.Error("expected error parsing invalid JSON content")
+	}
+}
+
 func testAccCheckAWSSSMDocumentExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1009,6 +1132,36 @@ DOC
 `, rName, content)
 }
 
+func testAccAWSSSMDocumentConfigVersionName(rName, versionName, command string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+  version_name  = %[2]q
+
+  content = <<DOC
+{
+  "schemaVersion": "2.0",
+  "description": "Sample version 2.0 document",
+  "parameters": {},
+  "mainSteps": [
+    {
+      "action": "aws:runPowerShellScript",
+      "name": "runPowerShellScript",
+      "inputs": {
+        "runCommand": [
+          %[3]q
+        ]
+      }
+    }
+  ]
+}
+DOC
+
+}
+`, rName, versionName, command)
+}
+
 func testAccAWSSSMDocumentConfigSchemaVersion1(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
@@ -32,12 +32,14 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 
 			"max_concurrency": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 
 			"max_errors": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 
 			"task_type": {
@@ -58,7 +60,7 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 
 			"targets": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"key": {
@@ -91,6 +93,12 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 				Optional: true,
 			},
 
+			"cutoff_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ssm.MaintenanceWindowTaskCutoffBehavior_Values(), false),
+			},
+
 			"task_invocation_parameters": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -575,12 +583,25 @@ func resourceAwsSsmMaintenanceWindowTaskCreate(d *schema.ResourceData, meta inte
 
 	params := &ssm.RegisterTaskWithMaintenanceWindowInput{
 		WindowId:       aws.String(d.Get("window_id").(string)),
-		MaxConcurrency: aws.String(d.Get("max_concurrency").(string)),
-		MaxErrors:      aws.String(d.Get("max_errors").(string)),
 		TaskType:       aws.String(d.Get("task_type").(string)),
 		ServiceRoleArn: aws.String(d.Get("service_role_arn").(string)),
 		TaskArn:        aws.String(d.Get("task_arn").(string)),
-		Targets:        expandAwsSsmTargets(d.Get("targets").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("max_concurrency"); ok {
+		params.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_errors"); ok {
+		params.MaxErrors = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("targets"); ok {
+		params.Targets = expandAwsSsmTargets(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("cutoff_behavior"); ok {
+		params.CutoffBehavior = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -636,6 +657,7 @@ func resourceAwsSsmMaintenanceWindowTaskRead(d *schema.ResourceData, meta interf
 	d.Set("priority", resp.Priority)
 	d.Set("name", resp.Name)
 	d.Set("description", resp.Description)
+	d.Set("cutoff_behavior", resp.CutoffBehavior)
 
 	if resp.TaskInvocationParameters != nil {
 		if err := d.Set("task_invocation_parameters", flattenAwsSsmTaskInvocationParameters(resp.TaskInvocationParameters)); err != nil {
@@ -654,17 +676,32 @@ func resourceAwsSsmMaintenanceWindowTaskUpdate(d *schema.ResourceData, meta inte
 	ssmconn := meta.(*AWSClient).ssmconn
 	windowID := d.Get("window_id").(string)
 
+	// Replace resets any optional field omitted from the request, so the
+	// full desired configuration is always sent.
 	params := &ssm.UpdateMaintenanceWindowTaskInput{
 		WindowId:       aws.String(windowID),
 		WindowTaskId:   aws.String(d.Id()),
-		MaxConcurrency: aws.String(d.Get("max_concurrency").(string)),
-		MaxErrors:      aws.String(d.Get("max_errors").(string)),
 		ServiceRoleArn: aws.String(d.Get("service_role_arn").(string)),
 		TaskArn:        aws.String(d.Get("task_arn").(string)),
-		Targets:        expandAwsSsmTargets(d.Get("targets").([]interface{})),
 		Replace:        aws.Bool(true),
 	}
 
+	if v, ok := d.GetOk("max_concurrency"); ok {
+		params.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_errors"); ok {
+		params.MaxErrors = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("targets"); ok {
+		params.Targets = expandAwsSsmTargets(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("cutoff_behavior"); ok {
+		params.CutoffBehavior = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("name"); ok {
 		params.Name = aws.String(v.(string))
 	}
@@ -52,7 +52,7 @@ func TestAccAWSSSMMaintenanceWindowTask_basic(t *testing.T) {
 	})
 }
 
-func TestAccAWSSSMMaintenanceWindowTask_updateForcesNewResource(t *testing.T) {
+func TestAccAWSSSMMaintenanceWindowTask_updateInvocationParameters(t *testing.T) {
 	var before, after ssm.MaintenanceWindowTask
 	name := acctest.RandString(10)
 	resourceName := "aws_ssm_maintenance_window_task.test"
@@ -74,7 +74,74 @@ func TestAccAWSSSMMaintenanceWindowTask_updateForcesNewResource(t *testing.T) {
 					testAccCheckAWSSSMMaintenanceWindowTaskExists(resourceName, &after),
 					resource.TestCheckResourceAttr(resourceName, "name", "TestMaintenanceWindowTask"),
 					resource.TestCheckResourceAttr(resourceName, "description", "This resource is for test purpose only"),
-					testAccCheckAwsSsmWindowsTaskRecreated(t, &before, &after),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "task_invocation_parameters.0.run_command_parameters.0.parameter.*", map[string]string{
+						"name":     "commands",
+						"values.#": "1",
+						"values.0": "date",
+					}),
+					testAccCheckAwsSsmWindowsTaskNotRecreated(t, &before, &after),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSSMMaintenanceWindowTaskImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMMaintenanceWindowTask_CutoffBehavior(t *testing.T) {
+	var before, after ssm.MaintenanceWindowTask
+	resourceName := "aws_ssm_maintenance_window_task.test"
+
+	name := acctest.RandString(10)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMMaintenanceWindowTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMMaintenanceWindowTaskConfigCutoffBehavior(name, ssm.MaintenanceWindowTaskCutoffBehaviorContinueTask),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowTaskExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "cutoff_behavior", ssm.MaintenanceWindowTaskCutoffBehaviorContinueTask),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSSMMaintenanceWindowTaskImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSSMMaintenanceWindowTaskConfigCutoffBehavior(name, ssm.MaintenanceWindowTaskCutoffBehaviorCancelTask),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowTaskExists(resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "cutoff_behavior", ssm.MaintenanceWindowTaskCutoffBehaviorCancelTask),
+					testAccCheckAwsSsmWindowsTaskNotRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMMaintenanceWindowTask_noTarget(t *testing.T) {
+	var task ssm.MaintenanceWindowTask
+	resourceName := "aws_ssm_maintenance_window_task.test"
+
+	name := acctest.RandString(10)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMMaintenanceWindowTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMMaintenanceWindowTaskConfigNoTarget(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMMaintenanceWindowTaskExists(resourceName, &task),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "0"),
 				),
 			},
 			{
@@ -251,16 +318,6 @@ func testAccCheckAwsSsmWindowsTaskNotRecreated(t *testing.T,
 	}
 }
 
-func testAccCheckAwsSsmWindowsTaskRecreated(t *testing.T,
-	before, after *ssm.MaintenanceWindowTask) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		if before.WindowTaskId == after.WindowTaskId {
-			t.Fatalf("Expected change of Windows Task IDs, but both were %v", before.WindowTaskId)
-		}
-		return nil
-	}
-}
-
 func testAccCheckAWSSSMMaintenanceWindowTaskExists(n string, task *ssm.MaintenanceWindowTask) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -522,6 +579,60 @@ resource "aws_ssm_maintenance_window_task" "test" {
 `)
 }
 
+func testAccAWSSSMMaintenanceWindowTaskConfigCutoffBehavior(rName, cutoffBehavior string) string {
+	return fmt.Sprintf(testAccAWSSSMMaintenanceWindowTaskConfigBase(rName)+`
+
+resource "aws_ssm_maintenance_window_task" "test" {
+  window_id        = aws_ssm_maintenance_window.test.id
+  task_type        = "RUN_COMMAND"
+  task_arn         = "AWS-RunShellScript"
+  priority         = 1
+  service_role_arn = aws_iam_role.test.arn
+  max_concurrency  = "2"
+  max_errors       = "1"
+  cutoff_behavior  = %[2]q
+
+  targets {
+    key    = "WindowTargetIds"
+    values = [aws_ssm_maintenance_window_target.test.id]
+  }
+
+  task_invocation_parameters {
+    run_command_parameters {
+      parameter {
+        name   = "commands"
+        values = ["pwd"]
+      }
+    }
+  }
+}
+`, rName, cutoffBehavior)
+}
+
+func testAccAWSSSMMaintenanceWindowTaskConfigNoTarget(rName string) string {
+	return fmt.Sprintf(testAccAWSSSMMaintenanceWindowTaskConfigBase(rName) + `
+
+resource "aws_ssm_maintenance_window_task" "test" {
+  window_id        = aws_ssm_maintenance_window.test.id
+  task_type        = "AUTOMATION"
+  task_arn         = "AWS-RestartEC2Instance"
+  priority         = 1
+  service_role_arn = aws_iam_role.test.arn
+
+  task_invocation_parameters {
+    automation_parameters {
+      document_version = "$DEFAULT"
+
+      parameter {
+        name   = "InstanceId"
+        values = ["i-00000000000000000"]
+      }
+    }
+  }
+}
+`)
+}
+
 func testAccAWSSSMMaintenanceWindowTaskConfigEmptyNotifcationConfig(rName string) string {
 	return fmt.Sprintf(testAccAWSSSMMaintenanceWindowTaskConfigBase(rName) + `
 
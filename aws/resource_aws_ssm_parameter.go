@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
@@ -20,8 +21,14 @@ import (
 const (
 	// Maximum amount of time to wait for asynchronous validation on SSM Parameter creation.
 	ssmParameterCreationValidationTimeout = 2 * time.Minute
+
+	ssmParameterDataTypeAwsEc2Image       = "aws:ec2:image"
+	ssmParameterDataTypeAwsSsmIntegration = "aws:ssm:integration"
+	ssmParameterDataTypeText              = "text"
 )
 
+var ssmParameterAwsEc2ImageValueRegexp = regexp.MustCompile(`^ami-[0-9a-f]{8}([0-9a-f]{9})?$`)
+
 func resourceAwsSsmParameter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSsmParameterPut,
@@ -80,8 +87,9 @@ func resourceAwsSsmParameter() *schema.Resource {
 				Optional: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					"aws:ec2:image",
-					"text",
+					ssmParameterDataTypeAwsEc2Image,
+					ssmParameterDataTypeAwsSsmIntegration,
+					ssmParameterDataTypeText,
 				}, false),
 			},
 			"overwrite": {
@@ -105,6 +113,18 @@ func resourceAwsSsmParameter() *schema.Resource {
 			customdiff.ForceNewIfChange("tier", func(_ context.Context, old, new, meta interface{}) bool {
 				return old.(string) == ssm.ParameterTierAdvanced && new.(string) == ssm.ParameterTierStandard
 			}),
+			// SSM validates aws:ec2:image values asynchronously after creation, so catch malformed AMI IDs at plan time.
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if diff.Get("data_type").(string) != ssmParameterDataTypeAwsEc2Image || !diff.NewValueKnown("value") {
+					return nil
+				}
+
+				if !ssmParameterAwsEc2ImageValueRegexp.MatchString(diff.Get("value").(string)) {
+					return fmt.Errorf("value must be a valid AMI ID (e.g. ami-12345678) when data_type is %q", ssmParameterDataTypeAwsEc2Image)
+				}
+
+				return nil
+			},
 		),
 	}
 }
@@ -125,7 +145,7 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 		var err error
 		resp, err = ssmconn.GetParameter(input)
 
-		if isAWSErr(err, ssm.ErrCodeParameterNotFound, "") && d.IsNewResource() && d.Get("data_type").(string) == "aws:ec2:image" {
+		if isAWSErr(err, ssm.ErrCodeParameterNotFound, "") && d.IsNewResource() && d.Get("data_type").(string) == ssmParameterDataTypeAwsEc2Image {
 			return resource.RetryableError(fmt.Errorf("error reading SSM Parameter (%s) after creation: this can indicate that the provided parameter value could not be validated by SSM", d.Id()))
 		}
 
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -362,6 +363,22 @@ func TestAccAWSSSMParameter_DataType_AwsEc2Image(t *testing.T) {
 	})
 }
 
+func TestAccAWSSSMParameter_DataType_AwsEc2Image_InvalidValue(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSSMParameterConfigDataTypeAwsEc2ImageInvalidValue(rName),
+				ExpectError: regexp.MustCompile(`value must be a valid AMI ID`),
+			},
+		},
+	})
+}
+
 func TestAccAWSSSMParameter_secure_with_key(t *testing.T) {
 	var param ssm.Parameter
 	randString := acctest.RandString(10)
@@ -550,6 +567,17 @@ resource "aws_ssm_parameter" "test" {
 `, rName))
 }
 
+func testAccAWSSSMParameterConfigDataTypeAwsEc2ImageInvalidValue(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name      = %[1]q
+  data_type = "aws:ec2:image"
+  type      = "String"
+  value     = "not-an-ami"
+}
+`, rName)
+}
+
 func testAccAWSSSMParameterBasicConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
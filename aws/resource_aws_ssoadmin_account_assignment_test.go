@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/finder"
+)
+
+func TestAccAWSSSOAdminAccountAssignment_Basic_Group(t *testing.T) {
+	resourceName := "aws_ssoadmin_account_assignment.test"
+	groupID := os.Getenv("AWS_IDENTITY_STORE_GROUP_ID")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreGroupID(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminAccountAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSOAdminAccountAssignmentBasicGroupConfig(groupID, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminAccountAssignmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_type", ssoadmin.TargetTypeAwsAccount),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", ssoadmin.PrincipalTypeGroup),
+					resource.TestCheckResourceAttr(resourceName, "principal_id", groupID),
+					testAccCheckResourceAttrAccountID(resourceName, "target_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSOAdminAccountAssignment_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_account_assignment.test"
+	groupID := os.Getenv("AWS_IDENTITY_STORE_GROUP_ID")
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreGroupID(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminAccountAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSOAdminAccountAssignmentBasicGroupConfig(groupID, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminAccountAssignmentExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsoAdminAccountAssignment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSIdentityStoreGroupID(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_GROUP_ID") == "" {
+		t.Skip("AWS_IDENTITY_STORE_GROUP_ID env var must be set for SSO Account Assignment acceptance tests")
+	}
+}
+
+func testAccCheckAWSSSOAdminAccountAssignmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_account_assignment" {
+			continue
+		}
+
+		idParts, err := parseSsoAdminAccountAssignmentID(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Account Assignment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		principalID := idParts[0]
+		principalType := idParts[1]
+		targetID := idParts[2]
+		permissionSetArn := idParts[4]
+		instanceArn := idParts[5]
+
+		accountAssignment, err := finder.AccountAssignment(conn, principalID, principalType, targetID, permissionSetArn, instanceArn)
+
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading SSO Account Assignment for Principal (%s): %w", principalID, err)
+		}
+
+		if accountAssignment != nil {
+			return fmt.Errorf("SSO Account Assignment for Principal (%s) still exists", principalID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSSOAdminAccountAssignmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		idParts, err := parseSsoAdminAccountAssignmentID(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Account Assignment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		principalID := idParts[0]
+		principalType := idParts[1]
+		targetID := idParts[2]
+		permissionSetArn := idParts[4]
+		instanceArn := idParts[5]
+
+		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+		accountAssignment, err := finder.AccountAssignment(conn, principalID, principalType, targetID, permissionSetArn, instanceArn)
+
+		if err != nil {
+			return err
+		}
+
+		if accountAssignment == nil {
+			return fmt.Errorf("SSO Account Assignment for Principal (%s) not found", principalID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSSOAdminAccountAssignmentBasicGroupConfig(groupID, rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %[2]q
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_ssoadmin_account_assignment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  principal_id       = %[1]q
+  principal_type     = "GROUP"
+  target_id          = data.aws_caller_identity.current.account_id
+  target_type        = "AWS_ACCOUNT"
+}
+`, groupID, rName)
+}
@@ -0,0 +1,235 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/storagegateway/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/storagegateway/waiter"
+)
+
+func resourceAwsStorageGatewayFileSystemAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewayFileSystemAssociationCreate,
+		Read:   resourceAwsStorageGatewayFileSystemAssociationRead,
+		Update: resourceAwsStorageGatewayFileSystemAssociationUpdate,
+		Delete: resourceAwsStorageGatewayFileSystemAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"audit_destination_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"cache_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_stale_timeout_in_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+							ValidateFunc: validation.Any(
+								validation.IntInSlice([]int{0}),
+								validation.IntBetween(300, 2592000),
+							),
+						},
+					},
+				},
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"location_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+func resourceAwsStorageGatewayFileSystemAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	gatewayARN := d.Get("gateway_arn").(string)
+	input := &storagegateway.AssociateFileSystemInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		GatewayARN:  aws.String(gatewayARN),
+		LocationARN: aws.String(d.Get("location_arn").(string)),
+		Password:    aws.String(d.Get("password").(string)),
+		Tags:        keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().StoragegatewayTags(),
+		UserName:    aws.String(d.Get("username").(string)),
+	}
+
+	if v, ok := d.GetOk("audit_destination_arn"); ok {
+		input.AuditDestinationARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cache_attributes"); ok {
+		input.CacheAttributes = expandStorageGatewayNfsFileShareCacheAttributes(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Storage Gateway File System Association: %s", input)
+	output, err := conn.AssociateFileSystem(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Storage Gateway (%s) File System Association: %w", gatewayARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.FileSystemAssociationARN))
+
+	if _, err = waiter.FileSystemAssociationAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Storage Gateway File System Association (%s) to be Available: %w", d.Id(), err)
+	}
+
+	return resourceAwsStorageGatewayFileSystemAssociationRead(d, meta)
+}
+
+func resourceAwsStorageGatewayFileSystemAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	fileSystemAssociation, err := finder.FileSystemAssociationByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified file system association was not found.") {
+		log.Printf("[WARN] Storage Gateway File System Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Storage Gateway File System Association (%s): %w", d.Id(), err)
+	}
+
+	if fileSystemAssociation == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Storage Gateway File System Association (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Storage Gateway File System Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", fileSystemAssociation.FileSystemAssociationARN)
+	d.Set("audit_destination_arn", fileSystemAssociation.AuditDestinationARN)
+	d.Set("gateway_arn", fileSystemAssociation.GatewayARN)
+	d.Set("location_arn", fileSystemAssociation.LocationARN)
+	d.Set("status", fileSystemAssociation.FileSystemAssociationStatus)
+
+	if err := d.Set("cache_attributes", flattenStorageGatewayNfsFileShareCacheAttributes(fileSystemAssociation.CacheAttributes)); err != nil {
+		return fmt.Errorf("error setting cache_attributes: %w", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.StoragegatewayKeyValueTags(fileSystemAssociation.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsStorageGatewayFileSystemAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.StoragegatewayUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Storage Gateway File System Association (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("audit_destination_arn", "cache_attributes", "password", "username") {
+		input := &storagegateway.UpdateFileSystemAssociationInput{
+			AuditDestinationARN:      aws.String(d.Get("audit_destination_arn").(string)),
+			FileSystemAssociationARN: aws.String(d.Id()),
+			Password:                 aws.String(d.Get("password").(string)),
+			UserName:                 aws.String(d.Get("username").(string)),
+		}
+
+		if v, ok := d.GetOk("cache_attributes"); ok {
+			input.CacheAttributes = expandStorageGatewayNfsFileShareCacheAttributes(v.([]interface{}))
+		} else {
+			input.CacheAttributes = &storagegateway.CacheAttributes{
+				CacheStaleTimeoutInSeconds: aws.Int64(0),
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Storage Gateway File System Association: %s", input)
+		if _, err := conn.UpdateFileSystemAssociation(input); err != nil {
+			return fmt.Errorf("error updating Storage Gateway File System Association (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.FileSystemAssociationAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Storage Gateway File System Association (%s) to be Available: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsStorageGatewayFileSystemAssociationRead(d, meta)
+}
+
+func resourceAwsStorageGatewayFileSystemAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	log.Printf("[DEBUG] Deleting Storage Gateway File System Association: %s", d.Id())
+	_, err := conn.DisassociateFileSystem(&storagegateway.DisassociateFileSystemInput{
+		FileSystemAssociationARN: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified file system association was not found.") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Storage Gateway File System Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err = waiter.FileSystemAssociationDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Storage Gateway File System Association (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
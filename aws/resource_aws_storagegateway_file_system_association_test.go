@@ -0,0 +1,483 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/storagegateway/finder"
+)
+
+func TestAccAWSStorageGatewayFileSystemAssociation_basic(t *testing.T) {
+	var fileSystemAssociation storagegateway.FileSystemAssociationInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_file_system_association.test"
+	gatewayResourceName := "aws_storagegateway_gateway.test"
+	fsxResourceName := "aws_fsx_windows_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(fsx.EndpointsID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayFileSystemAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayFileSystemAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayFileSystemAssociationExists(resourceName, &fileSystemAssociation),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "storagegateway", regexp.MustCompile(`fs-association/fsa-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "audit_destination_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_arn", gatewayResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "location_arn", fsxResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "username", "Admin"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "username"},
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayFileSystemAssociation_tags(t *testing.T) {
+	var fileSystemAssociation storagegateway.FileSystemAssociationInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_file_system_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(fsx.EndpointsID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayFileSystemAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayFileSystemAssociationConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayFileSystemAssociationExists(resourceName, &fileSystemAssociation),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "username"},
+			},
+			{
+				Config: testAccAWSStorageGatewayFileSystemAssociationConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayFileSystemAssociationExists(resourceName, &fileSystemAssociation),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSStorageGatewayFileSystemAssociationConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayFileSystemAssociationExists(resourceName, &fileSystemAssociation),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayFileSystemAssociation_AuditDestinationArn(t *testing.T) {
+	var fileSystemAssociation storagegateway.FileSystemAssociationInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_file_system_association.test"
+	logResourceName := "aws_cloudwatch_log_group.test"
+	logResourceNameSecond := "aws_cloudwatch_log_group.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(fsx.EndpointsID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayFileSystemAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayFileSystemAssociationConfigAuditDestination(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayFileSystemAssociationExists(resourceName, &fileSystemAssociation),
+					resource.TestCheckResourceAttrPair(resourceName, "audit_destination_arn", logResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "username"},
+			},
+			{
+				Config: testAccAWSStorageGatewayFileSystemAssociationConfigAuditDestination(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayFileSystemAssociationExists(resourceName, &fileSystemAssociation),
+					resource.TestCheckResourceAttrPair(resourceName, "audit_destination_arn", logResourceNameSecond, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayFileSystemAssociation_CacheAttributes(t *testing.T) {
+	var fileSystemAssociation storagegateway.FileSystemAssociationInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_file_system_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(fsx.EndpointsID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayFileSystemAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayFileSystemAssociationConfigCacheAttributes(rName, 400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayFileSystemAssociationExists(resourceName, &fileSystemAssociation),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.0.cache_stale_timeout_in_seconds", "400"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "username"},
+			},
+			{
+				Config: testAccAWSStorageGatewayFileSystemAssociationConfigCacheAttributes(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayFileSystemAssociationExists(resourceName, &fileSystemAssociation),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cache_attributes.0.cache_stale_timeout_in_seconds", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayFileSystemAssociation_disappears(t *testing.T) {
+	var fileSystemAssociation storagegateway.FileSystemAssociationInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_file_system_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(fsx.EndpointsID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayFileSystemAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayFileSystemAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayFileSystemAssociationExists(resourceName, &fileSystemAssociation),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsStorageGatewayFileSystemAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSStorageGatewayFileSystemAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_storagegateway_file_system_association" {
+			continue
+		}
+
+		output, err := finder.FileSystemAssociationByARN(conn, rs.Primary.ID)
+
+		if tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified file system association was not found.") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Storage Gateway File System Association %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSStorageGatewayFileSystemAssociationExists(resourceName string, fileSystemAssociation *storagegateway.FileSystemAssociationInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+		output, err := finder.FileSystemAssociationByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Storage Gateway File System Association %q does not exist", rs.Primary.ID)
+		}
+
+		*fileSystemAssociation = *output
+
+		return nil
+	}
+}
+
+func testAccAWSStorageGatewayFileSystemAssociationConfigBase(rName string) string {
+	return composeConfig(
+		// Reference: https://docs.aws.amazon.com/storagegateway/latest/userguide/Requirements.html
+		testAccAvailableEc2InstanceTypeForAvailabilityZone("aws_subnet.test[0].availability_zone", "m5.xlarge", "m4.xlarge"),
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+# Directory Service Directories must be deployed across multiple EC2 Availability Zones
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route" "test" {
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = aws_internet_gateway.test.id
+  route_table_id         = aws_vpc.test.main_route_table_id
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  ingress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+# FSx for Windows File Server requires an AWS Managed Microsoft AD
+resource "aws_directory_service_directory" "test" {
+  edition  = "Standard"
+  name     = "corp.notexample.com"
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+
+  vpc_settings {
+    subnet_ids = aws_subnet.test[*].id
+    vpc_id     = aws_vpc.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_dhcp_options" "test" {
+  domain_name         = aws_directory_service_directory.test.name
+  domain_name_servers = aws_directory_service_directory.test.dns_ip_addresses
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_dhcp_options_association" "test" {
+  dhcp_options_id = aws_vpc_dhcp_options.test.id
+  vpc_id          = aws_vpc.test.id
+}
+
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id = aws_directory_service_directory.test.id
+  security_group_ids  = [aws_security_group.test.id]
+  skip_final_backup   = true
+  storage_capacity    = 32
+  subnet_ids          = [aws_subnet.test[0].id]
+  throughput_capacity = 8
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+# Reference: https://docs.aws.amazon.com/storagegateway/latest/userguide/ec2-gateway-file.html
+data "aws_ssm_parameter" "aws_service_storagegateway_ami_FILE_S3_latest" {
+  name = "/aws/service/storagegateway/ami/FILE_S3/latest"
+}
+
+resource "aws_instance" "test" {
+  depends_on = [aws_route.test, aws_vpc_dhcp_options_association.test]
+
+  ami                         = data.aws_ssm_parameter.aws_service_storagegateway_ami_FILE_S3_latest.value
+  associate_public_ip_address = true
+  instance_type               = data.aws_ec2_instance_type_offering.available.instance_type
+  vpc_security_group_ids      = [aws_security_group.test.id]
+  subnet_id                   = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "FILE_FSX_SMB"
+
+  smb_active_directory_settings {
+    domain_name = aws_directory_service_directory.test.name
+    password    = aws_directory_service_directory.test.password
+    username    = "Admin"
+  }
+}
+`, rName))
+}
+
+func testAccAWSStorageGatewayFileSystemAssociationConfig(rName string) string {
+	return composeConfig(
+		testAccAWSStorageGatewayFileSystemAssociationConfigBase(rName),
+		`
+resource "aws_storagegateway_file_system_association" "test" {
+  gateway_arn  = aws_storagegateway_gateway.test.arn
+  location_arn = aws_fsx_windows_file_system.test.arn
+  username     = "Admin"
+  password     = aws_directory_service_directory.test.password
+}
+`)
+}
+
+func testAccAWSStorageGatewayFileSystemAssociationConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSStorageGatewayFileSystemAssociationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_storagegateway_file_system_association" "test" {
+  gateway_arn  = aws_storagegateway_gateway.test.arn
+  location_arn = aws_fsx_windows_file_system.test.arn
+  username     = "Admin"
+  password     = aws_directory_service_directory.test.password
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSStorageGatewayFileSystemAssociationConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSStorageGatewayFileSystemAssociationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_storagegateway_file_system_association" "test" {
+  gateway_arn  = aws_storagegateway_gateway.test.arn
+  location_arn = aws_fsx_windows_file_system.test.arn
+  username     = "Admin"
+  password     = aws_directory_service_directory.test.password
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccAWSStorageGatewayFileSystemAssociationConfigAuditDestination(rName, logGroupName string) string {
+	return composeConfig(
+		testAccAWSStorageGatewayFileSystemAssociationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_group" "test2" {
+  name = "%[1]s-updated"
+}
+
+resource "aws_storagegateway_file_system_association" "test" {
+  gateway_arn           = aws_storagegateway_gateway.test.arn
+  location_arn          = aws_fsx_windows_file_system.test.arn
+  username              = "Admin"
+  password              = aws_directory_service_directory.test.password
+  audit_destination_arn = aws_cloudwatch_log_group.%[2]s.arn
+}
+`, rName, logGroupName))
+}
+
+func testAccAWSStorageGatewayFileSystemAssociationConfigCacheAttributes(rName string, cacheStaleTimeoutInSeconds int) string {
+	return composeConfig(
+		testAccAWSStorageGatewayFileSystemAssociationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_storagegateway_file_system_association" "test" {
+  gateway_arn  = aws_storagegateway_gateway.test.arn
+  location_arn = aws_fsx_windows_file_system.test.arn
+  username     = "Admin"
+  password     = aws_directory_service_directory.test.password
+
+  cache_attributes {
+    cache_stale_timeout_in_seconds = %[1]d
+  }
+}
+`, cacheStaleTimeoutInSeconds))
+}
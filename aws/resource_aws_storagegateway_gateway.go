@@ -89,6 +89,7 @@ func resourceAwsStorageGatewayGateway() *schema.Resource {
 				Default:  "STORED",
 				ValidateFunc: validation.StringInSlice([]string{
 					"CACHED",
+					"FILE_FSX_SMB",
 					"FILE_S3",
 					"STORED",
 					"VTL",
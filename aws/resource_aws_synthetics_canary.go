@@ -0,0 +1,690 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/waiter"
+)
+
+func resourceAwsSyntheticsCanary() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSyntheticsCanaryCreate,
+		Read:   resourceAwsSyntheticsCanaryRead,
+		Update: resourceAwsSyntheticsCanaryUpdate,
+		Delete: resourceAwsSyntheticsCanaryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"artifact_s3_location": {
+				Type:     schema.TypeString,
+				Required: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimPrefix(new, "s3://") == old
+				},
+			},
+			"engine_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"failure_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      31,
+				ValidateFunc: validation.IntBetween(1, 455),
+			},
+			"handler": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 21),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z_\-]+$`), "must contain only lowercase alphanumeric, hyphen, or underscore characters"),
+				),
+			},
+			"run_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active_tracing": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"environment_variables": {
+							Type:      schema.TypeMap,
+							Optional:  true,
+							Sensitive: true,
+							Elem:      &schema.Schema{Type: schema.TypeString},
+						},
+						"memory_in_mb": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.All(
+								validation.IntAtLeast(960),
+								validation.IntDivisibleBy(64),
+							),
+						},
+						"timeout_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(3, 840),
+						},
+					},
+				},
+			},
+			"runtime_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"s3_bucket": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"zip_file"},
+				RequiredWith:  []string{"s3_bucket", "s3_key"},
+			},
+			"s3_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"zip_file"},
+				RequiredWith:  []string{"s3_bucket", "s3_key"},
+			},
+			"s3_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"zip_file"},
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration_in_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"source_location_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_canary": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"success_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      31,
+				ValidateFunc: validation.IntBetween(1, 455),
+			},
+			"tags": tagsSchema(),
+			"timeline": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_started": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_stopped": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"zip_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_version"},
+			},
+		},
+	}
+}
+
+func resourceAwsSyntheticsCanaryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+
+	name := d.Get("name").(string)
+	input := &synthetics.CreateCanaryInput{
+		ArtifactS3Location:           aws.String(d.Get("artifact_s3_location").(string)),
+		ExecutionRoleArn:             aws.String(d.Get("execution_role_arn").(string)),
+		FailureRetentionPeriodInDays: aws.Int64(int64(d.Get("failure_retention_period").(int))),
+		Name:                         aws.String(name),
+		RuntimeVersion:               aws.String(d.Get("runtime_version").(string)),
+		SuccessRetentionPeriodInDays: aws.Int64(int64(d.Get("success_retention_period").(int))),
+	}
+
+	code, err := expandSyntheticsCanaryCode(d)
+
+	if err != nil {
+		return err
+	}
+
+	input.Code = code
+
+	if v, ok := d.GetOk("run_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RunConfig = expandSyntheticsCanaryRunConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("schedule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Schedule = expandSyntheticsCanarySchedule(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VpcConfig = expandSyntheticsCanaryVpcConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().SyntheticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating Synthetics Canary: %s", input)
+	_, err = conn.CreateCanary(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Synthetics Canary (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.CanaryReady(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) creation: %w", d.Id(), err)
+	}
+
+	if d.Get("start_canary").(bool) {
+		if err := syntheticsStartCanary(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSyntheticsCanaryRead(d, meta)
+}
+
+func resourceAwsSyntheticsCanaryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	canary, err := finder.CanaryByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Synthetics Canary (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Synthetics Canary (%s): %w", d.Id(), err)
+	}
+
+	if canary == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Synthetics Canary (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Synthetics Canary (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	canaryArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   synthetics.ServiceName,
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("canary:%s", aws.StringValue(canary.Name)),
+	}.String()
+
+	d.Set("arn", canaryArn)
+	d.Set("artifact_s3_location", canary.ArtifactS3Location)
+	d.Set("engine_arn", canary.EngineArn)
+	d.Set("execution_role_arn", canary.ExecutionRoleArn)
+	d.Set("failure_retention_period", canary.FailureRetentionPeriodInDays)
+	d.Set("name", canary.Name)
+	d.Set("runtime_version", canary.RuntimeVersion)
+	d.Set("success_retention_period", canary.SuccessRetentionPeriodInDays)
+
+	if code := canary.Code; code != nil {
+		d.Set("handler", code.Handler)
+		d.Set("source_location_arn", code.SourceLocationArn)
+	} else {
+		d.Set("handler", nil)
+		d.Set("source_location_arn", nil)
+	}
+
+	if status := canary.Status; status != nil {
+		d.Set("status", status.State)
+	} else {
+		d.Set("status", nil)
+	}
+
+	if err := d.Set("run_config", flattenSyntheticsCanaryRunConfig(canary.RunConfig, d.Get("run_config.0.environment_variables").(map[string]interface{}))); err != nil {
+		return fmt.Errorf("error setting run_config: %w", err)
+	}
+
+	if err := d.Set("schedule", flattenSyntheticsCanarySchedule(canary.Schedule)); err != nil {
+		return fmt.Errorf("error setting schedule: %w", err)
+	}
+
+	if err := d.Set("timeline", flattenSyntheticsCanaryTimeline(canary.Timeline)); err != nil {
+		return fmt.Errorf("error setting timeline: %w", err)
+	}
+
+	if err := d.Set("vpc_config", flattenSyntheticsCanaryVpcConfig(canary.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %w", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.SyntheticsKeyValueTags(canary.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSyntheticsCanaryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+
+	if d.HasChangesExcept("start_canary", "tags") {
+		input := &synthetics.UpdateCanaryInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChanges("handler", "s3_bucket", "s3_key", "s3_version", "zip_file") {
+			code, err := expandSyntheticsCanaryCode(d)
+
+			if err != nil {
+				return err
+			}
+
+			input.Code = code
+		}
+
+		if d.HasChange("artifact_s3_location") {
+			input.ArtifactS3Location = aws.String(d.Get("artifact_s3_location").(string))
+		}
+
+		if d.HasChange("execution_role_arn") {
+			input.ExecutionRoleArn = aws.String(d.Get("execution_role_arn").(string))
+		}
+
+		if d.HasChange("failure_retention_period") {
+			input.FailureRetentionPeriodInDays = aws.Int64(int64(d.Get("failure_retention_period").(int)))
+		}
+
+		if d.HasChange("run_config") {
+			if v, ok := d.GetOk("run_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.RunConfig = expandSyntheticsCanaryRunConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("runtime_version") {
+			input.RuntimeVersion = aws.String(d.Get("runtime_version").(string))
+		}
+
+		if d.HasChange("schedule") {
+			if v, ok := d.GetOk("schedule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Schedule = expandSyntheticsCanarySchedule(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("success_retention_period") {
+			input.SuccessRetentionPeriodInDays = aws.Int64(int64(d.Get("success_retention_period").(int)))
+		}
+
+		if d.HasChange("vpc_config") {
+			if v, ok := d.GetOk("vpc_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.VpcConfig = expandSyntheticsCanaryVpcConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.VpcConfig = &synthetics.VpcConfigInput{}
+			}
+		}
+
+		// A running canary cannot be updated, so stop it first and restart it afterwards if requested.
+		if d.Get("status").(string) == synthetics.CanaryStateRunning {
+			if err := syntheticsStopCanary(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Synthetics Canary: %s", input)
+		if _, err := conn.UpdateCanary(input); err != nil {
+			return fmt.Errorf("error updating Synthetics Canary (%s): %w", d.Id(), err)
+		}
+
+		canary, err := waiter.CanaryUpdated(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error waiting for Synthetics Canary (%s) update: %w", d.Id(), err)
+		}
+
+		if status := canary.Status; status != nil {
+			d.Set("status", status.State)
+		}
+	}
+
+	if status := d.Get("status").(string); d.Get("start_canary").(bool) {
+		if status != synthetics.CanaryStateRunning {
+			if err := syntheticsStartCanary(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	} else if status == synthetics.CanaryStateRunning {
+		if err := syntheticsStopCanary(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.SyntheticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Synthetics Canary (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSyntheticsCanaryRead(d, meta)
+}
+
+func resourceAwsSyntheticsCanaryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+
+	if d.Get("status").(string) == synthetics.CanaryStateRunning {
+		if err := syntheticsStopCanary(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Synthetics Canary: %s", d.Id())
+	_, err := conn.DeleteCanary(&synthetics.DeleteCanaryInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Synthetics Canary (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.CanaryDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func syntheticsStartCanary(conn *synthetics.Synthetics, name string) error {
+	log.Printf("[DEBUG] Starting Synthetics Canary: %s", name)
+	_, err := conn.StartCanary(&synthetics.StartCanaryInput{
+		Name: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting Synthetics Canary (%s): %w", name, err)
+	}
+
+	if _, err := waiter.CanaryRunning(conn, name); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) start: %w", name, err)
+	}
+
+	return nil
+}
+
+func syntheticsStopCanary(conn *synthetics.Synthetics, name string) error {
+	log.Printf("[DEBUG] Stopping Synthetics Canary: %s", name)
+	_, err := conn.StopCanary(&synthetics.StopCanaryInput{
+		Name: aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeConflictException) {
+		// The canary is not running.
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error stopping Synthetics Canary (%s): %w", name, err)
+	}
+
+	if _, err := waiter.CanaryStopped(conn, name); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) stop: %w", name, err)
+	}
+
+	return nil
+}
+
+func expandSyntheticsCanaryCode(d *schema.ResourceData) (*synthetics.CanaryCodeInput, error) {
+	codeConfig := &synthetics.CanaryCodeInput{
+		Handler: aws.String(d.Get("handler").(string)),
+	}
+
+	if v, ok := d.GetOk("zip_file"); ok {
+		file, err := loadFileContent(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("error opening Synthetics Canary zip_file (%s): %w", v.(string), err)
+		}
+
+		codeConfig.ZipFile = file
+	} else {
+		codeConfig.S3Bucket = aws.String(d.Get("s3_bucket").(string))
+		codeConfig.S3Key = aws.String(d.Get("s3_key").(string))
+
+		if v, ok := d.GetOk("s3_version"); ok {
+			codeConfig.S3Version = aws.String(v.(string))
+		}
+	}
+
+	return codeConfig, nil
+}
+
+func expandSyntheticsCanaryRunConfig(tfMap map[string]interface{}) *synthetics.CanaryRunConfigInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &synthetics.CanaryRunConfigInput{}
+
+	if v, ok := tfMap["active_tracing"].(bool); ok {
+		apiObject.ActiveTracing = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["environment_variables"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.EnvironmentVariables = stringMapToPointers(v)
+	}
+
+	if v, ok := tfMap["memory_in_mb"].(int); ok && v != 0 {
+		apiObject.MemoryInMB = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.TimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenSyntheticsCanaryRunConfig(apiObject *synthetics.CanaryRunConfigOutput, environmentVariables map[string]interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"active_tracing":     aws.BoolValue(apiObject.ActiveTracing),
+		"memory_in_mb":       aws.Int64Value(apiObject.MemoryInMB),
+		"timeout_in_seconds": aws.Int64Value(apiObject.TimeoutInSeconds),
+	}
+
+	// Environment variables are not returned by the API.
+	if environmentVariables != nil {
+		tfMap["environment_variables"] = environmentVariables
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandSyntheticsCanarySchedule(tfMap map[string]interface{}) *synthetics.CanaryScheduleInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &synthetics.CanaryScheduleInput{}
+
+	if v, ok := tfMap["duration_in_seconds"].(int); ok {
+		apiObject.DurationInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["expression"].(string); ok && v != "" {
+		apiObject.Expression = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSyntheticsCanarySchedule(apiObject *synthetics.CanaryScheduleOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"duration_in_seconds": aws.Int64Value(apiObject.DurationInSeconds),
+		"expression":          aws.StringValue(apiObject.Expression),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSyntheticsCanaryTimeline(apiObject *synthetics.CanaryTimeline) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"created": aws.TimeValue(apiObject.Created).Format(time.RFC3339),
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.LastStarted; v != nil {
+		tfMap["last_started"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.LastStopped; v != nil {
+		tfMap["last_stopped"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandSyntheticsCanaryVpcConfig(tfMap map[string]interface{}) *synthetics.VpcConfigInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &synthetics.VpcConfigInput{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenSyntheticsCanaryVpcConfig(apiObject *synthetics.VpcConfigOutput) []interface{} {
+	if apiObject == nil || aws.StringValue(apiObject.VpcId) == "" {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": flattenStringSet(apiObject.SecurityGroupIds),
+		"subnet_ids":         flattenStringSet(apiObject.SubnetIds),
+		"vpc_id":             aws.StringValue(apiObject.VpcId),
+	}
+
+	return []interface{}{tfMap}
+}
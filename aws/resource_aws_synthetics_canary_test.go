@@ -0,0 +1,610 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_synthetics_canary", &resource.Sweeper{
+		Name: "aws_synthetics_canary",
+		F:    testSweepSyntheticsCanaries,
+		Dependencies: []string{
+			"aws_lambda_function",
+			"aws_lambda_layer",
+		},
+	})
+}
+
+func testSweepSyntheticsCanaries(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).syntheticsconn
+	input := &synthetics.DescribeCanariesInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.DescribeCanaries(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping Synthetics Canary sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Synthetics Canaries: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, canary := range output.Canaries {
+			name := aws.StringValue(canary.Name)
+
+			if canary.Status != nil && aws.StringValue(canary.Status.State) == synthetics.CanaryStateRunning {
+				if err := syntheticsStopCanary(conn, name); err != nil {
+					log.Printf("[ERROR] %s", err)
+					sweeperErrs = multierror.Append(sweeperErrs, err)
+					continue
+				}
+			}
+
+			log.Printf("[INFO] Deleting Synthetics Canary: %s", name)
+			_, err := conn.DeleteCanary(&synthetics.DeleteCanaryInput{
+				Name: aws.String(name),
+			})
+
+			if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting Synthetics Canary (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSSyntheticsCanary_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", synthetics.ServiceName, fmt.Sprintf("canary:%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "artifact_s3_location"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "engine_arn", "lambda", regexp.MustCompile(fmt.Sprintf(`function:cwsyn-%s.+`, rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "failure_retention_period", "31"),
+					resource.TestCheckResourceAttr(resourceName, "handler", "exports.handler"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "run_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.memory_in_mb", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "840"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version", testAccAWSSyntheticsCanaryRuntimeVersion),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.duration_in_seconds", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.expression", "rate(0 hour)"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "source_location_arn", "lambda", regexp.MustCompile(fmt.Sprintf(`layer:cwsyn-%s.+`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "status", synthetics.CanaryStateReady),
+					resource.TestCheckResourceAttr(resourceName, "success_retention_period", "31"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "timeline.0.created"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_canary", "zip_file"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_disappears(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSyntheticsCanary(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_StartCanary(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigStartCanary(rName, true, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_canary", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", synthetics.CanaryStateRunning),
+					resource.TestCheckResourceAttrSet(resourceName, "timeline.0.last_started"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_canary", "zip_file"},
+			},
+			{
+				// Updating a running canary stops it, applies the update and starts it again.
+				Config: testAccAWSSyntheticsCanaryConfigStartCanary(rName, true, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "failure_retention_period", "10"),
+					resource.TestCheckResourceAttr(resourceName, "status", synthetics.CanaryStateRunning),
+				),
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryConfigStartCanary(rName, false, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_canary", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", synthetics.CanaryStateStopped),
+					resource.TestCheckResourceAttrSet(resourceName, "timeline.0.last_stopped"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_RunConfig(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigRunConfig(rName, 60, 960, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "run_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.active_tracing", "false"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.environment_variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.environment_variables.test1", "result1"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.memory_in_mb", "960"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "60"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"run_config.0.environment_variables", "start_canary", "zip_file"},
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryConfigRunConfig(rName, 120, 1024, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "run_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.active_tracing", "true"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.memory_in_mb", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_VpcConfig(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigVpcConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_config.0.vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_canary", "zip_file"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_Tags(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(synthetics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_canary", "zip_file"},
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSyntheticsCanaryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).syntheticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_synthetics_canary" {
+			continue
+		}
+
+		output, err := finder.CanaryByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Synthetics Canary (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSyntheticsCanaryExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).syntheticsconn
+
+		output, err := finder.CanaryByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Synthetics Canary (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccAWSSyntheticsCanaryRuntimeVersion = "syn-nodejs-puppeteer-6.2"
+
+func testAccAWSSyntheticsCanaryConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:PutObject",
+        "s3:GetBucketLocation",
+        "s3:ListAllMyBuckets",
+        "cloudwatch:PutMetricData",
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "xray:PutTraceSegments",
+        "ec2:CreateNetworkInterface",
+        "ec2:DescribeNetworkInterfaces",
+        "ec2:DeleteNetworkInterface"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSSyntheticsCanaryConfigBasic(rName string) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = %[2]q
+
+  schedule {
+    expression = "rate(0 hour)"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccAWSSyntheticsCanaryRuntimeVersion))
+}
+
+func testAccAWSSyntheticsCanaryConfigStartCanary(rName string, startCanary bool, failureRetentionPeriod int) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                     = %[1]q
+  artifact_s3_location     = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn       = aws_iam_role.test.arn
+  handler                  = "exports.handler"
+  zip_file                 = "test-fixtures/synthetics_canary.zip"
+  runtime_version          = %[2]q
+  start_canary             = %[3]t
+  failure_retention_period = %[4]d
+
+  schedule {
+    expression = "rate(5 minutes)"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccAWSSyntheticsCanaryRuntimeVersion, startCanary, failureRetentionPeriod))
+}
+
+func testAccAWSSyntheticsCanaryConfigRunConfig(rName string, timeout, memory int, activeTracing bool) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = %[2]q
+
+  schedule {
+    expression = "rate(0 hour)"
+  }
+
+  run_config {
+    timeout_in_seconds = %[3]d
+    memory_in_mb       = %[4]d
+    active_tracing     = %[5]t
+
+    environment_variables = {
+      test1 = "result1"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccAWSSyntheticsCanaryRuntimeVersion, timeout, memory, activeTracing))
+}
+
+func testAccAWSSyntheticsCanaryConfigVpcConfig(rName string) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = %[2]q
+
+  schedule {
+    expression = "rate(0 hour)"
+  }
+
+  vpc_config {
+    subnet_ids         = [aws_subnet.test.id]
+    security_group_ids = [aws_security_group.test.id]
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccAWSSyntheticsCanaryRuntimeVersion))
+}
+
+func testAccAWSSyntheticsCanaryConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = %[2]q
+
+  schedule {
+    expression = "rate(0 hour)"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccAWSSyntheticsCanaryRuntimeVersion, tagKey1, tagValue1))
+}
+
+func testAccAWSSyntheticsCanaryConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = %[2]q
+
+  schedule {
+    expression = "rate(0 hour)"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, testAccAWSSyntheticsCanaryRuntimeVersion, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,416 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tftimestreamwrite "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/timestreamwrite"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/timestreamwrite/finder"
+)
+
+func resourceAwsTimestreamWriteTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsTimestreamWriteTableCreate,
+		Read:   resourceAwsTimestreamWriteTableRead,
+		Update: resourceAwsTimestreamWriteTableUpdate,
+		Delete: resourceAwsTimestreamWriteTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"magnetic_store_write_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_magnetic_store_writes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"magnetic_store_rejected_data_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"encryption_option": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(timestreamwrite.S3EncryptionOption_Values(), false),
+												},
+												"kms_key_id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 2048),
+												},
+												"object_key_prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 928),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"retention_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"magnetic_store_retention_period_in_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 73000),
+						},
+						"memory_store_retention_period_in_hours": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 8766),
+						},
+					},
+				},
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsTimestreamWriteTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	databaseName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	input := &timestreamwrite.CreateTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	}
+
+	if v, ok := d.GetOk("magnetic_store_write_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MagneticStoreWriteProperties = expandTimestreamWriteMagneticStoreWriteProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("retention_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionProperties = expandTimestreamWriteRetentionProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().TimestreamwriteTags()
+	}
+
+	log.Printf("[DEBUG] Creating Timestream Table: %s", input)
+	_, err := conn.CreateTable(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Timestream Table (%s) in Database (%s): %w", tableName, databaseName, err)
+	}
+
+	d.SetId(tftimestreamwrite.TableCreateID(databaseName, tableName))
+
+	return resourceAwsTimestreamWriteTableRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteTableRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	databaseName, tableName, err := tftimestreamwrite.TableParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	table, err := finder.TableByName(conn, databaseName, tableName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, timestreamwrite.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Timestream Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Timestream Table (%s): %w", d.Id(), err)
+	}
+
+	if table == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Timestream Table (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Timestream Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(table.Arn)
+
+	d.Set("arn", arn)
+	d.Set("database_name", table.DatabaseName)
+	d.Set("table_name", table.TableName)
+
+	if err := d.Set("magnetic_store_write_properties", flattenTimestreamWriteMagneticStoreWriteProperties(table.MagneticStoreWriteProperties)); err != nil {
+		return fmt.Errorf("error setting magnetic_store_write_properties: %w", err)
+	}
+
+	if err := d.Set("retention_properties", flattenTimestreamWriteRetentionProperties(table.RetentionProperties)); err != nil {
+		return fmt.Errorf("error setting retention_properties: %w", err)
+	}
+
+	tags, err := keyvaluetags.TimestreamwriteListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Timestream Table (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsTimestreamWriteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	if d.HasChanges("magnetic_store_write_properties", "retention_properties") {
+		databaseName, tableName, err := tftimestreamwrite.TableParseID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &timestreamwrite.UpdateTableInput{
+			DatabaseName: aws.String(databaseName),
+			TableName:    aws.String(tableName),
+		}
+
+		if d.HasChange("magnetic_store_write_properties") {
+			if v, ok := d.GetOk("magnetic_store_write_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.MagneticStoreWriteProperties = expandTimestreamWriteMagneticStoreWriteProperties(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("retention_properties") {
+			if v, ok := d.GetOk("retention_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.RetentionProperties = expandTimestreamWriteRetentionProperties(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Timestream Table: %s", input)
+		if _, err := conn.UpdateTable(input); err != nil {
+			return fmt.Errorf("error updating Timestream Table (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.TimestreamwriteUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Timestream Table (%s) tags: %w", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceAwsTimestreamWriteTableRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	databaseName, tableName, err := tftimestreamwrite.TableParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Timestream Table: %s", d.Id())
+	_, err = conn.DeleteTable(&timestreamwrite.DeleteTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, timestreamwrite.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Timestream Table (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandTimestreamWriteRetentionProperties(tfMap map[string]interface{}) *timestreamwrite.RetentionProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreamwrite.RetentionProperties{}
+
+	if v, ok := tfMap["magnetic_store_retention_period_in_days"].(int); ok {
+		apiObject.MagneticStoreRetentionPeriodInDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["memory_store_retention_period_in_hours"].(int); ok {
+		apiObject.MemoryStoreRetentionPeriodInHours = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenTimestreamWriteRetentionProperties(apiObject *timestreamwrite.RetentionProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"magnetic_store_retention_period_in_days": aws.Int64Value(apiObject.MagneticStoreRetentionPeriodInDays),
+		"memory_store_retention_period_in_hours":  aws.Int64Value(apiObject.MemoryStoreRetentionPeriodInHours),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandTimestreamWriteMagneticStoreWriteProperties(tfMap map[string]interface{}) *timestreamwrite.MagneticStoreWriteProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreamwrite.MagneticStoreWriteProperties{
+		EnableMagneticStoreWrites: aws.Bool(tfMap["enable_magnetic_store_writes"].(bool)),
+	}
+
+	if v, ok := tfMap["magnetic_store_rejected_data_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MagneticStoreRejectedDataLocation = expandTimestreamWriteMagneticStoreRejectedDataLocation(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTimestreamWriteMagneticStoreRejectedDataLocation(tfMap map[string]interface{}) *timestreamwrite.MagneticStoreRejectedDataLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreamwrite.MagneticStoreRejectedDataLocation{}
+
+	if v, ok := tfMap["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Configuration = expandTimestreamWriteS3Configuration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTimestreamWriteS3Configuration(tfMap map[string]interface{}) *timestreamwrite.S3Configuration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreamwrite.S3Configuration{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_option"].(string); ok && v != "" {
+		apiObject.EncryptionOption = aws.String(v)
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_key_prefix"].(string); ok && v != "" {
+		apiObject.ObjectKeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTimestreamWriteMagneticStoreWriteProperties(apiObject *timestreamwrite.MagneticStoreWriteProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_magnetic_store_writes":          aws.BoolValue(apiObject.EnableMagneticStoreWrites),
+		"magnetic_store_rejected_data_location": flattenTimestreamWriteMagneticStoreRejectedDataLocation(apiObject.MagneticStoreRejectedDataLocation),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTimestreamWriteMagneticStoreRejectedDataLocation(apiObject *timestreamwrite.MagneticStoreRejectedDataLocation) []interface{} {
+	if apiObject == nil || apiObject.S3Configuration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_configuration": flattenTimestreamWriteS3Configuration(apiObject.S3Configuration),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTimestreamWriteS3Configuration(apiObject *timestreamwrite.S3Configuration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_name":       aws.StringValue(apiObject.BucketName),
+		"encryption_option": aws.StringValue(apiObject.EncryptionOption),
+		"kms_key_id":        aws.StringValue(apiObject.KmsKeyId),
+		"object_key_prefix": aws.StringValue(apiObject.ObjectKeyPrefix),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -418,6 +418,10 @@ func resourceAwsVpnConnection() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"tunnel1_certificate_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 
 			"tunnel2_address": {
 				Type:     schema.TypeString,
@@ -439,6 +443,10 @@ func resourceAwsVpnConnection() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"tunnel2_certificate_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 
 			"routes": {
 				Type:     schema.TypeSet,
@@ -481,6 +489,11 @@ func resourceAwsVpnConnection() *schema.Resource {
 							Computed: true,
 						},
 
+						"certificate_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
 						"last_status_change": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -719,6 +732,15 @@ func resourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) erro
 			d.Set("tunnel2_vgw_inside_address", tunnelInfo.Tunnel2VgwInsideAddress)
 			d.Set("tunnel2_bgp_asn", tunnelInfo.Tunnel2BGPASN)
 			d.Set("tunnel2_bgp_holdtime", tunnelInfo.Tunnel2BGPHoldTime)
+
+			for _, t := range vpnConnection.VgwTelemetry {
+				switch aws.StringValue(t.OutsideIpAddress) {
+				case tunnelInfo.Tunnel1Address:
+					d.Set("tunnel1_certificate_arn", t.CertificateArn)
+				case tunnelInfo.Tunnel2Address:
+					d.Set("tunnel2_certificate_arn", t.CertificateArn)
+				}
+			}
 		}
 	}
 
@@ -743,17 +765,25 @@ func resourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func flattenTunnelOptions(d *schema.ResourceData, vpnConnection *ec2.VpnConnection) error {
-	if len(vpnConnection.Options.TunnelOptions) >= 1 {
-		if err := d.Set("tunnel1_dpd_timeout_action", vpnConnection.Options.TunnelOptions[0].DpdTimeoutAction); err != nil {
+	// Order the tunnels by outside IP address to match the customer gateway
+	// configuration, from which tunnel1_address and tunnel2_address are set.
+	tunnelOptions := make([]*ec2.TunnelOption, len(vpnConnection.Options.TunnelOptions))
+	copy(tunnelOptions, vpnConnection.Options.TunnelOptions)
+	sort.Slice(tunnelOptions, func(i, j int) bool {
+		return aws.StringValue(tunnelOptions[i].OutsideIpAddress) < aws.StringValue(tunnelOptions[j].OutsideIpAddress)
+	})
+
+	if len(tunnelOptions) >= 1 {
+		if err := d.Set("tunnel1_dpd_timeout_action", tunnelOptions[0].DpdTimeoutAction); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel1_dpd_timeout_seconds", vpnConnection.Options.TunnelOptions[0].DpdTimeoutSeconds); err != nil {
+		if err := d.Set("tunnel1_dpd_timeout_seconds", tunnelOptions[0].DpdTimeoutSeconds); err != nil {
 			return err
 		}
 
 		ikeVersions := []string{}
-		for _, ikeVersion := range vpnConnection.Options.TunnelOptions[0].IkeVersions {
+		for _, ikeVersion := range tunnelOptions[0].IkeVersions {
 			ikeVersions = append(ikeVersions, *ikeVersion.Value)
 		}
 		if err := d.Set("tunnel1_ike_versions", ikeVersions); err != nil {
@@ -761,7 +791,7 @@ func flattenTunnelOptions(d *schema.ResourceData, vpnConnection *ec2.VpnConnecti
 		}
 
 		phase1DHGroupNumbers := []int64{}
-		for _, phase1DHGroupNumber := range vpnConnection.Options.TunnelOptions[0].Phase1DHGroupNumbers {
+		for _, phase1DHGroupNumber := range tunnelOptions[0].Phase1DHGroupNumbers {
 			phase1DHGroupNumbers = append(phase1DHGroupNumbers, *phase1DHGroupNumber.Value)
 		}
 		if err := d.Set("tunnel1_phase1_dh_group_numbers", phase1DHGroupNumbers); err != nil {
@@ -769,7 +799,7 @@ func flattenTunnelOptions(d *schema.ResourceData, vpnConnection *ec2.VpnConnecti
 		}
 
 		phase1EncAlgorithms := []string{}
-		for _, phase1EncAlgorithm := range vpnConnection.Options.TunnelOptions[0].Phase1EncryptionAlgorithms {
+		for _, phase1EncAlgorithm := range tunnelOptions[0].Phase1EncryptionAlgorithms {
 			phase1EncAlgorithms = append(phase1EncAlgorithms, *phase1EncAlgorithm.Value)
 		}
 		if err := d.Set("tunnel1_phase1_encryption_algorithms", phase1EncAlgorithms); err != nil {
@@ -777,19 +807,19 @@ func flattenTunnelOptions(d *schema.ResourceData, vpnConnection *ec2.VpnConnecti
 		}
 
 		phase1IntegrityAlgorithms := []string{}
-		for _, phase1IntegrityAlgorithm := range vpnConnection.Options.TunnelOptions[0].Phase1IntegrityAlgorithms {
+		for _, phase1IntegrityAlgorithm := range tunnelOptions[0].Phase1IntegrityAlgorithms {
 			phase1IntegrityAlgorithms = append(phase1IntegrityAlgorithms, *phase1IntegrityAlgorithm.Value)
 		}
 		if err := d.Set("tunnel1_phase1_integrity_algorithms", phase1IntegrityAlgorithms); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel1_phase1_lifetime_seconds", vpnConnection.Options.TunnelOptions[0].Phase1LifetimeSeconds); err != nil {
+		if err := d.Set("tunnel1_phase1_lifetime_seconds", tunnelOptions[0].Phase1LifetimeSeconds); err != nil {
 			return err
 		}
 
 		phase2DHGroupNumbers := []int64{}
-		for _, phase2DHGroupNumber := range vpnConnection.Options.TunnelOptions[0].Phase2DHGroupNumbers {
+		for _, phase2DHGroupNumber := range tunnelOptions[0].Phase2DHGroupNumbers {
 			phase2DHGroupNumbers = append(phase2DHGroupNumbers, *phase2DHGroupNumber.Value)
 		}
 		if err := d.Set("tunnel1_phase2_dh_group_numbers", phase2DHGroupNumbers); err != nil {
@@ -797,7 +827,7 @@ func flattenTunnelOptions(d *schema.ResourceData, vpnConnection *ec2.VpnConnecti
 		}
 
 		phase2EncAlgorithms := []string{}
-		for _, phase2EncAlgorithm := range vpnConnection.Options.TunnelOptions[0].Phase2EncryptionAlgorithms {
+		for _, phase2EncAlgorithm := range tunnelOptions[0].Phase2EncryptionAlgorithms {
 			phase2EncAlgorithms = append(phase2EncAlgorithms, *phase2EncAlgorithm.Value)
 		}
 		if err := d.Set("tunnel1_phase2_encryption_algorithms", phase2EncAlgorithms); err != nil {
@@ -805,52 +835,52 @@ func flattenTunnelOptions(d *schema.ResourceData, vpnConnection *ec2.VpnConnecti
 		}
 
 		phase2IntegrityAlgorithms := []string{}
-		for _, phase2IntegrityAlgorithm := range vpnConnection.Options.TunnelOptions[0].Phase2IntegrityAlgorithms {
+		for _, phase2IntegrityAlgorithm := range tunnelOptions[0].Phase2IntegrityAlgorithms {
 			phase2IntegrityAlgorithms = append(phase2IntegrityAlgorithms, *phase2IntegrityAlgorithm.Value)
 		}
 		if err := d.Set("tunnel1_phase2_integrity_algorithms", phase2IntegrityAlgorithms); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel1_phase2_lifetime_seconds", vpnConnection.Options.TunnelOptions[0].Phase2LifetimeSeconds); err != nil {
+		if err := d.Set("tunnel1_phase2_lifetime_seconds", tunnelOptions[0].Phase2LifetimeSeconds); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel1_rekey_fuzz_percentage", vpnConnection.Options.TunnelOptions[0].RekeyFuzzPercentage); err != nil {
+		if err := d.Set("tunnel1_rekey_fuzz_percentage", tunnelOptions[0].RekeyFuzzPercentage); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel1_rekey_margin_time_seconds", vpnConnection.Options.TunnelOptions[0].RekeyMarginTimeSeconds); err != nil {
+		if err := d.Set("tunnel1_rekey_margin_time_seconds", tunnelOptions[0].RekeyMarginTimeSeconds); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel1_replay_window_size", vpnConnection.Options.TunnelOptions[0].ReplayWindowSize); err != nil {
+		if err := d.Set("tunnel1_replay_window_size", tunnelOptions[0].ReplayWindowSize); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel1_startup_action", vpnConnection.Options.TunnelOptions[0].StartupAction); err != nil {
+		if err := d.Set("tunnel1_startup_action", tunnelOptions[0].StartupAction); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel1_inside_cidr", vpnConnection.Options.TunnelOptions[0].TunnelInsideCidr); err != nil {
+		if err := d.Set("tunnel1_inside_cidr", tunnelOptions[0].TunnelInsideCidr); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel1_inside_ipv6_cidr", vpnConnection.Options.TunnelOptions[0].TunnelInsideIpv6Cidr); err != nil {
+		if err := d.Set("tunnel1_inside_ipv6_cidr", tunnelOptions[0].TunnelInsideIpv6Cidr); err != nil {
 			return err
 		}
 	}
-	if len(vpnConnection.Options.TunnelOptions) >= 2 {
-		if err := d.Set("tunnel2_dpd_timeout_action", vpnConnection.Options.TunnelOptions[1].DpdTimeoutAction); err != nil {
+	if len(tunnelOptions) >= 2 {
+		if err := d.Set("tunnel2_dpd_timeout_action", tunnelOptions[1].DpdTimeoutAction); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel2_dpd_timeout_seconds", vpnConnection.Options.TunnelOptions[1].DpdTimeoutSeconds); err != nil {
+		if err := d.Set("tunnel2_dpd_timeout_seconds", tunnelOptions[1].DpdTimeoutSeconds); err != nil {
 			return err
 		}
 
 		ikeVersions := []string{}
-		for _, ikeVersion := range vpnConnection.Options.TunnelOptions[1].IkeVersions {
+		for _, ikeVersion := range tunnelOptions[1].IkeVersions {
 			ikeVersions = append(ikeVersions, *ikeVersion.Value)
 		}
 		if err := d.Set("tunnel2_ike_versions", ikeVersions); err != nil {
@@ -858,7 +888,7 @@ func flattenTunnelOptions(d *schema.ResourceData, vpnConnection *ec2.VpnConnecti
 		}
 
 		phase1DHGroupNumbers := []int64{}
-		for _, phase1DHGroupNumber := range vpnConnection.Options.TunnelOptions[1].Phase1DHGroupNumbers {
+		for _, phase1DHGroupNumber := range tunnelOptions[1].Phase1DHGroupNumbers {
 			phase1DHGroupNumbers = append(phase1DHGroupNumbers, *phase1DHGroupNumber.Value)
 		}
 		if err := d.Set("tunnel2_phase1_dh_group_numbers", phase1DHGroupNumbers); err != nil {
@@ -866,7 +896,7 @@ func flattenTunnelOptions(d *schema.ResourceData, vpnConnection *ec2.VpnConnecti
 		}
 
 		phase1EncAlgorithms := []string{}
-		for _, phase1EncAlgorithm := range vpnConnection.Options.TunnelOptions[1].Phase1EncryptionAlgorithms {
+		for _, phase1EncAlgorithm := range tunnelOptions[1].Phase1EncryptionAlgorithms {
 			phase1EncAlgorithms = append(phase1EncAlgorithms, *phase1EncAlgorithm.Value)
 		}
 
@@ -875,19 +905,19 @@ func flattenTunnelOptions(d *schema.ResourceData, vpnConnection *ec2.VpnConnecti
 		}
 
 		phase1IntegrityAlgorithms := []string{}
-		for _, phase1IntegrityAlgorithm := range vpnConnection.Options.TunnelOptions[1].Phase1IntegrityAlgorithms {
+		for _, phase1IntegrityAlgorithm := range tunnelOptions[1].Phase1IntegrityAlgorithms {
 			phase1IntegrityAlgorithms = append(phase1IntegrityAlgorithms, *phase1IntegrityAlgorithm.Value)
 		}
 		if err := d.Set("tunnel2_phase1_integrity_algorithms", phase1IntegrityAlgorithms); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel2_phase1_lifetime_seconds", vpnConnection.Options.TunnelOptions[1].Phase1LifetimeSeconds); err != nil {
+		if err := d.Set("tunnel2_phase1_lifetime_seconds", tunnelOptions[1].Phase1LifetimeSeconds); err != nil {
 			return err
 		}
 
 		phase2DHGroupNumbers := []int64{}
-		for _, phase2DHGroupNumber := range vpnConnection.Options.TunnelOptions[1].Phase2DHGroupNumbers {
+		for _, phase2DHGroupNumber := range tunnelOptions[1].Phase2DHGroupNumbers {
 			phase2DHGroupNumbers = append(phase2DHGroupNumbers, *phase2DHGroupNumber.Value)
 		}
 		if err := d.Set("tunnel2_phase2_dh_group_numbers", phase2DHGroupNumbers); err != nil {
@@ -895,7 +925,7 @@ func flattenTunnelOptions(d *schema.ResourceData, vpnConnection *ec2.VpnConnecti
 		}
 
 		phase2EncAlgorithms := []string{}
-		for _, phase2EncAlgorithm := range vpnConnection.Options.TunnelOptions[1].Phase2EncryptionAlgorithms {
+		for _, phase2EncAlgorithm := range tunnelOptions[1].Phase2EncryptionAlgorithms {
 			phase2EncAlgorithms = append(phase2EncAlgorithms, *phase2EncAlgorithm.Value)
 		}
 
@@ -904,38 +934,38 @@ func flattenTunnelOptions(d *schema.ResourceData, vpnConnection *ec2.VpnConnecti
 		}
 
 		phase2IntegrityAlgorithms := []string{}
-		for _, phase2IntegrityAlgorithm := range vpnConnection.Options.TunnelOptions[1].Phase2IntegrityAlgorithms {
+		for _, phase2IntegrityAlgorithm := range tunnelOptions[1].Phase2IntegrityAlgorithms {
 			phase2IntegrityAlgorithms = append(phase2IntegrityAlgorithms, *phase2IntegrityAlgorithm.Value)
 		}
 		if err := d.Set("tunnel2_phase2_integrity_algorithms", phase2IntegrityAlgorithms); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel2_phase2_lifetime_seconds", vpnConnection.Options.TunnelOptions[1].Phase2LifetimeSeconds); err != nil {
+		if err := d.Set("tunnel2_phase2_lifetime_seconds", tunnelOptions[1].Phase2LifetimeSeconds); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel2_rekey_fuzz_percentage", vpnConnection.Options.TunnelOptions[1].RekeyFuzzPercentage); err != nil {
+		if err := d.Set("tunnel2_rekey_fuzz_percentage", tunnelOptions[1].RekeyFuzzPercentage); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel2_rekey_margin_time_seconds", vpnConnection.Options.TunnelOptions[1].RekeyMarginTimeSeconds); err != nil {
+		if err := d.Set("tunnel2_rekey_margin_time_seconds", tunnelOptions[1].RekeyMarginTimeSeconds); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel2_replay_window_size", vpnConnection.Options.TunnelOptions[1].ReplayWindowSize); err != nil {
+		if err := d.Set("tunnel2_replay_window_size", tunnelOptions[1].ReplayWindowSize); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel2_startup_action", vpnConnection.Options.TunnelOptions[1].StartupAction); err != nil {
+		if err := d.Set("tunnel2_startup_action", tunnelOptions[1].StartupAction); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel2_inside_cidr", vpnConnection.Options.TunnelOptions[1].TunnelInsideCidr); err != nil {
+		if err := d.Set("tunnel2_inside_cidr", tunnelOptions[1].TunnelInsideCidr); err != nil {
 			return err
 		}
 
-		if err := d.Set("tunnel2_inside_ipv6_cidr", vpnConnection.Options.TunnelOptions[1].TunnelInsideIpv6Cidr); err != nil {
+		if err := d.Set("tunnel2_inside_ipv6_cidr", tunnelOptions[1].TunnelInsideIpv6Cidr); err != nil {
 			return err
 		}
 	}
@@ -1258,6 +1288,7 @@ func telemetryToMapList(telemetry []*ec2.VgwTelemetry) []map[string]interface{}
 	for _, t := range telemetry {
 		vgw := make(map[string]interface{})
 		vgw["accepted_route_count"] = aws.Int64Value(t.AcceptedRouteCount)
+		vgw["certificate_arn"] = aws.StringValue(t.CertificateArn)
 		vgw["outside_ip_address"] = aws.StringValue(t.OutsideIpAddress)
 		vgw["status"] = aws.StringValue(t.Status)
 		vgw["status_message"] = aws.StringValue(t.StatusMessage)
@@ -1315,8 +1346,6 @@ func modifyVpnConnectionOptions(d *schema.ResourceData, conn *ec2.EC2) error {
 func modifyVpnTunnels(d *schema.ResourceData, conn *ec2.EC2) error {
 	tun1Changed := false
 	tun2Changed := false
-	vgwTelemetryTun1Index := 0
-	vgwTelemetryTun2Index := 1
 	options := []*ec2.ModifyVpnTunnelOptionsSpecification{
 		{}, {},
 	}
@@ -1529,14 +1558,15 @@ func modifyVpnTunnels(d *schema.ResourceData, conn *ec2.EC2) error {
 		options[1].StartupAction = aws.String(d.Get("tunnel2_startup_action").(string))
 	}
 
+	// Tunnels are modified one at a time as each modification briefly takes the tunnel down.
 	if tun1Changed {
-		if err := modifyVpnTunnelOptions(conn, d.Get("vgw_telemetry").(*schema.Set), vpnConnectionID, vgwTelemetryTun1Index, options[0]); err != nil {
+		if err := modifyVpnTunnelOptions(conn, vpnConnectionID, d.Get("tunnel1_address").(string), options[0]); err != nil {
 			return err
 		}
 	}
 
 	if tun2Changed {
-		if err := modifyVpnTunnelOptions(conn, d.Get("vgw_telemetry").(*schema.Set), vpnConnectionID, vgwTelemetryTun2Index, options[1]); err != nil {
+		if err := modifyVpnTunnelOptions(conn, vpnConnectionID, d.Get("tunnel2_address").(string), options[1]); err != nil {
 			return err
 		}
 	}
@@ -1544,24 +1574,24 @@ func modifyVpnTunnels(d *schema.ResourceData, conn *ec2.EC2) error {
 	return nil
 }
 
-func modifyVpnTunnelOptions(conn *ec2.EC2, vgwTelemetry *schema.Set, vpnConnectionID string, vgwTelemetryTunIndex int, optionsTun *ec2.ModifyVpnTunnelOptionsSpecification) error {
-	if v := vgwTelemetry; v.Len() > 0 {
-		vpnTunnelOutsideIPAddress := v.List()[vgwTelemetryTunIndex].(map[string]interface{})["outside_ip_address"].(string)
+func modifyVpnTunnelOptions(conn *ec2.EC2, vpnConnectionID, vpnTunnelOutsideIPAddress string, optionsTun *ec2.ModifyVpnTunnelOptionsSpecification) error {
+	if vpnTunnelOutsideIPAddress == "" {
+		return nil
+	}
 
-		o := &ec2.ModifyVpnTunnelOptionsInput{
-			VpnConnectionId:           aws.String(vpnConnectionID),
-			VpnTunnelOutsideIpAddress: aws.String(vpnTunnelOutsideIPAddress),
-			TunnelOptions:             optionsTun,
-		}
+	o := &ec2.ModifyVpnTunnelOptionsInput{
+		VpnConnectionId:           aws.String(vpnConnectionID),
+		VpnTunnelOutsideIpAddress: aws.String(vpnTunnelOutsideIPAddress),
+		TunnelOptions:             optionsTun,
+	}
 
-		_, err := conn.ModifyVpnTunnelOptions(o)
-		if err != nil {
-			return fmt.Errorf("Error modifying vpn tunnel options: %s", err)
-		}
+	_, err := conn.ModifyVpnTunnelOptions(o)
+	if err != nil {
+		return fmt.Errorf("Error modifying vpn tunnel (%s) options: %s", vpnTunnelOutsideIPAddress, err)
+	}
 
-		if err := waitForEc2VpnConnectionAvailableWhenModifying(conn, vpnConnectionID); err != nil {
-			return fmt.Errorf("error waiting for VPN connection (%s) to become available: %s", vpnConnectionID, err)
-		}
+	if err := waitForEc2VpnConnectionAvailableWhenModifying(conn, vpnConnectionID); err != nil {
+		return fmt.Errorf("error waiting for VPN connection (%s) to become available: %s", vpnConnectionID, err)
 	}
 
 	return nil
@@ -205,6 +205,17 @@ func TestAccAWSVpnConnection_tunnelOptions(t *testing.T) {
 		startupAction:              "add",
 	}
 
+	tunnel1Updated := tunnel1
+	tunnel1Updated.dpdTimeoutAction = "restart"
+	tunnel1Updated.dpdTimeoutSeconds = 45
+	tunnel1Updated.ikeVersions = "\"ikev2\""
+	tunnel1Updated.startupAction = "start"
+
+	tunnel2Updated := tunnel2
+	tunnel2Updated.phase1LifetimeSeconds = 14400
+	tunnel2Updated.phase2LifetimeSeconds = 1800
+	tunnel2Updated.rekeyMarginTimeSeconds = 600
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:      func() { testAccPreCheck(t) },
 		IDRefreshName: resourceName,
@@ -302,6 +313,25 @@ func TestAccAWSVpnConnection_tunnelOptions(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key", "abcdefgh"),
 				),
 			},
+			// Tunnel options are modified in-place, one tunnel at a time
+			{
+				Config: testAccAwsVpnConnectionConfigTunnelOptions(rBgpAsn, "192.168.1.1/32", "192.168.1.2/32", tunnel1Updated, tunnel2Updated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsVpnConnectionExists(resourceName, &vpn),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_inside_cidr", "169.254.8.0/30"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_dpd_timeout_action", "restart"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_dpd_timeout_seconds", "45"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_ike_versions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tunnel1_ike_versions.*", "ikev2"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_startup_action", "start"),
+
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_inside_cidr", "169.254.9.0/30"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_phase1_lifetime_seconds", "14400"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_phase2_lifetime_seconds", "1800"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_rekey_margin_time_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "vgw_telemetry.#", "2"),
+				),
+			},
 			// TODO: Once #396, #3359, #5809 are fixed, an import test step should be added here
 		},
 	})
@@ -48,6 +48,7 @@ func resourceAwsWafv2RuleGroup() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
+			"custom_response_body": wafv2CustomResponseBodySchema(),
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -86,9 +87,9 @@ func resourceAwsWafv2RuleGroup() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"allow": wafv2EmptySchema(),
-									"block": wafv2EmptySchema(),
-									"count": wafv2EmptySchema(),
+									"allow": wafv2AllowConfigSchema(),
+									"block": wafv2BlockConfigSchema(),
+									"count": wafv2CountConfigSchema(),
 								},
 							},
 						},
@@ -124,6 +125,10 @@ func resourceAwsWafv2RuleGroupCreate(d *schema.ResourceData, meta interface{}) e
 		VisibilityConfig: expandWafv2VisibilityConfig(d.Get("visibility_config").([]interface{})),
 	}
 
+	if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
+		params.CustomResponseBodies = expandWafv2CustomResponseBodies(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		params.Description = aws.String(v.(string))
 	}
@@ -191,6 +196,10 @@ func resourceAwsWafv2RuleGroupRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("arn", aws.StringValue(resp.RuleGroup.ARN))
 	d.Set("lock_token", aws.StringValue(resp.LockToken))
 
+	if err := d.Set("custom_response_body", flattenWafv2CustomResponseBodies(resp.RuleGroup.CustomResponseBodies)); err != nil {
+		return fmt.Errorf("Error setting custom_response_body: %s", err)
+	}
+
 	if err := d.Set("rule", flattenWafv2Rules(resp.RuleGroup.Rules)); err != nil {
 		return fmt.Errorf("Error setting rule: %s", err)
 	}
@@ -226,6 +235,10 @@ func resourceAwsWafv2RuleGroupUpdate(d *schema.ResourceData, meta interface{}) e
 		VisibilityConfig: expandWafv2VisibilityConfig(d.Get("visibility_config").([]interface{})),
 	}
 
+	if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
+		u.CustomResponseBodies = expandWafv2CustomResponseBodies(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		u.Description = aws.String(v.(string))
 	}
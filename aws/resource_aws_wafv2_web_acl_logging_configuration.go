@@ -3,10 +3,12 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAwsWafv2WebACLLoggingConfiguration() *schema.Resource {
@@ -33,11 +35,85 @@ func resourceAwsWafv2WebACLLoggingConfiguration() *schema.Resource {
 				},
 				Description: "AWS Kinesis Firehose Delivery Stream ARNs",
 			},
+			"logging_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(wafv2.FilterBehavior_Values(), false),
+						},
+						"filter": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"behavior": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(wafv2.FilterBehavior_Values(), false),
+									},
+									"condition": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"action_condition": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"action": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(wafv2.ActionValue_Values(), false),
+															},
+														},
+													},
+												},
+												"label_name_condition": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"label_name": {
+																Type:     schema.TypeString,
+																Required: true,
+																ValidateFunc: validation.All(
+																	validation.StringLenBetween(1, 1024),
+																	validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_\-:]+$`), "must contain only alphanumeric characters, underscores, hyphens, and colons"),
+																),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"requirement": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(wafv2.FilterRequirement_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+				Description: "Filtering that specifies which web requests are kept in the logs and which are dropped",
+			},
 			"redacted_fields": {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				MaxItems:    100,
-				Elem:        wafv2FieldToMatchBaseSchema(),
+				Elem:        wafv2RedactedFieldsSchema(),
 				Description: "Parts of the request to exclude from logs",
 			},
 			"resource_arn": {
@@ -60,6 +136,10 @@ func resourceAwsWafv2WebACLLoggingConfigurationPut(d *schema.ResourceData, meta
 		ResourceArn:           aws.String(resourceArn),
 	}
 
+	if v, ok := d.GetOk("logging_filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.LoggingFilter = expandWafv2LoggingFilter(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("redacted_fields"); ok && v.(*schema.Set).Len() > 0 {
 		config.RedactedFields = expandWafv2RedactedFields(v.(*schema.Set).List())
 	} else {
@@ -104,6 +184,10 @@ func resourceAwsWafv2WebACLLoggingConfigurationRead(d *schema.ResourceData, meta
 		return fmt.Errorf("error setting log_destination_configs: %w", err)
 	}
 
+	if err := d.Set("logging_filter", flattenWafv2LoggingFilter(output.LoggingConfiguration.LoggingFilter)); err != nil {
+		return fmt.Errorf("error setting logging_filter: %w", err)
+	}
+
 	if err := d.Set("redacted_fields", flattenWafv2RedactedFields(output.LoggingConfiguration.RedactedFields)); err != nil {
 		return fmt.Errorf("error setting redacted_fields: %w", err)
 	}
@@ -126,6 +210,217 @@ func resourceAwsWafv2WebACLLoggingConfigurationDelete(d *schema.ResourceData, me
 	return nil
 }
 
+// wafv2RedactedFieldsSchema returns the subset of field to match types that
+// can be redacted from logs.
+func wafv2RedactedFieldsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"all_query_arguments": {
+				Type:       schema.TypeList,
+				Optional:   true,
+				MaxItems:   1,
+				Deprecated: "Not supported by WAFv2 API",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{},
+				},
+			},
+			"body": {
+				Type:       schema.TypeList,
+				Optional:   true,
+				MaxItems:   1,
+				Deprecated: "Not supported by WAFv2 API",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{},
+				},
+			},
+			"method":       wafv2EmptySchema(),
+			"query_string": wafv2EmptySchema(),
+			"single_header": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 40),
+								// The value is returned in lower case by the API.
+								validation.StringMatch(regexp.MustCompile(`^[a-z0-9-_]+$`), "must contain only lowercase alphanumeric characters, underscores, and hyphens"),
+							),
+						},
+					},
+				},
+			},
+			"single_query_argument": {
+				Type:       schema.TypeList,
+				Optional:   true,
+				MaxItems:   1,
+				Deprecated: "Not supported by WAFv2 API",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"uri_path": wafv2EmptySchema(),
+		},
+	}
+}
+
+func expandWafv2LoggingFilter(l []interface{}) *wafv2.LoggingFilter {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	loggingFilter := &wafv2.LoggingFilter{}
+
+	if v, ok := tfMap["default_behavior"].(string); ok && v != "" {
+		loggingFilter.DefaultBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["filter"].(*schema.Set); ok && v.Len() > 0 {
+		loggingFilter.Filters = expandWafv2LoggingFilters(v.List())
+	}
+
+	return loggingFilter
+}
+
+func expandWafv2LoggingFilters(l []interface{}) []*wafv2.Filter {
+	var filters []*wafv2.Filter
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		filter := &wafv2.Filter{}
+
+		if v, ok := tfMap["behavior"].(string); ok && v != "" {
+			filter.Behavior = aws.String(v)
+		}
+
+		if v, ok := tfMap["condition"].(*schema.Set); ok && v.Len() > 0 {
+			filter.Conditions = expandWafv2LoggingFilterConditions(v.List())
+		}
+
+		if v, ok := tfMap["requirement"].(string); ok && v != "" {
+			filter.Requirement = aws.String(v)
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters
+}
+
+func expandWafv2LoggingFilterConditions(l []interface{}) []*wafv2.Condition {
+	var conditions []*wafv2.Condition
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		condition := &wafv2.Condition{}
+
+		if v, ok := tfMap["action_condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			condition.ActionCondition = &wafv2.ActionCondition{
+				Action: aws.String(v[0].(map[string]interface{})["action"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["label_name_condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			condition.LabelNameCondition = &wafv2.LabelNameCondition{
+				LabelName: aws.String(v[0].(map[string]interface{})["label_name"].(string)),
+			}
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return conditions
+}
+
+func flattenWafv2LoggingFilter(loggingFilter *wafv2.LoggingFilter) []interface{} {
+	// Configurations written outside of Terraform may carry a default
+	// behavior without any filters, which is equivalent to no filtering.
+	if loggingFilter == nil || len(loggingFilter.Filters) == 0 {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"default_behavior": aws.StringValue(loggingFilter.DefaultBehavior),
+		"filter":           flattenWafv2LoggingFilters(loggingFilter.Filters),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenWafv2LoggingFilters(filters []*wafv2.Filter) []interface{} {
+	var tfList []interface{}
+
+	for _, filter := range filters {
+		if filter == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"behavior":    aws.StringValue(filter.Behavior),
+			"condition":   flattenWafv2LoggingFilterConditions(filter.Conditions),
+			"requirement": aws.StringValue(filter.Requirement),
+		})
+	}
+
+	return tfList
+}
+
+func flattenWafv2LoggingFilterConditions(conditions []*wafv2.Condition) []interface{} {
+	var tfList []interface{}
+
+	for _, condition := range conditions {
+		if condition == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := condition.ActionCondition; v != nil {
+			tfMap["action_condition"] = []interface{}{
+				map[string]interface{}{
+					"action": aws.StringValue(v.Action),
+				},
+			}
+		}
+
+		if v := condition.LabelNameCondition; v != nil {
+			tfMap["label_name_condition"] = []interface{}{
+				map[string]interface{}{
+					"label_name": aws.StringValue(v.LabelName),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenWafv2RedactedFields(fields []*wafv2.FieldToMatch) []map[string]interface{} {
 	redactedFields := make([]map[string]interface{}, 0, len(fields))
 	for _, field := range fields {
@@ -1120,6 +1120,84 @@ func TestAccAwsWafv2WebACL_RuleGroupReferenceStatement(t *testing.T) {
 	})
 }
 
+func TestAccAwsWafv2WebACL_CustomResponse(t *testing.T) {
+	var v wafv2.WebACL
+	webACLName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWafv2ScopeRegional(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWafv2WebACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWafv2WebACLConfig_CustomResponse(webACLName, 403, "x-blocked"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWafv2WebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "custom_response_body.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_response_body.*", map[string]string{
+						"key":          "test_body",
+						"content":      "<html><body>Access denied</body></html>",
+						"content_type": wafv2.ResponseContentTypeTextHtml,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "default_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.allow.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.allow.0.custom_request_handling.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "default_action.0.allow.0.custom_request_handling.0.insert_header.*", map[string]string{
+						"name":  "x-default-action",
+						"value": "allowed",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"name":                               "rule-block",
+						"action.#":                           "1",
+						"action.0.block.#":                   "1",
+						"action.0.block.0.custom_response.#": "1",
+						"action.0.block.0.custom_response.0.custom_response_body_key": "test_body",
+						"action.0.block.0.custom_response.0.response_code":            "403",
+						"action.0.block.0.custom_response.0.response_header.#":        "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*.action.0.block.0.custom_response.0.response_header.*", map[string]string{
+						"name":  "x-blocked",
+						"value": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"name":             "rule-count",
+						"action.#":         "1",
+						"action.0.count.#": "1",
+						"action.0.count.0.custom_request_handling.#":                 "1",
+						"action.0.count.0.custom_request_handling.0.insert_header.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*.action.0.count.0.custom_request_handling.0.insert_header.*", map[string]string{
+						"name":  "x-counted",
+						"value": "true",
+					}),
+				),
+			},
+			{
+				Config: testAccAwsWafv2WebACLConfig_CustomResponse(webACLName, 429, "x-throttled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWafv2WebACLExists(resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"name": "rule-block",
+						"action.0.block.0.custom_response.0.response_code": "429",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*.action.0.block.0.custom_response.0.response_header.*", map[string]string{
+						"name":  "x-throttled",
+						"value": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAwsWafv2WebACLImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
 func TestAccAwsWafv2WebACL_Tags(t *testing.T) {
 	var v wafv2.WebACL
 	webACLName := acctest.RandomWithPrefix("tf-acc-test")
@@ -2133,6 +2211,97 @@ resource "aws_wafv2_web_acl" "test" {
 `, name)
 }
 
+func testAccAwsWafv2WebACLConfig_CustomResponse(name string, responseCode int, responseHeaderName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  custom_response_body {
+    key          = "test_body"
+    content      = "<html><body>Access denied</body></html>"
+    content_type = "TEXT_HTML"
+  }
+
+  default_action {
+    allow {
+      custom_request_handling {
+        insert_header {
+          name  = "x-default-action"
+          value = "allowed"
+        }
+      }
+    }
+  }
+
+  rule {
+    name     = "rule-block"
+    priority = 1
+
+    action {
+      block {
+        custom_response {
+          custom_response_body_key = "test_body"
+          response_code            = %[2]d
+
+          response_header {
+            name  = %[3]q
+            value = "true"
+          }
+        }
+      }
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["US", "NL"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "rule-block-metric-name"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  rule {
+    name     = "rule-count"
+    priority = 2
+
+    action {
+      count {
+        custom_request_handling {
+          insert_header {
+            name  = "x-counted"
+            value = "true"
+          }
+        }
+      }
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["CA"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "rule-count-metric-name"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name, responseCode, responseHeaderName)
+}
+
 func testAccAwsWafv2WebACLConfig_OneTag(name, tagKey, tagValue string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_web_acl" "test" {
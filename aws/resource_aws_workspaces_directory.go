@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/waiter"
 )
@@ -104,6 +105,56 @@ func resourceAwsWorkspacesDirectory() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"tags": tagsSchema(),
+			"workspace_access_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_type_android": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(workspaces.AccessPropertyValue_Values(), false),
+						},
+						"device_type_chromeos": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(workspaces.AccessPropertyValue_Values(), false),
+						},
+						"device_type_ios": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(workspaces.AccessPropertyValue_Values(), false),
+						},
+						"device_type_linux": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(workspaces.AccessPropertyValue_Values(), false),
+						},
+						"device_type_osx": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(workspaces.AccessPropertyValue_Values(), false),
+						},
+						"device_type_web": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(workspaces.AccessPropertyValue_Values(), false),
+						},
+						"device_type_windows": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(workspaces.AccessPropertyValue_Values(), false),
+						},
+						"device_type_zeroclient": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(workspaces.AccessPropertyValue_Values(), false),
+						},
+					},
+				},
+			},
 			"workspace_creation_properties": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -189,6 +240,18 @@ func resourceAwsWorkspacesDirectoryCreate(d *schema.ResourceData, meta interface
 		log.Printf("[INFO] Modified WorkSpaces Directory (%s) self-service permissions", directoryID)
 	}
 
+	if v, ok := d.GetOk("workspace_access_properties"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) access properties", directoryID)
+		_, err := conn.ModifyWorkspaceAccessProperties(&workspaces.ModifyWorkspaceAccessPropertiesInput{
+			ResourceId:                aws.String(directoryID),
+			WorkspaceAccessProperties: expandWorkspaceAccessProperties(v.([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error setting WorkSpaces Directory (%s) access properties: %w", directoryID, err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) access properties", directoryID)
+	}
+
 	if v, ok := d.GetOk("workspace_creation_properties"); ok {
 		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) creation properties", directoryID)
 		_, err := conn.ModifyWorkspaceCreationProperties(&workspaces.ModifyWorkspaceCreationPropertiesInput{
@@ -247,6 +310,10 @@ func resourceAwsWorkspacesDirectoryRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("error setting self_service_permissions: %w", err)
 	}
 
+	if err := d.Set("workspace_access_properties", flattenWorkspaceAccessProperties(directory.WorkspaceAccessProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_access_properties: %w", err)
+	}
+
 	if err := d.Set("workspace_creation_properties", flattenWorkspaceCreationProperties(directory.WorkspaceCreationProperties)); err != nil {
 		return fmt.Errorf("error setting workspace_creation_properties: %w", err)
 	}
@@ -288,6 +355,20 @@ func resourceAwsWorkspacesDirectoryUpdate(d *schema.ResourceData, meta interface
 		log.Printf("[INFO] Modified WorkSpaces Directory (%s) self-service permissions", d.Id())
 	}
 
+	if d.HasChange("workspace_access_properties") {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) access properties", d.Id())
+		properties := d.Get("workspace_access_properties").([]interface{})
+
+		_, err := conn.ModifyWorkspaceAccessProperties(&workspaces.ModifyWorkspaceAccessPropertiesInput{
+			ResourceId:                aws.String(d.Id()),
+			WorkspaceAccessProperties: expandWorkspaceAccessProperties(properties),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating WorkSpaces Directory (%s) access properties: %w", d.Id(), err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) access properties", d.Id())
+	}
+
 	if d.HasChange("workspace_creation_properties") {
 		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) creation properties", d.Id())
 		properties := d.Get("workspace_creation_properties").([]interface{})
@@ -309,22 +390,26 @@ func resourceAwsWorkspacesDirectoryUpdate(d *schema.ResourceData, meta interface
 		added := new.Difference(old)
 		removed := old.Difference(new)
 
-		log.Printf("[DEBUG] Associating WorkSpaces Directory (%s) with IP Groups %s", d.Id(), added.GoString())
-		_, err := conn.AssociateIpGroups(&workspaces.AssociateIpGroupsInput{
-			DirectoryId: aws.String(d.Id()),
-			GroupIds:    expandStringSet(added),
-		})
-		if err != nil {
-			return fmt.Errorf("error asassociating WorkSpaces Directory (%s) IP Groups: %w", d.Id(), err)
+		if added.Len() > 0 {
+			log.Printf("[DEBUG] Associating WorkSpaces Directory (%s) with IP Groups %s", d.Id(), added.GoString())
+			_, err := conn.AssociateIpGroups(&workspaces.AssociateIpGroupsInput{
+				DirectoryId: aws.String(d.Id()),
+				GroupIds:    expandStringSet(added),
+			})
+			if err != nil {
+				return fmt.Errorf("error asassociating WorkSpaces Directory (%s) IP Groups: %w", d.Id(), err)
+			}
 		}
 
-		log.Printf("[DEBUG] Disassociating WorkSpaces Directory (%s) with IP Groups %s", d.Id(), removed.GoString())
-		_, err = conn.DisassociateIpGroups(&workspaces.DisassociateIpGroupsInput{
-			DirectoryId: aws.String(d.Id()),
-			GroupIds:    expandStringSet(removed),
-		})
-		if err != nil {
-			return fmt.Errorf("error disasassociating WorkSpaces Directory (%s) IP Groups: %w", d.Id(), err)
+		if removed.Len() > 0 {
+			log.Printf("[DEBUG] Disassociating WorkSpaces Directory (%s) with IP Groups %s", d.Id(), removed.GoString())
+			_, err := conn.DisassociateIpGroups(&workspaces.DisassociateIpGroupsInput{
+				DirectoryId: aws.String(d.Id()),
+				GroupIds:    expandStringSet(removed),
+			})
+			if err != nil {
+				return fmt.Errorf("error disasassociating WorkSpaces Directory (%s) IP Groups: %w", d.Id(), err)
+			}
 		}
 
 		log.Printf("[INFO] Updated WorkSpaces Directory (%s) IP Groups", d.Id())
@@ -412,6 +497,50 @@ func expandSelfServicePermissions(permissions []interface{}) *workspaces.Selfser
 	return result
 }
 
+func expandWorkspaceAccessProperties(properties []interface{}) *workspaces.WorkspaceAccessProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	result := &workspaces.WorkspaceAccessProperties{}
+
+	p := properties[0].(map[string]interface{})
+
+	if p["device_type_android"].(string) != "" {
+		result.DeviceTypeAndroid = aws.String(p["device_type_android"].(string))
+	}
+
+	if p["device_type_chromeos"].(string) != "" {
+		result.DeviceTypeChromeOs = aws.String(p["device_type_chromeos"].(string))
+	}
+
+	if p["device_type_ios"].(string) != "" {
+		result.DeviceTypeIos = aws.String(p["device_type_ios"].(string))
+	}
+
+	if p["device_type_linux"].(string) != "" {
+		result.DeviceTypeLinux = aws.String(p["device_type_linux"].(string))
+	}
+
+	if p["device_type_osx"].(string) != "" {
+		result.DeviceTypeOsx = aws.String(p["device_type_osx"].(string))
+	}
+
+	if p["device_type_web"].(string) != "" {
+		result.DeviceTypeWeb = aws.String(p["device_type_web"].(string))
+	}
+
+	if p["device_type_windows"].(string) != "" {
+		result.DeviceTypeWindows = aws.String(p["device_type_windows"].(string))
+	}
+
+	if p["device_type_zeroclient"].(string) != "" {
+		result.DeviceTypeZeroClient = aws.String(p["device_type_zeroclient"].(string))
+	}
+
+	return result
+}
+
 func expandWorkspaceCreationProperties(properties []interface{}) *workspaces.WorkspaceCreationProperties {
 	if len(properties) == 0 || properties[0] == nil {
 		return nil
@@ -491,6 +620,25 @@ func flattenSelfServicePermissions(permissions *workspaces.SelfservicePermission
 	return []interface{}{result}
 }
 
+func flattenWorkspaceAccessProperties(properties *workspaces.WorkspaceAccessProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"device_type_android":    aws.StringValue(properties.DeviceTypeAndroid),
+			"device_type_chromeos":   aws.StringValue(properties.DeviceTypeChromeOs),
+			"device_type_ios":        aws.StringValue(properties.DeviceTypeIos),
+			"device_type_linux":      aws.StringValue(properties.DeviceTypeLinux),
+			"device_type_osx":        aws.StringValue(properties.DeviceTypeOsx),
+			"device_type_web":        aws.StringValue(properties.DeviceTypeWeb),
+			"device_type_windows":    aws.StringValue(properties.DeviceTypeWindows),
+			"device_type_zeroclient": aws.StringValue(properties.DeviceTypeZeroClient),
+		},
+	}
+}
+
 func flattenWorkspaceCreationProperties(properties *workspaces.DefaultWorkspaceCreationProperties) []interface{} {
 	if properties == nil {
 		return []interface{}{}
@@ -250,6 +250,46 @@ func TestAccAwsWorkspacesDirectory_selfServicePermissions(t *testing.T) {
 	})
 }
 
+func TestAccAwsWorkspacesDirectory_workspaceAccessProperties(t *testing.T) {
+	var v workspaces.WorkspaceDirectory
+	rName := acctest.RandString(8)
+
+	resourceName := "aws_workspaces_directory.main"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWorkspacesDirectory(t)
+			testAccPreCheckAWSDirectoryServiceSimpleDirectory(t)
+			testAccPreCheckHasIAMRole(t, "workspaces_DefaultRole")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspacesDirectoryConfig_workspaceAccessProperties(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAwsWorkspacesDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_android", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_chromeos", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_ios", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_linux", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_osx", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_web", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_windows", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_access_properties.0.device_type_zeroclient", "DENY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAwsWorkspacesDirectory_workspaceCreationProperties(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
 	rName := acctest.RandString(8)
@@ -452,6 +492,115 @@ func TestFlattenSelfServicePermissions(t *testing.T) {
 	}
 }
 
+func TestExpandWorkspaceAccessProperties(t *testing.T) {
+	cases := []struct {
+		input    []interface{}
+		expected *workspaces.WorkspaceAccessProperties
+	}{
+		// Empty
+		{
+			input:    []interface{}{},
+			expected: nil,
+		},
+		// Full
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"device_type_android":    "ALLOW",
+					"device_type_chromeos":   "ALLOW",
+					"device_type_ios":        "ALLOW",
+					"device_type_linux":      "DENY",
+					"device_type_osx":        "ALLOW",
+					"device_type_web":        "DENY",
+					"device_type_windows":    "DENY",
+					"device_type_zeroclient": "DENY",
+				},
+			},
+			expected: &workspaces.WorkspaceAccessProperties{
+				DeviceTypeAndroid:    aws.String("ALLOW"),
+				DeviceTypeChromeOs:   aws.String("ALLOW"),
+				DeviceTypeIos:        aws.String("ALLOW"),
+				DeviceTypeLinux:      aws.String("DENY"),
+				DeviceTypeOsx:        aws.String("ALLOW"),
+				DeviceTypeWeb:        aws.String("DENY"),
+				DeviceTypeWindows:    aws.String("DENY"),
+				DeviceTypeZeroClient: aws.String("DENY"),
+			},
+		},
+		// Partial
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"device_type_android":    "ALLOW",
+					"device_type_chromeos":   "",
+					"device_type_ios":        "",
+					"device_type_linux":      "",
+					"device_type_osx":        "",
+					"device_type_web":        "DENY",
+					"device_type_windows":    "",
+					"device_type_zeroclient": "",
+				},
+			},
+			expected: &workspaces.WorkspaceAccessProperties{
+				DeviceTypeAndroid: aws.String("ALLOW"),
+				DeviceTypeWeb:     aws.String("DENY"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := expandWorkspaceAccessProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
+func TestFlattenWorkspaceAccessProperties(t *testing.T) {
+	cases := []struct {
+		input    *workspaces.WorkspaceAccessProperties
+		expected []interface{}
+	}{
+		// Empty
+		{
+			input:    nil,
+			expected: []interface{}{},
+		},
+		// Full
+		{
+			input: &workspaces.WorkspaceAccessProperties{
+				DeviceTypeAndroid:    aws.String("ALLOW"),
+				DeviceTypeChromeOs:   aws.String("ALLOW"),
+				DeviceTypeIos:        aws.String("ALLOW"),
+				DeviceTypeLinux:      aws.String("DENY"),
+				DeviceTypeOsx:        aws.String("ALLOW"),
+				DeviceTypeWeb:        aws.String("DENY"),
+				DeviceTypeWindows:    aws.String("DENY"),
+				DeviceTypeZeroClient: aws.String("DENY"),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"device_type_android":    "ALLOW",
+					"device_type_chromeos":   "ALLOW",
+					"device_type_ios":        "ALLOW",
+					"device_type_linux":      "DENY",
+					"device_type_osx":        "ALLOW",
+					"device_type_web":        "DENY",
+					"device_type_windows":    "DENY",
+					"device_type_zeroclient": "DENY",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := flattenWorkspaceAccessProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
 func TestExpandWorkspaceCreationProperties(t *testing.T) {
 	cases := []struct {
 		input    []interface{}
@@ -789,6 +938,30 @@ resource "aws_workspaces_directory" "main" {
 `, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
+func testAccWorkspacesDirectoryConfig_workspaceAccessProperties(rName string) string {
+	return composeConfig(
+		testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName), `
+resource "aws_workspaces_directory" "main" {
+  directory_id = aws_directory_service_directory.main.id
+
+  workspace_access_properties {
+    device_type_android    = "ALLOW"
+    device_type_chromeos   = "ALLOW"
+    device_type_ios        = "ALLOW"
+    device_type_linux      = "DENY"
+    device_type_osx        = "ALLOW"
+    device_type_web        = "DENY"
+    device_type_windows    = "DENY"
+    device_type_zeroclient = "DENY"
+  }
+
+  tags = {
+    Name = "tf-testacc-workspaces-directory-%[1]s"
+  }
+}
+`)
+}
+
 func testAccWorkspacesDirectoryConfig_workspaceCreationProperties(rName string) string {
 	return composeConfig(
 		testAccAwsWorkspacesDirectoryConfig_Prerequisites(rName),
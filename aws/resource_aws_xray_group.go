@@ -35,6 +35,25 @@ func resourceAwsXrayGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"insights_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"insights_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"notifications_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -48,6 +67,10 @@ func resourceAwsXrayGroupCreate(d *schema.ResourceData, meta interface{}) error
 		Tags:             keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().XrayTags(),
 	}
 
+	if v, ok := d.GetOk("insights_configuration"); ok {
+		input.InsightsConfiguration = expandXrayInsightsConfig(v.([]interface{}))
+	}
+
 	out, err := conn.CreateGroup(input)
 	if err != nil {
 		return fmt.Errorf("error creating XRay Group: %w", err)
@@ -82,6 +105,10 @@ func resourceAwsXrayGroupRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("group_name", group.Group.GroupName)
 	d.Set("filter_expression", group.Group.FilterExpression)
 
+	if err := d.Set("insights_configuration", flattenXrayInsightsConfig(group.Group.InsightsConfiguration)); err != nil {
+		return fmt.Errorf("error setting insights_configuration: %w", err)
+	}
+
 	tags, err := keyvaluetags.XrayListTags(conn, arn)
 	if err != nil {
 		return fmt.Errorf("error listing tags for Xray Group (%q): %s", d.Id(), err)
@@ -97,12 +124,16 @@ func resourceAwsXrayGroupRead(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsXrayGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).xrayconn
 
-	if d.HasChange("filter_expression") {
+	if d.HasChanges("filter_expression", "insights_configuration") {
 		input := &xray.UpdateGroupInput{
 			GroupARN:         aws.String(d.Id()),
 			FilterExpression: aws.String(d.Get("filter_expression").(string)),
 		}
 
+		if v, ok := d.GetOk("insights_configuration"); ok {
+			input.InsightsConfiguration = expandXrayInsightsConfig(v.([]interface{}))
+		}
+
 		_, err := conn.UpdateGroup(input)
 		if err != nil {
 			return fmt.Errorf("error updating XRay Group (%s): %w", d.Id(), err)
@@ -134,3 +165,37 @@ func resourceAwsXrayGroupDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+func expandXrayInsightsConfig(l []interface{}) *xray.InsightsConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	data := l[0].(map[string]interface{})
+	config := xray.InsightsConfiguration{}
+
+	if v, ok := data["insights_enabled"]; ok {
+		config.InsightsEnabled = aws.Bool(v.(bool))
+	}
+	if v, ok := data["notifications_enabled"]; ok {
+		config.NotificationsEnabled = aws.Bool(v.(bool))
+	}
+
+	return &config
+}
+
+func flattenXrayInsightsConfig(config *xray.InsightsConfiguration) []interface{} {
+	if config == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if config.InsightsEnabled != nil {
+		m["insights_enabled"] = aws.BoolValue(config.InsightsEnabled)
+	}
+	if config.NotificationsEnabled != nil {
+		m["notifications_enabled"] = aws.BoolValue(config.NotificationsEnabled)
+	}
+
+	return []interface{}{m}
+}
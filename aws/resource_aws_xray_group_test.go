@@ -29,6 +29,7 @@ func TestAccAWSXrayGroup_basic(t *testing.T) {
 					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "xray", regexp.MustCompile(`group/.+`)),
 					resource.TestCheckResourceAttr(resourceName, "group_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "filter_expression", "responsetime > 5"),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.#", "1"), // Computed.
 				),
 			},
 			{
@@ -49,6 +50,43 @@ func TestAccAWSXrayGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSXrayGroup_insights(t *testing.T) {
+	var Group xray.Group
+	resourceName := "aws_xray_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSXrayGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSXrayGroupInsightsConfig(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXrayGroupExists(resourceName, &Group),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.0.insights_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.0.notifications_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSXrayGroupInsightsConfig(rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXrayGroupExists(resourceName, &Group),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.0.insights_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "insights_configuration.0.notifications_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSXrayGroup_tags(t *testing.T) {
 	var Group xray.Group
 	resourceName := "aws_xray_group.test"
@@ -181,6 +219,20 @@ resource "aws_xray_group" "test" {
 `, rName, expression)
 }
 
+func testAccAWSXrayGroupInsightsConfig(rName string, insightsEnabled, notificationsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_xray_group" "test" {
+  group_name        = %[1]q
+  filter_expression = "responsetime > 5"
+
+  insights_configuration {
+    insights_enabled      = %[2]t
+    notifications_enabled = %[3]t
+  }
+}
+`, rName, insightsEnabled, notificationsEnabled)
+}
+
 func testAccAWSXrayGroupBasicConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_xray_group" "test" {
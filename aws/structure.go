@@ -2534,10 +2534,30 @@ func expandCognitoUserPoolLambdaConfig(config map[string]interface{}) *cognitoid
 		configs.CustomMessage = aws.String(v.(string))
 	}
 
+	if v, ok := config["custom_email_sender"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		configs.CustomEmailSender = &cognitoidentityprovider.CustomEmailLambdaVersionConfigType{
+			LambdaArn:     aws.String(m["lambda_arn"].(string)),
+			LambdaVersion: aws.String(m["lambda_version"].(string)),
+		}
+	}
+
+	if v, ok := config["custom_sms_sender"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		configs.CustomSMSSender = &cognitoidentityprovider.CustomSMSLambdaVersionConfigType{
+			LambdaArn:     aws.String(m["lambda_arn"].(string)),
+			LambdaVersion: aws.String(m["lambda_version"].(string)),
+		}
+	}
+
 	if v, ok := config["define_auth_challenge"]; ok && v.(string) != "" {
 		configs.DefineAuthChallenge = aws.String(v.(string))
 	}
 
+	if v, ok := config["kms_key_id"]; ok && v.(string) != "" {
+		configs.KMSKeyID = aws.String(v.(string))
+	}
+
 	if v, ok := config["post_authentication"]; ok && v.(string) != "" {
 		configs.PostAuthentication = aws.String(v.(string))
 	}
@@ -2584,10 +2604,32 @@ func flattenCognitoUserPoolLambdaConfig(s *cognitoidentityprovider.LambdaConfigT
 		m["custom_message"] = *s.CustomMessage
 	}
 
+	if s.CustomEmailSender != nil {
+		m["custom_email_sender"] = []interface{}{
+			map[string]interface{}{
+				"lambda_arn":     aws.StringValue(s.CustomEmailSender.LambdaArn),
+				"lambda_version": aws.StringValue(s.CustomEmailSender.LambdaVersion),
+			},
+		}
+	}
+
+	if s.CustomSMSSender != nil {
+		m["custom_sms_sender"] = []interface{}{
+			map[string]interface{}{
+				"lambda_arn":     aws.StringValue(s.CustomSMSSender.LambdaArn),
+				"lambda_version": aws.StringValue(s.CustomSMSSender.LambdaVersion),
+			},
+		}
+	}
+
 	if s.DefineAuthChallenge != nil {
 		m["define_auth_challenge"] = *s.DefineAuthChallenge
 	}
 
+	if s.KMSKeyID != nil {
+		m["kms_key_id"] = *s.KMSKeyID
+	}
+
 	if s.PostAuthentication != nil {
 		m["post_authentication"] = *s.PostAuthentication
 	}
@@ -3765,7 +3807,7 @@ func flattenMqLogs(logs *mq.LogsSummary) []interface{} {
 	return []interface{}{m}
 }
 
-func expandMqLogs(l []interface{}) *mq.Logs {
+func expandMqLogs(engineType string, l []interface{}) *mq.Logs {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
@@ -3773,10 +3815,14 @@ func expandMqLogs(l []interface{}) *mq.Logs {
 	m := l[0].(map[string]interface{})
 
 	logs := &mq.Logs{
-		Audit:   aws.Bool(m["audit"].(bool)),
 		General: aws.Bool(m["general"].(bool)),
 	}
 
+	// Audit logs are not supported for RabbitMQ brokers
+	if !strings.EqualFold(engineType, mq.EngineTypeRabbitmq) {
+		logs.Audit = aws.Bool(m["audit"].(bool))
+	}
+
 	return logs
 }
 
@@ -4948,9 +4994,65 @@ func expandAppmeshVirtualNodeSpec(vSpec []interface{}) *appmesh.VirtualNodeSpec
 						certificate.File = file
 					}
 
+					if vSds, ok := mCertificate["sds"].([]interface{}); ok && len(vSds) > 0 && vSds[0] != nil {
+						sds := &appmesh.ListenerTlsSdsCertificate{}
+
+						mSds := vSds[0].(map[string]interface{})
+
+						if vSecretName, ok := mSds["secret_name"].(string); ok && vSecretName != "" {
+							sds.SecretName = aws.String(vSecretName)
+						}
+
+						certificate.Sds = sds
+					}
+
 					tls.Certificate = certificate
 				}
 
+				if vValidation, ok := mTls["validation"].([]interface{}); ok && len(vValidation) > 0 && vValidation[0] != nil {
+					validation := &appmesh.ListenerTlsValidationContext{}
+
+					mValidation := vValidation[0].(map[string]interface{})
+
+					if vSubjectAlternativeNames, ok := mValidation["subject_alternative_names"].([]interface{}); ok {
+						validation.SubjectAlternativeNames = expandAppmeshSubjectAlternativeNames(vSubjectAlternativeNames)
+					}
+
+					if vTrust, ok := mValidation["trust"].([]interface{}); ok && len(vTrust) > 0 && vTrust[0] != nil {
+						trust := &appmesh.ListenerTlsValidationContextTrust{}
+
+						mTrust := vTrust[0].(map[string]interface{})
+
+						if vFile, ok := mTrust["file"].([]interface{}); ok && len(vFile) > 0 && vFile[0] != nil {
+							file := &appmesh.TlsValidationContextFileTrust{}
+
+							mFile := vFile[0].(map[string]interface{})
+
+							if vCertificateChain, ok := mFile["certificate_chain"].(string); ok && vCertificateChain != "" {
+								file.CertificateChain = aws.String(vCertificateChain)
+							}
+
+							trust.File = file
+						}
+
+						if vSds, ok := mTrust["sds"].([]interface{}); ok && len(vSds) > 0 && vSds[0] != nil {
+							sds := &appmesh.TlsValidationContextSdsTrust{}
+
+							mSds := vSds[0].(map[string]interface{})
+
+							if vSecretName, ok := mSds["secret_name"].(string); ok && vSecretName != "" {
+								sds.SecretName = aws.String(vSecretName)
+							}
+
+							trust.Sds = sds
+						}
+
+						validation.Trust = trust
+					}
+
+					tls.Validation = validation
+				}
+
 				listener.Tls = tls
 			}
 
@@ -5180,9 +5282,47 @@ func flattenAppmeshVirtualNodeSpec(spec *appmesh.VirtualNodeSpec) []interface{}
 					mCertificate["file"] = []interface{}{mFile}
 				}
 
+				if sds := certificate.Sds; sds != nil {
+					mSds := map[string]interface{}{
+						"secret_name": aws.StringValue(sds.SecretName),
+					}
+
+					mCertificate["sds"] = []interface{}{mSds}
+				}
+
 				mTls["certificate"] = []interface{}{mCertificate}
 			}
 
+			if validation := tls.Validation; validation != nil {
+				mValidation := map[string]interface{}{
+					"subject_alternative_names": flattenAppmeshSubjectAlternativeNames(validation.SubjectAlternativeNames),
+				}
+
+				if trust := validation.Trust; trust != nil {
+					mTrust := map[string]interface{}{}
+
+					if file := trust.File; file != nil {
+						mFile := map[string]interface{}{
+							"certificate_chain": aws.StringValue(file.CertificateChain),
+						}
+
+						mTrust["file"] = []interface{}{mFile}
+					}
+
+					if sds := trust.Sds; sds != nil {
+						mSds := map[string]interface{}{
+							"secret_name": aws.StringValue(sds.SecretName),
+						}
+
+						mTrust["sds"] = []interface{}{mSds}
+					}
+
+					mValidation["trust"] = []interface{}{mTrust}
+				}
+
+				mTls["validation"] = []interface{}{mValidation}
+			}
+
 			mListener["tls"] = []interface{}{mTls}
 		}
 
@@ -5264,11 +5404,50 @@ func expandAppmeshClientPolicy(vClientPolicy []interface{}) *appmesh.ClientPolic
 			tls.Ports = expandInt64Set(vPorts)
 		}
 
+		if vCertificate, ok := mTls["certificate"].([]interface{}); ok && len(vCertificate) > 0 && vCertificate[0] != nil {
+			certificate := &appmesh.ClientTlsCertificate{}
+
+			mCertificate := vCertificate[0].(map[string]interface{})
+
+			if vFile, ok := mCertificate["file"].([]interface{}); ok && len(vFile) > 0 && vFile[0] != nil {
+				file := &appmesh.ListenerTlsFileCertificate{}
+
+				mFile := vFile[0].(map[string]interface{})
+
+				if vCertificateChain, ok := mFile["certificate_chain"].(string); ok && vCertificateChain != "" {
+					file.CertificateChain = aws.String(vCertificateChain)
+				}
+				if vPrivateKey, ok := mFile["private_key"].(string); ok && vPrivateKey != "" {
+					file.PrivateKey = aws.String(vPrivateKey)
+				}
+
+				certificate.File = file
+			}
+
+			if vSds, ok := mCertificate["sds"].([]interface{}); ok && len(vSds) > 0 && vSds[0] != nil {
+				sds := &appmesh.ListenerTlsSdsCertificate{}
+
+				mSds := vSds[0].(map[string]interface{})
+
+				if vSecretName, ok := mSds["secret_name"].(string); ok && vSecretName != "" {
+					sds.SecretName = aws.String(vSecretName)
+				}
+
+				certificate.Sds = sds
+			}
+
+			tls.Certificate = certificate
+		}
+
 		if vValidation, ok := mTls["validation"].([]interface{}); ok && len(vValidation) > 0 && vValidation[0] != nil {
 			validation := &appmesh.TlsValidationContext{}
 
 			mValidation := vValidation[0].(map[string]interface{})
 
+			if vSubjectAlternativeNames, ok := mValidation["subject_alternative_names"].([]interface{}); ok {
+				validation.SubjectAlternativeNames = expandAppmeshSubjectAlternativeNames(vSubjectAlternativeNames)
+			}
+
 			if vTrust, ok := mValidation["trust"].([]interface{}); ok && len(vTrust) > 0 && vTrust[0] != nil {
 				trust := &appmesh.TlsValidationContextTrust{}
 
@@ -5298,35 +5477,17 @@ func expandAppmeshClientPolicy(vClientPolicy []interface{}) *appmesh.ClientPolic
 					trust.File = file
 				}
 
-				// if vSds, ok := mTrust["sds"].([]interface{}); ok && len(vSds) > 0 && vSds[0] != nil {
-				// 	sds := &appmesh.TlsValidationContextSdsTrust{}
-
-				// 	mSds := vSds[0].(map[string]interface{})
-
-				// 	if vSecretName, ok := mSds["secret_name"].(string); ok && vSecretName != "" {
-				// 		sds.SecretName = aws.String(vSecretName)
-				// 	}
-
-				// 	if vSource, ok := mSds["source"].([]interface{}); ok && len(vSource) > 0 && vSource[0] != nil {
-				// 		source := &appmesh.SdsSource{}
+				if vSds, ok := mTrust["sds"].([]interface{}); ok && len(vSds) > 0 && vSds[0] != nil {
+					sds := &appmesh.TlsValidationContextSdsTrust{}
 
-				// 		mSource := vSource[0].(map[string]interface{})
+					mSds := vSds[0].(map[string]interface{})
 
-				// 		if vUnixDomainSocket, ok := mSource["unix_domain_socket"].([]interface{}); ok && len(vUnixDomainSocket) > 0 && vUnixDomainSocket[0] != nil {
-				// 			unixDomainSocket := &appmesh.SdsUnixDomainSocketSource{}
-
-				// 			mUnixDomainSocket := vUnixDomainSocket[0].(map[string]interface{})
-
-				// 			if vPath, ok := mUnixDomainSocket["path"].(string); ok && vPath != "" {
-				// 				unixDomainSocket.Path = aws.String(vPath)
-				// 			}
-
-				// 			source.UnixDomainSocket = unixDomainSocket
-				// 		}
-				// 	}
+					if vSecretName, ok := mSds["secret_name"].(string); ok && vSecretName != "" {
+						sds.SecretName = aws.String(vSecretName)
+					}
 
-				// 	trust.Sds = sds
-				// }
+					trust.Sds = sds
+				}
 
 				validation.Trust = trust
 			}
@@ -5353,8 +5514,33 @@ func flattenAppmeshClientPolicy(clientPolicy *appmesh.ClientPolicy) []interface{
 			"ports":   flattenInt64Set(tls.Ports),
 		}
 
+		if certificate := tls.Certificate; certificate != nil {
+			mCertificate := map[string]interface{}{}
+
+			if file := certificate.File; file != nil {
+				mFile := map[string]interface{}{
+					"certificate_chain": aws.StringValue(file.CertificateChain),
+					"private_key":       aws.StringValue(file.PrivateKey),
+				}
+
+				mCertificate["file"] = []interface{}{mFile}
+			}
+
+			if sds := certificate.Sds; sds != nil {
+				mSds := map[string]interface{}{
+					"secret_name": aws.StringValue(sds.SecretName),
+				}
+
+				mCertificate["sds"] = []interface{}{mSds}
+			}
+
+			mTls["certificate"] = []interface{}{mCertificate}
+		}
+
 		if validation := tls.Validation; validation != nil {
-			mValidation := map[string]interface{}{}
+			mValidation := map[string]interface{}{
+				"subject_alternative_names": flattenAppmeshSubjectAlternativeNames(validation.SubjectAlternativeNames),
+			}
 
 			if trust := validation.Trust; trust != nil {
 				mTrust := map[string]interface{}{}
@@ -5375,27 +5561,13 @@ func flattenAppmeshClientPolicy(clientPolicy *appmesh.ClientPolicy) []interface{
 					mTrust["file"] = []interface{}{mFile}
 				}
 
-				// if sds := trust.Sds; sds != nil {
-				// 	mSds := map[string]interface{}{
-				// 		"secret_name": aws.StringValue(sds.SecretName),
-				// 	}
-
-				// 	if source := sds.Source; source != nil {
-				// 		mSource := map[string]interface{}{}
-
-				// 		if unixDomainSocket := source.UnixDomainSocket; unixDomainSocket != nil {
-				// 			mUnixDomainSocket := map[string]interface{}{
-				// 				"path": aws.StringValue(unixDomainSocket.Path),
-				// 			}
-
-				// 			mSource["unix_domain_socket"] = []interface{}{mUnixDomainSocket}
-				// 		}
-
-				// 		mSds["source"] = []interface{}{mSource}
-				// 	}
+				if sds := trust.Sds; sds != nil {
+					mSds := map[string]interface{}{
+						"secret_name": aws.StringValue(sds.SecretName),
+					}
 
-				// 	mTrust["sds"] = []interface{}{mSds}
-				// }
+					mTrust["sds"] = []interface{}{mSds}
+				}
 
 				mValidation["trust"] = []interface{}{mTrust}
 			}
@@ -5409,6 +5581,48 @@ func flattenAppmeshClientPolicy(clientPolicy *appmesh.ClientPolicy) []interface{
 	return []interface{}{mClientPolicy}
 }
 
+func expandAppmeshSubjectAlternativeNames(vSubjectAlternativeNames []interface{}) *appmesh.SubjectAlternativeNames {
+	if len(vSubjectAlternativeNames) == 0 || vSubjectAlternativeNames[0] == nil {
+		return nil
+	}
+
+	subjectAlternativeNames := &appmesh.SubjectAlternativeNames{}
+
+	mSubjectAlternativeNames := vSubjectAlternativeNames[0].(map[string]interface{})
+
+	if vMatch, ok := mSubjectAlternativeNames["match"].([]interface{}); ok && len(vMatch) > 0 && vMatch[0] != nil {
+		match := &appmesh.SubjectAlternativeNameMatchers{}
+
+		mMatch := vMatch[0].(map[string]interface{})
+
+		if vExact, ok := mMatch["exact"].(*schema.Set); ok && vExact.Len() > 0 {
+			match.Exact = expandStringSet(vExact)
+		}
+
+		subjectAlternativeNames.Match = match
+	}
+
+	return subjectAlternativeNames
+}
+
+func flattenAppmeshSubjectAlternativeNames(subjectAlternativeNames *appmesh.SubjectAlternativeNames) []interface{} {
+	if subjectAlternativeNames == nil {
+		return []interface{}{}
+	}
+
+	mSubjectAlternativeNames := map[string]interface{}{}
+
+	if match := subjectAlternativeNames.Match; match != nil {
+		mMatch := map[string]interface{}{
+			"exact": flattenStringSet(match.Exact),
+		}
+
+		mSubjectAlternativeNames["match"] = []interface{}{mMatch}
+	}
+
+	return []interface{}{mSubjectAlternativeNames}
+}
+
 func expandAppmeshVirtualServiceSpec(vSpec []interface{}) *appmesh.VirtualServiceSpec {
 	spec := &appmesh.VirtualServiceSpec{}
 
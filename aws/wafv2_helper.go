@@ -21,6 +21,142 @@ func wafv2EmptySchema() *schema.Schema {
 	}
 }
 
+func wafv2AllowConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"custom_request_handling": wafv2CustomRequestHandlingSchema(),
+			},
+		},
+	}
+}
+
+func wafv2BlockConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"custom_response": wafv2CustomResponseSchema(),
+			},
+		},
+	}
+}
+
+func wafv2CountConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"custom_request_handling": wafv2CustomRequestHandlingSchema(),
+			},
+		},
+	}
+}
+
+func wafv2CustomRequestHandlingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"insert_header": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem:     wafv2CustomHTTPHeaderSchema(),
+				},
+			},
+		},
+	}
+}
+
+func wafv2CustomResponseSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"custom_response_body_key": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 128),
+						validation.StringMatch(regexp.MustCompile(`^[\w\-]+$`), "must contain only alphanumeric, hyphen, and underscore characters"),
+					),
+				},
+				"response_code": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(200, 600),
+				},
+				"response_header": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     wafv2CustomHTTPHeaderSchema(),
+				},
+			},
+		},
+	}
+}
+
+func wafv2CustomResponseBodySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 128),
+						validation.StringMatch(regexp.MustCompile(`^[\w\-]+$`), "must contain only alphanumeric, hyphen, and underscore characters"),
+					),
+				},
+				"content": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 10240),
+				},
+				"content_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(wafv2.ResponseContentType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func wafv2CustomHTTPHeaderSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9._$-]+$`), "must contain only alphanumeric, hyphen, underscore, dot and $ characters"),
+				),
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
 func wafv2RootStatementSchema(level int) *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -445,20 +581,145 @@ func expandWafv2RuleAction(l []interface{}) *wafv2.RuleAction {
 	action := &wafv2.RuleAction{}
 
 	if v, ok := m["allow"]; ok && len(v.([]interface{})) > 0 {
-		action.Allow = &wafv2.AllowAction{}
+		action.Allow = expandWafv2AllowAction(v.([]interface{}))
 	}
 
 	if v, ok := m["block"]; ok && len(v.([]interface{})) > 0 {
-		action.Block = &wafv2.BlockAction{}
+		action.Block = expandWafv2BlockAction(v.([]interface{}))
 	}
 
 	if v, ok := m["count"]; ok && len(v.([]interface{})) > 0 {
-		action.Count = &wafv2.CountAction{}
+		action.Count = expandWafv2CountAction(v.([]interface{}))
+	}
+
+	return action
+}
+
+func expandWafv2AllowAction(l []interface{}) *wafv2.AllowAction {
+	action := &wafv2.AllowAction{}
+
+	if len(l) == 0 || l[0] == nil {
+		return action
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["custom_request_handling"].([]interface{}); ok && len(v) > 0 {
+		action.CustomRequestHandling = expandWafv2CustomRequestHandling(v)
 	}
 
 	return action
 }
 
+func expandWafv2BlockAction(l []interface{}) *wafv2.BlockAction {
+	action := &wafv2.BlockAction{}
+
+	if len(l) == 0 || l[0] == nil {
+		return action
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["custom_response"].([]interface{}); ok && len(v) > 0 {
+		action.CustomResponse = expandWafv2CustomResponse(v)
+	}
+
+	return action
+}
+
+func expandWafv2CountAction(l []interface{}) *wafv2.CountAction {
+	action := &wafv2.CountAction{}
+
+	if len(l) == 0 || l[0] == nil {
+		return action
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["custom_request_handling"].([]interface{}); ok && len(v) > 0 {
+		action.CustomRequestHandling = expandWafv2CustomRequestHandling(v)
+	}
+
+	return action
+}
+
+func expandWafv2CustomRequestHandling(l []interface{}) *wafv2.CustomRequestHandling {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &wafv2.CustomRequestHandling{
+		InsertHeaders: expandWafv2CustomHTTPHeaders(m["insert_header"].(*schema.Set).List()),
+	}
+}
+
+func expandWafv2CustomResponse(l []interface{}) *wafv2.CustomResponse {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	customResponse := &wafv2.CustomResponse{
+		ResponseCode: aws.Int64(int64(m["response_code"].(int))),
+	}
+
+	if v, ok := m["custom_response_body_key"].(string); ok && v != "" {
+		customResponse.CustomResponseBodyKey = aws.String(v)
+	}
+
+	if v, ok := m["response_header"].(*schema.Set); ok && v.Len() > 0 {
+		customResponse.ResponseHeaders = expandWafv2CustomHTTPHeaders(v.List())
+	}
+
+	return customResponse
+}
+
+func expandWafv2CustomHTTPHeaders(l []interface{}) []*wafv2.CustomHTTPHeader {
+	if len(l) == 0 {
+		return nil
+	}
+
+	headers := make([]*wafv2.CustomHTTPHeader, 0)
+
+	for _, header := range l {
+		if header == nil {
+			continue
+		}
+		m := header.(map[string]interface{})
+
+		headers = append(headers, &wafv2.CustomHTTPHeader{
+			Name:  aws.String(m["name"].(string)),
+			Value: aws.String(m["value"].(string)),
+		})
+	}
+
+	return headers
+}
+
+func expandWafv2CustomResponseBodies(l []interface{}) map[string]*wafv2.CustomResponseBody {
+	if len(l) == 0 {
+		return nil
+	}
+
+	customResponseBodies := make(map[string]*wafv2.CustomResponseBody, len(l))
+
+	for _, body := range l {
+		if body == nil {
+			continue
+		}
+		m := body.(map[string]interface{})
+
+		customResponseBodies[m["key"].(string)] = &wafv2.CustomResponseBody{
+			Content:     aws.String(m["content"].(string)),
+			ContentType: aws.String(m["content_type"].(string)),
+		}
+	}
+
+	return customResponseBodies
+}
+
 func expandWafv2VisibilityConfig(l []interface{}) *wafv2.VisibilityConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -850,20 +1111,117 @@ func flattenWafv2RuleAction(a *wafv2.RuleAction) interface{} {
 	m := map[string]interface{}{}
 
 	if a.Allow != nil {
-		m["allow"] = make([]map[string]interface{}, 1)
+		m["allow"] = flattenWafv2AllowAction(a.Allow)
 	}
 
 	if a.Block != nil {
-		m["block"] = make([]map[string]interface{}, 1)
+		m["block"] = flattenWafv2BlockAction(a.Block)
 	}
 
 	if a.Count != nil {
-		m["count"] = make([]map[string]interface{}, 1)
+		m["count"] = flattenWafv2CountAction(a.Count)
 	}
 
 	return []interface{}{m}
 }
 
+func flattenWafv2AllowAction(a *wafv2.AllowAction) []interface{} {
+	if a == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if a.CustomRequestHandling != nil {
+		m["custom_request_handling"] = flattenWafv2CustomRequestHandling(a.CustomRequestHandling)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWafv2BlockAction(a *wafv2.BlockAction) []interface{} {
+	if a == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if a.CustomResponse != nil {
+		m["custom_response"] = flattenWafv2CustomResponse(a.CustomResponse)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWafv2CountAction(a *wafv2.CountAction) []interface{} {
+	if a == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if a.CustomRequestHandling != nil {
+		m["custom_request_handling"] = flattenWafv2CustomRequestHandling(a.CustomRequestHandling)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWafv2CustomRequestHandling(c *wafv2.CustomRequestHandling) []interface{} {
+	if c == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"insert_header": flattenWafv2CustomHTTPHeaders(c.InsertHeaders),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWafv2CustomResponse(r *wafv2.CustomResponse) []interface{} {
+	if r == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"custom_response_body_key": aws.StringValue(r.CustomResponseBodyKey),
+		"response_code":            int(aws.Int64Value(r.ResponseCode)),
+		"response_header":          flattenWafv2CustomHTTPHeaders(r.ResponseHeaders),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWafv2CustomHTTPHeaders(h []*wafv2.CustomHTTPHeader) []interface{} {
+	out := make([]interface{}, len(h))
+	for i, header := range h {
+		out[i] = map[string]interface{}{
+			"name":  aws.StringValue(header.Name),
+			"value": aws.StringValue(header.Value),
+		}
+	}
+
+	return out
+}
+
+func flattenWafv2CustomResponseBodies(b map[string]*wafv2.CustomResponseBody) []interface{} {
+	if len(b) == 0 {
+		return []interface{}{}
+	}
+
+	out := make([]interface{}, 0, len(b))
+	for key, body := range b {
+		out = append(out, map[string]interface{}{
+			"key":          key,
+			"content":      aws.StringValue(body.Content),
+			"content_type": aws.StringValue(body.ContentType),
+		})
+	}
+
+	return out
+}
+
 func flattenWafv2RootStatement(s *wafv2.Statement) interface{} {
 	if s == nil {
 		return []interface{}{}
@@ -1,9 +1,9 @@
 module github.com/terraform-providers/terraform-provider-aws
 
-go 1.15
+go 1.19
 
 require (
-	github.com/aws/aws-sdk-go v1.36.19
+	github.com/aws/aws-sdk-go v1.47.13
 	github.com/beevik/etree v1.1.0
 	github.com/fatih/color v1.9.0 // indirect
 	github.com/hashicorp/aws-sdk-go-base v0.7.0
@@ -19,6 +19,7 @@ variable "service_labels" {
     "applicationdiscoveryservice",
     "applicationinsights",
     "appmesh",
+    "apprunner",
     "appstream",
     "appsync",
     "athena",
@@ -103,6 +104,7 @@ variable "service_labels" {
     "identitystore",
     "imagebuilder",
     "inspector",
+    "inspector2",
     "iot",
     "iotanalytics",
     "iotevents",
@@ -126,6 +128,7 @@ variable "service_labels" {
     "macie",
     "macie2",
     "managedblockchain",
+    "managedgrafana",
     "marketplacecatalog",
     "mediaconnect",
     "mediaconvert",
@@ -134,6 +137,7 @@ variable "service_labels" {
     "mediapackagevod",
     "mediastore",
     "mediatailor",
+    "memorydb",
     "meteringmarketplace",
     "mobile",
     "mq",
@@ -151,6 +155,7 @@ variable "service_labels" {
     "pinpointsmsvoice",
     "polly",
     "pricing",
+    "prometheusservice",
     "qldb",
     "quicksight",
     "ram",